@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"github.com/roboco-io/ghx-cli/internal/api"
+)
+
+// Exit codes returned by ghx
+const (
+	ExitOK          = 0
+	ExitError       = 1
+	ExitNotFound    = 3
+	ExitAuth        = 4
+	ExitRateLimited = 5
+	ExitValidation  = 6
+	ExitNetwork     = 7
+	ExitServer      = 8
+)
+
+// ExitCode maps an error returned by a command to a process exit code
+func ExitCode(err error) int {
+	if err == nil {
+		return ExitOK
+	}
+
+	apiErr, ok := api.AsError(err)
+	if !ok {
+		return ExitError
+	}
+
+	switch apiErr.Type {
+	case api.ErrorTypeNotFound:
+		return ExitNotFound
+	case api.ErrorTypeUnauthorized, api.ErrorTypeForbidden, api.ErrorTypeInsufficientScopes:
+		return ExitAuth
+	case api.ErrorTypeRateLimited:
+		return ExitRateLimited
+	case api.ErrorTypeValidation:
+		return ExitValidation
+	case api.ErrorTypeNetwork:
+		return ExitNetwork
+	case api.ErrorTypeServer:
+		return ExitServer
+	case api.ErrorTypeUnknown:
+		return ExitError
+	default:
+		return ExitError
+	}
+}
+
+// ErrorHint returns an actionable suggestion for an error, or an empty string
+func ErrorHint(err error) string {
+	apiErr, ok := api.AsError(err)
+	if !ok {
+		return ""
+	}
+	return apiErr.Hint()
+}
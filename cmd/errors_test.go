@@ -0,0 +1,34 @@
+package cmd
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+)
+
+func TestExitCode(t *testing.T) {
+	t.Run("Typed API errors map to distinct exit codes", func(t *testing.T) {
+		assert.Equal(t, ExitOK, ExitCode(nil))
+		assert.Equal(t, ExitError, ExitCode(fmt.Errorf("plain error")))
+		assert.Equal(t, ExitNotFound, ExitCode(&api.Error{Type: api.ErrorTypeNotFound}))
+		assert.Equal(t, ExitAuth, ExitCode(&api.Error{Type: api.ErrorTypeInsufficientScopes}))
+		assert.Equal(t, ExitRateLimited, ExitCode(&api.Error{Type: api.ErrorTypeRateLimited}))
+		assert.Equal(t, ExitNetwork, ExitCode(&api.Error{Type: api.ErrorTypeNetwork}))
+	})
+
+	t.Run("Wrapped API errors are recognized", func(t *testing.T) {
+		err := fmt.Errorf("failed to get project: %w", &api.Error{Type: api.ErrorTypeValidation})
+		assert.Equal(t, ExitValidation, ExitCode(err))
+	})
+}
+
+func TestErrorHint(t *testing.T) {
+	t.Run("Hint comes from the API error", func(t *testing.T) {
+		err := fmt.Errorf("failed: %w", &api.Error{Type: api.ErrorTypeInsufficientScopes, RequiredScopes: []string{"project"}})
+		assert.Contains(t, ErrorHint(err), "gh auth refresh -s project")
+		assert.Empty(t, ErrorHint(fmt.Errorf("plain error")))
+	})
+}
@@ -21,6 +21,9 @@ func main() {
 	// Execute the root command
 	if err := cmd.Execute(); err != nil {
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		if hint := cmd.ErrorHint(err); hint != "" {
+			fmt.Fprintf(os.Stderr, "Hint: %s\n", hint)
+		}
+		os.Exit(cmd.ExitCode(err))
 	}
 }
@@ -0,0 +1,583 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// runAgainstFake runs ghx with args against a fake GitHub API
+func runAgainstFake(t *testing.T, server *fake.Server, args ...string) error {
+	t.Helper()
+
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(api.EnvAPIURL, server.URL)
+	t.Setenv("GH_TOKEN", "ghp_fake")
+	t.Setenv("PATH", "")
+
+	cmd := NewRootCmd()
+	cmd.SetArgs(args)
+	cmd.SetOut(new(bytes.Buffer))
+	cmd.SetErr(new(bytes.Buffer))
+	return cmd.Execute()
+}
+
+// completeAgainstFake asks the CLI for shell completions of args the way the
+// generated completion scripts do and returns the candidates
+func completeAgainstFake(t *testing.T, server *fake.Server, home string, args ...string) []string {
+	t.Helper()
+
+	t.Setenv("HOME", home)
+	t.Setenv(api.EnvAPIURL, server.URL)
+	t.Setenv("GH_TOKEN", "ghp_fake")
+	t.Setenv("PATH", "")
+
+	var out bytes.Buffer
+	cmd := NewRootCmd()
+	cmd.SetArgs(append([]string{cobra.ShellCompRequestCmd}, args...))
+	cmd.SetOut(&out)
+	cmd.SetErr(new(bytes.Buffer))
+	require.NoError(t, cmd.Execute())
+
+	// The last line holds the completion directive
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	return lines[:len(lines)-1]
+}
+
+func TestCommandsAgainstFakeAPI(t *testing.T) {
+	t.Run("Project create and item add reach the API", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		issue := store.AddIssue(repo, "Fix login")
+		owner := store.Account(fake.DefaultViewer)
+
+		require.NoError(t, runAgainstFake(t, server, "project", "create", "Roadmap", "--owner-id", owner.ID))
+		project := store.Project(fake.DefaultViewer, 1)
+		require.NotNil(t, project)
+		assert.Equal(t, "Roadmap", project.Title)
+
+		require.NoError(t, runAgainstFake(t, server, "item", "add", "octocat/1", "octocat/app#1"))
+		require.Len(t, project.Items, 1)
+		assert.Equal(t, issue, project.Items[0].Issue)
+	})
+
+	t.Run("Project apply converges on the spec", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		project := server.Store.AddProject(fake.DefaultViewer, "Draft")
+		specFile := filepath.Join(t.TempDir(), "project.yaml")
+		spec := "title: Roadmap\nfields:\n  - name: Estimate\n    type: number\n"
+		require.NoError(t, os.WriteFile(specFile, []byte(spec), 0o600))
+
+		require.NoError(t, runAgainstFake(t, server, "project", "apply", "octocat/1", "-f", specFile, "--force"))
+		assert.Equal(t, "Roadmap", project.Title)
+		require.NotNil(t, project.Field("Estimate"))
+	})
+
+	t.Run("Project edit updates README, description and visibility", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		project := server.Store.AddProject(fake.DefaultViewer, "Roadmap")
+		dir := t.TempDir()
+		readmeFile := filepath.Join(dir, "README.md")
+		require.NoError(t, os.WriteFile(readmeFile, []byte("# Roadmap\n"), 0o600))
+
+		require.NoError(t, runAgainstFake(t, server, "project", "edit", "octocat/1",
+			"--readme-file", readmeFile, "--description", "What we ship", "--visibility", "public"))
+		assert.Equal(t, "# Roadmap\n", project.Readme)
+		assert.Equal(t, "What we ship", project.ShortDescription)
+		assert.True(t, project.Public)
+
+		editor := filepath.Join(dir, "editor.sh")
+		require.NoError(t, os.WriteFile(editor, []byte("#!/bin/sh\necho 'Updated weekly.' >> \"$1\"\n"), 0o700)) //nolint:gosec // the editor must be executable
+		t.Setenv("VISUAL", "")
+		t.Setenv("EDITOR", editor)
+
+		require.NoError(t, runAgainstFake(t, server, "project", "edit", "octocat/1", "--edit-readme"))
+		assert.Equal(t, "# Roadmap\nUpdated weekly.\n", project.Readme)
+
+		err := runAgainstFake(t, server, "project", "edit", "octocat/1", "--readme", "x", "--edit-readme")
+		assert.ErrorContains(t, err, "only one of")
+	})
+
+	t.Run("Project link, link list and unlink", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		org := store.AddOrganization("acme")
+		store.AddTeam(org, "platform")
+		store.AddRepository("acme", "app")
+		project := store.AddProject("acme", "Roadmap")
+
+		require.NoError(t, runAgainstFake(t, server, "project", "link", "acme/1", "--team", "acme/platform"))
+		require.NoError(t, runAgainstFake(t, server, "project", "link", "acme/1", "--repo", "acme/app"))
+		require.Len(t, project.Teams, 1)
+		require.Len(t, project.Repositories, 1)
+
+		require.NoError(t, runAgainstFake(t, server, "project", "link", "list", "acme/1", "--format", "json"))
+
+		require.NoError(t, runAgainstFake(t, server, "project", "unlink", "acme/1", "--team", "acme/platform"))
+		assert.Empty(t, project.Teams)
+
+		err := runAgainstFake(t, server, "project", "unlink", "acme/1")
+		assert.ErrorContains(t, err, "either --repo or --team")
+	})
+
+	t.Run("Project status updates are posted, edited and shown", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+		status := project.Field("Status")
+		item := store.AddItem(project, store.AddIssue(repo, "Ship login"))
+		store.SetValue(item, status, fake.Value{OptionID: status.Option("Done").ID})
+
+		require.NoError(t, runAgainstFake(t, server, "project", "status-update", "create", "octocat/1",
+			"--status", "at-risk", "--body", "Waiting on review", "--target-date", "2026-06-30"))
+		require.NoError(t, runAgainstFake(t, server, "project", "status-update", "create", "octocat/1",
+			"--generate", "--body", "Weekly update"))
+		require.Len(t, project.StatusUpdates, 2)
+		generated := project.StatusUpdates[1]
+		assert.Equal(t, "COMPLETE", generated.Status)
+		assert.Contains(t, generated.Body, "Weekly update\n\n## Summary")
+		assert.Contains(t, generated.Body, "- Ship login")
+
+		require.NoError(t, runAgainstFake(t, server, "project", "status-update", "edit", generated.ID, "--status", "on-track"))
+		assert.Equal(t, "ON_TRACK", generated.Status)
+		require.NoError(t, runAgainstFake(t, server, "project", "status-update", "list", "octocat/1"))
+		require.NoError(t, runAgainstFake(t, server, "project", "view", "octocat/1", "--format", "json"))
+
+		require.NoError(t, runAgainstFake(t, server, "project", "status-update", "delete", generated.ID, "--force"))
+		assert.Len(t, project.StatusUpdates, 1)
+
+		err := runAgainstFake(t, server, "project", "status-update", "create", "octocat/1", "--status", "green")
+		assert.ErrorContains(t, err, "invalid status")
+		err = runAgainstFake(t, server, "project", "status-update", "edit", project.StatusUpdates[0].ID)
+		assert.ErrorContains(t, err, "nothing to update")
+	})
+
+	t.Run("Project sync adds search matches once", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		bug := store.AddLabel(repo, "bug", "d73a4a")
+		issue := store.AddIssue(repo, "Crash on start")
+		issue.Labels = append(issue.Labels, bug)
+		store.AddIssue(repo, "Add dark mode")
+		project := store.AddProject(fake.DefaultViewer, "Bugs")
+
+		args := []string{"project", "sync", "octocat/1", "--query", "repo:octocat/app label:bug", "--set", "Status=Todo"}
+		require.NoError(t, runAgainstFake(t, server, append(args, "--dry-run")...))
+		assert.Empty(t, project.Items)
+
+		require.NoError(t, runAgainstFake(t, server, args...))
+		require.NoError(t, runAgainstFake(t, server, append(args, "--format", "json")...))
+		require.Len(t, project.Items, 1)
+		status := project.Field("Status")
+		assert.Equal(t, status.Option("Todo").ID, project.Items[0].Values[status.ID].OptionID)
+
+		err := runAgainstFake(t, server, "project", "sync", "octocat/1", "--query", "label:bug", "--set", "Status")
+		assert.ErrorContains(t, err, "expected Field=Value")
+	})
+
+	t.Run("Item convert turns drafts into issues", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		store.AddLabel(repo, "bug", "d73a4a")
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+		status := project.Field("Status")
+		first := store.AddDraftIssue(project, "Fix crash", "")
+		store.SetValue(first, status, fake.Value{OptionID: status.Option("Todo").ID})
+		second := store.AddDraftIssue(project, "Write docs", "")
+		third := store.AddDraftIssue(project, "Plan release", "")
+
+		require.NoError(t, runAgainstFake(t, server, "item", "convert", "octocat/1", first.ID, "--repo", "octocat/app", "--label", "bug"))
+		require.NotNil(t, first.Issue)
+		assert.Equal(t, "bug", first.Issue.Labels[0].Name)
+		assert.Equal(t, status.Option("Todo").ID, first.Values[status.ID].OptionID)
+
+		require.NoError(t, runAgainstFake(t, server, "item", "convert-bulk", "octocat/1", "--repo", "octocat/app", "--filter", "docs"))
+		assert.NotNil(t, second.Issue)
+		assert.Nil(t, third.Issue)
+
+		err := runAgainstFake(t, server, "item", "convert", "octocat/1", third.ID, "--repo", "octocat/app", "--label", "feature")
+		assert.ErrorContains(t, err, "label feature not found")
+	})
+
+	t.Run("Item move and reorder change the manual order", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		project := store.AddProject(fake.DefaultViewer, "Backlog")
+		priority := store.AddField(project, "Priority", "SINGLE_SELECT", "High", "Low")
+		low := store.AddDraftIssue(project, "Polish", "")
+		store.SetValue(low, priority, fake.Value{OptionID: priority.Option("Low").ID})
+		high := store.AddDraftIssue(project, "Fix crash", "")
+		store.SetValue(high, priority, fake.Value{OptionID: priority.Option("High").ID})
+
+		require.NoError(t, runAgainstFake(t, server, "item", "move", "octocat/1", high.ID, "--top"))
+		assert.Equal(t, []*fake.Item{high, low}, project.Items)
+		require.NoError(t, runAgainstFake(t, server, "item", "move", "octocat/1", high.ID, "--after", low.ID))
+		assert.Equal(t, []*fake.Item{low, high}, project.Items)
+
+		require.NoError(t, runAgainstFake(t, server, "item", "reorder", "octocat/1", "--by", "Priority"))
+		assert.Equal(t, []*fake.Item{high, low}, project.Items)
+		require.NoError(t, runAgainstFake(t, server, "item", "list", "--project", "octocat/1"))
+
+		err := runAgainstFake(t, server, "item", "move", "octocat/1", high.ID)
+		assert.ErrorContains(t, err, "either --after or --top")
+	})
+
+	t.Run("Sub-issues are linked, shown and added with their epic", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		epic := store.AddIssue(repo, "Epic")
+		login := store.AddIssue(repo, "Login")
+		oauth := store.AddIssue(repo, "OAuth")
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+
+		require.NoError(t, runAgainstFake(t, server, "item", "sub-issue", "add", "octocat/app#1", "octocat/app#2"))
+		require.NoError(t, runAgainstFake(t, server, "item", "sub-issue", "add", "octocat/app#2", "octocat/app#3"))
+		assert.Equal(t, []*fake.Issue{login}, epic.SubIssues)
+		assert.Equal(t, login, oauth.Parent)
+		require.NoError(t, runAgainstFake(t, server, "item", "view", "octocat/app#1"))
+
+		require.NoError(t, runAgainstFake(t, server, "item", "add", "octocat/1", "octocat/app#1", "--with-sub-issues"))
+		assert.Len(t, project.Items, 3)
+		require.NoError(t, runAgainstFake(t, server, "item", "list", "--project", "octocat/1", "--tree"))
+
+		require.NoError(t, runAgainstFake(t, server, "item", "sub-issue", "remove", "octocat/app#2", "octocat/app#3"))
+		assert.Nil(t, oauth.Parent)
+		err := runAgainstFake(t, server, "item", "list", "--tree")
+		assert.ErrorContains(t, err, "--tree requires --project")
+	})
+
+	t.Run("View render shows a view in every format", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+		store.AddField(project, "Due", "DATE")
+		store.AddDraftIssue(project, "Write docs", "")
+		store.AddView(project, "Board", "BOARD_LAYOUT")
+		store.AddView(project, "Timeline", "ROADMAP_LAYOUT")
+
+		for _, format := range []string{"table", "markdown", "csv", "json"} {
+			require.NoError(t, runAgainstFake(t, server, "view", "render", "octocat/1", "1", "--format", format))
+		}
+		require.NoError(t, runAgainstFake(t, server, "view", "render", "octocat/1", "board"))
+		require.NoError(t, runAgainstFake(t, server, "view", "render", "octocat/1", "Timeline", "--format", "markdown"))
+
+		err := runAgainstFake(t, server, "view", "render", "octocat/1", "Backlog")
+		assert.ErrorContains(t, err, "view Backlog not found")
+		err = runAgainstFake(t, server, "view", "render", "octocat/1", "Board", "--format", "xml")
+		assert.ErrorContains(t, err, "unknown format: xml")
+	})
+
+	t.Run("Field convert migrates values to a new field", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		project := store.AddProject(fake.DefaultViewer, "Backlog")
+		priority := store.AddField(project, "Priority", "TEXT")
+		item := store.AddDraftIssue(project, "Fix crash", "")
+		store.SetValue(item, priority, fake.Value{Text: "P1"})
+
+		require.NoError(t, runAgainstFake(t, server, "field", "convert", "Priority", "--project", "octocat/1",
+			"--to", "single_select", "--map", "P1=High", "--dry-run"))
+		assert.Nil(t, project.Field("Priority (Single Select)"))
+
+		require.NoError(t, runAgainstFake(t, server, "field", "convert", "Priority", "--project", "octocat/1",
+			"--to", "single_select", "--map", "P1=High", "--replace", "--force"))
+		field := project.Field("Priority")
+		require.NotNil(t, field)
+		assert.Equal(t, "SINGLE_SELECT", field.DataType)
+		assert.Equal(t, field.Option("High").ID, item.Values[field.ID].OptionID)
+
+		err := runAgainstFake(t, server, "field", "convert", "Priority", "--project", "octocat/1", "--to", "color")
+		assert.ErrorContains(t, err, "invalid field type")
+	})
+
+	t.Run("Field option commands merge, reorder and sync options", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		project := store.AddProject(fake.DefaultViewer, "Backlog")
+		status := project.Field("Status")
+		store.AddOption(status, "In Review")
+		review := store.AddOption(status, "Review")
+		item := store.AddDraftIssue(project, "Fix crash", "")
+		store.SetValue(item, status, fake.Value{OptionID: status.Option("In Review").ID})
+
+		require.NoError(t, runAgainstFake(t, server, "field", "merge-option", "Status", "--project", "octocat/1",
+			"--from", "In Review", "--into", "Review"))
+		assert.Nil(t, status.Option("In Review"))
+		assert.Equal(t, review.ID, item.Values[status.ID].OptionID)
+
+		require.NoError(t, runAgainstFake(t, server, "field", "reorder-options", "Status", "Review", "Done",
+			"--project", "octocat/1"))
+		assert.Equal(t, "Review", status.Options[0].Name)
+
+		file := filepath.Join(t.TempDir(), "status.yaml")
+		require.NoError(t, os.WriteFile(file, []byte(`options:
+  - name: Todo
+  - name: In Review
+    renamedFrom: Review
+    color: yellow
+  - name: Done
+`), 0o600))
+		require.NoError(t, runAgainstFake(t, server, "field", "set-options", "Status", "-f", file,
+			"--project", "octocat/1", "--force"))
+		require.Len(t, status.Options, 3)
+		assert.Equal(t, "In Review", review.Name)
+		assert.Equal(t, "YELLOW", review.Color)
+		assert.Equal(t, review.ID, item.Values[status.ID].OptionID)
+
+		err := runAgainstFake(t, server, "field", "merge-option", "Status", "--project", "octocat/1",
+			"--from", "Todo", "--into", "Blocked")
+		assert.ErrorContains(t, err, "option 'Blocked' not found")
+	})
+
+	t.Run("Analytics report renders a template and publishes it", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		store.AddDiscussionCategory(repo, "Announcements", false)
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+		issue := store.AddIssue(repo, "Fix login")
+		issue.Closed = true
+		store.AddItem(project, issue)
+		store.AddDraftIssue(project, "Write docs", "")
+
+		dir := t.TempDir()
+		template := filepath.Join(dir, "weekly.md.tmpl")
+		output := filepath.Join(dir, "weekly.md")
+		require.NoError(t, os.WriteFile(template, []byte("{{.Project.Title}}: {{.Done}}/{{.Total}}"), 0o600))
+		require.NoError(t, runAgainstFake(t, server, "analytics", "report", "octocat/1",
+			"--template", template, "--output", output))
+		data, err := os.ReadFile(output)
+		require.NoError(t, err)
+		assert.Equal(t, "Roadmap: 1/2", string(data))
+
+		require.NoError(t, runAgainstFake(t, server, "analytics", "report", "octocat/1",
+			"--publish", "octocat/app", "--category", "announcements", "--title", "Weekly"))
+		require.Len(t, repo.Discussions, 1)
+		assert.Equal(t, "Weekly", repo.Discussions[0].Title)
+		assert.Contains(t, repo.Discussions[0].Body, "1 of 2 items done (50%)")
+
+		err = runAgainstFake(t, server, "analytics", "report", "octocat/1", "--publish", "app")
+		assert.ErrorContains(t, err, "invalid repository format")
+	})
+
+	t.Run("Analytics burndown writes SVG and CSV charts", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+		sprint := store.AddField(project, "Sprint", "ITERATION")
+		for _, title := range []string{"Fix login", "Write docs"} {
+			item := store.AddItem(project, store.AddIssue(repo, title))
+			store.SetValue(item, sprint, fake.Value{IterationID: sprint.Iterations[0].ID})
+		}
+
+		dir := t.TempDir()
+		svg := filepath.Join(dir, "burnup.svg")
+		require.NoError(t, runAgainstFake(t, server, "analytics", "burndown", "octocat/1",
+			"--chart", "burnup", "--format", "svg", "--output", svg))
+		data, err := os.ReadFile(svg)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "<svg")
+
+		csv := filepath.Join(dir, "burndown.csv")
+		require.NoError(t, runAgainstFake(t, server, "analytics", "burndown", "octocat/1",
+			"--format", "csv", "--output", csv))
+		data, err = os.ReadFile(csv)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(string(data), "date,scope,completed,remaining,ideal\n"))
+
+		err = runAgainstFake(t, server, "analytics", "burndown", "octocat/1", "--chart", "pie")
+		assert.ErrorContains(t, err, "invalid chart: pie")
+	})
+
+	t.Run("Serve send-webhook delivers signed payloads", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+		t.Setenv(api.EnvAPIURL, server.URL)
+
+		store := server.Store
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+		issue := store.AddIssue(repo, "Fix login")
+
+		config, err := service.ParseWebhookConfig([]byte("handlers:\n  - events: [issues]\n    addToProject: octocat/1\n"))
+		require.NoError(t, err)
+		receiver, err := service.NewWebhookReceiver(context.Background(), api.NewClient("ghp_fake"), config, "s3cret",
+			slog.New(slog.NewTextHandler(io.Discard, nil)))
+		require.NoError(t, err)
+		hooks := httptest.NewServer(receiver)
+		defer hooks.Close()
+
+		payload := filepath.Join(t.TempDir(), "issue.json")
+		require.NoError(t, os.WriteFile(payload, []byte(`{"action":"opened","issue":{"node_id":"`+issue.ID+`"}}`), 0o600))
+		require.NoError(t, runAgainstFake(t, server, "serve", "send-webhook", payload,
+			"--event", "issues", "--url", hooks.URL, "--secret", "s3cret", "--delivery", "d-1"))
+		receiver.Wait()
+		require.Len(t, project.Items, 1)
+		assert.Same(t, issue, project.Items[0].Issue)
+
+		err = runAgainstFake(t, server, "serve", "send-webhook", payload,
+			"--event", "issues", "--url", hooks.URL, "--secret", "wrong")
+		assert.ErrorContains(t, err, "webhook server answered 401 Unauthorized")
+	})
+
+	t.Run("MCP tools checks the allowlist", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		require.NoError(t, runAgainstFake(t, server, "mcp", "tools", "--read-only", "--tools", "view_project,add_item"))
+
+		err := runAgainstFake(t, server, "mcp", "tools", "--tools", "view_project,drop_project")
+		assert.ErrorContains(t, err, "unknown tool: drop_project")
+
+		err = runAgainstFake(t, server, "mcp", "serve", "--read-only", "--tools", "add_item")
+		assert.ErrorContains(t, err, "no tools enabled")
+	})
+
+	t.Run("Completion looks up and caches projects, fields and categories", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+		store.AddField(project, "Points", "NUMBER")
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		store.AddDiscussionCategory(repo, "Ideas", false)
+
+		home := t.TempDir()
+		assert.Equal(t, []string{"octocat/1\tRoadmap"}, completeAgainstFake(t, server, home, "project", "view", "octocat/"))
+		assert.Equal(t, []string{"Todo", "In Progress", "Done"},
+			completeAgainstFake(t, server, home, "item", "edit", "octocat/1", "PVTI_1", "--field", "Status", "--value", ""))
+		assert.Equal(t, []string{"Points\tNumber"}, completeAgainstFake(t, server, home, "analytics", "burndown", "octocat/1", "--estimate", ""))
+		assert.Equal(t, []string{"ideas\tIdeas"}, completeAgainstFake(t, server, home, "discussion", "create", "octocat/app", "--category", ""))
+
+		// Candidates are cached until they expire or --no-cache is given
+		store.AddProject(fake.DefaultViewer, "Launch")
+		assert.Len(t, completeAgainstFake(t, server, home, "item", "add", "octocat/"), 1)
+		assert.Len(t, completeAgainstFake(t, server, home, "item", "add", "--no-cache", "octocat/"), 2)
+	})
+
+	t.Run("Commands take names and issue references instead of node IDs", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+		store.AddView(project, "Board", "BOARD_LAYOUT")
+		store.AddView(project, "Triage", "TABLE_LAYOUT")
+		store.AddView(project, "triage", "BOARD_LAYOUT")
+		priority := store.AddField(project, "Priority", "SINGLE_SELECT", "High", "Low")
+		status := project.Field("Status")
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		issue := store.AddIssue(repo, "Login fails")
+		pr := store.AddPullRequest(repo, "Fix login")
+		issueItem := store.AddItem(project, issue)
+		prItem := store.AddItem(project, pr)
+
+		require.NoError(t, runAgainstFake(t, server, "item", "edit", "octocat/1", "octocat/app#1", "--field", "status", "--value", "Done"))
+		assert.Equal(t, status.Option("Done").ID, issueItem.Values[status.ID].OptionID)
+		require.NoError(t, runAgainstFake(t, server, "item", "move", "octocat/1", "https://github.com/octocat/app/pull/2", "--top"))
+		assert.Equal(t, []*fake.Item{prItem, issueItem}, project.Items)
+
+		require.NoError(t, runAgainstFake(t, server, "view", "copy", "Board", "Board Copy", "--project", "octocat/1"))
+		require.NoError(t, runAgainstFake(t, server, "view", "delete", "#2", "--project", "octocat/1", "--force"))
+		var views []string
+		for _, view := range project.Views {
+			views = append(views, view.Name)
+		}
+		assert.Equal(t, []string{"View 1", "Triage", "triage", "Board Copy"}, views)
+
+		require.NoError(t, runAgainstFake(t, server, "field", "delete-option", "Priority:Low", "--project", "octocat/1", "--force"))
+		assert.Nil(t, priority.Option("Low"))
+
+		err := runAgainstFake(t, server, "view", "delete", "TRIAGE", "--project", "octocat/1", "--force")
+		assert.ErrorContains(t, err, `view "TRIAGE" is ambiguous`)
+		err = runAgainstFake(t, server, "view", "delete", "Triage", "--force")
+		assert.ErrorContains(t, err, "--project owner/number")
+		err = runAgainstFake(t, server, "item", "remove", "octocat/1", "octocat/app#9", "--force")
+		assert.ErrorContains(t, err, "octocat/app#9")
+	})
+
+	t.Run("Item view shows projects and activity, item edit takes --project", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		store := server.Store
+		project := store.AddProject(fake.DefaultViewer, "Roadmap")
+		status := project.Field("Status")
+		repo := store.AddRepository(fake.DefaultViewer, "app")
+		issue := store.AddIssue(repo, "Login fails")
+		pr := store.AddPullRequest(repo, "Fix login")
+		item := store.AddItem(project, issue)
+		store.SetValue(item, status, fake.Value{OptionID: status.Option("Todo").ID})
+		store.AddIssueComment(issue, "Reproduced on Safari")
+		store.LinkClosingPullRequest(pr, issue)
+
+		for _, format := range []string{"details", "json"} {
+			require.NoError(t, runAgainstFake(t, server, "item", "view", "octocat/app#1", "--comments", "--timeline", "--linked-prs", "--format", format))
+			require.NoError(t, runAgainstFake(t, server, "item", "view", "octocat/app#2", "--linked-prs", "--format", format))
+		}
+
+		require.NoError(t, runAgainstFake(t, server, "item", "edit", "octocat/app#1", "--project", "octocat/1", "--field", "Status", "--value", "Done"))
+		assert.Equal(t, status.Option("Done").ID, item.Values[status.ID].OptionID)
+
+		err := runAgainstFake(t, server, "item", "edit", "octocat/app#1", "--field", "Status", "--value", "Done")
+		assert.ErrorContains(t, err, "<item> with --project")
+	})
+
+	t.Run("Missing projects surface typed errors", func(t *testing.T) {
+		server := fake.NewServer()
+		defer server.Close()
+
+		err := runAgainstFake(t, server, "project", "view", "octocat/7")
+		require.Error(t, err)
+		assert.Equal(t, ExitNotFound, ExitCode(err))
+	})
+}
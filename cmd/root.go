@@ -3,16 +3,21 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/roboco-io/ghx-cli/internal/api"
+	authpkg "github.com/roboco-io/ghx-cli/internal/auth"
 	"github.com/roboco-io/ghx-cli/internal/cmd/analytics"
 	"github.com/roboco-io/ghx-cli/internal/cmd/auth"
 	"github.com/roboco-io/ghx-cli/internal/cmd/discussion"
 	"github.com/roboco-io/ghx-cli/internal/cmd/field"
 	"github.com/roboco-io/ghx-cli/internal/cmd/item"
+	"github.com/roboco-io/ghx-cli/internal/cmd/mcp"
 	"github.com/roboco-io/ghx-cli/internal/cmd/project"
+	"github.com/roboco-io/ghx-cli/internal/cmd/serve"
 	"github.com/roboco-io/ghx-cli/internal/cmd/view"
 )
 
@@ -44,6 +49,8 @@ It provides complete control over GitHub features including:
 - View management (table, board, roadmap)
 - Discussion management (create, comment, answer)
 - Automation workflows
+- Webhook handlers
+- MCP tools for AI assistants
 - Reporting and analytics
 - Bulk operations
 
@@ -53,11 +60,14 @@ Example:
   ghx discussion list owner/repo
   ghx discussion create owner/repo --category ideas`,
 		Version: fmt.Sprintf("%s (commit: %s, built: %s)", version, commit, buildTime),
+		// Errors are printed by main together with a hint and a typed exit code
+		SilenceErrors: true,
 	}
 
 	// Add persistent flags
 	cmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.ghx.yaml)")
 	cmd.PersistentFlags().String("token", "", "GitHub Personal Access Token")
+	cmd.PersistentFlags().String("profile", "", "Authentication profile from the config file")
 	cmd.PersistentFlags().String("org", "", "GitHub organization")
 	cmd.PersistentFlags().String("user", "", "GitHub user")
 	cmd.PersistentFlags().String("format", "table", "Output format (table, json, yaml)")
@@ -66,6 +76,7 @@ Example:
 
 	// Bind flags to viper
 	_ = viper.BindPFlag("token", cmd.PersistentFlags().Lookup("token"))
+	_ = viper.BindPFlag("profile", cmd.PersistentFlags().Lookup("profile"))
 	_ = viper.BindPFlag("org", cmd.PersistentFlags().Lookup("org"))
 	_ = viper.BindPFlag("user", cmd.PersistentFlags().Lookup("user"))
 	_ = viper.BindPFlag("format", cmd.PersistentFlags().Lookup("format"))
@@ -78,7 +89,9 @@ Example:
 	cmd.AddCommand(discussion.NewDiscussionCmd())
 	cmd.AddCommand(field.NewFieldCmd())
 	cmd.AddCommand(item.NewItemCmd())
+	cmd.AddCommand(mcp.NewMCPCmd())
 	cmd.AddCommand(project.NewProjectCmd())
+	cmd.AddCommand(serve.NewServeCmd())
 	cmd.AddCommand(view.NewViewCmd())
 
 	// Initialize config
@@ -123,6 +136,7 @@ func initConfig() {
 
 	// Read in environment variables that match
 	viper.SetEnvPrefix("GHX")
+	viper.SetEnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_"))
 	viper.AutomaticEnv()
 
 	// If a config file is found, read it in
@@ -132,6 +146,11 @@ func initConfig() {
 		}
 	}
 
+	// Point API clients at the host of the active profile
+	if profile, err := authpkg.ActiveProfile(); err == nil && profile != nil {
+		api.SetAPIURL(profile.GraphQLURL())
+	}
+
 	// Check for GitHub token in environment if not set
 	if viper.GetString("token") == "" {
 		if token := os.Getenv("GITHUB_TOKEN"); token != "" {
@@ -1,11 +1,10 @@
 package api
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
+	"os"
 	"strings"
 	"time"
 
@@ -24,12 +23,39 @@ const (
 	DefaultTimeout = 30 * time.Second
 )
 
+// EnvAPIURL names the environment variable that overrides the GitHub API base URL,
+// e.g. to run against a local fake server. GraphQL requests go to <url>/graphql.
+const EnvAPIURL = "GHX_API_URL"
+
+// apiURL is the GraphQL endpoint used by newly created clients
+var apiURL = DefaultAPIURL
+
+// SetAPIURL sets the GraphQL endpoint used by clients created afterwards,
+// e.g. for a GitHub Enterprise Server host
+func SetAPIURL(url string) {
+	apiURL = url
+}
+
+// Endpoint returns the GraphQL endpoint newly created clients talk to
+func Endpoint() string {
+	return graphqlEndpoint()
+}
+
+// graphqlEndpoint returns the GraphQL endpoint, preferring the GHX_API_URL override
+func graphqlEndpoint() string {
+	if base := os.Getenv(EnvAPIURL); base != "" {
+		return strings.TrimSuffix(base, "/") + "/graphql"
+	}
+	return apiURL
+}
+
 // Client is a GraphQL client for GitHub API
 type Client struct {
 	httpClient    *http.Client
 	graphqlClient *graphql.Client
 	rateLimiter   *RateLimiter
 	retryConfig   *RetryConfig
+	tokenSource   oauth2.TokenSource
 	token         string
 	baseURL       string
 }
@@ -47,12 +73,6 @@ type RateLimiter struct {
 	requestsPerSecond int
 }
 
-// GraphQLRequest represents a GraphQL request
-type GraphQLRequest struct {
-	Variables map[string]interface{} `json:"variables,omitempty"`
-	Query     string                 `json:"query"`
-}
-
 // GraphQLResponse represents a GraphQL response
 type GraphQLResponse struct {
 	Data   interface{}    `json:"data"`
@@ -61,9 +81,11 @@ type GraphQLResponse struct {
 
 // GraphQLError represents a GraphQL error
 type GraphQLError struct {
-	Message   string                 `json:"message"`
-	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
-	Path      []interface{}          `json:"path,omitempty"`
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+	Type       string                 `json:"type,omitempty"`
+	Message    string                 `json:"message"`
+	Locations  []GraphQLErrorLocation `json:"locations,omitempty"`
+	Path       []interface{}          `json:"path,omitempty"`
 }
 
 // GraphQLErrorLocation represents the location of a GraphQL error
@@ -74,19 +96,38 @@ type GraphQLErrorLocation struct {
 
 // NewClient creates a new GraphQL client for GitHub API
 func NewClient(token string) *Client {
-	// Create GraphQL client with authentication
-	src := oauth2.StaticTokenSource(
+	client := newClient(oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
-	)
-	httpClient := oauth2.NewClient(context.Background(), src)
+	))
+	client.token = token
+	return client
+}
+
+// NewClientWithTokenSource creates a new GraphQL client whose token is obtained from src
+// before each request, so short-lived tokens such as GitHub App installation tokens are
+// refreshed automatically
+func NewClientWithTokenSource(src oauth2.TokenSource) *Client {
+	client := newClient(src)
+	client.tokenSource = src
+	return client
+}
+
+// newClient creates a client that authenticates requests with tokens from src
+func newClient(src oauth2.TokenSource) *Client {
+	httpClient := &http.Client{
+		Timeout: DefaultTimeout,
+		Transport: &errorTransport{
+			base: &oauth2.Transport{Source: src, Base: http.DefaultTransport},
+		},
+	}
 
-	graphqlClient := graphql.NewClient(DefaultAPIURL, httpClient)
+	endpoint := graphqlEndpoint()
+	graphqlClient := graphql.NewClient(endpoint, httpClient)
 
 	return &Client{
 		httpClient:    httpClient,
 		graphqlClient: graphqlClient,
-		token:         token,
-		baseURL:       DefaultAPIURL,
+		baseURL:       endpoint,
 		rateLimiter: &RateLimiter{
 			requestsPerSecond: DefaultRateLimit,
 		},
@@ -100,7 +141,7 @@ func NewClient(token string) *Client {
 
 // HealthCheck validates the connection to GitHub API
 func (c *Client) HealthCheck(ctx context.Context) error {
-	if c.token == "" {
+	if c.token == "" && c.tokenSource == nil {
 		return fmt.Errorf("authentication token is required")
 	}
 
@@ -111,7 +152,7 @@ func (c *Client) HealthCheck(ctx context.Context) error {
 		}
 	}
 
-	err := c.graphqlClient.Query(ctx, &query, nil)
+	err := normalizeError(c.graphqlClient.Query(ctx, &query, nil))
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
@@ -126,7 +167,7 @@ func (c *Client) Query(ctx context.Context, query interface{}, variables map[str
 
 	// Execute query with retry logic
 	return c.retryOperation(func() error {
-		return c.graphqlClient.Query(ctx, query, variables)
+		return normalizeError(c.graphqlClient.Query(ctx, query, variables))
 	})
 }
 
@@ -137,7 +178,7 @@ func (c *Client) Mutate(ctx context.Context, mutation interface{}, variables map
 
 	// Execute mutation with retry logic
 	return c.retryOperation(func() error {
-		return c.graphqlClient.Mutate(ctx, mutation, variables)
+		return normalizeError(c.graphqlClient.Mutate(ctx, mutation, variables))
 	})
 }
 
@@ -176,100 +217,30 @@ func (c *Client) retryOperation(operation func() error) error {
 			return err
 		}
 
-		// Calculate delay with exponential backoff
-		delay := time.Duration(attempt+1) * c.retryConfig.BaseDelay
-		if delay > c.retryConfig.MaxDelay {
-			delay = c.retryConfig.MaxDelay
-		}
-
-		time.Sleep(delay)
+		time.Sleep(c.retryDelay(err, attempt))
 	}
 
 	return lastErr
 }
 
-// isRetryableError determines if an error should trigger a retry
-func (c *Client) isRetryableError(err error) bool {
-	if err == nil {
-		return false
-	}
-
-	errStr := err.Error()
-
-	// Network-related errors that are often temporary
-	retryableErrors := []string{
-		"connection refused",
-		"connection reset",
-		"connection timeout",
-		"timeout",
-		"temporary failure",
-		"service unavailable",
-		"bad gateway",
-		"gateway timeout",
-		"rate limit",
-		"too many requests",
-		"network is unreachable",
-		"no such host",
-	}
-
-	// Check for retryable error patterns
-	errStrLower := strings.ToLower(errStr)
-	for _, pattern := range retryableErrors {
-		if strings.Contains(errStrLower, pattern) {
-			return true
-		}
-	}
-
-	// Check for specific HTTP status codes that indicate retryable errors
-	if strings.Contains(errStrLower, "502") || // Bad Gateway
-		strings.Contains(errStrLower, "503") || // Service Unavailable
-		strings.Contains(errStrLower, "504") || // Gateway Timeout
-		strings.Contains(errStrLower, "429") { // Too Many Requests
-		return true
-	}
-
-	return false
-}
-
-// buildRequest creates an HTTP request for GraphQL
-func (c *Client) buildRequest(query string, variables map[string]interface{}) (*http.Request, error) {
-	reqBody := GraphQLRequest{
-		Query:     query,
-		Variables: variables,
-	}
-
-	jsonBody, err := json.Marshal(reqBody)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal request: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(context.Background(), "POST", c.baseURL, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
-	}
+// retryDelay calculates the delay before the next attempt, honoring Retry-After on rate limits
+func (c *Client) retryDelay(err error, attempt int) time.Duration {
+	// Calculate delay with exponential backoff
+	delay := time.Duration(attempt+1) * c.retryConfig.BaseDelay
 
-	req.Header.Set("Authorization", fmt.Sprintf("token %s", c.token))
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-
-	return req, nil
-}
-
-// parseGraphQLError parses GraphQL error response
-func (c *Client) parseGraphQLError(responseBody []byte) error {
-	var response GraphQLResponse
-	if err := json.Unmarshal(responseBody, &response); err != nil {
-		return fmt.Errorf("failed to parse error response: %w", err)
+	if apiErr, ok := AsError(err); ok && apiErr.RetryAfter > delay {
+		delay = apiErr.RetryAfter
 	}
 
-	if len(response.Errors) == 0 {
-		return fmt.Errorf("unknown GraphQL error")
+	if delay > c.retryConfig.MaxDelay {
+		delay = c.retryConfig.MaxDelay
 	}
 
-	return fmt.Errorf("GraphQL error: %s", response.Errors[0].Message)
+	return delay
 }
 
-// handleNetworkError handles network-related errors
-func (c *Client) handleNetworkError(err error) error {
-	return fmt.Errorf("network error: %w", err)
+// isRetryableError determines if an error should trigger a retry
+func (c *Client) isRetryableError(err error) bool {
+	apiErr, ok := AsError(err)
+	return ok && apiErr.Retryable()
 }
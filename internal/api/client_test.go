@@ -3,9 +3,9 @@ package api
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 func TestGraphQLClient(t *testing.T) {
@@ -81,37 +81,22 @@ func TestHealthCheck(t *testing.T) {
 }
 
 func TestErrorHandling(t *testing.T) {
-	t.Run("Client handles GraphQL errors", func(t *testing.T) {
+	t.Run("Client retries only retryable API errors", func(t *testing.T) {
 		client := NewClient("test-token")
 
-		// Test error parsing
-		err := client.parseGraphQLError([]byte(`{"errors": [{"message": "test error"}]}`))
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "test error")
-	})
-
-	t.Run("Client handles network errors", func(t *testing.T) {
-		client := NewClient("test-token")
-
-		// Test network error handling
-		err := client.handleNetworkError(assert.AnError)
-		assert.Error(t, err)
+		assert.True(t, client.isRetryableError(&Error{Type: ErrorTypeNetwork}))
+		assert.True(t, client.isRetryableError(&Error{Type: ErrorTypeRateLimited}))
+		assert.True(t, client.isRetryableError(&Error{Type: ErrorTypeServer}))
+		assert.False(t, client.isRetryableError(&Error{Type: ErrorTypeNotFound}))
+		assert.False(t, client.isRetryableError(&Error{Type: ErrorTypeInsufficientScopes}))
+		assert.False(t, client.isRetryableError(assert.AnError))
 	})
-}
 
-func TestRequestBuilder(t *testing.T) {
-	t.Run("BuildRequest creates proper GraphQL request", func(t *testing.T) {
+	t.Run("Retry delay honors Retry-After within the max delay", func(t *testing.T) {
 		client := NewClient("test-token")
 
-		query := "query { viewer { login } }"
-		variables := map[string]interface{}{"test": "value"}
-
-		req, err := client.buildRequest(query, variables)
-		require.NoError(t, err)
-		assert.NotNil(t, req)
-		assert.Equal(t, "POST", req.Method)
-		assert.Equal(t, DefaultAPIURL, req.URL.String())
-		assert.Contains(t, req.Header.Get("Authorization"), "token test-token")
-		assert.Equal(t, "application/json", req.Header.Get("Content-Type"))
+		assert.Equal(t, client.retryConfig.BaseDelay, client.retryDelay(assert.AnError, 0))
+		assert.Equal(t, 10*time.Second, client.retryDelay(&Error{Type: ErrorTypeRateLimited, RetryAfter: 10 * time.Second}, 0))
+		assert.Equal(t, client.retryConfig.MaxDelay, client.retryDelay(&Error{Type: ErrorTypeRateLimited, RetryAfter: time.Hour}, 0))
 	})
 }
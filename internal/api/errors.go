@@ -0,0 +1,319 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrorType classifies errors returned by the GitHub API
+type ErrorType string
+
+const (
+	ErrorTypeUnknown            ErrorType = "UNKNOWN"
+	ErrorTypeUnauthorized       ErrorType = "UNAUTHORIZED"
+	ErrorTypeNotFound           ErrorType = "NOT_FOUND"
+	ErrorTypeForbidden          ErrorType = "FORBIDDEN"
+	ErrorTypeInsufficientScopes ErrorType = "INSUFFICIENT_SCOPES"
+	ErrorTypeRateLimited        ErrorType = "RATE_LIMITED"
+	ErrorTypeValidation         ErrorType = "VALIDATION"
+	ErrorTypeServer             ErrorType = "SERVER"
+	ErrorTypeNetwork            ErrorType = "NETWORK"
+)
+
+// defaultRequiredScope is suggested when GitHub does not name the missing scope
+const defaultRequiredScope = "project"
+
+// requiredScopesPattern extracts scope names from INSUFFICIENT_SCOPES messages, e.g.
+// "The 'id' field requires one of the following scopes: ['read:project'], but ..."
+var requiredScopesPattern = regexp.MustCompile(`requires one of the following scopes: \[([^\]]*)\]`)
+
+// Error is a typed GitHub API error built from the HTTP status and the GraphQL errors array
+type Error struct {
+	Err            error
+	ResetAt        *time.Time
+	Type           ErrorType
+	Message        string
+	Path           []interface{}
+	RequiredScopes []string
+	StatusCode     int
+	RetryAfter     time.Duration
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	msg := e.Message
+	if msg == "" && e.Err != nil {
+		msg = e.Err.Error()
+	}
+
+	switch e.Type {
+	case ErrorTypeNetwork:
+		return fmt.Sprintf("network error: %s", msg)
+	case ErrorTypeUnknown:
+		if e.StatusCode != 0 {
+			return fmt.Sprintf("GitHub API error (HTTP %d): %s", e.StatusCode, msg)
+		}
+		return fmt.Sprintf("GitHub API error: %s", msg)
+	default:
+		return msg
+	}
+}
+
+// Unwrap returns the underlying error, if any
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Retryable reports whether the request that produced this error may succeed if repeated
+func (e *Error) Retryable() bool {
+	switch e.Type {
+	case ErrorTypeNetwork, ErrorTypeRateLimited, ErrorTypeServer:
+		return true
+	default:
+		return false
+	}
+}
+
+// Hint returns an actionable suggestion for resolving the error
+func (e *Error) Hint() string {
+	switch e.Type {
+	case ErrorTypeUnauthorized:
+		return "token is invalid or expired; run `gh auth login` or set GITHUB_TOKEN"
+	case ErrorTypeInsufficientScopes:
+		scope := defaultRequiredScope
+		if len(e.RequiredScopes) > 0 {
+			scope = e.RequiredScopes[0]
+		}
+		return fmt.Sprintf("token lacks `%s` scope; run `gh auth refresh -s %s`", scope, scope)
+	case ErrorTypeForbidden:
+		return "token does not have permission for this resource; check organization SSO authorization and access settings"
+	case ErrorTypeNotFound:
+		return "check the owner, number and name, and that your token can access the resource"
+	case ErrorTypeRateLimited:
+		if e.ResetAt != nil {
+			return fmt.Sprintf("GitHub API rate limit exceeded; try again after %s", e.ResetAt.Local().Format("15:04:05"))
+		}
+		return "GitHub API rate limit exceeded; wait a moment and try again"
+	case ErrorTypeNetwork:
+		return "check your network connection and proxy settings"
+	case ErrorTypeServer:
+		return "GitHub returned a server error; try again later"
+	case ErrorTypeValidation, ErrorTypeUnknown:
+		return ""
+	default:
+		return ""
+	}
+}
+
+// AsError returns the typed API error wrapped in err, if any
+func AsError(err error) (*Error, bool) {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr, true
+	}
+	return nil, false
+}
+
+// IsErrorType reports whether err wraps an API error of the given type
+func IsErrorType(err error, errType ErrorType) bool {
+	apiErr, ok := AsError(err)
+	return ok && apiErr.Type == errType
+}
+
+// IsNotFound reports whether err is a NOT_FOUND API error
+func IsNotFound(err error) bool {
+	return IsErrorType(err, ErrorTypeNotFound)
+}
+
+// graphQLErrorType maps a GraphQL errors[].type value to an ErrorType
+func graphQLErrorType(gqlErr *GraphQLError) ErrorType {
+	switch strings.ToUpper(gqlErr.Type) {
+	case "NOT_FOUND":
+		return ErrorTypeNotFound
+	case "FORBIDDEN":
+		return ErrorTypeForbidden
+	case "INSUFFICIENT_SCOPES":
+		return ErrorTypeInsufficientScopes
+	case "RATE_LIMITED":
+		return ErrorTypeRateLimited
+	case "UNPROCESSABLE", "ARGUMENT_ERROR", "MAX_NODE_LIMIT_EXCEEDED", "BAD_REQUEST":
+		return ErrorTypeValidation
+	case "SERVICE_UNAVAILABLE", "INTERNAL":
+		return ErrorTypeServer
+	case "":
+		// Schema validation errors carry extensions.code instead of a type
+		if len(gqlErr.Extensions) > 0 {
+			return ErrorTypeValidation
+		}
+		return ErrorTypeUnknown
+	default:
+		return ErrorTypeUnknown
+	}
+}
+
+// statusErrorType maps an HTTP status code to an ErrorType
+func statusErrorType(statusCode int, header http.Header, body []byte) ErrorType {
+	switch {
+	case statusCode == http.StatusUnauthorized:
+		return ErrorTypeUnauthorized
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorTypeRateLimited
+	case statusCode == http.StatusForbidden:
+		if header.Get("X-RateLimit-Remaining") == "0" || header.Get("Retry-After") != "" ||
+			strings.Contains(strings.ToLower(string(body)), "rate limit") {
+			return ErrorTypeRateLimited
+		}
+		return ErrorTypeForbidden
+	case statusCode == http.StatusNotFound:
+		return ErrorTypeNotFound
+	case statusCode == http.StatusUnprocessableEntity || statusCode == http.StatusBadRequest:
+		return ErrorTypeValidation
+	case statusCode >= http.StatusInternalServerError:
+		return ErrorTypeServer
+	default:
+		return ErrorTypeUnknown
+	}
+}
+
+// parseRequiredScopes extracts the scopes named in an INSUFFICIENT_SCOPES message
+func parseRequiredScopes(message string) []string {
+	match := requiredScopesPattern.FindStringSubmatch(message)
+	if len(match) < 2 {
+		return nil
+	}
+
+	var scopes []string
+	for _, scope := range strings.Split(match[1], ",") {
+		scope = strings.Trim(strings.TrimSpace(scope), `'"`)
+		if scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+// applyRateLimitHeaders records Retry-After and X-RateLimit-Reset on a rate limit error
+func applyRateLimitHeaders(apiErr *Error, header http.Header) {
+	if header == nil {
+		return
+	}
+	if retryAfter, err := strconv.Atoi(header.Get("Retry-After")); err == nil && retryAfter > 0 {
+		apiErr.RetryAfter = time.Duration(retryAfter) * time.Second
+	}
+	if reset, err := strconv.ParseInt(header.Get("X-RateLimit-Reset"), 10, 64); err == nil && reset > 0 {
+		resetAt := time.Unix(reset, 0)
+		apiErr.ResetAt = &resetAt
+	}
+}
+
+// newGraphQLError builds a typed error from the first entry of a GraphQL errors array
+func newGraphQLError(statusCode int, header http.Header, gqlErrors []GraphQLError) *Error {
+	first := gqlErrors[0]
+	apiErr := &Error{
+		Type:       graphQLErrorType(&first),
+		Message:    first.Message,
+		Path:       first.Path,
+		StatusCode: statusCode,
+	}
+	if apiErr.Type == ErrorTypeInsufficientScopes {
+		apiErr.RequiredScopes = parseRequiredScopes(first.Message)
+	}
+	if apiErr.Type == ErrorTypeRateLimited {
+		applyRateLimitHeaders(apiErr, header)
+	}
+	return apiErr
+}
+
+// newStatusError builds a typed error from a non-200 HTTP response
+func newStatusError(statusCode int, header http.Header, body []byte) *Error {
+	var response GraphQLResponse
+	if err := json.Unmarshal(body, &response); err == nil && len(response.Errors) > 0 {
+		apiErr := newGraphQLError(statusCode, header, response.Errors)
+		if apiErr.Type == ErrorTypeUnknown {
+			apiErr.Type = statusErrorType(statusCode, header, body)
+		}
+		return apiErr
+	}
+
+	// REST-style error bodies ({"message": "..."}) are returned for auth and rate limit failures
+	var restErr struct {
+		Message string `json:"message"`
+	}
+	message := http.StatusText(statusCode)
+	if err := json.Unmarshal(body, &restErr); err == nil && restErr.Message != "" {
+		message = restErr.Message
+	}
+
+	apiErr := &Error{
+		Type:       statusErrorType(statusCode, header, body),
+		Message:    message,
+		StatusCode: statusCode,
+	}
+	if apiErr.Type == ErrorTypeRateLimited {
+		applyRateLimitHeaders(apiErr, header)
+	}
+	return apiErr
+}
+
+// normalizeError converts errors returned by the GraphQL client into typed API errors
+func normalizeError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if apiErr, ok := AsError(err); ok {
+		return apiErr
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+
+	var urlErr *url.Error
+	var netErr net.Error
+	if errors.As(err, &urlErr) || errors.As(err, &netErr) {
+		return &Error{Type: ErrorTypeNetwork, Err: err}
+	}
+
+	return err
+}
+
+// errorTransport turns HTTP and GraphQL failures into typed API errors
+type errorTransport struct {
+	base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *errorTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, &Error{Type: ErrorTypeNetwork, Err: err}
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, newStatusError(resp.StatusCode, resp.Header, body)
+	}
+
+	var response GraphQLResponse
+	if jsonErr := json.Unmarshal(body, &response); jsonErr == nil && len(response.Errors) > 0 {
+		return nil, newGraphQLError(resp.StatusCode, resp.Header, response.Errors)
+	}
+
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
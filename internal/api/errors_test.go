@@ -0,0 +1,181 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/shurcooL/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestClient creates a client pointed at a test server with retries disabled
+func newTestClient(serverURL string) *Client {
+	client := NewClient("test-token")
+	client.graphqlClient = graphql.NewClient(serverURL, client.httpClient)
+	client.retryConfig.MaxRetries = 0
+	return client
+}
+
+func TestErrorTransport(t *testing.T) {
+	var query struct {
+		Viewer struct {
+			Login string
+		}
+	}
+
+	t.Run("GraphQL NOT_FOUND becomes a typed error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"data":null,"errors":[{"type":"NOT_FOUND","path":["repository"],"message":"Could not resolve to a Repository with the name 'octo/missing'."}]}`))
+		}))
+		defer server.Close()
+
+		err := newTestClient(server.URL).Query(context.Background(), &query, nil)
+		require.Error(t, err)
+
+		apiErr, ok := AsError(err)
+		require.True(t, ok)
+		assert.Equal(t, ErrorTypeNotFound, apiErr.Type)
+		assert.Equal(t, []interface{}{"repository"}, apiErr.Path)
+		assert.True(t, IsNotFound(err))
+		assert.Contains(t, err.Error(), "Could not resolve")
+	})
+
+	t.Run("INSUFFICIENT_SCOPES records the required scopes", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"errors":[{"type":"INSUFFICIENT_SCOPES","message":"Your token has not been granted the required scopes to execute this query. The 'id' field requires one of the following scopes: ['read:project'], but your token has only been granted the: ['repo'] scopes."}]}`))
+		}))
+		defer server.Close()
+
+		err := newTestClient(server.URL).Query(context.Background(), &query, nil)
+
+		apiErr, ok := AsError(err)
+		require.True(t, ok)
+		assert.Equal(t, ErrorTypeInsufficientScopes, apiErr.Type)
+		assert.Equal(t, []string{"read:project"}, apiErr.RequiredScopes)
+		assert.Contains(t, apiErr.Hint(), "gh auth refresh -s read:project")
+	})
+
+	t.Run("Schema errors are validation errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"errors":[{"extensions":{"code":"undefinedField"},"message":"Field 'foo' doesn't exist on type 'Query'"}]}`))
+		}))
+		defer server.Close()
+
+		err := newTestClient(server.URL).Query(context.Background(), &query, nil)
+		assert.True(t, IsErrorType(err, ErrorTypeValidation))
+	})
+
+	t.Run("HTTP status codes are classified", func(t *testing.T) {
+		tests := []struct {
+			header   map[string]string
+			body     string
+			expected ErrorType
+			status   int
+		}{
+			{status: http.StatusUnauthorized, body: `{"message":"Bad credentials"}`, expected: ErrorTypeUnauthorized},
+			{status: http.StatusForbidden, body: `{"message":"Resource not accessible by integration"}`, expected: ErrorTypeForbidden},
+			{
+				status:   http.StatusForbidden,
+				body:     `{"message":"API rate limit exceeded"}`,
+				header:   map[string]string{"X-RateLimit-Remaining": "0", "X-RateLimit-Reset": "1700000000"},
+				expected: ErrorTypeRateLimited,
+			},
+			{status: http.StatusTooManyRequests, header: map[string]string{"Retry-After": "7"}, expected: ErrorTypeRateLimited},
+			{status: http.StatusBadGateway, expected: ErrorTypeServer},
+		}
+
+		for _, tt := range tests {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				for key, value := range tt.header {
+					w.Header().Set(key, value)
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+
+			err := newTestClient(server.URL).Query(context.Background(), &query, nil)
+			server.Close()
+
+			apiErr, ok := AsError(err)
+			require.True(t, ok, "status %d", tt.status)
+			assert.Equal(t, tt.expected, apiErr.Type, "status %d", tt.status)
+			assert.Equal(t, tt.status, apiErr.StatusCode)
+		}
+	})
+
+	t.Run("Rate limit headers are recorded", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("Retry-After", "7")
+			w.Header().Set("X-RateLimit-Reset", "1700000000")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer server.Close()
+
+		err := newTestClient(server.URL).Query(context.Background(), &query, nil)
+
+		apiErr, ok := AsError(err)
+		require.True(t, ok)
+		assert.Equal(t, 7*time.Second, apiErr.RetryAfter)
+		require.NotNil(t, apiErr.ResetAt)
+		assert.Equal(t, int64(1700000000), apiErr.ResetAt.Unix())
+	})
+
+	t.Run("Successful responses are passed through", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"data":{"viewer":{"login":"octocat"}}}`))
+		}))
+		defer server.Close()
+
+		err := newTestClient(server.URL).Query(context.Background(), &query, nil)
+		require.NoError(t, err)
+		assert.Equal(t, "octocat", query.Viewer.Login)
+	})
+
+	t.Run("Server errors are retried", func(t *testing.T) {
+		attempts := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			attempts++
+			if attempts == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"viewer":{"login":"octocat"}}}`))
+		}))
+		defer server.Close()
+
+		client := newTestClient(server.URL)
+		client.retryConfig.MaxRetries = 1
+		client.retryConfig.BaseDelay = time.Millisecond
+
+		err := client.Query(context.Background(), &query, nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+	})
+
+	t.Run("Connection failures become network errors", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		serverURL := server.URL
+		server.Close()
+
+		err := newTestClient(serverURL).Query(context.Background(), &query, nil)
+		assert.True(t, IsErrorType(err, ErrorTypeNetwork))
+		assert.Contains(t, err.Error(), "network error")
+	})
+}
+
+func TestErrorHints(t *testing.T) {
+	t.Run("Insufficient scopes defaults to project scope", func(t *testing.T) {
+		err := &Error{Type: ErrorTypeInsufficientScopes}
+		assert.Equal(t, "token lacks `project` scope; run `gh auth refresh -s project`", err.Hint())
+	})
+
+	t.Run("Validation errors have no hint", func(t *testing.T) {
+		err := &Error{Type: ErrorTypeValidation, Message: "bad input"}
+		assert.Empty(t, err.Hint())
+		assert.Equal(t, "bad input", err.Error())
+	})
+}
@@ -0,0 +1,387 @@
+package fake
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// resolver computes a field value from its arguments. It returns nil, a scalar,
+// a time.Time, an *object or a list of those.
+type resolver func(args map[string]interface{}) (interface{}, error)
+
+// object is a GraphQL object with its typename and field resolvers
+type object struct {
+	fields   map[string]resolver
+	typename string
+}
+
+// newObject creates an object of the given type
+func newObject(typename string, fields map[string]resolver) *object {
+	return &object{typename: typename, fields: fields}
+}
+
+// value returns a resolver for a constant value
+func value(v interface{}) resolver {
+	return func(map[string]interface{}) (interface{}, error) {
+		return v, nil
+	}
+}
+
+// implements lists the interfaces and unions each object type belongs to, used to match
+// inline fragments
+var implements = map[string][]string{
+	"User":                                {"Node", "Actor", "ProjectV2Owner", "RepositoryOwner", "Assignee", "RequestedReviewer"},
+	"Organization":                        {"Node", "Actor", "ProjectV2Owner", "RepositoryOwner"},
+	"Repository":                          {"Node", "RepositoryInfo", "SearchResultItem"},
+	"Issue":                               {"Node", "Assignable", "Closable", "Comment", "Labelable", "Lockable", "ProjectV2ItemContent", "SearchResultItem", "IssueOrPullRequest"},
+	"PullRequest":                         {"Node", "Assignable", "Closable", "Comment", "Labelable", "Lockable", "ProjectV2ItemContent", "SearchResultItem", "IssueOrPullRequest"},
+	"DraftIssue":                          {"Node", "ProjectV2ItemContent"},
+	"Label":                               {"Node"},
+	"Milestone":                           {"Node"},
+	"ProjectV2":                           {"Node", "Closable"},
+	"ProjectV2Item":                       {"Node"},
+	"ProjectV2View":                       {"Node"},
+	"ProjectV2Field":                      {"Node", "ProjectV2FieldCommon", "ProjectV2FieldConfiguration"},
+	"ProjectV2SingleSelectField":          {"Node", "ProjectV2FieldCommon", "ProjectV2FieldConfiguration"},
+	"ProjectV2IterationField":             {"Node", "ProjectV2FieldCommon", "ProjectV2FieldConfiguration"},
+	"ProjectV2ItemFieldTextValue":         {"Node", "ProjectV2ItemFieldValueCommon", "ProjectV2ItemFieldValue"},
+	"ProjectV2ItemFieldNumberValue":       {"Node", "ProjectV2ItemFieldValueCommon", "ProjectV2ItemFieldValue"},
+	"ProjectV2ItemFieldDateValue":         {"Node", "ProjectV2ItemFieldValueCommon", "ProjectV2ItemFieldValue"},
+	"ProjectV2ItemFieldSingleSelectValue": {"Node", "ProjectV2ItemFieldValueCommon", "ProjectV2ItemFieldValue"},
+	"ProjectV2ItemFieldIterationValue":    {"Node", "ProjectV2ItemFieldValueCommon", "ProjectV2ItemFieldValue"},
+	"Discussion":                          {"Node", "Closable", "Comment", "Labelable", "Lockable", "SearchResultItem"},
+	"DiscussionCategory":                  {"Node"},
+	"DiscussionComment":                   {"Node", "Comment"},
+	"IssueComment":                        {"Node", "Comment", "IssueTimelineItems", "PullRequestTimelineItem"},
+	"ClosedEvent":                         {"Node", "IssueTimelineItems", "PullRequestTimelineItem"},
+	"ReopenedEvent":                       {"Node", "IssueTimelineItems", "PullRequestTimelineItem"},
+	"MergedEvent":                         {"Node", "PullRequestTimelineItem"},
+	"LabeledEvent":                        {"Node", "IssueTimelineItems", "PullRequestTimelineItem"},
+	"UnlabeledEvent":                      {"Node", "IssueTimelineItems", "PullRequestTimelineItem"},
+	"AssignedEvent":                       {"Node", "IssueTimelineItems", "PullRequestTimelineItem"},
+	"UnassignedEvent":                     {"Node", "IssueTimelineItems", "PullRequestTimelineItem"},
+	"RenamedTitleEvent":                   {"Node", "IssueTimelineItems", "PullRequestTimelineItem"},
+	"CrossReferencedEvent":                {"Node", "IssueTimelineItems", "PullRequestTimelineItem"},
+}
+
+// matchesType reports whether an object of type typename satisfies a type condition
+func matchesType(typename, condition string) bool {
+	if condition == "" || condition == typename {
+		return true
+	}
+	for _, name := range implements[typename] {
+		if name == condition {
+			return true
+		}
+	}
+	return false
+}
+
+// Error is a GraphQL error returned in the response errors list
+type Error struct {
+	Extensions map[string]interface{} `json:"extensions,omitempty"`
+	Type       string                 `json:"type,omitempty"`
+	Message    string                 `json:"message"`
+	Path       []interface{}          `json:"path,omitempty"`
+}
+
+// Error implements error
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// notFound returns a NOT_FOUND error like GitHub does for unknown nodes and owners
+func notFound(format string, args ...interface{}) *Error {
+	return &Error{Type: "NOT_FOUND", Message: fmt.Sprintf(format, args...)}
+}
+
+// unprocessable returns an UNPROCESSABLE error for invalid mutation input
+func unprocessable(format string, args ...interface{}) *Error {
+	return &Error{Type: "UNPROCESSABLE", Message: fmt.Sprintf(format, args...)}
+}
+
+// executor evaluates an operation against a root object
+type executor struct {
+	variables map[string]interface{}
+}
+
+// execute evaluates selections against the root object and returns the response data
+func (e *executor) execute(root *object, selections []*selection) (map[string]interface{}, error) {
+	return e.selectObject(root, selections, nil)
+}
+
+func (e *executor) selectObject(obj *object, selections []*selection, path []interface{}) (map[string]interface{}, error) {
+	result := map[string]interface{}{}
+	for _, sel := range selections {
+		if sel.fragment {
+			if !matchesType(obj.typename, sel.typeCondition) {
+				continue
+			}
+			fragment, err := e.selectObject(obj, sel.selections, path)
+			if err != nil {
+				return nil, err
+			}
+			mergeResults(result, fragment)
+			continue
+		}
+
+		key := sel.responseKey()
+		fieldPath := append(append([]interface{}{}, path...), key)
+
+		if sel.name == "__typename" {
+			result[key] = obj.typename
+			continue
+		}
+
+		resolve, ok := obj.fields[sel.name]
+		if !ok {
+			return nil, &Error{
+				Message:    fmt.Sprintf("Field '%s' doesn't exist on type '%s'", sel.name, obj.typename),
+				Path:       fieldPath,
+				Extensions: map[string]interface{}{"code": "undefinedField", "typeName": obj.typename, "fieldName": sel.name},
+			}
+		}
+
+		resolved, err := resolve(resolveArguments(sel.args, e.variables))
+		if err != nil {
+			if gqlErr, ok := err.(*Error); ok && gqlErr.Path == nil {
+				gqlErr.Path = fieldPath
+			}
+			return nil, err
+		}
+
+		completed, err := e.complete(resolved, sel, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		if existing, ok := result[key].(map[string]interface{}); ok {
+			if fields, ok := completed.(map[string]interface{}); ok {
+				mergeResults(existing, fields)
+				continue
+			}
+		}
+		result[key] = completed
+	}
+	return result, nil
+}
+
+// complete converts a resolved value into its JSON response form
+func (e *executor) complete(resolved interface{}, sel *selection, path []interface{}) (interface{}, error) {
+	switch v := resolved.(type) {
+	case nil:
+		return nil, nil
+	case *object:
+		if v == nil {
+			return nil, nil
+		}
+		if len(sel.selections) == 0 {
+			return nil, &Error{
+				Message: fmt.Sprintf("Field must have selections (field '%s' returns %s but has no selections)", sel.name, v.typename),
+				Path:    path,
+			}
+		}
+		return e.selectObject(v, sel.selections, path)
+	case []*object:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			completed, err := e.complete(item, sel, append(append([]interface{}{}, path...), i))
+			if err != nil {
+				return nil, err
+			}
+			list[i] = completed
+		}
+		return list, nil
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			completed, err := e.complete(item, sel, append(append([]interface{}{}, path...), i))
+			if err != nil {
+				return nil, err
+			}
+			list[i] = completed
+		}
+		return list, nil
+	case time.Time:
+		return v.UTC().Format(time.RFC3339), nil
+	case *time.Time:
+		if v == nil {
+			return nil, nil
+		}
+		return v.UTC().Format(time.RFC3339), nil
+	default:
+		if len(sel.selections) > 0 {
+			return nil, &Error{
+				Message: fmt.Sprintf("Selections can't be made on scalars (field '%s')", sel.name),
+				Path:    path,
+			}
+		}
+		return v, nil
+	}
+}
+
+// mergeResults deep merges src into dst, combining fields selected by several fragments
+func mergeResults(dst, src map[string]interface{}) {
+	for key, v := range src {
+		existing, ok := dst[key].(map[string]interface{})
+		incoming, isMap := v.(map[string]interface{})
+		if ok && isMap {
+			mergeResults(existing, incoming)
+			continue
+		}
+		dst[key] = v
+	}
+}
+
+// connection builds a connection object with nodes, edges, totalCount and pageInfo,
+// paginated with the first/after and last/before arguments
+func connection(typename string, nodes []*object, args map[string]interface{}) (*object, error) {
+	start, end := 0, len(nodes)
+	if after, ok := args["after"].(string); ok && after != "" {
+		index, err := decodeCursor(after)
+		if err != nil {
+			return nil, err
+		}
+		start = index + 1
+	}
+	if before, ok := args["before"].(string); ok && before != "" {
+		index, err := decodeCursor(before)
+		if err != nil {
+			return nil, err
+		}
+		end = index
+	}
+	if start > len(nodes) {
+		start = len(nodes)
+	}
+	if end < start {
+		end = start
+	}
+	if first, ok := intArg(args, "first"); ok && first >= 0 && start+first < end {
+		end = start + first
+	}
+	if last, ok := intArg(args, "last"); ok && last >= 0 && end-last > start {
+		start = end - last
+	}
+
+	page := nodes[start:end]
+	edges := make([]*object, len(page))
+	for i, node := range page {
+		edges[i] = newObject(typename+"Edge", map[string]resolver{
+			"cursor": value(encodeCursor(start + i)),
+			"node":   value(node),
+		})
+	}
+
+	var startCursor, endCursor interface{}
+	if len(page) > 0 {
+		startCursor = encodeCursor(start)
+		endCursor = encodeCursor(end - 1)
+	}
+
+	return newObject(typename+"Connection", map[string]resolver{
+		"nodes":      value(page),
+		"edges":      value(edges),
+		"totalCount": value(len(nodes)),
+		"pageInfo": value(newObject("PageInfo", map[string]resolver{
+			"hasNextPage":     value(end < len(nodes)),
+			"hasPreviousPage": value(start > 0),
+			"startCursor":     value(startCursor),
+			"endCursor":       value(endCursor),
+		})),
+	}), nil
+}
+
+// connectionResolver returns a resolver building a connection from nodes computed at resolve time
+func connectionResolver(typename string, nodes func(args map[string]interface{}) []*object) resolver {
+	return func(args map[string]interface{}) (interface{}, error) {
+		return connection(typename, nodes(args), args)
+	}
+}
+
+func encodeCursor(index int) string {
+	return base64.StdEncoding.EncodeToString([]byte("cursor:" + strconv.Itoa(index)))
+}
+
+func decodeCursor(cursor string) (int, error) {
+	decoded, err := base64.StdEncoding.DecodeString(cursor)
+	if err != nil || !strings.HasPrefix(string(decoded), "cursor:") {
+		return 0, &Error{Type: "INVALID_CURSOR_ARGUMENTS", Message: fmt.Sprintf("`%s` does not appear to be a valid cursor.", cursor)}
+	}
+	index, err := strconv.Atoi(strings.TrimPrefix(string(decoded), "cursor:"))
+	if err != nil {
+		return 0, &Error{Type: "INVALID_CURSOR_ARGUMENTS", Message: fmt.Sprintf("`%s` does not appear to be a valid cursor.", cursor)}
+	}
+	return index, nil
+}
+
+// intArg returns an integer argument, accepting literals and JSON numbers from variables
+func intArg(args map[string]interface{}, name string) (int, bool) {
+	switch v := args[name].(type) {
+	case int:
+		return v, true
+	case float64:
+		return int(v), true
+	case string:
+		n, err := strconv.Atoi(v)
+		return n, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// floatArg returns a numeric argument
+func floatArg(args map[string]interface{}, name string) (float64, bool) {
+	switch v := args[name].(type) {
+	case int:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
+
+// stringArg returns a string argument and whether it was set
+func stringArg(args map[string]interface{}, name string) (string, bool) {
+	v, ok := args[name].(string)
+	return v, ok
+}
+
+// boolArg returns a boolean argument and whether it was set
+func boolArg(args map[string]interface{}, name string) (b, ok bool) {
+	b, ok = args[name].(bool)
+	return b, ok
+}
+
+// stringListArg returns a list argument of strings; a single string is treated as a list
+func stringListArg(args map[string]interface{}, name string) []string {
+	switch v := args[name].(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		list := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+		return list
+	default:
+		return nil
+	}
+}
+
+// inputArg returns the input object argument of a mutation
+func inputArg(args map[string]interface{}) (map[string]interface{}, error) {
+	input, ok := args["input"].(map[string]interface{})
+	if !ok {
+		return nil, &Error{
+			Message:    "Argument 'input' on Field is required",
+			Extensions: map[string]interface{}{"code": "missingRequiredArguments"},
+		}
+	}
+	return input, nil
+}
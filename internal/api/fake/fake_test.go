@@ -0,0 +1,433 @@
+package fake_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// newClient starts a fake server and returns an API client pointed at it through GHX_API_URL
+func newClient(t *testing.T) (*fake.Server, *api.Client) {
+	t.Helper()
+
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	return server, api.NewClient("ghp_fake")
+}
+
+func TestProjects(t *testing.T) {
+	ctx := context.Background()
+	server, client := newClient(t)
+	store := server.Store
+
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	issue := store.AddIssue(repo, "Fix login")
+	projects := service.NewProjectService(client)
+
+	t.Run("create, list and get", func(t *testing.T) {
+		created, err := projects.CreateProject(ctx, &service.CreateProjectInput{
+			OwnerID: store.Account(fake.DefaultViewer).ID,
+			Title:   "Roadmap",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Roadmap", created.Title)
+		assert.Equal(t, 1, created.Number)
+		assert.Equal(t, "https://github.com/users/octocat/projects/1", created.URL)
+
+		list, err := projects.ListUserProjects(ctx, service.ListUserProjectsOptions{Login: fake.DefaultViewer})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, created.ID, list[0].ID)
+
+		project, err := projects.GetProject(ctx, fake.DefaultViewer, 1, false)
+		require.NoError(t, err)
+		assert.Equal(t, "Roadmap", project.Title)
+
+		var status *graphql.ProjectV2Field
+		for i := range project.Fields.Nodes {
+			if project.Fields.Nodes[i].Name == "Status" {
+				status = &project.Fields.Nodes[i]
+			}
+		}
+		require.NotNil(t, status)
+		assert.Len(t, status.SingleSelect.Options, 3)
+	})
+
+	t.Run("items and field values", func(t *testing.T) {
+		project := store.Project(fake.DefaultViewer, 1)
+		require.NotNil(t, project)
+		status := project.Field("Status")
+
+		item, err := projects.AddItem(ctx, service.AddItemInput{ProjectID: project.ID, ContentID: issue.ID})
+		require.NoError(t, err)
+		assert.Equal(t, "Issue", item.Content.TypeName)
+		assert.Equal(t, "Fix login", item.Content.Issue.Title)
+
+		_, err = projects.UpdateItemField(ctx, service.UpdateItemFieldInput{
+			ProjectID: project.ID,
+			ItemID:    item.ID,
+			FieldID:   status.ID,
+			Value:     map[string]interface{}{"singleSelectOptionId": status.Option("Done").ID},
+		})
+		require.NoError(t, err)
+
+		got, err := projects.GetProject(ctx, fake.DefaultViewer, 1, false)
+		require.NoError(t, err)
+		require.Len(t, got.Items.Nodes, 1)
+
+		var statusName string
+		for _, v := range got.Items.Nodes[0].FieldValues.Nodes {
+			if v.TypeName == "ProjectV2ItemFieldSingleSelectValue" {
+				statusName = *v.SingleSelectValue.Name
+			}
+		}
+		assert.Equal(t, "Done", statusName)
+
+		items := service.NewItemService(client)
+		body := "Notes"
+		draft, err := items.CreateDraftIssue(ctx, project.ID, "Write docs", &body)
+		require.NoError(t, err)
+		assert.Equal(t, "DraftIssue", draft.Content.TypeName)
+		assert.Equal(t, "Write docs", draft.Content.DraftIssue.Title)
+
+		require.NoError(t, items.RemoveItemFromProject(ctx, project.ID, draft.ID))
+		assert.Len(t, store.Project(fake.DefaultViewer, 1).Items, 1)
+	})
+
+	t.Run("update and delete", func(t *testing.T) {
+		project := store.Project(fake.DefaultViewer, 1)
+		title := "Roadmap 2025"
+		closed := true
+
+		updated, err := projects.UpdateProject(ctx, service.UpdateProjectInput{
+			ProjectID: project.ID,
+			Title:     &title,
+			Closed:    &closed,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, title, updated.Title)
+		assert.True(t, updated.Closed)
+
+		require.NoError(t, projects.DeleteProject(ctx, project.ID))
+		assert.Nil(t, store.Project(fake.DefaultViewer, 1))
+	})
+}
+
+func TestFields(t *testing.T) {
+	ctx := context.Background()
+	server, client := newClient(t)
+	project := server.Store.AddProject(fake.DefaultViewer, "Planning")
+	fields := service.NewFieldService(client)
+
+	field, err := fields.CreateField(ctx, service.CreateFieldInput{
+		ProjectID:           project.ID,
+		Name:                "Priority",
+		DataType:            graphql.ProjectV2FieldDataTypeSingleSelect,
+		SingleSelectOptions: []string{"High", "Low"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Priority", field.Name)
+	require.Len(t, field.SingleSelect.Options, 2)
+
+	option, err := fields.CreateFieldOption(ctx, service.CreateFieldOptionInput{
+		FieldID: field.ID,
+		Name:    "Medium",
+		Color:   "YELLOW",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Medium", option.Name)
+
+	require.NoError(t, fields.DeleteFieldOption(ctx, service.DeleteFieldOptionInput{OptionID: field.SingleSelect.Options[1].ID}))
+
+	infos, err := fields.GetProjectFields(ctx, fake.DefaultViewer, project.Number, false)
+	require.NoError(t, err)
+
+	var priority *service.FieldInfo
+	for i := range infos {
+		if infos[i].Name == "Priority" {
+			priority = &infos[i]
+		}
+	}
+	require.NotNil(t, priority)
+	require.Len(t, priority.Options, 2)
+	assert.Equal(t, "High", priority.Options[0].Name)
+	assert.Equal(t, "Medium", priority.Options[1].Name)
+
+	_, err = fields.CreateField(ctx, service.CreateFieldInput{
+		ProjectID: project.ID,
+		Name:      "Priority",
+		DataType:  graphql.ProjectV2FieldDataTypeText,
+	})
+	assert.True(t, api.IsErrorType(err, api.ErrorTypeValidation))
+}
+
+func TestViews(t *testing.T) {
+	ctx := context.Background()
+	server, client := newClient(t)
+	project := server.Store.AddProject(fake.DefaultViewer, "Planning")
+	views := service.NewViewService(client)
+
+	board, err := views.CreateView(ctx, service.CreateViewInput{
+		ProjectID: project.ID,
+		Name:      "Board",
+		Layout:    graphql.ProjectV2ViewLayoutBoard,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, graphql.ProjectV2ViewLayoutBoard, board.Layout)
+
+	statusID := project.Field("Status").ID
+	require.NoError(t, views.UpdateViewGroup(ctx, service.UpdateViewGroupInput{
+		ViewID:    board.ID,
+		GroupByID: &statusID,
+		Direction: graphql.ProjectV2ViewSortDirectionASC,
+	}))
+
+	view, err := views.GetView(ctx, board.ID)
+	require.NoError(t, err)
+	require.Len(t, view.GroupBy, 1)
+	assert.Equal(t, "Status", view.GroupBy[0].FieldName)
+
+	_, err = views.CopyView(ctx, service.CopyViewInput{ProjectID: project.ID, ViewID: board.ID, Name: "Board copy"})
+	require.NoError(t, err)
+
+	list, err := views.GetProjectViews(ctx, project.ID)
+	require.NoError(t, err)
+	assert.Len(t, list, 3)
+
+	require.NoError(t, views.DeleteView(ctx, service.DeleteViewInput{ViewID: board.ID}))
+	assert.Len(t, project.Views, 2)
+}
+
+func TestIssuesAndPullRequests(t *testing.T) {
+	ctx := context.Background()
+	server, client := newClient(t)
+	store := server.Store
+
+	repo := store.AddRepository("octo-org", "api")
+	bug := store.AddIssue(repo, "Crash on startup")
+	store.AddIssue(repo, "Add dark mode").Closed = true
+	store.AddPullRequest(repo, "Fix crash")
+	bug.Labels = append(bug.Labels, store.AddLabel(repo, "bug", "d73a4a"))
+
+	items := service.NewItemService(client)
+
+	issue, err := items.GetIssue(ctx, "octo-org", "api", bug.Number)
+	require.NoError(t, err)
+	assert.Equal(t, "Crash on startup", issue.Title)
+
+	pr, err := items.GetPullRequest(ctx, "octo-org", "api", 3)
+	require.NoError(t, err)
+	assert.Equal(t, "Fix crash", pr.Title)
+
+	open, err := items.ListRepositoryIssues(ctx, "octo-org", "api", []string{"OPEN"}, 10)
+	require.NoError(t, err)
+	require.Len(t, open, 1)
+	assert.Equal(t, "Crash on startup", open[0].Title)
+
+	found, err := items.SearchIssues(ctx, "repo:octo-org/api is:issue label:bug", 10)
+	require.NoError(t, err)
+	require.Len(t, found, 1)
+	assert.Equal(t, bug.ID, found[0].ID)
+
+	_, err = items.GetIssue(ctx, "octo-org", "api", 99)
+	assert.True(t, api.IsNotFound(err))
+}
+
+func TestDiscussions(t *testing.T) {
+	ctx := context.Background()
+	server, client := newClient(t)
+	store := server.Store
+
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	store.AddDiscussionCategory(repo, "Q&A", true)
+	discussions := service.NewDiscussionService(client)
+
+	created, err := discussions.CreateDiscussion(ctx, service.CreateDiscussionOptions{
+		Owner:    fake.DefaultViewer,
+		Repo:     "app",
+		Category: "q&a",
+		Title:    "How do I log in?",
+		Body:     "Details",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, created.Number)
+
+	comment, err := discussions.AddComment(ctx, service.AddCommentOptions{
+		Owner:  fake.DefaultViewer,
+		Repo:   "app",
+		Number: created.Number,
+		Body:   "Use ghx auth login",
+	})
+	require.NoError(t, err)
+	require.NoError(t, discussions.MarkAnswer(ctx, comment.ID))
+
+	details, err := discussions.GetDiscussion(ctx, fake.DefaultViewer, "app", created.Number, 10)
+	require.NoError(t, err)
+	require.Len(t, details.Comments, 1)
+	require.NotNil(t, details.Answer)
+	assert.Equal(t, comment.ID, details.Answer.ID)
+
+	list, err := discussions.ListDiscussions(ctx, service.ListDiscussionsOptions{Owner: fake.DefaultViewer, Repo: "app", First: 10})
+	require.NoError(t, err)
+	assert.Len(t, list, 1)
+}
+
+func TestErrors(t *testing.T) {
+	server, client := newClient(t)
+	ctx := context.Background()
+
+	t.Run("unknown field is a validation error", func(t *testing.T) {
+		var query struct {
+			Viewer struct {
+				Unknown string `graphql:"unknownField"`
+			} `graphql:"viewer"`
+		}
+		err := client.Query(ctx, &query, nil)
+		require.Error(t, err)
+		assert.True(t, api.IsErrorType(err, api.ErrorTypeValidation))
+		assert.Contains(t, err.Error(), "Field 'unknownField' doesn't exist on type 'User'")
+	})
+
+	t.Run("missing project is not found", func(t *testing.T) {
+		_, err := service.NewProjectService(client).GetProject(ctx, fake.DefaultViewer, 42, false)
+		assert.True(t, api.IsNotFound(err))
+	})
+
+	t.Run("requests without a token are unauthorized", func(t *testing.T) {
+		unauthenticated := api.NewClient("")
+		var query struct {
+			Viewer struct {
+				Login string `graphql:"login"`
+			} `graphql:"viewer"`
+		}
+		err := unauthenticated.Query(ctx, &query, nil)
+		assert.True(t, api.IsErrorType(err, api.ErrorTypeUnauthorized))
+	})
+
+	t.Run("execute supports aliases and fragments", func(t *testing.T) {
+		server.Store.AddOrganization("octo-org")
+		data, err := server.Store.Execute(`query($login: String!) {
+			owner: repositoryOwner(login: $login) {
+				__typename
+				... on Organization { login }
+				... on User { name }
+			}
+		}`, map[string]interface{}{"login": "octo-org"})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"owner": map[string]interface{}{"__typename": "Organization", "login": "octo-org"},
+		}, data)
+	})
+}
+
+func TestIterations(t *testing.T) {
+	ctx := context.Background()
+	server, client := newClient(t)
+	store := server.Store
+	store.SetClock(func() time.Time { return time.Date(2025, 1, 25, 12, 0, 0, 0, time.UTC) })
+
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	issue := store.AddIssue(repo, "Fix login")
+	project := store.AddProject(fake.DefaultViewer, "Planning")
+	item := store.AddItem(project, issue)
+	fields := service.NewFieldService(client)
+
+	created, err := fields.CreateField(ctx, service.CreateFieldInput{
+		ProjectID:      project.ID,
+		Name:           "Sprint",
+		DataType:       graphql.ProjectV2FieldDataTypeIteration,
+		Duration:       "1w",
+		IterationStart: time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	sprint, err := fields.GetIterationField(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 7, sprint.Duration)
+	require.Len(t, sprint.Iterations, 3)
+	assert.Equal(t, "Iteration 1", sprint.Iterations[0].Title)
+	assert.Len(t, created.Iteration.Configuration.CompletedIterations, 1)
+
+	t.Run("items accept relative iteration references", func(t *testing.T) {
+		project, err := service.NewProjectService(client).GetProject(ctx, fake.DefaultViewer, project.Number, false)
+		require.NoError(t, err)
+
+		var field *graphql.ProjectV2Field
+		for i := range project.Fields.Nodes {
+			if project.Fields.Nodes[i].Name == "Sprint" {
+				field = &project.Fields.Nodes[i]
+			}
+		}
+		require.NotNil(t, field)
+
+		value, err := service.BuildFieldValue(field, "@next", time.Date(2025, 1, 25, 0, 0, 0, 0, time.UTC))
+		require.NoError(t, err)
+		updated, err := service.NewProjectService(client).UpdateItemField(ctx, service.UpdateItemFieldInput{
+			ProjectID: project.ID,
+			ItemID:    item.ID,
+			FieldID:   field.ID,
+			Value:     value,
+		})
+		require.NoError(t, err)
+
+		var title string
+		for _, v := range updated.FieldValues.Nodes {
+			if v.TypeName == "ProjectV2ItemFieldIterationValue" {
+				title = *v.IterationValue.Title
+			}
+		}
+		assert.Equal(t, "Iteration 3", title)
+	})
+
+	t.Run("iterations are appended, shifted and removed", func(t *testing.T) {
+		inUse, err := fields.CountIterationItems(ctx, sprint.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, inUse)
+
+		iterations := service.AppendIterations(sprint.Iterations, service.IterationInfo{Duration: 7}, 1, 7)
+		updated, err := fields.SetIterations(ctx, sprint.ID, sprint.Duration, iterations)
+		require.NoError(t, err)
+		require.Len(t, updated.Iterations, 4)
+		assert.Equal(t, "Iteration 4", updated.Iterations[3].Title)
+		assert.Equal(t, "2025-02-10", updated.Iterations[3].StartDate.Format(service.DateLayout))
+
+		updated, err = fields.SetIterations(ctx, sprint.ID, sprint.Duration, updated.Iterations[1:3])
+		require.NoError(t, err)
+		assert.Len(t, updated.Iterations, 2)
+
+		_, err = fields.SetIterations(ctx, sprint.ID, sprint.Duration, []service.IterationInfo{})
+		assert.Error(t, err)
+	})
+
+	t.Run("changing iterations recreates them and clears item values", func(t *testing.T) {
+		assert.Empty(t, item.Values)
+
+		before, err := fields.GetIterationField(ctx, sprint.ID)
+		require.NoError(t, err)
+		store.SetValue(item, store.Node(sprint.ID).(*fake.Field), fake.Value{IterationID: before.Iterations[0].ID})
+
+		// Iterations that are kept unchanged still get new IDs
+		after, err := fields.SetIterations(ctx, sprint.ID, sprint.Duration, before.Iterations)
+		require.NoError(t, err)
+		require.Len(t, after.Iterations, len(before.Iterations))
+		for i := range after.Iterations {
+			assert.Equal(t, before.Iterations[i].Title, after.Iterations[i].Title)
+			assert.NotEqual(t, before.Iterations[i].ID, after.Iterations[i].ID)
+		}
+		assert.Empty(t, item.Values)
+
+		inUse, err := fields.CountIterationItems(ctx, sprint.ID)
+		require.NoError(t, err)
+		assert.Zero(t, inUse)
+	})
+}
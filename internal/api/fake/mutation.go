@@ -0,0 +1,1335 @@
+package fake
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// mutationRoot builds the Mutation root object
+func (s *Store) mutationRoot() *object {
+	mutations := map[string]func(input map[string]interface{}) (map[string]resolver, error){
+		"createProjectV2":                        s.createProject,
+		"updateProjectV2":                        s.updateProject,
+		"deleteProjectV2":                        s.deleteProject,
+		"copyProjectV2":                          s.copyProject,
+		"createProjectV2StatusUpdate":            s.createStatusUpdate,
+		"updateProjectV2StatusUpdate":            s.updateStatusUpdate,
+		"deleteProjectV2StatusUpdate":            s.deleteStatusUpdate,
+		"linkProjectV2ToRepository":              s.linkProjectToRepository,
+		"unlinkProjectV2FromRepository":          s.unlinkProjectFromRepository,
+		"linkProjectV2ToTeam":                    s.linkProjectToTeam,
+		"unlinkProjectV2FromTeam":                s.unlinkProjectFromTeam,
+		"addProjectV2ItemById":                   s.addProjectItem,
+		"addProjectV2DraftIssue":                 s.addProjectDraftIssue,
+		"updateProjectV2DraftIssue":              s.updateProjectDraftIssue,
+		"updateProjectV2ItemFieldValue":          s.updateItemFieldValue,
+		"clearProjectV2ItemFieldValue":           s.clearItemFieldValue,
+		"archiveProjectV2Item":                   s.archiveItem(true),
+		"unarchiveProjectV2Item":                 s.archiveItem(false),
+		"deleteProjectV2Item":                    s.deleteProjectItem,
+		"updateProjectV2ItemPosition":            s.updateItemPosition,
+		"convertProjectV2DraftIssueItemToIssue":  s.convertDraftIssue,
+		"addLabelsToLabelable":                   s.addLabels,
+		"addAssigneesToAssignable":               s.addAssignees,
+		"addSubIssue":                            s.addSubIssue,
+		"removeSubIssue":                         s.removeSubIssue,
+		"createProjectV2Field":                   s.createField,
+		"updateProjectV2Field":                   s.updateField,
+		"deleteProjectV2Field":                   s.deleteField,
+		"createProjectV2SingleSelectFieldOption": s.createOption,
+		"updateProjectV2SingleSelectFieldOption": s.updateOption,
+		"deleteProjectV2SingleSelectFieldOption": s.deleteOption,
+		"createProjectV2View":                    s.createView,
+		"updateProjectV2View":                    s.updateView,
+		"deleteProjectV2View":                    s.deleteView,
+		"copyProjectV2View":                      s.copyView,
+		"createDiscussion":                       s.createDiscussion,
+		"updateDiscussion":                       s.updateDiscussion,
+		"deleteDiscussion":                       s.deleteDiscussion,
+		"closeDiscussion":                        s.closeDiscussion,
+		"reopenDiscussion":                       s.reopenDiscussion,
+		"lockLockable":                           s.setLocked(true),
+		"unlockLockable":                         s.setLocked(false),
+		"addDiscussionComment":                   s.addDiscussionComment,
+		"updateDiscussionComment":                s.updateDiscussionComment,
+		"deleteDiscussionComment":                s.deleteDiscussionComment,
+		"markDiscussionCommentAsAnswer":          s.markAnswer(true),
+		"unmarkDiscussionCommentAsAnswer":        s.markAnswer(false),
+	}
+
+	fields := make(map[string]resolver, len(mutations))
+	for name, mutate := range mutations {
+		fields[name] = payload(name, mutate)
+	}
+	return newObject("Mutation", fields)
+}
+
+// payload wraps a mutation implementation into a resolver returning its payload object
+func payload(name string, mutate func(input map[string]interface{}) (map[string]resolver, error)) resolver {
+	typename := strings.ToUpper(name[:1]) + name[1:] + "Payload"
+	return func(args map[string]interface{}) (interface{}, error) {
+		input, err := inputArg(args)
+		if err != nil {
+			return nil, err
+		}
+		fields, err := mutate(input)
+		if err != nil {
+			return nil, err
+		}
+		fields["clientMutationId"] = value(input["clientMutationId"])
+		return newObject(typename, fields), nil
+	}
+}
+
+// lookup finds a node of type T by the ID in input[key]
+func lookup[T any](s *Store, input map[string]interface{}, key string) (T, error) {
+	var zero T
+	id, _ := stringArg(input, key)
+	if id == "" {
+		return zero, unprocessable("%s is required", key)
+	}
+	node, ok := s.nodes[id].(T)
+	if !ok {
+		return zero, notFound("Could not resolve to a node with the global id of '%s'", id)
+	}
+	return node, nil
+}
+
+func (s *Store) touch(project *Project) time.Time {
+	now := s.now()
+	project.UpdatedAt = now
+	return now
+}
+
+func (s *Store) createProject(input map[string]interface{}) (map[string]resolver, error) {
+	owner, err := lookup[*Account](s, input, "ownerId")
+	if err != nil {
+		return nil, err
+	}
+	title, _ := stringArg(input, "title")
+	if title == "" {
+		return nil, unprocessable("Title can't be blank")
+	}
+
+	project := s.addProject(owner, title)
+	if id, ok := stringArg(input, "repositoryId"); ok && id != "" {
+		repo, ok := s.nodes[id].(*Repository)
+		if !ok {
+			return nil, notFound("Could not resolve to a node with the global id of '%s'", id)
+		}
+		project.Repositories = append(project.Repositories, repo)
+	}
+	applyProjectSettings(project, input)
+	return map[string]resolver{"projectV2": value(s.projectObject(project))}, nil
+}
+
+// applyProjectSettings applies the optional project settings of a create or update input
+func applyProjectSettings(project *Project, input map[string]interface{}) {
+	if title, ok := stringArg(input, "title"); ok {
+		project.Title = title
+	}
+	if description, ok := stringArg(input, "shortDescription"); ok {
+		project.ShortDescription = description
+	}
+	if description, ok := stringArg(input, "description"); ok {
+		project.ShortDescription = description
+	}
+	if readme, ok := stringArg(input, "readme"); ok {
+		project.Readme = readme
+	}
+	if public, ok := boolArg(input, "public"); ok {
+		project.Public = public
+	}
+	if visibility, ok := stringArg(input, "visibility"); ok {
+		project.Public = strings.EqualFold(visibility, "public")
+	}
+	if closed, ok := boolArg(input, "closed"); ok {
+		project.Closed = closed
+	}
+}
+
+func (s *Store) updateProject(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	if title, ok := stringArg(input, "title"); ok && title == "" {
+		return nil, unprocessable("Title can't be blank")
+	}
+
+	applyProjectSettings(project, input)
+	s.touch(project)
+	return map[string]resolver{"projectV2": value(s.projectObject(project))}, nil
+}
+
+func (s *Store) deleteProject(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+
+	owner := project.Owner
+	for i, p := range owner.Projects {
+		if p == project {
+			owner.Projects = append(owner.Projects[:i], owner.Projects[i+1:]...)
+			break
+		}
+	}
+	s.unregister(project.ID)
+	return map[string]resolver{"projectV2": value(s.projectObject(project))}, nil
+}
+
+// copyProject copies the fields, views and settings of a project like GitHub does.
+// Items are not copied, except draft issues when includeDraftIssues is set; the copy
+// is private and not linked to any repository.
+func (s *Store) copyProject(input map[string]interface{}) (map[string]resolver, error) {
+	source, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	owner, err := lookup[*Account](s, input, "ownerId")
+	if err != nil {
+		return nil, err
+	}
+	title, _ := stringArg(input, "title")
+	if title == "" {
+		return nil, unprocessable("Title can't be blank")
+	}
+
+	project := s.newProject(owner, title)
+	project.ShortDescription = source.ShortDescription
+	project.Readme = source.Readme
+
+	// Field, option and iteration IDs of the source mapped to their copies
+	fields := make(map[*Field]*Field, len(source.Fields))
+	ids := map[string]string{}
+	for _, field := range source.Fields {
+		copied := s.addField(project, field.Name, field.DataType, nil)
+		for _, option := range field.Options {
+			c := *option
+			s.addOption(copied, &c)
+			ids[option.ID] = c.ID
+		}
+		copied.Iterations = nil
+		copied.IterationDuration = field.IterationDuration
+		for _, iteration := range field.Iterations {
+			ids[iteration.ID] = s.addIteration(copied, iteration.Title, iteration.StartDate, iteration.Duration).ID
+		}
+		fields[field] = copied
+		ids[field.ID] = copied.ID
+	}
+
+	copySettings := func(settings []ViewSetting) []ViewSetting {
+		var result []ViewSetting
+		for _, setting := range settings {
+			result = append(result, ViewSetting{Field: fields[setting.Field], Direction: setting.Direction})
+		}
+		return result
+	}
+	for _, view := range source.Views {
+		copied := s.addView(project, view.Name, view.Layout)
+		copied.Filter = view.Filter
+		copied.SortBy = copySettings(view.SortBy)
+		copied.GroupBy = copySettings(view.GroupBy)
+		copied.VerticalGroupBy = copySettings(view.VerticalGroupBy)
+		if view.Fields != nil {
+			copied.Fields = make([]*Field, len(view.Fields))
+			for i, field := range view.Fields {
+				copied.Fields[i] = fields[field]
+			}
+		}
+	}
+
+	if includeDrafts, _ := boolArg(input, "includeDraftIssues"); includeDrafts {
+		for _, item := range source.Items {
+			if item.Draft == nil || item.Archived {
+				continue
+			}
+			copied := s.addDraftIssue(project, item.Draft.Title, item.Draft.Body)
+			for fieldID, v := range item.Values {
+				if id, ok := ids[v.OptionID]; ok {
+					v.OptionID = id
+				}
+				if id, ok := ids[v.IterationID]; ok {
+					v.IterationID = id
+				}
+				copied.Values[ids[fieldID]] = v
+			}
+		}
+	}
+
+	return map[string]resolver{"projectV2": value(s.projectObject(project))}, nil
+}
+
+func (s *Store) createStatusUpdate(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+
+	update := s.addStatusUpdate(project, "", "")
+	if err := applyStatusUpdate(update, input); err != nil {
+		s.removeStatusUpdate(update)
+		return nil, err
+	}
+	return map[string]resolver{"statusUpdate": value(s.statusUpdateObject(update))}, nil
+}
+
+func (s *Store) updateStatusUpdate(input map[string]interface{}) (map[string]resolver, error) {
+	update, err := lookup[*StatusUpdate](s, input, "statusUpdateId")
+	if err != nil {
+		return nil, err
+	}
+
+	previous := *update
+	if err := applyStatusUpdate(update, input); err != nil {
+		*update = previous
+		return nil, err
+	}
+	update.UpdatedAt = s.now()
+	return map[string]resolver{"statusUpdate": value(s.statusUpdateObject(update))}, nil
+}
+
+func (s *Store) deleteStatusUpdate(input map[string]interface{}) (map[string]resolver, error) {
+	update, err := lookup[*StatusUpdate](s, input, "statusUpdateId")
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeStatusUpdate(update)
+	return map[string]resolver{
+		"deletedStatusUpdateId": value(update.ID),
+		"projectV2":             value(s.projectObject(update.Project)),
+	}, nil
+}
+
+func (s *Store) removeStatusUpdate(update *StatusUpdate) {
+	project := update.Project
+	for i, u := range project.StatusUpdates {
+		if u == update {
+			project.StatusUpdates = append(project.StatusUpdates[:i], project.StatusUpdates[i+1:]...)
+			break
+		}
+	}
+	s.unregister(update.ID)
+}
+
+// applyStatusUpdate applies the optional fields of a status update input
+func applyStatusUpdate(update *StatusUpdate, input map[string]interface{}) error {
+	if body, ok := stringArg(input, "body"); ok {
+		update.Body = body
+	}
+	if status, ok := stringArg(input, "status"); ok {
+		switch status {
+		case "INACTIVE", "ON_TRACK", "AT_RISK", "OFF_TRACK", "COMPLETE":
+			update.Status = status
+		default:
+			return unprocessable("Status must be one of INACTIVE, ON_TRACK, AT_RISK, OFF_TRACK, COMPLETE")
+		}
+	}
+	for key, target := range map[string]*string{"startDate": &update.StartDate, "targetDate": &update.TargetDate} {
+		date, ok := stringArg(input, key)
+		if !ok {
+			continue
+		}
+		parsed, err := parseDate(date)
+		if err != nil {
+			return unprocessable("%s is not a valid date", key)
+		}
+		*target = parsed
+	}
+	if update.StartDate != "" && update.TargetDate != "" && update.TargetDate < update.StartDate {
+		return unprocessable("Target date must be on or after the start date")
+	}
+	return nil
+}
+
+func (s *Store) linkProjectToRepository(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	repo, err := lookup[*Repository](s, input, "repositoryId")
+	if err != nil {
+		return nil, err
+	}
+
+	linked := false
+	for _, r := range project.Repositories {
+		linked = linked || r == repo
+	}
+	if !linked {
+		project.Repositories = append(project.Repositories, repo)
+	}
+	return map[string]resolver{"repository": value(s.repositoryObject(repo))}, nil
+}
+
+func (s *Store) unlinkProjectFromRepository(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	repo, err := lookup[*Repository](s, input, "repositoryId")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, r := range project.Repositories {
+		if r == repo {
+			project.Repositories = append(project.Repositories[:i], project.Repositories[i+1:]...)
+			break
+		}
+	}
+	return map[string]resolver{"repository": value(s.repositoryObject(repo))}, nil
+}
+
+func (s *Store) linkProjectToTeam(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	team, err := lookup[*Team](s, input, "teamId")
+	if err != nil {
+		return nil, err
+	}
+	if team.Organization != project.Owner {
+		return nil, unprocessable("Projects can only be linked to teams of the organization that owns them")
+	}
+
+	linked := false
+	for _, t := range project.Teams {
+		linked = linked || t == team
+	}
+	if !linked {
+		project.Teams = append(project.Teams, team)
+	}
+	return map[string]resolver{"team": value(s.teamObject(team))}, nil
+}
+
+func (s *Store) unlinkProjectFromTeam(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	team, err := lookup[*Team](s, input, "teamId")
+	if err != nil {
+		return nil, err
+	}
+
+	for i, t := range project.Teams {
+		if t == team {
+			project.Teams = append(project.Teams[:i], project.Teams[i+1:]...)
+			break
+		}
+	}
+	return map[string]resolver{"team": value(s.teamObject(team))}, nil
+}
+
+func (s *Store) addProjectItem(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	issue, err := lookup[*Issue](s, input, "contentId")
+	if err != nil {
+		return nil, err
+	}
+
+	item := s.addItem(project, issue, nil)
+	return map[string]resolver{"item": value(s.itemObject(item))}, nil
+}
+
+func (s *Store) addProjectDraftIssue(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	title, _ := stringArg(input, "title")
+	if title == "" {
+		return nil, unprocessable("Title can't be blank")
+	}
+	body, _ := stringArg(input, "body")
+
+	item := s.addDraftIssue(project, title, body)
+	return map[string]resolver{"projectItem": value(s.itemObject(item))}, nil
+}
+
+func (s *Store) updateProjectDraftIssue(input map[string]interface{}) (map[string]resolver, error) {
+	draft, err := lookup[*DraftIssue](s, input, "draftIssueId")
+	if err != nil {
+		return nil, err
+	}
+
+	if title, ok := stringArg(input, "title"); ok {
+		draft.Title = title
+	}
+	if body, ok := stringArg(input, "body"); ok {
+		draft.Body = body
+	}
+	draft.UpdatedAt = s.now()
+	return map[string]resolver{"draftIssue": value(s.draftIssueObject(draft))}, nil
+}
+
+// itemField resolves the project, item and field of an item field value mutation
+func (s *Store) itemField(input map[string]interface{}) (*Item, *Field, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, nil, err
+	}
+	item, err := lookup[*Item](s, input, "itemId")
+	if err != nil {
+		return nil, nil, err
+	}
+	field, err := lookup[*Field](s, input, "fieldId")
+	if err != nil {
+		return nil, nil, err
+	}
+	if item.Project != project || field.Project != project {
+		return nil, nil, unprocessable("The item and field must belong to the project")
+	}
+	return item, field, nil
+}
+
+func (s *Store) updateItemFieldValue(input map[string]interface{}) (map[string]resolver, error) {
+	item, field, err := s.itemField(input)
+	if err != nil {
+		return nil, err
+	}
+	valueInput, ok := input["value"].(map[string]interface{})
+	if !ok {
+		return nil, unprocessable("value is required")
+	}
+
+	var v Value
+	switch field.DataType {
+	case "TEXT":
+		if v.Text, ok = stringArg(valueInput, "text"); !ok {
+			return nil, unprocessable("The field %s requires a text value", field.Name)
+		}
+	case "NUMBER":
+		if v.Number, ok = floatArg(valueInput, "number"); !ok {
+			return nil, unprocessable("The field %s requires a number value", field.Name)
+		}
+	case "DATE":
+		date, _ := stringArg(valueInput, "date")
+		parsed, err := parseDate(date)
+		if err != nil {
+			return nil, unprocessable("The field %s requires a date value", field.Name)
+		}
+		v.Date = parsed
+	case "SINGLE_SELECT":
+		v.OptionID, _ = stringArg(valueInput, "singleSelectOptionId")
+		found := false
+		for _, option := range field.Options {
+			found = found || option.ID == v.OptionID
+		}
+		if !found {
+			return nil, unprocessable("The single select option Id does not belong to the field")
+		}
+	case "ITERATION":
+		v.IterationID, _ = stringArg(valueInput, "iterationId")
+		found := false
+		for _, iteration := range field.Iterations {
+			found = found || iteration.ID == v.IterationID
+		}
+		if !found {
+			return nil, unprocessable("The iteration Id does not belong to the field")
+		}
+	default:
+		return nil, unprocessable("The field %s of type %s cannot be updated", field.Name, field.DataType)
+	}
+
+	item.Values[field.ID] = v
+	item.UpdatedAt = s.touch(item.Project)
+	return map[string]resolver{"projectV2Item": value(s.itemObject(item))}, nil
+}
+
+// parseDate accepts a date or an ISO 8601 timestamp and returns the date part
+func parseDate(date string) (string, error) {
+	if parsed, err := time.Parse("2006-01-02", date); err == nil {
+		return parsed.Format("2006-01-02"), nil
+	}
+	parsed, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Format("2006-01-02"), nil
+}
+
+func (s *Store) clearItemFieldValue(input map[string]interface{}) (map[string]resolver, error) {
+	item, field, err := s.itemField(input)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(item.Values, field.ID)
+	item.UpdatedAt = s.touch(item.Project)
+	return map[string]resolver{"projectV2Item": value(s.itemObject(item))}, nil
+}
+
+func (s *Store) archiveItem(archived bool) func(map[string]interface{}) (map[string]resolver, error) {
+	return func(input map[string]interface{}) (map[string]resolver, error) {
+		item, err := lookup[*Item](s, input, "itemId")
+		if err != nil {
+			return nil, err
+		}
+
+		item.Archived = archived
+		item.UpdatedAt = s.touch(item.Project)
+		return map[string]resolver{"item": value(s.itemObject(item))}, nil
+	}
+}
+
+func (s *Store) deleteProjectItem(input map[string]interface{}) (map[string]resolver, error) {
+	item, err := lookup[*Item](s, input, "itemId")
+	if err != nil {
+		return nil, err
+	}
+
+	project := item.Project
+	for i, it := range project.Items {
+		if it == item {
+			project.Items = append(project.Items[:i], project.Items[i+1:]...)
+			break
+		}
+	}
+	s.touch(project)
+	s.unregister(item.ID)
+	if item.Draft != nil {
+		s.unregister(item.Draft.ID)
+	}
+	return map[string]resolver{"deletedItemId": value(item.ID)}, nil
+}
+
+// updateItemPosition moves an item after another item of its project, or to the
+// top without afterId
+func (s *Store) updateItemPosition(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	item, err := lookup[*Item](s, input, "itemId")
+	if err != nil {
+		return nil, err
+	}
+	var after *Item
+	if afterID, _ := stringArg(input, "afterId"); afterID != "" {
+		if after, err = lookup[*Item](s, input, "afterId"); err != nil {
+			return nil, err
+		}
+	}
+	if item.Project != project || (after != nil && after.Project != project) {
+		return nil, unprocessable("The item does not belong to the project")
+	}
+
+	items := make([]*Item, 0, len(project.Items))
+	if after == nil {
+		items = append(items, item)
+	}
+	for _, it := range project.Items {
+		if it == item {
+			continue
+		}
+		items = append(items, it)
+		if it == after {
+			items = append(items, item)
+		}
+	}
+	project.Items = items
+	s.touch(project)
+	return map[string]resolver{"items": connectionResolver("ProjectV2Item", func(map[string]interface{}) []*object {
+		nodes := make([]*object, len(project.Items))
+		for i, it := range project.Items {
+			nodes[i] = s.itemObject(it)
+		}
+		return nodes
+	})}, nil
+}
+
+// convertDraftIssue turns a draft issue item into an issue; the item keeps its ID
+// and field values like on GitHub
+func (s *Store) convertDraftIssue(input map[string]interface{}) (map[string]resolver, error) {
+	item, err := lookup[*Item](s, input, "itemId")
+	if err != nil {
+		return nil, err
+	}
+	if item.Draft == nil {
+		return nil, unprocessable("The item is not a draft issue")
+	}
+	repo, err := lookup[*Repository](s, input, "repositoryId")
+	if err != nil {
+		return nil, err
+	}
+
+	draft := item.Draft
+	issue := s.addIssue(repo, draft.Title, false)
+	issue.Body = draft.Body
+	issue.Assignees = append(issue.Assignees, draft.Assignees...)
+	s.unregister(draft.ID)
+	item.Draft = nil
+	item.Issue = issue
+	item.UpdatedAt = s.touch(item.Project)
+	return map[string]resolver{"item": value(s.itemObject(item))}, nil
+}
+
+func (s *Store) addLabels(input map[string]interface{}) (map[string]resolver, error) {
+	issue, err := lookup[*Issue](s, input, "labelableId")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range stringListArg(input, "labelIds") {
+		label, ok := s.nodes[id].(*Label)
+		if !ok {
+			return nil, notFound("Could not resolve to a node with the global id of '%s'", id)
+		}
+		if !hasAnyLabel(issue, []string{label.Name}) {
+			issue.Labels = append(issue.Labels, label)
+		}
+	}
+	issue.UpdatedAt = s.now()
+	return map[string]resolver{"labelable": value(s.issueObject(issue))}, nil
+}
+
+func (s *Store) addAssignees(input map[string]interface{}) (map[string]resolver, error) {
+	issue, err := lookup[*Issue](s, input, "assignableId")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, id := range stringListArg(input, "assigneeIds") {
+		account, ok := s.nodes[id].(*Account)
+		if !ok || account.Organization {
+			return nil, notFound("Could not resolve to a User with the global id of '%s'", id)
+		}
+		assigned := false
+		for _, assignee := range issue.Assignees {
+			assigned = assigned || assignee == account
+		}
+		if !assigned {
+			issue.Assignees = append(issue.Assignees, account)
+		}
+	}
+	issue.UpdatedAt = s.now()
+	return map[string]resolver{"assignable": value(s.issueObject(issue))}, nil
+}
+
+// subIssueArgs looks up the parent issue and sub-issue of a sub-issue mutation
+func (s *Store) subIssueArgs(input map[string]interface{}) (parent, child *Issue, err error) {
+	if parent, err = lookup[*Issue](s, input, "issueId"); err != nil {
+		return nil, nil, err
+	}
+	if child, err = lookup[*Issue](s, input, "subIssueId"); err != nil {
+		return nil, nil, err
+	}
+	if parent.PullRequest || child.PullRequest {
+		return nil, nil, unprocessable("Sub-issues can only be linked between issues")
+	}
+	return parent, child, nil
+}
+
+func (s *Store) addSubIssue(input map[string]interface{}) (map[string]resolver, error) {
+	parent, child, err := s.subIssueArgs(input)
+	if err != nil {
+		return nil, err
+	}
+	for ancestor := parent; ancestor != nil; ancestor = ancestor.Parent {
+		if ancestor == child {
+			return nil, unprocessable("An issue cannot be a sub-issue of itself or of its sub-issues")
+		}
+	}
+	if replace, _ := boolArg(input, "replaceParent"); child.Parent != nil && !replace {
+		return nil, unprocessable("Issue may not contain duplicate sub-issues and may only have one parent")
+	}
+
+	s.linkSubIssue(parent, child)
+	return map[string]resolver{"issue": value(s.issueObject(parent)), "subIssue": value(s.issueObject(child))}, nil
+}
+
+func (s *Store) removeSubIssue(input map[string]interface{}) (map[string]resolver, error) {
+	parent, child, err := s.subIssueArgs(input)
+	if err != nil {
+		return nil, err
+	}
+	if child.Parent != parent {
+		return nil, unprocessable("%s#%d is not a sub-issue of %s#%d",
+			child.Repository.NameWithOwner(), child.Number, parent.Repository.NameWithOwner(), parent.Number)
+	}
+
+	s.unlinkSubIssue(parent, child)
+	return map[string]resolver{"issue": value(s.issueObject(parent)), "subIssue": value(s.issueObject(child))}, nil
+}
+
+func (s *Store) createField(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	name, _ := stringArg(input, "name")
+	dataType, _ := stringArg(input, "dataType")
+	if name == "" {
+		return nil, unprocessable("Name can't be blank")
+	}
+	if project.Field(name) != nil {
+		return nil, unprocessable("Name has already been taken")
+	}
+	switch dataType {
+	case "TEXT", "NUMBER", "DATE", "SINGLE_SELECT", "ITERATION":
+	default:
+		return nil, unprocessable("Data type %q is not supported", dataType)
+	}
+
+	var options []*Option
+	if list, ok := input["singleSelectOptions"].([]interface{}); ok {
+		for _, entry := range list {
+			optionInput, _ := entry.(map[string]interface{})
+			option := &Option{}
+			option.Name, _ = stringArg(optionInput, "name")
+			option.Color, _ = stringArg(optionInput, "color")
+			option.Description, _ = stringArg(optionInput, "description")
+			options = append(options, option)
+		}
+	}
+	if dataType == "SINGLE_SELECT" && len(options) == 0 {
+		return nil, unprocessable("Single select fields require at least one option")
+	}
+	config, hasConfig := input["iterationConfiguration"].(map[string]interface{})
+	if dataType == "ITERATION" && !hasConfig {
+		return nil, unprocessable("Iteration fields require an iteration configuration")
+	}
+
+	field := s.addField(project, name, dataType, options)
+	if dataType == "ITERATION" {
+		field.Iterations = nil
+		if err := s.configureIterations(field, config); err != nil {
+			s.removeField(field)
+			return nil, err
+		}
+	}
+	s.touch(project)
+	return map[string]resolver{"projectV2Field": value(s.fieldObject(field))}, nil
+}
+
+func (s *Store) updateField(input map[string]interface{}) (map[string]resolver, error) {
+	field, err := lookup[*Field](s, input, "fieldId")
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := stringArg(input, "name"); ok {
+		if name == "" {
+			return nil, unprocessable("Name can't be blank")
+		}
+		field.Name = name
+	}
+	if config, ok := input["iterationConfiguration"].(map[string]interface{}); ok {
+		if field.DataType != "ITERATION" {
+			return nil, unprocessable("Only iteration fields have an iteration configuration")
+		}
+		if err := s.configureIterations(field, config); err != nil {
+			return nil, err
+		}
+	}
+	if list, ok := input["singleSelectOptions"].([]interface{}); ok {
+		if field.DataType != "SINGLE_SELECT" {
+			return nil, unprocessable("Only single select fields have options")
+		}
+		if err := s.replaceOptions(field, list); err != nil {
+			return nil, err
+		}
+	}
+	field.UpdatedAt = s.touch(field.Project)
+	return map[string]resolver{"projectV2Field": value(s.fieldObject(field))}, nil
+}
+
+// replaceOptions replaces the options of a single select field with the ones in a
+// list of option inputs, in their order. Inputs with the ID of an existing option
+// update it; items with an option that is left out lose their value.
+func (s *Store) replaceOptions(field *Field, list []interface{}) error {
+	if len(list) == 0 {
+		return unprocessable("Single select fields require at least one option")
+	}
+
+	existing := map[string]*Option{}
+	for _, option := range field.Options {
+		existing[option.ID] = option
+	}
+
+	options := make([]*Option, 0, len(list))
+	for _, entry := range list {
+		optionInput, _ := entry.(map[string]interface{})
+		name, _ := stringArg(optionInput, "name")
+		if name == "" {
+			return unprocessable("Option names can't be blank")
+		}
+		option := &Option{ID: s.newShortID()}
+		if id, ok := stringArg(optionInput, "id"); ok {
+			if option = existing[id]; option == nil {
+				return notFound("Could not resolve to a single select option with the id of '%s'", id)
+			}
+			delete(existing, id)
+		}
+		option.Name = name
+		option.Color, _ = stringArg(optionInput, "color")
+		option.Description, _ = stringArg(optionInput, "description")
+		options = append(options, option)
+	}
+
+	field.Options = options
+	for _, removed := range existing {
+		for _, item := range field.Project.Items {
+			if v, ok := item.Values[field.ID]; ok && v.OptionID == removed.ID {
+				delete(item.Values, field.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Store) deleteField(input map[string]interface{}) (map[string]resolver, error) {
+	field, err := lookup[*Field](s, input, "fieldId")
+	if err != nil {
+		return nil, err
+	}
+
+	s.removeField(field)
+	s.touch(field.Project)
+	return map[string]resolver{"projectV2Field": value(s.fieldObject(field))}, nil
+}
+
+// removeField removes a field and its values from its project
+func (s *Store) removeField(field *Field) {
+	project := field.Project
+	for i, f := range project.Fields {
+		if f == field {
+			project.Fields = append(project.Fields[:i], project.Fields[i+1:]...)
+			break
+		}
+	}
+	for _, item := range project.Items {
+		delete(item.Values, field.ID)
+	}
+	s.unregister(field.ID)
+}
+
+// configureIterations replaces the iterations of a field with the ones in an iteration
+// configuration input. Like GitHub, every iteration gets a new ID, since iteration
+// inputs carry none, and all items lose their value for the field.
+func (s *Store) configureIterations(field *Field, config map[string]interface{}) error {
+	duration, ok := intArg(config, "duration")
+	if !ok || duration <= 0 {
+		return unprocessable("Iteration duration must be greater than 0")
+	}
+	startDate, _ := stringArg(config, "startDate")
+	start, err := time.Parse("2006-01-02", startDate)
+	if err != nil {
+		return unprocessable("Start date is not a valid date")
+	}
+
+	list, _ := config["iterations"].([]interface{})
+	iterations := make([]*Iteration, 0, len(list))
+	for _, entry := range list {
+		iterationInput, _ := entry.(map[string]interface{})
+		title, _ := stringArg(iterationInput, "title")
+		date, _ := stringArg(iterationInput, "startDate")
+		days, _ := intArg(iterationInput, "duration")
+		iterationStart, parseErr := time.Parse("2006-01-02", date)
+		if title == "" || parseErr != nil || days <= 0 {
+			return unprocessable("Iterations require a title, a start date and a duration")
+		}
+
+		iterations = append(iterations, &Iteration{
+			ID:        s.newShortID(),
+			Title:     title,
+			StartDate: iterationStart,
+			Duration:  days,
+		})
+	}
+	if len(list) == 0 {
+		for i := 1; i <= 3; i++ {
+			iterations = append(iterations, &Iteration{
+				ID:        s.newShortID(),
+				Title:     "Iteration " + strconv.Itoa(i),
+				StartDate: start.AddDate(0, 0, (i-1)*duration),
+				Duration:  duration,
+			})
+		}
+	}
+
+	sortIterations(iterations)
+	for i := 1; i < len(iterations); i++ {
+		if iterations[i-1].EndDate().After(iterations[i].StartDate) {
+			return unprocessable("Iterations cannot overlap")
+		}
+	}
+
+	field.IterationDuration = duration
+	field.Iterations = iterations
+	for _, item := range field.Project.Items {
+		delete(item.Values, field.ID)
+	}
+	return nil
+}
+
+// findOption finds a single select option by ID across all projects
+func (s *Store) findOption(id string) (*Field, *Option, error) {
+	for _, project := range s.projects() {
+		for _, field := range project.Fields {
+			for _, option := range field.Options {
+				if option.ID == id {
+					return field, option, nil
+				}
+			}
+		}
+	}
+	return nil, nil, notFound("Could not resolve to a single select option with the id of '%s'", id)
+}
+
+func (s *Store) createOption(input map[string]interface{}) (map[string]resolver, error) {
+	field, err := lookup[*Field](s, input, "fieldId")
+	if err != nil {
+		return nil, err
+	}
+	if field.DataType != "SINGLE_SELECT" {
+		return nil, unprocessable("Options can only be added to single select fields")
+	}
+
+	option := &Option{}
+	option.Name, _ = stringArg(input, "name")
+	option.Color, _ = stringArg(input, "color")
+	option.Description, _ = stringArg(input, "description")
+	if option.Name == "" {
+		return nil, unprocessable("Name can't be blank")
+	}
+	s.addOption(field, option)
+	field.UpdatedAt = s.touch(field.Project)
+	return map[string]resolver{"projectV2SingleSelectFieldOption": value(optionObject(option))}, nil
+}
+
+func (s *Store) updateOption(input map[string]interface{}) (map[string]resolver, error) {
+	id, _ := stringArg(input, "singleSelectOptionId")
+	field, option, err := s.findOption(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := stringArg(input, "name"); ok {
+		option.Name = name
+	}
+	if color, ok := stringArg(input, "color"); ok {
+		option.Color = color
+	}
+	if description, ok := stringArg(input, "description"); ok {
+		option.Description = description
+	}
+	field.UpdatedAt = s.touch(field.Project)
+	return map[string]resolver{"projectV2SingleSelectFieldOption": value(optionObject(option))}, nil
+}
+
+func (s *Store) deleteOption(input map[string]interface{}) (map[string]resolver, error) {
+	id, _ := stringArg(input, "singleSelectOptionId")
+	field, option, err := s.findOption(id)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, o := range field.Options {
+		if o == option {
+			field.Options = append(field.Options[:i], field.Options[i+1:]...)
+			break
+		}
+	}
+	for _, item := range field.Project.Items {
+		if v, ok := item.Values[field.ID]; ok && v.OptionID == option.ID {
+			delete(item.Values, field.ID)
+		}
+	}
+	field.UpdatedAt = s.touch(field.Project)
+	return map[string]resolver{"projectV2SingleSelectFieldOption": value(optionObject(option))}, nil
+}
+
+func (s *Store) createView(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	name, _ := stringArg(input, "name")
+	layout, _ := stringArg(input, "layout")
+	if name == "" {
+		return nil, unprocessable("Name can't be blank")
+	}
+	if layout == "" {
+		layout = "TABLE_LAYOUT"
+	}
+
+	view := s.addView(project, name, layout)
+	if filter, ok := stringArg(input, "filter"); ok {
+		view.Filter = filter
+	}
+	return map[string]resolver{"projectV2View": value(s.viewObject(view))}, nil
+}
+
+func (s *Store) updateView(input map[string]interface{}) (map[string]resolver, error) {
+	view, err := lookup[*View](s, input, "viewId")
+	if err != nil {
+		return nil, err
+	}
+
+	if name, ok := stringArg(input, "name"); ok {
+		view.Name = name
+	}
+	if filter, ok := stringArg(input, "filter"); ok {
+		view.Filter = filter
+	}
+	if layout, ok := stringArg(input, "layout"); ok {
+		view.Layout = layout
+	}
+	direction, _ := stringArg(input, "direction")
+	if direction == "" {
+		direction = "ASC"
+	}
+	for key, target := range map[string]*[]ViewSetting{"sortById": &view.SortBy, "groupById": &view.GroupBy} {
+		if _, ok := input[key]; !ok {
+			continue
+		}
+		id, _ := stringArg(input, key)
+		if id == "" {
+			*target = nil
+			continue
+		}
+		field, ok := s.nodes[id].(*Field)
+		if !ok || field.Project != view.Project {
+			return nil, notFound("Could not resolve to a field with the global id of '%s'", id)
+		}
+		*target = []ViewSetting{{Field: field, Direction: direction}}
+	}
+	view.UpdatedAt = s.now()
+	return map[string]resolver{"projectV2View": value(s.viewObject(view))}, nil
+}
+
+func (s *Store) deleteView(input map[string]interface{}) (map[string]resolver, error) {
+	view, err := lookup[*View](s, input, "viewId")
+	if err != nil {
+		return nil, err
+	}
+
+	project := view.Project
+	for i, v := range project.Views {
+		if v == view {
+			project.Views = append(project.Views[:i], project.Views[i+1:]...)
+			break
+		}
+	}
+	s.unregister(view.ID)
+	return map[string]resolver{"projectV2View": value(s.viewObject(view))}, nil
+}
+
+func (s *Store) copyView(input map[string]interface{}) (map[string]resolver, error) {
+	project, err := lookup[*Project](s, input, "projectId")
+	if err != nil {
+		return nil, err
+	}
+	source, err := lookup[*View](s, input, "viewId")
+	if err != nil {
+		return nil, err
+	}
+	name, _ := stringArg(input, "name")
+	if name == "" {
+		name = source.Name + " (copy)"
+	}
+
+	view := s.addView(project, name, source.Layout)
+	view.Filter = source.Filter
+	view.SortBy = append([]ViewSetting(nil), source.SortBy...)
+	view.GroupBy = append([]ViewSetting(nil), source.GroupBy...)
+	view.VerticalGroupBy = append([]ViewSetting(nil), source.VerticalGroupBy...)
+	view.Fields = append([]*Field(nil), source.Fields...)
+	return map[string]resolver{"projectV2View": value(s.viewObject(view))}, nil
+}
+
+func (s *Store) createDiscussion(input map[string]interface{}) (map[string]resolver, error) {
+	repo, err := lookup[*Repository](s, input, "repositoryId")
+	if err != nil {
+		return nil, err
+	}
+	category, err := lookup[*DiscussionCategory](s, input, "categoryId")
+	if err != nil {
+		return nil, err
+	}
+	title, _ := stringArg(input, "title")
+	body, _ := stringArg(input, "body")
+	if title == "" {
+		return nil, unprocessable("Title can't be blank")
+	}
+
+	discussion := s.addDiscussion(repo, category, title, body)
+	return map[string]resolver{"discussion": value(s.discussionObject(discussion))}, nil
+}
+
+func (s *Store) updateDiscussion(input map[string]interface{}) (map[string]resolver, error) {
+	discussion, err := lookup[*Discussion](s, input, "discussionId")
+	if err != nil {
+		return nil, err
+	}
+
+	if title, ok := stringArg(input, "title"); ok {
+		discussion.Title = title
+	}
+	if body, ok := stringArg(input, "body"); ok {
+		discussion.Body = body
+	}
+	if _, ok := input["categoryId"]; ok {
+		category, err := lookup[*DiscussionCategory](s, input, "categoryId")
+		if err != nil {
+			return nil, err
+		}
+		discussion.Category = category
+	}
+	discussion.UpdatedAt = s.now()
+	return map[string]resolver{"discussion": value(s.discussionObject(discussion))}, nil
+}
+
+func (s *Store) deleteDiscussion(input map[string]interface{}) (map[string]resolver, error) {
+	discussion, err := lookup[*Discussion](s, input, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	repo := discussion.Repository
+	for i, d := range repo.Discussions {
+		if d == discussion {
+			repo.Discussions = append(repo.Discussions[:i], repo.Discussions[i+1:]...)
+			break
+		}
+	}
+	s.unregister(discussion.ID)
+	return map[string]resolver{"discussion": value(s.discussionObject(discussion))}, nil
+}
+
+func (s *Store) closeDiscussion(input map[string]interface{}) (map[string]resolver, error) {
+	discussion, err := lookup[*Discussion](s, input, "discussionId")
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	discussion.Closed = true
+	discussion.ClosedAt = &now
+	discussion.UpdatedAt = now
+	return map[string]resolver{"discussion": value(s.discussionObject(discussion))}, nil
+}
+
+func (s *Store) reopenDiscussion(input map[string]interface{}) (map[string]resolver, error) {
+	discussion, err := lookup[*Discussion](s, input, "discussionId")
+	if err != nil {
+		return nil, err
+	}
+
+	discussion.Closed = false
+	discussion.ClosedAt = nil
+	discussion.UpdatedAt = s.now()
+	return map[string]resolver{"discussion": value(s.discussionObject(discussion))}, nil
+}
+
+func (s *Store) setLocked(locked bool) func(map[string]interface{}) (map[string]resolver, error) {
+	return func(input map[string]interface{}) (map[string]resolver, error) {
+		discussion, err := lookup[*Discussion](s, input, "lockableId")
+		if err != nil {
+			return nil, err
+		}
+
+		discussion.Locked = locked
+		record := "lockedRecord"
+		if !locked {
+			record = "unlockedRecord"
+		}
+		return map[string]resolver{record: value(s.discussionObject(discussion))}, nil
+	}
+}
+
+func (s *Store) addDiscussionComment(input map[string]interface{}) (map[string]resolver, error) {
+	discussion, err := lookup[*Discussion](s, input, "discussionId")
+	if err != nil {
+		return nil, err
+	}
+	body, _ := stringArg(input, "body")
+	if body == "" {
+		return nil, unprocessable("Body can't be blank")
+	}
+	if discussion.Locked {
+		return nil, &Error{Type: "FORBIDDEN", Message: "Discussion is locked"}
+	}
+
+	now := s.now()
+	comment := &DiscussionComment{
+		ID:         s.newID("DC"),
+		Discussion: discussion,
+		Author:     s.viewer(),
+		Body:       body,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if _, ok := input["replyToId"]; ok {
+		replyTo, err := lookup[*DiscussionComment](s, input, "replyToId")
+		if err != nil {
+			return nil, err
+		}
+		comment.ReplyTo = replyTo
+	}
+	discussion.Comments = append(discussion.Comments, comment)
+	s.register(comment.ID, comment)
+	return map[string]resolver{"comment": value(s.commentObject(comment))}, nil
+}
+
+func (s *Store) updateDiscussionComment(input map[string]interface{}) (map[string]resolver, error) {
+	comment, err := lookup[*DiscussionComment](s, input, "commentId")
+	if err != nil {
+		return nil, err
+	}
+
+	comment.Body, _ = stringArg(input, "body")
+	comment.UpdatedAt = s.now()
+	return map[string]resolver{"comment": value(s.commentObject(comment))}, nil
+}
+
+func (s *Store) deleteDiscussionComment(input map[string]interface{}) (map[string]resolver, error) {
+	comment, err := lookup[*DiscussionComment](s, input, "id")
+	if err != nil {
+		return nil, err
+	}
+
+	discussion := comment.Discussion
+	for i, c := range discussion.Comments {
+		if c == comment {
+			discussion.Comments = append(discussion.Comments[:i], discussion.Comments[i+1:]...)
+			break
+		}
+	}
+	if discussion.Answer == comment {
+		discussion.Answer = nil
+	}
+	s.unregister(comment.ID)
+	return map[string]resolver{"comment": value(s.commentObject(comment))}, nil
+}
+
+func (s *Store) markAnswer(answer bool) func(map[string]interface{}) (map[string]resolver, error) {
+	return func(input map[string]interface{}) (map[string]resolver, error) {
+		comment, err := lookup[*DiscussionComment](s, input, "id")
+		if err != nil {
+			return nil, err
+		}
+
+		discussion := comment.Discussion
+		if answer {
+			if !discussion.Category.Answerable {
+				return nil, unprocessable("Discussion category %q does not accept answers", discussion.Category.Name)
+			}
+			discussion.Answer = comment
+		} else if discussion.Answer == comment {
+			discussion.Answer = nil
+		}
+		discussion.UpdatedAt = s.now()
+		return map[string]resolver{"discussion": value(s.discussionObject(discussion))}, nil
+	}
+}
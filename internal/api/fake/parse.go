@@ -0,0 +1,507 @@
+package fake
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// tokenKind identifies the kind of a GraphQL token
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenName
+	tokenPunct
+	tokenString
+	tokenInt
+	tokenFloat
+)
+
+// token is a lexical GraphQL token
+type token struct {
+	text string
+	kind tokenKind
+}
+
+// operation is a parsed GraphQL operation
+type operation struct {
+	kind       string
+	selections []*selection
+}
+
+// selection is a field or inline fragment in a selection set
+type selection struct {
+	args          map[string]interface{}
+	alias         string
+	name          string
+	typeCondition string
+	selections    []*selection
+	fragment      bool
+}
+
+// responseKey returns the key the selection is written to in the response
+func (s *selection) responseKey() string {
+	if s.alias != "" {
+		return s.alias
+	}
+	return s.name
+}
+
+// variable is a reference to an operation variable in an argument value
+type variable string
+
+// enumValue is an enum literal in an argument value
+type enumValue string
+
+// lex splits a GraphQL document into tokens; commas are insignificant and dropped
+func lex(src string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',':
+			i++
+		case c == '#':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case strings.HasPrefix(src[i:], "..."):
+			tokens = append(tokens, token{kind: tokenPunct, text: "..."})
+			i += 3
+		case strings.ContainsRune("{}()[]:!$=@|&", rune(c)):
+			tokens = append(tokens, token{kind: tokenPunct, text: string(c)})
+			i++
+		case c == '"':
+			text, n, err := lexString(src[i:])
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text})
+			i += n
+		case c == '-' || (c >= '0' && c <= '9'):
+			start := i
+			kind := tokenInt
+			i++
+			for i < len(src) && strings.IndexByte("0123456789.eE+-", src[i]) >= 0 {
+				if src[i] == '.' || src[i] == 'e' || src[i] == 'E' {
+					kind = tokenFloat
+				}
+				i++
+			}
+			tokens = append(tokens, token{kind: kind, text: src[start:i]})
+		case c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z'):
+			start := i
+			for i < len(src) && (src[i] == '_' || (src[i] >= 'a' && src[i] <= 'z') ||
+				(src[i] >= 'A' && src[i] <= 'Z') || (src[i] >= '0' && src[i] <= '9')) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenName, text: src[start:i]})
+		default:
+			return nil, fmt.Errorf("unexpected character %q", c)
+		}
+	}
+	return tokens, nil
+}
+
+// lexString reads a quoted string at the start of src and returns its value and length
+func lexString(src string) (string, int, error) {
+	if strings.HasPrefix(src, `"""`) {
+		end := strings.Index(src[3:], `"""`)
+		if end < 0 {
+			return "", 0, fmt.Errorf("unterminated block string")
+		}
+		return src[3 : 3+end], end + 6, nil
+	}
+
+	for i := 1; i < len(src); i++ {
+		switch src[i] {
+		case '\\':
+			i++
+		case '"':
+			value, err := strconv.Unquote(src[:i+1])
+			if err != nil {
+				return "", 0, fmt.Errorf("invalid string literal: %w", err)
+			}
+			return value, i + 1, nil
+		case '\n':
+			return "", 0, fmt.Errorf("unterminated string")
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated string")
+}
+
+// parser builds operations from tokens
+type parser struct {
+	fragments map[string]*selection
+	tokens    []token
+	pos       int
+}
+
+// parse parses a GraphQL document and returns its single operation
+func parse(src string) (*operation, error) {
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens, fragments: map[string]*selection{}}
+	var op *operation
+	for !p.at(tokenEOF, "") {
+		if p.at(tokenName, "fragment") {
+			if err := p.parseFragmentDefinition(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if op != nil {
+			return nil, fmt.Errorf("documents with multiple operations are not supported")
+		}
+		if op, err = p.parseOperation(); err != nil {
+			return nil, err
+		}
+	}
+	if op == nil {
+		return nil, fmt.Errorf("document does not contain an operation")
+	}
+
+	if err := p.expandFragments(op.selections, 0); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (p *parser) peek() token {
+	if p.pos >= len(p.tokens) {
+		return token{kind: tokenEOF}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.peek()
+	if p.pos < len(p.tokens) {
+		p.pos++
+	}
+	return t
+}
+
+// at reports whether the next token has the given kind and, if text is set, text
+func (p *parser) at(kind tokenKind, text string) bool {
+	t := p.peek()
+	return t.kind == kind && (text == "" || t.text == text)
+}
+
+func (p *parser) expect(kind tokenKind, text string) (token, error) {
+	t := p.next()
+	if t.kind != kind || (text != "" && t.text != text) {
+		want := text
+		if want == "" {
+			want = "name"
+		}
+		if t.kind == tokenEOF {
+			return t, fmt.Errorf("expected %q, got end of document", want)
+		}
+		return t, fmt.Errorf("expected %q, got %q", want, t.text)
+	}
+	return t, nil
+}
+
+func (p *parser) parseOperation() (*operation, error) {
+	op := &operation{kind: "query"}
+	if p.at(tokenName, "") {
+		op.kind = p.next().text
+		if op.kind != "query" && op.kind != "mutation" {
+			return nil, fmt.Errorf("unsupported operation type %q", op.kind)
+		}
+		if p.at(tokenName, "") {
+			p.next()
+		}
+		if p.at(tokenPunct, "(") {
+			if err := p.skipVariableDefinitions(); err != nil {
+				return nil, err
+			}
+		}
+		p.skipDirectives()
+	}
+
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = selections
+	return op, nil
+}
+
+// skipVariableDefinitions skips the variable definitions; values come from the request
+func (p *parser) skipVariableDefinitions() error {
+	depth := 0
+	for {
+		t := p.next()
+		switch {
+		case t.kind == tokenEOF:
+			return fmt.Errorf("unterminated variable definitions")
+		case t.kind == tokenPunct && t.text == "(":
+			depth++
+		case t.kind == tokenPunct && t.text == ")":
+			depth--
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+}
+
+func (p *parser) skipDirectives() {
+	for p.at(tokenPunct, "@") {
+		p.next()
+		p.next()
+		if p.at(tokenPunct, "(") {
+			_, _ = p.parseArguments()
+		}
+	}
+}
+
+func (p *parser) parseFragmentDefinition() error {
+	p.next()
+	name, err := p.expect(tokenName, "")
+	if err != nil {
+		return err
+	}
+	if _, err := p.expect(tokenName, "on"); err != nil {
+		return err
+	}
+	typeName, err := p.expect(tokenName, "")
+	if err != nil {
+		return err
+	}
+	p.skipDirectives()
+
+	selections, err := p.parseSelectionSet()
+	if err != nil {
+		return err
+	}
+	p.fragments[name.text] = &selection{fragment: true, typeCondition: typeName.text, selections: selections}
+	return nil
+}
+
+func (p *parser) parseSelectionSet() ([]*selection, error) {
+	if _, err := p.expect(tokenPunct, "{"); err != nil {
+		return nil, err
+	}
+
+	var selections []*selection
+	for !p.at(tokenPunct, "}") {
+		if p.at(tokenEOF, "") {
+			return nil, fmt.Errorf("unterminated selection set")
+		}
+		sel, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		selections = append(selections, sel)
+	}
+	p.next()
+	return selections, nil
+}
+
+func (p *parser) parseSelection() (*selection, error) {
+	if p.at(tokenPunct, "...") {
+		p.next()
+		sel := &selection{fragment: true}
+		switch {
+		case p.at(tokenName, "on"):
+			p.next()
+			typeName, err := p.expect(tokenName, "")
+			if err != nil {
+				return nil, err
+			}
+			sel.typeCondition = typeName.text
+		case p.at(tokenName, ""):
+			// Named fragment spread, expanded once the whole document is parsed
+			sel.name = p.next().text
+			p.skipDirectives()
+			return sel, nil
+		}
+		p.skipDirectives()
+
+		selections, err := p.parseSelectionSet()
+		if err != nil {
+			return nil, err
+		}
+		sel.selections = selections
+		return sel, nil
+	}
+
+	name, err := p.expect(tokenName, "")
+	if err != nil {
+		return nil, err
+	}
+	sel := &selection{name: name.text}
+	if p.at(tokenPunct, ":") {
+		p.next()
+		field, err := p.expect(tokenName, "")
+		if err != nil {
+			return nil, err
+		}
+		sel.alias, sel.name = name.text, field.text
+	}
+	if p.at(tokenPunct, "(") {
+		if sel.args, err = p.parseArguments(); err != nil {
+			return nil, err
+		}
+	}
+	p.skipDirectives()
+	if p.at(tokenPunct, "{") {
+		if sel.selections, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return sel, nil
+}
+
+func (p *parser) parseArguments() (map[string]interface{}, error) {
+	p.next()
+	args := map[string]interface{}{}
+	for !p.at(tokenPunct, ")") {
+		name, err := p.expect(tokenName, "")
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(tokenPunct, ":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		args[name.text] = value
+	}
+	p.next()
+	return args, nil
+}
+
+func (p *parser) parseValue() (interface{}, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		return t.text, nil
+	case tokenInt:
+		n, err := strconv.Atoi(t.text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %q", t.text)
+		}
+		return n, nil
+	case tokenFloat:
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid float %q", t.text)
+		}
+		return f, nil
+	case tokenName:
+		switch t.text {
+		case "true":
+			return true, nil
+		case "false":
+			return false, nil
+		case "null":
+			return nil, nil
+		default:
+			return enumValue(t.text), nil
+		}
+	case tokenPunct:
+		switch t.text {
+		case "$":
+			name, err := p.expect(tokenName, "")
+			if err != nil {
+				return nil, err
+			}
+			return variable(name.text), nil
+		case "[":
+			list := []interface{}{}
+			for !p.at(tokenPunct, "]") {
+				value, err := p.parseValue()
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, value)
+			}
+			p.next()
+			return list, nil
+		case "{":
+			object := map[string]interface{}{}
+			for !p.at(tokenPunct, "}") {
+				name, err := p.expect(tokenName, "")
+				if err != nil {
+					return nil, err
+				}
+				if _, err := p.expect(tokenPunct, ":"); err != nil {
+					return nil, err
+				}
+				value, err := p.parseValue()
+				if err != nil {
+					return nil, err
+				}
+				object[name.text] = value
+			}
+			p.next()
+			return object, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected %q in argument value", t.text)
+}
+
+// expandFragments replaces named fragment spreads with the fragment definitions
+func (p *parser) expandFragments(selections []*selection, depth int) error {
+	const maxFragmentDepth = 32
+	if depth > maxFragmentDepth {
+		return fmt.Errorf("fragment spreads nest too deeply")
+	}
+
+	for i, sel := range selections {
+		if sel.fragment && sel.name != "" {
+			definition, ok := p.fragments[sel.name]
+			if !ok {
+				return fmt.Errorf("unknown fragment %q", sel.name)
+			}
+			selections[i] = definition
+			sel = definition
+		}
+		if err := p.expandFragments(sel.selections, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveArguments substitutes variables in argument values
+func resolveArguments(args, variables map[string]interface{}) map[string]interface{} {
+	resolved := make(map[string]interface{}, len(args))
+	for name, value := range args {
+		resolved[name] = resolveValue(value, variables)
+	}
+	return resolved
+}
+
+func resolveValue(value interface{}, variables map[string]interface{}) interface{} {
+	switch v := value.(type) {
+	case variable:
+		return variables[string(v)]
+	case enumValue:
+		return string(v)
+	case []interface{}:
+		list := make([]interface{}, len(v))
+		for i, item := range v {
+			list[i] = resolveValue(item, variables)
+		}
+		return list
+	case map[string]interface{}:
+		object := make(map[string]interface{}, len(v))
+		for name, item := range v {
+			object[name] = resolveValue(item, variables)
+		}
+		return object
+	default:
+		return v
+	}
+}
@@ -0,0 +1,198 @@
+package fake
+
+import (
+	"strings"
+)
+
+// queryRoot builds the Query root object
+func (s *Store) queryRoot() *object {
+	return newObject("Query", map[string]resolver{
+		"viewer": func(map[string]interface{}) (interface{}, error) {
+			return s.accountObject(s.viewer()), nil
+		},
+		"user": func(args map[string]interface{}) (interface{}, error) {
+			login, _ := stringArg(args, "login")
+			account := s.accounts[strings.ToLower(login)]
+			if account == nil || account.Organization {
+				return nil, notFound("Could not resolve to a User with the login of '%s'.", login)
+			}
+			return s.accountObject(account), nil
+		},
+		"organization": func(args map[string]interface{}) (interface{}, error) {
+			login, _ := stringArg(args, "login")
+			account := s.accounts[strings.ToLower(login)]
+			if account == nil || !account.Organization {
+				return nil, notFound("Could not resolve to an Organization with the login of '%s'.", login)
+			}
+			return s.accountObject(account), nil
+		},
+		"repositoryOwner": func(args map[string]interface{}) (interface{}, error) {
+			login, _ := stringArg(args, "login")
+			if account := s.accounts[strings.ToLower(login)]; account != nil {
+				return s.accountObject(account), nil
+			}
+			return nil, nil
+		},
+		"repository": func(args map[string]interface{}) (interface{}, error) {
+			owner, _ := stringArg(args, "owner")
+			name, _ := stringArg(args, "name")
+			repo := s.repository(owner, name)
+			if repo == nil {
+				return nil, notFound("Could not resolve to a Repository with the name '%s/%s'.", owner, name)
+			}
+			return s.repositoryObject(repo), nil
+		},
+		"node": func(args map[string]interface{}) (interface{}, error) {
+			id, _ := stringArg(args, "id")
+			node, ok := s.nodes[id]
+			if !ok {
+				return nil, notFound("Could not resolve to a node with the global id of '%s'", id)
+			}
+			return s.objectFor(node), nil
+		},
+		"nodes": func(args map[string]interface{}) (interface{}, error) {
+			ids := stringListArg(args, "ids")
+			nodes := make([]interface{}, len(ids))
+			for i, id := range ids {
+				node, ok := s.nodes[id]
+				if !ok {
+					return nil, notFound("Could not resolve to a node with the global id of '%s'", id)
+				}
+				nodes[i] = s.objectFor(node)
+			}
+			return nodes, nil
+		},
+		"search": func(args map[string]interface{}) (interface{}, error) {
+			query, _ := stringArg(args, "query")
+			searchType, _ := stringArg(args, "type")
+			nodes := s.search(query, searchType)
+
+			conn, err := connection("SearchResultItem", nodes, args)
+			if err != nil {
+				return nil, err
+			}
+			count := value(len(nodes))
+			conn.fields["issueCount"] = count
+			conn.fields["repositoryCount"] = count
+			conn.fields["discussionCount"] = count
+			conn.fields["userCount"] = count
+			return conn, nil
+		},
+	})
+}
+
+// search runs a search query. Issue searches understand the repo:, org:, user:, is:,
+// state:, author:, assignee: and label: qualifiers; other terms must appear in the title.
+func (s *Store) search(query, searchType string) []*object {
+	var terms []string
+	filters := map[string][]string{}
+	for _, word := range strings.Fields(query) {
+		if key, v, ok := strings.Cut(word, ":"); ok && v != "" {
+			filters[strings.ToLower(key)] = append(filters[strings.ToLower(key)], strings.Trim(v, `"`))
+			continue
+		}
+		terms = append(terms, strings.ToLower(word))
+	}
+
+	matchesTerms := func(text string) bool {
+		for _, term := range terms {
+			if !strings.Contains(strings.ToLower(text), term) {
+				return false
+			}
+		}
+		return true
+	}
+
+	var nodes []*object
+	switch searchType {
+	case "REPOSITORY":
+		for _, repo := range s.repos {
+			if matchesOwner(filters, repo.Owner.Login) && matchesTerms(repo.Name) {
+				nodes = append(nodes, s.repositoryObject(repo))
+			}
+		}
+	case "DISCUSSION":
+		for _, repo := range s.repos {
+			if !matchesRepository(filters, repo) {
+				continue
+			}
+			for _, discussion := range repo.Discussions {
+				if matchesTerms(discussion.Title) {
+					nodes = append(nodes, s.discussionObject(discussion))
+				}
+			}
+		}
+	default:
+		for _, repo := range s.repos {
+			if !matchesRepository(filters, repo) {
+				continue
+			}
+			for _, issue := range repo.Issues {
+				if matchesIssue(filters, issue) && matchesTerms(issue.Title) {
+					nodes = append(nodes, s.issueObject(issue))
+				}
+			}
+		}
+	}
+	return nodes
+}
+
+func matchesOwner(filters map[string][]string, login string) bool {
+	for _, key := range []string{"org", "user", "owner"} {
+		if values, ok := filters[key]; ok && !containsFold(values, login) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchesRepository(filters map[string][]string, repo *Repository) bool {
+	if values, ok := filters["repo"]; ok && !containsFold(values, repo.NameWithOwner()) {
+		return false
+	}
+	return matchesOwner(filters, repo.Owner.Login)
+}
+
+func matchesIssue(filters map[string][]string, issue *Issue) bool {
+	for _, v := range filters["is"] {
+		switch strings.ToLower(v) {
+		case "issue":
+			if issue.PullRequest {
+				return false
+			}
+		case "pr", "pull-request":
+			if !issue.PullRequest {
+				return false
+			}
+		case "open", "closed", "merged":
+			if !strings.EqualFold(issue.State(), v) && !(v == "closed" && issue.Merged) {
+				return false
+			}
+		}
+	}
+	for _, v := range filters["state"] {
+		if !strings.EqualFold(issue.State(), v) && !(strings.EqualFold(v, "closed") && issue.Merged) {
+			return false
+		}
+	}
+	for _, v := range filters["author"] {
+		if !strings.EqualFold(issue.Author.Login, v) {
+			return false
+		}
+	}
+	for _, v := range filters["assignee"] {
+		found := false
+		for _, assignee := range issue.Assignees {
+			found = found || strings.EqualFold(assignee.Login, v)
+		}
+		if !found {
+			return false
+		}
+	}
+	for _, v := range filters["label"] {
+		if !hasAnyLabel(issue, []string{v}) {
+			return false
+		}
+	}
+	return true
+}
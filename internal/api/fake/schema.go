@@ -0,0 +1,962 @@
+package fake
+
+import (
+	"sort"
+	"strings"
+)
+
+// objectFor builds the GraphQL object for a stored node
+func (s *Store) objectFor(node interface{}) *object {
+	switch n := node.(type) {
+	case *Account:
+		return s.accountObject(n)
+	case *Repository:
+		return s.repositoryObject(n)
+	case *Label:
+		return labelObject(n)
+	case *Milestone:
+		return milestoneObject(n)
+	case *Team:
+		return s.teamObject(n)
+	case *StatusUpdate:
+		return s.statusUpdateObject(n)
+	case *Issue:
+		return s.issueObject(n)
+	case *Project:
+		return s.projectObject(n)
+	case *Field:
+		return s.fieldObject(n)
+	case *Item:
+		return s.itemObject(n)
+	case *DraftIssue:
+		return s.draftIssueObject(n)
+	case *View:
+		return s.viewObject(n)
+	case *DiscussionCategory:
+		return categoryObject(n)
+	case *Discussion:
+		return s.discussionObject(n)
+	case *DiscussionComment:
+		return s.commentObject(n)
+	case *IssueComment:
+		return s.issueCommentObject(n)
+	default:
+		return nil
+	}
+}
+
+// optional returns nil for a nil object so it is rendered as null
+func optional(obj *object) interface{} {
+	if obj == nil {
+		return nil
+	}
+	return obj
+}
+
+func (s *Store) accountObject(a *Account) *object {
+	typename := "User"
+	if a.Organization {
+		typename = "Organization"
+	}
+
+	return newObject(typename, map[string]resolver{
+		"id":         value(a.ID),
+		"login":      value(a.Login),
+		"name":       value(a.Name),
+		"url":        value(a.URL()),
+		"avatarUrl":  value("https://avatars.githubusercontent.com/" + a.Login),
+		"databaseId": value(databaseID(a.ID)),
+		"projectV2": func(args map[string]interface{}) (interface{}, error) {
+			number, _ := intArg(args, "number")
+			for _, project := range a.Projects {
+				if project.Number == number {
+					return s.projectObject(project), nil
+				}
+			}
+			return nil, notFound("Could not resolve to a ProjectV2 with the number %d.", number)
+		},
+		"projectsV2": connectionResolver("ProjectV2", func(args map[string]interface{}) []*object {
+			query, _ := stringArg(args, "query")
+			var nodes []*object
+			for _, project := range a.Projects {
+				if query == "" || strings.Contains(strings.ToLower(project.Title), strings.ToLower(query)) {
+					nodes = append(nodes, s.projectObject(project))
+				}
+			}
+			return nodes
+		}),
+		"repository": func(args map[string]interface{}) (interface{}, error) {
+			name, _ := stringArg(args, "name")
+			if repo := s.repository(a.Login, name); repo != nil {
+				return s.repositoryObject(repo), nil
+			}
+			return nil, nil
+		},
+		"team": func(args map[string]interface{}) (interface{}, error) {
+			slug, _ := stringArg(args, "slug")
+			for _, team := range a.Teams {
+				if strings.EqualFold(team.Slug, slug) {
+					return s.teamObject(team), nil
+				}
+			}
+			return nil, nil
+		},
+		"repositories": connectionResolver("Repository", func(map[string]interface{}) []*object {
+			var nodes []*object
+			for _, repo := range s.repos {
+				if repo.Owner == a {
+					nodes = append(nodes, s.repositoryObject(repo))
+				}
+			}
+			return nodes
+		}),
+	})
+}
+
+func (s *Store) repositoryObject(r *Repository) *object {
+	visibility := "PUBLIC"
+	if r.Private {
+		visibility = "PRIVATE"
+	}
+
+	issueByNumber := func(pullRequest bool) resolver {
+		return func(args map[string]interface{}) (interface{}, error) {
+			number, _ := intArg(args, "number")
+			for _, issue := range r.Issues {
+				if issue.Number == number && issue.PullRequest == pullRequest {
+					return s.issueObject(issue), nil
+				}
+			}
+			kind := "Issue"
+			if pullRequest {
+				kind = "PullRequest"
+			}
+			return nil, notFound("Could not resolve to a%s %s with the number of %d.", article(kind), kind, number)
+		}
+	}
+
+	issueList := func(pullRequest bool) func(args map[string]interface{}) []*object {
+		return func(args map[string]interface{}) []*object {
+			states := stringListArg(args, "states")
+			labels := stringListArg(args, "labels")
+			issues := make([]*Issue, 0, len(r.Issues))
+			for _, issue := range r.Issues {
+				if issue.PullRequest != pullRequest {
+					continue
+				}
+				if len(states) > 0 && !containsFold(states, issue.State()) {
+					continue
+				}
+				if len(labels) > 0 && !hasAnyLabel(issue, labels) {
+					continue
+				}
+				issues = append(issues, issue)
+			}
+			sortIssues(issues, args["orderBy"])
+
+			nodes := make([]*object, len(issues))
+			for i, issue := range issues {
+				nodes[i] = s.issueObject(issue)
+			}
+			return nodes
+		}
+	}
+
+	return newObject("Repository", map[string]resolver{
+		"id":                    value(r.ID),
+		"name":                  value(r.Name),
+		"nameWithOwner":         value(r.NameWithOwner()),
+		"owner":                 value(s.accountObject(r.Owner)),
+		"description":           value(r.Description),
+		"url":                   value(r.URL()),
+		"isPrivate":             value(r.Private),
+		"visibility":            value(visibility),
+		"hasDiscussionsEnabled": value(len(r.Categories) > 0),
+		"databaseId":            value(databaseID(r.ID)),
+		"issue":                 issueByNumber(false),
+		"pullRequest":           issueByNumber(true),
+		"issues":                connectionResolver("Issue", issueList(false)),
+		"pullRequests":          connectionResolver("PullRequest", issueList(true)),
+		"issueOrPullRequest": func(args map[string]interface{}) (interface{}, error) {
+			number, _ := intArg(args, "number")
+			for _, issue := range r.Issues {
+				if issue.Number == number {
+					return s.issueObject(issue), nil
+				}
+			}
+			return nil, notFound("Could not resolve to an issue or pull request with the number of %d.", number)
+		},
+		"labels": connectionResolver("Label", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(r.Labels))
+			for i, label := range r.Labels {
+				nodes[i] = labelObject(label)
+			}
+			return nodes
+		}),
+		"label": func(args map[string]interface{}) (interface{}, error) {
+			name, _ := stringArg(args, "name")
+			for _, label := range r.Labels {
+				if strings.EqualFold(label.Name, name) {
+					return labelObject(label), nil
+				}
+			}
+			return nil, nil
+		},
+		"discussion": func(args map[string]interface{}) (interface{}, error) {
+			number, _ := intArg(args, "number")
+			for _, discussion := range r.Discussions {
+				if discussion.Number == number {
+					return s.discussionObject(discussion), nil
+				}
+			}
+			return nil, notFound("Could not resolve to a Discussion with the number of %d.", number)
+		},
+		"discussions": connectionResolver("Discussion", func(args map[string]interface{}) []*object {
+			categoryID, _ := stringArg(args, "categoryId")
+			answered, filterAnswered := boolArg(args, "answered")
+			var nodes []*object
+			for _, discussion := range r.Discussions {
+				if categoryID != "" && discussion.Category.ID != categoryID {
+					continue
+				}
+				if filterAnswered && (discussion.Answer != nil) != answered {
+					continue
+				}
+				nodes = append(nodes, s.discussionObject(discussion))
+			}
+			return nodes
+		}),
+		"discussionCategories": connectionResolver("DiscussionCategory", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(r.Categories))
+			for i, category := range r.Categories {
+				nodes[i] = categoryObject(category)
+			}
+			return nodes
+		}),
+		"discussionCategory": func(args map[string]interface{}) (interface{}, error) {
+			slug, _ := stringArg(args, "slug")
+			for _, category := range r.Categories {
+				if category.Slug == slug {
+					return categoryObject(category), nil
+				}
+			}
+			return nil, nil
+		},
+		"projectsV2": connectionResolver("ProjectV2", func(map[string]interface{}) []*object {
+			var nodes []*object
+			for _, project := range s.projects() {
+				for _, linked := range project.Repositories {
+					if linked == r {
+						nodes = append(nodes, s.projectObject(project))
+					}
+				}
+			}
+			return nodes
+		}),
+	})
+}
+
+func labelObject(l *Label) *object {
+	return newObject("Label", map[string]resolver{
+		"id":          value(l.ID),
+		"name":        value(l.Name),
+		"color":       value(l.Color),
+		"description": value(l.Description),
+	})
+}
+
+func milestoneObject(m *Milestone) *object {
+	return newObject("Milestone", map[string]resolver{
+		"id":     value(m.ID),
+		"title":  value(m.Title),
+		"number": value(m.Number),
+	})
+}
+
+func (s *Store) issueObject(i *Issue) *object {
+	typename := "Issue"
+	if i.PullRequest {
+		typename = "PullRequest"
+	}
+	var milestone *object
+	if i.Milestone != nil {
+		milestone = milestoneObject(i.Milestone)
+	}
+
+	fields := map[string]resolver{
+		"id":         value(i.ID),
+		"number":     value(i.Number),
+		"title":      value(i.Title),
+		"body":       value(i.Body),
+		"bodyHTML":   value("<p>" + i.Body + "</p>"),
+		"state":      value(i.State()),
+		"closed":     value(i.Closed),
+		"closedAt":   value(i.ClosedAt),
+		"url":        value(i.URL()),
+		"createdAt":  value(i.CreatedAt),
+		"updatedAt":  value(i.UpdatedAt),
+		"databaseId": value(databaseID(i.ID)),
+		"author":     value(s.accountObject(i.Author)),
+		"repository": value(s.repositoryObject(i.Repository)),
+		"milestone":  value(optional(milestone)),
+		"labels": connectionResolver("Label", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(i.Labels))
+			for j, label := range i.Labels {
+				nodes[j] = labelObject(label)
+			}
+			return nodes
+		}),
+		"assignees": connectionResolver("User", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(i.Assignees))
+			for j, assignee := range i.Assignees {
+				nodes[j] = s.accountObject(assignee)
+			}
+			return nodes
+		}),
+		"comments": connectionResolver("IssueComment", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(i.Comments))
+			for j, comment := range i.Comments {
+				nodes[j] = s.issueCommentObject(comment)
+			}
+			return nodes
+		}),
+		"timelineItems": connectionResolver("IssueTimelineItems", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(i.Timeline))
+			for j, event := range i.Timeline {
+				nodes[j] = s.timelineEventObject(event)
+			}
+			return nodes
+		}),
+		"projectItems": connectionResolver("ProjectV2Item", func(map[string]interface{}) []*object {
+			var nodes []*object
+			for _, project := range s.projects() {
+				for _, item := range project.Items {
+					if item.Issue == i {
+						nodes = append(nodes, s.itemObject(item))
+					}
+				}
+			}
+			return nodes
+		}),
+	}
+	if !i.PullRequest {
+		var parent *object
+		if i.Parent != nil {
+			parent = s.issueObject(i.Parent)
+		}
+		completed := 0
+		for _, sub := range i.SubIssues {
+			if sub.Closed {
+				completed++
+			}
+		}
+		percent := 0
+		if len(i.SubIssues) > 0 {
+			percent = completed * 100 / len(i.SubIssues)
+		}
+		fields["parent"] = value(optional(parent))
+		fields["subIssues"] = connectionResolver("Issue", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(i.SubIssues))
+			for j, sub := range i.SubIssues {
+				nodes[j] = s.issueObject(sub)
+			}
+			return nodes
+		})
+		fields["closedByPullRequestsReferences"] = connectionResolver("PullRequest", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(i.ClosedBy))
+			for j, pr := range i.ClosedBy {
+				nodes[j] = s.issueObject(pr)
+			}
+			return nodes
+		})
+		fields["subIssuesSummary"] = value(newObject("SubIssuesSummary", map[string]resolver{
+			"total":            value(len(i.SubIssues)),
+			"completed":        value(completed),
+			"percentCompleted": value(percent),
+		}))
+	}
+	if i.PullRequest {
+		fields["merged"] = value(i.Merged)
+		fields["isDraft"] = value(false)
+		fields["reviewRequests"] = connectionResolver("ReviewRequest", func(map[string]interface{}) []*object {
+			return nil
+		})
+		fields["closingIssuesReferences"] = connectionResolver("Issue", func(map[string]interface{}) []*object {
+			var nodes []*object
+			for _, repo := range s.repos {
+				for _, issue := range repo.Issues {
+					for _, pr := range issue.ClosedBy {
+						if pr == i {
+							nodes = append(nodes, s.issueObject(issue))
+						}
+					}
+				}
+			}
+			return nodes
+		})
+	}
+
+	return newObject(typename, fields)
+}
+
+func (s *Store) issueCommentObject(c *IssueComment) *object {
+	return newObject("IssueComment", map[string]resolver{
+		"id":        value(c.ID),
+		"body":      value(c.Body),
+		"url":       value(c.URL()),
+		"createdAt": value(c.CreatedAt),
+		"author":    value(s.accountObject(c.Author)),
+	})
+}
+
+// timelineEventObject builds a timeline entry with the fields of its type
+func (s *Store) timelineEventObject(e *TimelineEvent) *object {
+	if e.Comment != nil {
+		return s.issueCommentObject(e.Comment)
+	}
+
+	fields := map[string]resolver{
+		"id":        value(e.ID),
+		"createdAt": value(e.CreatedAt),
+		"actor":     value(s.accountObject(e.Actor)),
+	}
+	switch e.Type {
+	case "LabeledEvent", "UnlabeledEvent":
+		fields["label"] = value(labelObject(e.Label))
+	case "AssignedEvent", "UnassignedEvent":
+		fields["assignee"] = value(s.accountObject(e.Assignee))
+	case "RenamedTitleEvent":
+		fields["previousTitle"] = value(e.PreviousTitle)
+		fields["currentTitle"] = value(e.CurrentTitle)
+	case "CrossReferencedEvent":
+		fields["source"] = value(s.issueObject(e.Source))
+	}
+	return newObject(e.Type, fields)
+}
+
+func (s *Store) projectObject(p *Project) *object {
+	return newObject("ProjectV2", map[string]resolver{
+		"id":               value(p.ID),
+		"number":           value(p.Number),
+		"title":            value(p.Title),
+		"shortDescription": value(p.ShortDescription),
+		"description":      value(p.ShortDescription),
+		"readme":           value(p.Readme),
+		"public":           value(p.Public),
+		"closed":           value(p.Closed),
+		"template":         value(false),
+		"url":              value(p.URL()),
+		"createdAt":        value(p.CreatedAt),
+		"updatedAt":        value(p.UpdatedAt),
+		"databaseId":       value(databaseID(p.ID)),
+		"owner":            value(s.accountObject(p.Owner)),
+		"creator":          value(s.accountObject(p.Owner)),
+		"fields": connectionResolver("ProjectV2FieldConfiguration", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(p.Fields))
+			for i, field := range p.Fields {
+				nodes[i] = s.fieldObject(field)
+			}
+			return nodes
+		}),
+		"field": func(args map[string]interface{}) (interface{}, error) {
+			name, _ := stringArg(args, "name")
+			if field := p.Field(name); field != nil {
+				return s.fieldObject(field), nil
+			}
+			return nil, nil
+		},
+		"items": connectionResolver("ProjectV2Item", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(p.Items))
+			for i, item := range p.Items {
+				nodes[i] = s.itemObject(item)
+			}
+			return nodes
+		}),
+		"views": connectionResolver("ProjectV2View", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(p.Views))
+			for i, view := range p.Views {
+				nodes[i] = s.viewObject(view)
+			}
+			return nodes
+		}),
+		"view": func(args map[string]interface{}) (interface{}, error) {
+			number, _ := intArg(args, "number")
+			for _, view := range p.Views {
+				if view.Number == number {
+					return s.viewObject(view), nil
+				}
+			}
+			return nil, nil
+		},
+		"repositories": connectionResolver("Repository", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(p.Repositories))
+			for i, repo := range p.Repositories {
+				nodes[i] = s.repositoryObject(repo)
+			}
+			return nodes
+		}),
+		"statusUpdates": connectionResolver("ProjectV2StatusUpdate", func(map[string]interface{}) []*object {
+			// Newest first, as with orderBy: {field: CREATED_AT, direction: DESC}
+			nodes := make([]*object, len(p.StatusUpdates))
+			for i, update := range p.StatusUpdates {
+				nodes[len(nodes)-1-i] = s.statusUpdateObject(update)
+			}
+			return nodes
+		}),
+		"teams": connectionResolver("Team", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(p.Teams))
+			for i, team := range p.Teams {
+				nodes[i] = s.teamObject(team)
+			}
+			return nodes
+		}),
+	})
+}
+
+func (s *Store) statusUpdateObject(u *StatusUpdate) *object {
+	optionalString := func(v string) interface{} {
+		if v == "" {
+			return nil
+		}
+		return v
+	}
+
+	return newObject("ProjectV2StatusUpdate", map[string]resolver{
+		"id":         value(u.ID),
+		"body":       value(u.Body),
+		"bodyHTML":   value("<p>" + u.Body + "</p>"),
+		"status":     value(optionalString(u.Status)),
+		"startDate":  value(optionalString(u.StartDate)),
+		"targetDate": value(optionalString(u.TargetDate)),
+		"createdAt":  value(u.CreatedAt),
+		"updatedAt":  value(u.UpdatedAt),
+		"creator":    value(s.accountObject(u.Creator)),
+		"project": func(map[string]interface{}) (interface{}, error) {
+			return s.projectObject(u.Project), nil
+		},
+	})
+}
+
+func (s *Store) teamObject(t *Team) *object {
+	return newObject("Team", map[string]resolver{
+		"id":           value(t.ID),
+		"name":         value(t.Name),
+		"slug":         value(t.Slug),
+		"combinedSlug": value(t.CombinedSlug()),
+		"url":          value(t.URL()),
+		"organization": func(map[string]interface{}) (interface{}, error) {
+			return s.accountObject(t.Organization), nil
+		},
+	})
+}
+
+func (s *Store) fieldObject(f *Field) *object {
+	typename := "ProjectV2Field"
+	switch f.DataType {
+	case "SINGLE_SELECT":
+		typename = "ProjectV2SingleSelectField"
+	case "ITERATION":
+		typename = "ProjectV2IterationField"
+	}
+
+	fields := map[string]resolver{
+		"id":         value(f.ID),
+		"name":       value(f.Name),
+		"dataType":   value(f.DataType),
+		"createdAt":  value(f.CreatedAt),
+		"updatedAt":  value(f.UpdatedAt),
+		"databaseId": value(databaseID(f.ID)),
+		"project":    value(s.projectObject(f.Project)),
+	}
+	if f.DataType == "SINGLE_SELECT" {
+		fields["options"] = func(args map[string]interface{}) (interface{}, error) {
+			names := stringListArg(args, "names")
+			options := make([]*object, 0, len(f.Options))
+			for _, option := range f.Options {
+				if len(names) == 0 || containsFold(names, option.Name) {
+					options = append(options, optionObject(option))
+				}
+			}
+			return options, nil
+		}
+	}
+	if f.DataType == "ITERATION" {
+		fields["configuration"] = value(s.iterationConfigurationObject(f))
+	}
+
+	return newObject(typename, fields)
+}
+
+// iterationConfigurationObject splits the iterations of a field into completed ones and
+// current or upcoming ones the way GitHub does
+func (s *Store) iterationConfigurationObject(f *Field) *object {
+	today := s.today()
+	var active, completed []*object
+	for _, iteration := range f.Iterations {
+		if iteration.EndDate().After(today) {
+			active = append(active, iterationObject(iteration))
+		} else {
+			completed = append(completed, iterationObject(iteration))
+		}
+	}
+
+	startDay := 0
+	if len(f.Iterations) > 0 {
+		startDay = int(f.Iterations[0].StartDate.Weekday())
+	}
+	return newObject("ProjectV2IterationFieldConfiguration", map[string]resolver{
+		"duration":            value(f.IterationDuration),
+		"startDay":            value(startDay),
+		"iterations":          value(active),
+		"completedIterations": value(completed),
+	})
+}
+
+func iterationObject(i *Iteration) *object {
+	return newObject("ProjectV2IterationFieldIteration", map[string]resolver{
+		"id":        value(i.ID),
+		"title":     value(i.Title),
+		"titleHTML": value(i.Title),
+		"startDate": value(i.StartDate.Format("2006-01-02")),
+		"duration":  value(i.Duration),
+	})
+}
+
+func optionObject(o *Option) *object {
+	return newObject("ProjectV2SingleSelectFieldOption", map[string]resolver{
+		"id":              value(o.ID),
+		"name":            value(o.Name),
+		"nameHTML":        value(o.Name),
+		"color":           value(o.Color),
+		"description":     value(o.Description),
+		"descriptionHTML": value(o.Description),
+	})
+}
+
+func (s *Store) itemObject(i *Item) *object {
+	itemType := "DRAFT_ISSUE"
+	var content *object
+	switch {
+	case i.Issue != nil && i.Issue.PullRequest:
+		itemType = "PULL_REQUEST"
+		content = s.issueObject(i.Issue)
+	case i.Issue != nil:
+		itemType = "ISSUE"
+		content = s.issueObject(i.Issue)
+	default:
+		content = s.draftIssueObject(i.Draft)
+	}
+
+	return newObject("ProjectV2Item", map[string]resolver{
+		"id":         value(i.ID),
+		"type":       value(itemType),
+		"isArchived": value(i.Archived),
+		"createdAt":  value(i.CreatedAt),
+		"updatedAt":  value(i.UpdatedAt),
+		"databaseId": value(databaseID(i.ID)),
+		"content":    value(content),
+		"project":    value(s.projectObject(i.Project)),
+		"creator":    value(s.accountObject(s.viewer())),
+		"fieldValues": connectionResolver("ProjectV2ItemFieldValue", func(map[string]interface{}) []*object {
+			var nodes []*object
+			for _, field := range i.Project.Fields {
+				if obj := s.fieldValueObject(i, field); obj != nil {
+					nodes = append(nodes, obj)
+				}
+			}
+			return nodes
+		}),
+		"fieldValueByName": func(args map[string]interface{}) (interface{}, error) {
+			name, _ := stringArg(args, "name")
+			field := i.Project.Field(name)
+			if field == nil {
+				return nil, nil
+			}
+			return optional(s.fieldValueObject(i, field)), nil
+		},
+	})
+}
+
+// fieldValueObject builds the value of field on item, or nil when the item has no value
+func (s *Store) fieldValueObject(i *Item, f *Field) *object {
+	fields := map[string]resolver{
+		"field":     value(s.fieldObject(f)),
+		"item":      func(map[string]interface{}) (interface{}, error) { return s.itemObject(i), nil },
+		"createdAt": value(i.CreatedAt),
+		"updatedAt": value(i.UpdatedAt),
+	}
+
+	if f.DataType == "TITLE" {
+		fields["text"] = value(i.Title())
+		return newObject("ProjectV2ItemFieldTextValue", fields)
+	}
+
+	v, ok := i.Values[f.ID]
+	if !ok {
+		return nil
+	}
+
+	switch f.DataType {
+	case "TEXT":
+		fields["text"] = value(v.Text)
+		return newObject("ProjectV2ItemFieldTextValue", fields)
+	case "NUMBER":
+		fields["number"] = value(v.Number)
+		return newObject("ProjectV2ItemFieldNumberValue", fields)
+	case "DATE":
+		fields["date"] = value(v.Date)
+		return newObject("ProjectV2ItemFieldDateValue", fields)
+	case "SINGLE_SELECT":
+		var name interface{}
+		for _, option := range f.Options {
+			if option.ID == v.OptionID {
+				name = option.Name
+			}
+		}
+		fields["optionId"] = value(v.OptionID)
+		fields["name"] = value(name)
+		return newObject("ProjectV2ItemFieldSingleSelectValue", fields)
+	case "ITERATION":
+		fields["iterationId"] = value(v.IterationID)
+		fields["title"] = value(nil)
+		fields["startDate"] = value(nil)
+		fields["duration"] = value(nil)
+		for _, iteration := range f.Iterations {
+			if iteration.ID == v.IterationID {
+				fields["title"] = value(iteration.Title)
+				fields["startDate"] = value(iteration.StartDate.Format("2006-01-02"))
+				fields["duration"] = value(iteration.Duration)
+			}
+		}
+		return newObject("ProjectV2ItemFieldIterationValue", fields)
+	default:
+		return nil
+	}
+}
+
+func (s *Store) draftIssueObject(d *DraftIssue) *object {
+	return newObject("DraftIssue", map[string]resolver{
+		"id":        value(d.ID),
+		"title":     value(d.Title),
+		"body":      value(d.Body),
+		"createdAt": value(d.CreatedAt),
+		"updatedAt": value(d.UpdatedAt),
+		"creator":   value(s.accountObject(s.viewer())),
+		"assignees": connectionResolver("User", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(d.Assignees))
+			for i, assignee := range d.Assignees {
+				nodes[i] = s.accountObject(assignee)
+			}
+			return nodes
+		}),
+		"projectV2Items": connectionResolver("ProjectV2Item", func(map[string]interface{}) []*object {
+			if d.Item == nil {
+				return nil
+			}
+			return []*object{s.itemObject(d.Item)}
+		}),
+	})
+}
+
+func (s *Store) viewObject(v *View) *object {
+	settings := func(list []ViewSetting) []*object {
+		objects := make([]*object, len(list))
+		for i, setting := range list {
+			objects[i] = newObject("ProjectV2SortBy", map[string]resolver{
+				"field":     value(s.fieldObject(setting.Field)),
+				"direction": value(setting.Direction),
+			})
+		}
+		return objects
+	}
+	var filter interface{}
+	if v.Filter != "" {
+		filter = v.Filter
+	}
+
+	return newObject("ProjectV2View", map[string]resolver{
+		"id":              value(v.ID),
+		"number":          value(v.Number),
+		"name":            value(v.Name),
+		"layout":          value(v.Layout),
+		"filter":          value(filter),
+		"createdAt":       value(v.CreatedAt),
+		"updatedAt":       value(v.UpdatedAt),
+		"databaseId":      value(databaseID(v.ID)),
+		"project":         value(s.projectObject(v.Project)),
+		"sortBy":          value(settings(v.SortBy)),
+		"groupBy":         value(settings(v.GroupBy)),
+		"verticalGroupBy": value(settings(v.VerticalGroupBy)),
+		"groupByFields": connectionResolver("ProjectV2FieldConfiguration", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(v.GroupBy))
+			for i, setting := range v.GroupBy {
+				nodes[i] = s.fieldObject(setting.Field)
+			}
+			return nodes
+		}),
+		"sortByFields": connectionResolver("ProjectV2SortByField", func(map[string]interface{}) []*object {
+			return settings(v.SortBy)
+		}),
+		"fields": connectionResolver("ProjectV2FieldConfiguration", func(map[string]interface{}) []*object {
+			fields := v.Fields
+			if fields == nil {
+				fields = v.Project.Fields
+			}
+			nodes := make([]*object, len(fields))
+			for i, field := range fields {
+				nodes[i] = s.fieldObject(field)
+			}
+			return nodes
+		}),
+	})
+}
+
+func categoryObject(c *DiscussionCategory) *object {
+	return newObject("DiscussionCategory", map[string]resolver{
+		"id":           value(c.ID),
+		"name":         value(c.Name),
+		"slug":         value(c.Slug),
+		"description":  value(c.Description),
+		"emoji":        value(c.Emoji),
+		"isAnswerable": value(c.Answerable),
+		"createdAt":    value(c.CreatedAt),
+		"updatedAt":    value(c.CreatedAt),
+	})
+}
+
+func (s *Store) discussionObject(d *Discussion) *object {
+	var answer interface{}
+	var answerChosenAt interface{}
+	if d.Answer != nil {
+		answer = s.commentObject(d.Answer)
+		answerChosenAt = d.Answer.UpdatedAt
+	}
+
+	return newObject("Discussion", map[string]resolver{
+		"id":             value(d.ID),
+		"number":         value(d.Number),
+		"title":          value(d.Title),
+		"body":           value(d.Body),
+		"bodyHTML":       value("<p>" + d.Body + "</p>"),
+		"url":            value(d.URL()),
+		"locked":         value(d.Locked),
+		"closed":         value(d.Closed),
+		"closedAt":       value(d.ClosedAt),
+		"upvoteCount":    value(0),
+		"createdAt":      value(d.CreatedAt),
+		"updatedAt":      value(d.UpdatedAt),
+		"answerChosenAt": value(answerChosenAt),
+		"answer":         value(answer),
+		"author":         value(s.accountObject(d.Author)),
+		"category":       value(categoryObject(d.Category)),
+		"repository":     value(s.repositoryObject(d.Repository)),
+		"labels": connectionResolver("Label", func(map[string]interface{}) []*object {
+			return nil
+		}),
+		"comments": connectionResolver("DiscussionComment", func(map[string]interface{}) []*object {
+			nodes := make([]*object, len(d.Comments))
+			for i, comment := range d.Comments {
+				nodes[i] = s.commentObject(comment)
+			}
+			return nodes
+		}),
+	})
+}
+
+func (s *Store) commentObject(c *DiscussionComment) *object {
+	var replyTo interface{}
+	if c.ReplyTo != nil {
+		replyTo = s.commentObject(c.ReplyTo)
+	}
+
+	return newObject("DiscussionComment", map[string]resolver{
+		"id":          value(c.ID),
+		"body":        value(c.Body),
+		"bodyHTML":    value("<p>" + c.Body + "</p>"),
+		"url":         value(c.Discussion.URL()),
+		"upvoteCount": value(0),
+		"isAnswer":    value(c.Discussion.Answer == c),
+		"createdAt":   value(c.CreatedAt),
+		"updatedAt":   value(c.UpdatedAt),
+		"author":      value(s.accountObject(c.Author)),
+		"replyTo":     value(replyTo),
+		"discussion": func(map[string]interface{}) (interface{}, error) {
+			return s.discussionObject(c.Discussion), nil
+		},
+	})
+}
+
+// projects returns every project in the store
+func (s *Store) projects() []*Project {
+	var projects []*Project
+	for _, account := range s.sortedAccounts() {
+		projects = append(projects, account.Projects...)
+	}
+	return projects
+}
+
+// sortedAccounts returns the accounts ordered by login for stable results
+func (s *Store) sortedAccounts() []*Account {
+	accounts := make([]*Account, 0, len(s.accounts))
+	for _, account := range s.accounts {
+		accounts = append(accounts, account)
+	}
+	sort.Slice(accounts, func(i, j int) bool { return accounts[i].Login < accounts[j].Login })
+	return accounts
+}
+
+// sortIssues orders issues by the orderBy argument, defaulting to creation order
+func sortIssues(issues []*Issue, orderBy interface{}) {
+	order, _ := orderBy.(map[string]interface{})
+	field, _ := order["field"].(string)
+	desc := order["direction"] == "DESC"
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i], issues[j]
+		if desc {
+			a, b = b, a
+		}
+		switch field {
+		case "UPDATED_AT":
+			return a.UpdatedAt.Before(b.UpdatedAt) || (a.UpdatedAt.Equal(b.UpdatedAt) && a.Number < b.Number)
+		default:
+			return a.Number < b.Number
+		}
+	})
+}
+
+func hasAnyLabel(issue *Issue, names []string) bool {
+	for _, label := range issue.Labels {
+		if containsFold(names, label.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(list []string, s string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func article(word string) string {
+	if strings.ContainsRune("AEIOUaeiou", rune(word[0])) {
+		return "n"
+	}
+	return ""
+}
+
+// databaseID derives a stable numeric ID from a node ID
+func databaseID(id string) int {
+	n := 0
+	if i := strings.LastIndexByte(id, '_'); i >= 0 {
+		for _, c := range id[i+1:] {
+			n = n*10 + int(c-'0')
+		}
+	}
+	return n
+}
@@ -0,0 +1,160 @@
+// Package fake provides an in-process fake of the GitHub GraphQL API for offline
+// testing. It keeps users, organizations, repositories, issues, pull requests,
+// projects, fields, items, views and discussions in memory and answers the queries
+// and mutations ghx sends. Set GHX_API_URL to the server URL to run the whole CLI
+// against it.
+package fake
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+)
+
+// Scopes are the OAuth scopes the fake reports for every token
+const Scopes = "repo, project, read:org"
+
+// Server is a running fake GitHub API server
+type Server struct {
+	*httptest.Server
+	Store *Store
+}
+
+// NewServer starts a fake GitHub API server with an empty store. GraphQL requests
+// are served at /graphql; URL is the API base URL to use for GHX_API_URL.
+func NewServer() *Server {
+	store := NewStore()
+	return &Server{
+		Server: httptest.NewServer(store.Handler()),
+		Store:  store,
+	}
+}
+
+// GraphQLURL returns the GraphQL endpoint of the server
+func (s *Server) GraphQLURL() string {
+	return s.URL + "/graphql"
+}
+
+// request is a GraphQL request body
+type request struct {
+	Variables map[string]interface{} `json:"variables"`
+	Query     string                 `json:"query"`
+}
+
+// response is a GraphQL response body
+type response struct {
+	Data   interface{} `json:"data,omitempty"`
+	Errors []*Error    `json:"errors,omitempty"`
+}
+
+// Handler returns an HTTP handler serving the store as a GitHub API
+func (s *Store) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/graphql", s.serveGraphQL)
+	mux.HandleFunc("/user", s.serveUser)
+	mux.HandleFunc("/user/repos", s.serveUserRepos)
+	return requireToken(mux)
+}
+
+// requireToken rejects requests without credentials like GitHub does
+func requireToken(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization := r.Header.Get("Authorization")
+		_, token, _ := strings.Cut(authorization, " ")
+		if token == "" {
+			writeJSON(w, http.StatusUnauthorized, map[string]string{
+				"message":           "Requires authentication",
+				"documentation_url": "https://docs.github.com/graphql/guides/forming-calls-with-graphql#authenticating-with-graphql",
+			})
+			return
+		}
+		w.Header().Set("X-OAuth-Scopes", Scopes)
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (s *Store) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeJSON(w, http.StatusMethodNotAllowed, map[string]string{"message": "GraphQL requests must be POST"})
+		return
+	}
+
+	var req request
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeJSON(w, http.StatusBadRequest, map[string]string{"message": "Problems parsing JSON"})
+		return
+	}
+
+	data, err := s.Execute(req.Query, req.Variables)
+	if err != nil {
+		gqlErr, ok := err.(*Error)
+		if !ok {
+			gqlErr = &Error{Message: err.Error()}
+		}
+		writeJSON(w, http.StatusOK, response{Errors: []*Error{gqlErr}})
+		return
+	}
+	writeJSON(w, http.StatusOK, response{Data: data})
+}
+
+// Execute runs a GraphQL query or mutation against the store
+func (s *Store) Execute(query string, variables map[string]interface{}) (map[string]interface{}, error) {
+	op, err := parse(query)
+	if err != nil {
+		return nil, &Error{
+			Message:    "Parse error: " + err.Error(),
+			Extensions: map[string]interface{}{"code": "parseError"},
+		}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	root := s.queryRoot()
+	if op.kind == "mutation" {
+		root = s.mutationRoot()
+	}
+	return (&executor{variables: variables}).execute(root, op.selections)
+}
+
+func (s *Store) serveUser(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	viewer := s.viewer()
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"login":    viewer.Login,
+		"id":       databaseID(viewer.ID),
+		"node_id":  viewer.ID,
+		"name":     viewer.Name,
+		"html_url": viewer.URL(),
+		"type":     "User",
+	})
+}
+
+func (s *Store) serveUserRepos(w http.ResponseWriter, _ *http.Request) {
+	s.mu.Lock()
+	viewer := s.viewer()
+	repos := []map[string]interface{}{}
+	for _, repo := range s.repos {
+		if repo.Owner == viewer {
+			repos = append(repos, map[string]interface{}{
+				"id":        databaseID(repo.ID),
+				"node_id":   repo.ID,
+				"name":      repo.Name,
+				"full_name": repo.NameWithOwner(),
+				"private":   repo.Private,
+			})
+		}
+	}
+	s.mu.Unlock()
+
+	writeJSON(w, http.StatusOK, repos)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,956 @@
+package fake
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultIterationDuration is the duration in days of iterations created without one
+const defaultIterationDuration = 14
+
+// DefaultViewer is the login of the authenticated user of a new store
+const DefaultViewer = "octocat"
+
+// baseURL is the web URL used for resource links
+const baseURL = "https://github.com"
+
+// Account is a user or organization
+type Account struct {
+	ID           string
+	Login        string
+	Name         string
+	Projects     []*Project
+	Teams        []*Team
+	Organization bool
+}
+
+// URL returns the web URL of the account
+func (a *Account) URL() string {
+	return baseURL + "/" + a.Login
+}
+
+// Team is an organization team
+type Team struct {
+	Organization *Account
+	ID           string
+	Name         string
+	Slug         string
+}
+
+// CombinedSlug returns the org/team form of the team name
+func (t *Team) CombinedSlug() string {
+	return t.Organization.Login + "/" + t.Slug
+}
+
+// URL returns the web URL of the team
+func (t *Team) URL() string {
+	return fmt.Sprintf("%s/orgs/%s/teams/%s", baseURL, t.Organization.Login, t.Slug)
+}
+
+// Repository is a repository with its issues, pull requests and discussions
+type Repository struct {
+	Owner       *Account
+	ID          string
+	Name        string
+	Description string
+	Issues      []*Issue
+	Labels      []*Label
+	Milestones  []*Milestone
+	Discussions []*Discussion
+	Categories  []*DiscussionCategory
+	Private     bool
+	nextNumber  int
+}
+
+// NameWithOwner returns the owner/name form of the repository name
+func (r *Repository) NameWithOwner() string {
+	return r.Owner.Login + "/" + r.Name
+}
+
+// URL returns the web URL of the repository
+func (r *Repository) URL() string {
+	return baseURL + "/" + r.NameWithOwner()
+}
+
+// Label is a repository label
+type Label struct {
+	ID          string
+	Name        string
+	Color       string
+	Description string
+}
+
+// Milestone is a repository milestone
+type Milestone struct {
+	ID     string
+	Title  string
+	Number int
+}
+
+// Issue is an issue or, when PullRequest is set, a pull request
+type Issue struct {
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ClosedAt   *time.Time
+	Repository *Repository
+	Author     *Account
+	Milestone  *Milestone
+	ID         string
+	Title      string
+	Body       string
+	Labels     []*Label
+	Assignees  []*Account
+	Parent     *Issue
+	SubIssues  []*Issue
+	Comments   []*IssueComment
+	Timeline   []*TimelineEvent
+	// ClosedBy lists the pull requests that close the issue when merged
+	ClosedBy    []*Issue
+	Number      int
+	Closed      bool
+	PullRequest bool
+	Merged      bool
+}
+
+// IssueComment is a comment on an issue or pull request
+type IssueComment struct {
+	CreatedAt time.Time
+	Issue     *Issue
+	Author    *Account
+	ID        string
+	Body      string
+}
+
+// URL returns the web URL of the comment
+func (c *IssueComment) URL() string {
+	return fmt.Sprintf("%s#issuecomment-%d", c.Issue.URL(), databaseID(c.ID))
+}
+
+// TimelineEvent is an entry in the timeline of an issue or pull request. Type is
+// the GraphQL type such as LabeledEvent; the fields used depend on it.
+type TimelineEvent struct {
+	CreatedAt     time.Time
+	Actor         *Account
+	Label         *Label
+	Assignee      *Account
+	Source        *Issue
+	Comment       *IssueComment
+	ID            string
+	Type          string
+	PreviousTitle string
+	CurrentTitle  string
+}
+
+// State returns the GraphQL state of the issue or pull request
+func (i *Issue) State() string {
+	switch {
+	case i.Merged:
+		return "MERGED"
+	case i.Closed:
+		return "CLOSED"
+	default:
+		return "OPEN"
+	}
+}
+
+// URL returns the web URL of the issue or pull request
+func (i *Issue) URL() string {
+	kind := "issues"
+	if i.PullRequest {
+		kind = "pull"
+	}
+	return fmt.Sprintf("%s/%s/%d", i.Repository.URL(), kind, i.Number)
+}
+
+// Project is a Projects v2 project
+type Project struct {
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	Owner            *Account
+	ID               string
+	Title            string
+	ShortDescription string
+	Readme           string
+	Fields           []*Field
+	Items            []*Item
+	Views            []*View
+	Repositories     []*Repository
+	Teams            []*Team
+	StatusUpdates    []*StatusUpdate
+	Number           int
+	Public           bool
+	Closed           bool
+	nextViewNumber   int
+}
+
+// URL returns the web URL of the project
+func (p *Project) URL() string {
+	kind := "users"
+	if p.Owner.Organization {
+		kind = "orgs"
+	}
+	return fmt.Sprintf("%s/%s/%s/projects/%d", baseURL, kind, p.Owner.Login, p.Number)
+}
+
+// Field returns the project field with the given name, or nil
+func (p *Project) Field(name string) *Field {
+	for _, field := range p.Fields {
+		if strings.EqualFold(field.Name, name) {
+			return field
+		}
+	}
+	return nil
+}
+
+// StatusUpdate is a project status update; dates are YYYY-MM-DD or empty
+type StatusUpdate struct {
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Project    *Project
+	Creator    *Account
+	ID         string
+	Body       string
+	Status     string
+	StartDate  string
+	TargetDate string
+}
+
+// Field is a project field
+type Field struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Project   *Project
+	ID        string
+	Name      string
+	DataType  string
+	Options   []*Option
+
+	// Iterations of an iteration field sorted by start date, and the default duration in days
+	Iterations        []*Iteration
+	IterationDuration int
+}
+
+// Option returns the single select option with the given name, or nil
+func (f *Field) Option(name string) *Option {
+	for _, option := range f.Options {
+		if strings.EqualFold(option.Name, name) {
+			return option
+		}
+	}
+	return nil
+}
+
+// Iteration returns the iteration with the given title, or nil
+func (f *Field) Iteration(title string) *Iteration {
+	for _, iteration := range f.Iterations {
+		if strings.EqualFold(iteration.Title, title) {
+			return iteration
+		}
+	}
+	return nil
+}
+
+// Iteration is an iteration of an iteration field
+type Iteration struct {
+	StartDate time.Time
+	ID        string
+	Title     string
+	Duration  int
+}
+
+// EndDate returns the first day after the iteration
+func (i *Iteration) EndDate() time.Time {
+	return i.StartDate.AddDate(0, 0, i.Duration)
+}
+
+// Option is a single select field option
+type Option struct {
+	ID          string
+	Name        string
+	Color       string
+	Description string
+}
+
+// Value is the value of a field on an item; the field data type decides which member is used
+type Value struct {
+	Text        string
+	Date        string
+	OptionID    string
+	IterationID string
+	Number      float64
+}
+
+// Item is a project item
+type Item struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Project   *Project
+	Issue     *Issue
+	Draft     *DraftIssue
+	Values    map[string]Value
+	ID        string
+	Archived  bool
+}
+
+// Title returns the title of the item content
+func (i *Item) Title() string {
+	if i.Issue != nil {
+		return i.Issue.Title
+	}
+	return i.Draft.Title
+}
+
+// DraftIssue is a draft issue that only exists in a project
+type DraftIssue struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Item      *Item
+	ID        string
+	Title     string
+	Body      string
+	Assignees []*Account
+}
+
+// ViewSetting is a sort or group configuration of a view
+type ViewSetting struct {
+	Field     *Field
+	Direction string
+}
+
+// View is a project view
+type View struct {
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	Project   *Project
+	ID        string
+	Name      string
+	Layout    string
+	Filter    string
+	SortBy    []ViewSetting
+	GroupBy   []ViewSetting
+	// VerticalGroupBy is the swimlane field of a board view
+	VerticalGroupBy []ViewSetting
+	// Fields is the visible fields in display order; nil shows every field
+	Fields []*Field
+	Number int
+}
+
+// DiscussionCategory is a repository discussion category
+type DiscussionCategory struct {
+	CreatedAt   time.Time
+	ID          string
+	Name        string
+	Slug        string
+	Description string
+	Emoji       string
+	Answerable  bool
+}
+
+// Discussion is a repository discussion
+type Discussion struct {
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	ClosedAt   *time.Time
+	Repository *Repository
+	Category   *DiscussionCategory
+	Author     *Account
+	Answer     *DiscussionComment
+	ID         string
+	Title      string
+	Body       string
+	Comments   []*DiscussionComment
+	Number     int
+	Locked     bool
+	Closed     bool
+}
+
+// URL returns the web URL of the discussion
+func (d *Discussion) URL() string {
+	return fmt.Sprintf("%s/discussions/%d", d.Repository.URL(), d.Number)
+}
+
+// DiscussionComment is a comment on a discussion
+type DiscussionComment struct {
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	Discussion *Discussion
+	Author     *Account
+	ReplyTo    *DiscussionComment
+	ID         string
+	Body       string
+}
+
+// Store holds the in-memory GitHub data served by the fake server. Seeding methods
+// may be called while the server runs; every request holds the store lock.
+type Store struct {
+	now      func() time.Time
+	accounts map[string]*Account
+	nodes    map[string]interface{}
+	Viewer   string
+	repos    []*Repository
+	mu       sync.Mutex
+	sequence int
+}
+
+// NewStore creates a store whose viewer is DefaultViewer
+func NewStore() *Store {
+	s := &Store{
+		accounts: map[string]*Account{},
+		nodes:    map[string]interface{}{},
+		now:      time.Now,
+		Viewer:   DefaultViewer,
+	}
+	s.AddUser(DefaultViewer)
+	return s
+}
+
+// newShortID returns a new ID for objects that are not nodes, like options and iterations
+func (s *Store) newShortID() string {
+	s.sequence++
+	return fmt.Sprintf("%08x", s.sequence)
+}
+
+// SetClock replaces the clock used for timestamps and for deciding which iterations
+// are completed
+func (s *Store) SetClock(now func() time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = now
+}
+
+// today returns the current date at midnight UTC
+func (s *Store) today() time.Time {
+	now := s.now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// newID returns a new node ID with the given prefix
+func (s *Store) newID(prefix string) string {
+	s.sequence++
+	return fmt.Sprintf("%s_%d", prefix, s.sequence)
+}
+
+// register stores a node under its ID so node(id:) and mutations can find it
+func (s *Store) register(id string, node interface{}) {
+	s.nodes[id] = node
+}
+
+// AddUser adds a user, returning the existing one if the login is taken
+func (s *Store) AddUser(login string) *Account {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addAccount(login, false)
+}
+
+// AddOrganization adds an organization, returning the existing one if the login is taken
+func (s *Store) AddOrganization(login string) *Account {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addAccount(login, true)
+}
+
+func (s *Store) addAccount(login string, organization bool) *Account {
+	if account, ok := s.accounts[strings.ToLower(login)]; ok {
+		return account
+	}
+
+	prefix := "U"
+	if organization {
+		prefix = "O"
+	}
+	account := &Account{ID: s.newID(prefix), Login: login, Name: login, Organization: organization}
+	s.accounts[strings.ToLower(login)] = account
+	s.register(account.ID, account)
+	return account
+}
+
+// Account returns the user or organization with the given login, or nil
+func (s *Store) Account(login string) *Account {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.accounts[strings.ToLower(login)]
+}
+
+// AddTeam adds a team to an organization; the slug is derived from the name
+func (s *Store) AddTeam(org *Account, name string) *Team {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	team := &Team{
+		ID:           s.newID("T"),
+		Organization: org,
+		Name:         name,
+		Slug:         strings.ReplaceAll(strings.ToLower(name), " ", "-"),
+	}
+	org.Teams = append(org.Teams, team)
+	s.register(team.ID, team)
+	return team
+}
+
+// AddRepository adds a repository; a missing owner is created as a user
+func (s *Store) AddRepository(owner, name string) *Repository {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if repo := s.repository(owner, name); repo != nil {
+		return repo
+	}
+
+	ownerAccount := s.accounts[strings.ToLower(owner)]
+	if ownerAccount == nil {
+		ownerAccount = s.addAccount(owner, false)
+	}
+	repo := &Repository{ID: s.newID("R"), Owner: ownerAccount, Name: name}
+	s.repos = append(s.repos, repo)
+	s.register(repo.ID, repo)
+	return repo
+}
+
+func (s *Store) repository(owner, name string) *Repository {
+	for _, repo := range s.repos {
+		if strings.EqualFold(repo.Owner.Login, owner) && strings.EqualFold(repo.Name, name) {
+			return repo
+		}
+	}
+	return nil
+}
+
+// AddLabel adds a label to a repository
+func (s *Store) AddLabel(repo *Repository, name, color string) *Label {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	label := &Label{ID: s.newID("LA"), Name: name, Color: color}
+	repo.Labels = append(repo.Labels, label)
+	s.register(label.ID, label)
+	return label
+}
+
+// AddMilestone adds a milestone to a repository
+func (s *Store) AddMilestone(repo *Repository, title string) *Milestone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	milestone := &Milestone{ID: s.newID("MI"), Title: title, Number: len(repo.Milestones) + 1}
+	repo.Milestones = append(repo.Milestones, milestone)
+	s.register(milestone.ID, milestone)
+	return milestone
+}
+
+// AddIssue adds an open issue authored by the viewer
+func (s *Store) AddIssue(repo *Repository, title string) *Issue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addIssue(repo, title, false)
+}
+
+// AddPullRequest adds an open pull request authored by the viewer
+func (s *Store) AddPullRequest(repo *Repository, title string) *Issue {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addIssue(repo, title, true)
+}
+
+func (s *Store) addIssue(repo *Repository, title string, pullRequest bool) *Issue {
+	prefix := "I"
+	if pullRequest {
+		prefix = "PR"
+	}
+	now := s.now()
+	repo.nextNumber++
+	issue := &Issue{
+		ID:          s.newID(prefix),
+		Repository:  repo,
+		Author:      s.viewer(),
+		Title:       title,
+		Number:      repo.nextNumber,
+		PullRequest: pullRequest,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	repo.Issues = append(repo.Issues, issue)
+	s.register(issue.ID, issue)
+	return issue
+}
+
+// AddIssueComment adds a comment by the viewer to an issue or pull request and to
+// its timeline
+func (s *Store) AddIssueComment(issue *Issue, body string) *IssueComment {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	comment := &IssueComment{ID: s.newID("IC"), Issue: issue, Author: s.viewer(), Body: body, CreatedAt: s.now()}
+	issue.Comments = append(issue.Comments, comment)
+	issue.Timeline = append(issue.Timeline, &TimelineEvent{
+		ID: comment.ID, Type: "IssueComment", Comment: comment, Actor: comment.Author, CreatedAt: comment.CreatedAt,
+	})
+	s.register(comment.ID, comment)
+	return comment
+}
+
+// AddTimelineEvent appends an event to the timeline of an issue or pull request.
+// The actor defaults to the viewer and the time to now.
+func (s *Store) AddTimelineEvent(issue *Issue, event *TimelineEvent) *TimelineEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event.ID = s.newID("TE")
+	if event.Actor == nil {
+		event.Actor = s.viewer()
+	}
+	if event.CreatedAt.IsZero() {
+		event.CreatedAt = s.now()
+	}
+	issue.Timeline = append(issue.Timeline, event)
+	return event
+}
+
+// LinkClosingPullRequest records that pr closes issue when merged
+func (s *Store) LinkClosingPullRequest(pr, issue *Issue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	issue.ClosedBy = append(issue.ClosedBy, pr)
+}
+
+// AddSubIssue makes child a sub-issue of parent
+func (s *Store) AddSubIssue(parent, child *Issue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.linkSubIssue(parent, child)
+}
+
+func (s *Store) linkSubIssue(parent, child *Issue) {
+	if child.Parent != nil {
+		s.unlinkSubIssue(child.Parent, child)
+	}
+	child.Parent = parent
+	parent.SubIssues = append(parent.SubIssues, child)
+	parent.UpdatedAt = s.now()
+}
+
+func (s *Store) unlinkSubIssue(parent, child *Issue) {
+	for i, sub := range parent.SubIssues {
+		if sub == child {
+			parent.SubIssues = append(parent.SubIssues[:i], parent.SubIssues[i+1:]...)
+			break
+		}
+	}
+	child.Parent = nil
+	parent.UpdatedAt = s.now()
+}
+
+// AddProject adds a project with GitHub's default fields to the account with the given login
+func (s *Store) AddProject(owner, title string) *Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	account := s.accounts[strings.ToLower(owner)]
+	if account == nil {
+		account = s.addAccount(owner, false)
+	}
+	return s.addProject(account, title)
+}
+
+func (s *Store) addProject(owner *Account, title string) *Project {
+	project := s.newProject(owner, title)
+	s.addField(project, "Title", "TITLE", nil)
+	s.addField(project, "Assignees", "ASSIGNEES", nil)
+	s.addField(project, "Status", "SINGLE_SELECT", []*Option{
+		{Name: "Todo", Color: "GREEN"},
+		{Name: "In Progress", Color: "YELLOW"},
+		{Name: "Done", Color: "PURPLE"},
+	})
+	s.addField(project, "Labels", "LABELS", nil)
+	s.addField(project, "Repository", "REPOSITORY", nil)
+	s.addView(project, "View 1", "TABLE_LAYOUT")
+	return project
+}
+
+// newProject adds a project without any fields or views
+func (s *Store) newProject(owner *Account, title string) *Project {
+	now := s.now()
+	project := &Project{
+		ID:        s.newID("PVT"),
+		Owner:     owner,
+		Title:     title,
+		Number:    len(owner.Projects) + 1,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	owner.Projects = append(owner.Projects, project)
+	s.register(project.ID, project)
+	return project
+}
+
+// Project returns the project with the given owner login and number, or nil
+func (s *Store) Project(owner string, number int) *Project {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.project(owner, number)
+}
+
+func (s *Store) project(owner string, number int) *Project {
+	account := s.accounts[strings.ToLower(owner)]
+	if account == nil {
+		return nil
+	}
+	for _, project := range account.Projects {
+		if project.Number == number {
+			return project
+		}
+	}
+	return nil
+}
+
+// AddField adds a field to a project; options are only used by single select fields.
+// Iteration fields start with three two-week iterations beginning today.
+func (s *Store) AddField(project *Project, name, dataType string, options ...string) *Field {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fieldOptions := make([]*Option, len(options))
+	for i, name := range options {
+		fieldOptions[i] = &Option{Name: name, Color: "GRAY"}
+	}
+	return s.addField(project, name, dataType, fieldOptions)
+}
+
+func (s *Store) addField(project *Project, name, dataType string, options []*Option) *Field {
+	prefix := "PVTF"
+	switch dataType {
+	case "SINGLE_SELECT":
+		prefix = "PVTSSF"
+	case "ITERATION":
+		prefix = "PVTIF"
+	}
+
+	now := s.now()
+	field := &Field{
+		ID:        s.newID(prefix),
+		Project:   project,
+		Name:      name,
+		DataType:  dataType,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	for _, option := range options {
+		s.addOption(field, option)
+	}
+	if dataType == "ITERATION" {
+		field.IterationDuration = defaultIterationDuration
+		start := s.today()
+		for i := 1; i <= 3; i++ {
+			s.addIteration(field, fmt.Sprintf("Iteration %d", i), start, defaultIterationDuration)
+			start = start.AddDate(0, 0, defaultIterationDuration)
+		}
+	}
+	project.Fields = append(project.Fields, field)
+	s.register(field.ID, field)
+	return field
+}
+
+// AddIteration adds an iteration to an iteration field
+func (s *Store) AddIteration(field *Field, title string, start time.Time, duration int) *Iteration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addIteration(field, title, start, duration)
+}
+
+func (s *Store) addIteration(field *Field, title string, start time.Time, duration int) *Iteration {
+	iteration := &Iteration{
+		ID:        s.newShortID(),
+		Title:     title,
+		StartDate: start,
+		Duration:  duration,
+	}
+	field.Iterations = append(field.Iterations, iteration)
+	sortIterations(field.Iterations)
+	return iteration
+}
+
+func sortIterations(iterations []*Iteration) {
+	sort.SliceStable(iterations, func(i, j int) bool {
+		return iterations[i].StartDate.Before(iterations[j].StartDate)
+	})
+}
+
+// AddOption adds a gray option to a single select field
+func (s *Store) AddOption(field *Field, name string) *Option {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	option := &Option{Name: name, Color: "GRAY"}
+	s.addOption(field, option)
+	return option
+}
+
+func (s *Store) addOption(field *Field, option *Option) {
+	option.ID = s.newShortID()
+	field.Options = append(field.Options, option)
+}
+
+// AddItem adds an issue or pull request to a project
+func (s *Store) AddItem(project *Project, issue *Issue) *Item {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addItem(project, issue, nil)
+}
+
+// AddDraftIssue adds a draft issue to a project
+func (s *Store) AddDraftIssue(project *Project, title, body string) *Item {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addDraftIssue(project, title, body)
+}
+
+func (s *Store) addDraftIssue(project *Project, title, body string) *Item {
+	now := s.now()
+	draft := &DraftIssue{ID: s.newID("DI"), Title: title, Body: body, CreatedAt: now, UpdatedAt: now}
+	s.register(draft.ID, draft)
+	return s.addItem(project, nil, draft)
+}
+
+func (s *Store) addItem(project *Project, issue *Issue, draft *DraftIssue) *Item {
+	if issue != nil {
+		for _, item := range project.Items {
+			if item.Issue == issue {
+				return item
+			}
+		}
+	}
+
+	now := s.now()
+	item := &Item{
+		ID:        s.newID("PVTI"),
+		Project:   project,
+		Issue:     issue,
+		Draft:     draft,
+		Values:    map[string]Value{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if draft != nil {
+		draft.Item = item
+	}
+	project.Items = append(project.Items, item)
+	project.UpdatedAt = now
+	s.register(item.ID, item)
+	return item
+}
+
+// SetValue sets the value of a field on an item
+func (s *Store) SetValue(item *Item, field *Field, v Value) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	item.Values[field.ID] = v
+}
+
+// AddStatusUpdate adds a status update to a project
+func (s *Store) AddStatusUpdate(project *Project, status, body string) *StatusUpdate {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addStatusUpdate(project, status, body)
+}
+
+func (s *Store) addStatusUpdate(project *Project, status, body string) *StatusUpdate {
+	now := s.now()
+	update := &StatusUpdate{
+		ID:        s.newID("PVTSU"),
+		Project:   project,
+		Creator:   s.viewer(),
+		Status:    status,
+		Body:      body,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	project.StatusUpdates = append(project.StatusUpdates, update)
+	s.register(update.ID, update)
+	return update
+}
+
+// AddView adds a view to a project
+func (s *Store) AddView(project *Project, name, layout string) *View {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addView(project, name, layout)
+}
+
+func (s *Store) addView(project *Project, name, layout string) *View {
+	now := s.now()
+	project.nextViewNumber++
+	view := &View{
+		ID:        s.newID("PVTV"),
+		Project:   project,
+		Name:      name,
+		Layout:    layout,
+		Number:    project.nextViewNumber,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	project.Views = append(project.Views, view)
+	s.register(view.ID, view)
+	return view
+}
+
+// AddDiscussionCategory adds a discussion category to a repository
+func (s *Store) AddDiscussionCategory(repo *Repository, name string, answerable bool) *DiscussionCategory {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	category := &DiscussionCategory{
+		ID:         s.newID("DIC"),
+		Name:       name,
+		Slug:       strings.ToLower(strings.ReplaceAll(name, " ", "-")),
+		Answerable: answerable,
+		CreatedAt:  s.now(),
+	}
+	repo.Categories = append(repo.Categories, category)
+	s.register(category.ID, category)
+	return category
+}
+
+// AddDiscussion adds a discussion authored by the viewer
+func (s *Store) AddDiscussion(repo *Repository, category *DiscussionCategory, title, body string) *Discussion {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.addDiscussion(repo, category, title, body)
+}
+
+func (s *Store) addDiscussion(repo *Repository, category *DiscussionCategory, title, body string) *Discussion {
+	now := s.now()
+	repo.nextNumber++
+	discussion := &Discussion{
+		ID:         s.newID("D"),
+		Repository: repo,
+		Category:   category,
+		Author:     s.viewer(),
+		Title:      title,
+		Body:       body,
+		Number:     repo.nextNumber,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	repo.Discussions = append(repo.Discussions, discussion)
+	s.register(discussion.ID, discussion)
+	return discussion
+}
+
+// viewer returns the account of the authenticated user, creating it if needed
+func (s *Store) viewer() *Account {
+	return s.addAccount(s.Viewer, false)
+}
+
+// Node returns the object with the given node ID, or nil
+func (s *Store) Node(id string) interface{} {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.nodes[id]
+}
+
+// unregister removes a deleted node
+func (s *Store) unregister(id string) {
+	delete(s.nodes, id)
+}
@@ -50,27 +50,80 @@ type DeleteSingleSelectFieldOptionMutation struct {
 	} `graphql:"deleteProjectV2SingleSelectFieldOption(input: $input)"`
 }
 
+// GetFieldQuery gets a project field by node ID
+type GetFieldQuery struct {
+	Node struct {
+		Field ProjectV2Field `graphql:"... on ProjectV2FieldCommon"`
+	} `graphql:"node(id: $id)"`
+}
+
+// GetFieldProjectQuery gets the project a field belongs to
+type GetFieldProjectQuery struct {
+	Node struct {
+		Field struct {
+			Project struct {
+				ID string `graphql:"id"`
+			} `graphql:"project"`
+		} `graphql:"... on ProjectV2FieldCommon"`
+	} `graphql:"node(id: $id)"`
+}
+
+// ListProjectFieldsQuery lists a page of the fields of a project
+type ListProjectFieldsQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Fields struct {
+				PageInfo PageInfo         `graphql:"pageInfo"`
+				Nodes    []ProjectV2Field `graphql:"nodes"`
+			} `graphql:"fields(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
 // Field input types
 
 // CreateFieldInput represents input for creating a field
 type CreateFieldInput struct {
-	ProjectID           gql.ID                 `json:"projectId"`
-	Name                gql.String             `json:"name"`
-	DataType            ProjectV2FieldDataType `json:"dataType"`
-	SingleSelectOptions []SingleSelectOption   `json:"singleSelectOptions,omitempty"`
+	IterationConfiguration *IterationConfigurationInput `json:"iterationConfiguration,omitempty"`
+	ProjectID              gql.ID                       `json:"projectId"`
+	Name                   gql.String                   `json:"name"`
+	DataType               ProjectV2FieldDataType       `json:"dataType"`
+	SingleSelectOptions    []SingleSelectOption         `json:"singleSelectOptions,omitempty"`
 }
 
-// SingleSelectOption represents a single select option for field creation
+// SingleSelectOption represents a single select option for field creation. When
+// the options of a field are updated, an option with the ID of an existing
+// option updates it and keeps its item values.
 type SingleSelectOption struct {
+	ID          *gql.ID     `json:"id,omitempty"`
 	Name        gql.String  `json:"name"`
 	Color       gql.String  `json:"color"`
 	Description *gql.String `json:"description,omitempty"`
 }
 
-// UpdateFieldInput represents input for updating a field
+// UpdateFieldInput represents input for updating a field. The given single select
+// options replace the existing ones; options left out are deleted and items
+// lose them.
 type UpdateFieldInput struct {
-	Name    *gql.String `json:"name,omitempty"`
-	FieldID gql.ID      `json:"fieldId"`
+	Name                   *gql.String                  `json:"name,omitempty"`
+	IterationConfiguration *IterationConfigurationInput `json:"iterationConfiguration,omitempty"`
+	FieldID                gql.ID                       `json:"fieldId"`
+	SingleSelectOptions    []SingleSelectOption         `json:"singleSelectOptions,omitempty"`
+}
+
+// IterationConfigurationInput represents the iterations of an iteration field. On update
+// the given iterations replace the existing ones.
+type IterationConfigurationInput struct {
+	StartDate  gql.String       `json:"startDate"`
+	Iterations []IterationInput `json:"iterations"`
+	Duration   gql.Int          `json:"duration"`
+}
+
+// IterationInput represents a single iteration of an iteration field
+type IterationInput struct {
+	StartDate gql.String `json:"startDate"`
+	Title     gql.String `json:"title"`
+	Duration  gql.Int    `json:"duration"`
 }
 
 // DeleteFieldInput represents input for deleting a field
@@ -115,6 +168,27 @@ func BuildUpdateFieldVariables(input *UpdateFieldInput) map[string]interface{} {
 	}
 }
 
+// BuildGetFieldVariables builds variables for getting a field
+func BuildGetFieldVariables(fieldID string) map[string]interface{} {
+	return map[string]interface{}{
+		"id": gql.ID(fieldID),
+	}
+}
+
+// BuildListProjectFieldsVariables builds variables for listing project fields
+func BuildListProjectFieldsVariables(projectID string, first int, after *string) map[string]interface{} {
+	variables := map[string]interface{}{
+		"projectId": gql.ID(projectID),
+		"first":     gql.Int(first), //nolint:gosec // first is always within int32 range
+	}
+	if after != nil {
+		variables["after"] = gql.String(*after)
+	} else {
+		variables["after"] = (*gql.String)(nil)
+	}
+	return variables
+}
+
 // BuildDeleteFieldVariables builds variables for deleting a field
 func BuildDeleteFieldVariables(input *DeleteFieldInput) map[string]interface{} {
 	return map[string]interface{}{
@@ -1,6 +1,10 @@
 package graphql
 
-import "time"
+import (
+	"time"
+
+	gql "github.com/shurcooL/graphql"
+)
 
 // Repository represents a GitHub repository
 type Repository struct {
@@ -38,8 +42,13 @@ type Issue struct {
 			Login string `graphql:"login"`
 		} `graphql:"nodes"`
 	} `graphql:"assignees(first: 10)"`
-	Number int  `graphql:"number"`
-	Closed bool `graphql:"closed"`
+	Parent    *SubIssue `graphql:"parent"`
+	SubIssues struct {
+		Nodes []SubIssue `graphql:"nodes"`
+	} `graphql:"subIssues(first: 50)"`
+	SubIssuesSummary SubIssuesSummary `graphql:"subIssuesSummary"`
+	Number           int              `graphql:"number"`
+	Closed           bool             `graphql:"closed"`
 }
 
 // PullRequest represents a GitHub pull request
@@ -115,6 +124,210 @@ type GetPullRequestQuery struct {
 	} `graphql:"repository(owner: $owner, name: $repo)"`
 }
 
+// ContentProjectItem is a project item of an issue or pull request
+type ContentProjectItem struct {
+	ID      string `graphql:"id"`
+	Project struct {
+		ID    string `graphql:"id"`
+		Title string `graphql:"title"`
+		URL   string `graphql:"url"`
+		Owner struct {
+			Login string `graphql:"login"`
+		} `graphql:"owner"`
+		Number int `graphql:"number"`
+	} `graphql:"project"`
+}
+
+// ContentProjectItems is the project items connection of an issue or pull request
+type ContentProjectItems struct {
+	Nodes []ContentProjectItem `graphql:"nodes"`
+}
+
+// GetContentProjectItemsQuery gets the project items of an issue or pull request
+type GetContentProjectItemsQuery struct {
+	Repository struct {
+		IssueOrPullRequest struct {
+			TypeName string `graphql:"__typename"`
+			Issue    struct {
+				ID           string              `graphql:"id"`
+				ProjectItems ContentProjectItems `graphql:"projectItems(first: 50)"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				ID           string              `graphql:"id"`
+				ProjectItems ContentProjectItems `graphql:"projectItems(first: 50)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"issueOrPullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ContentProjectItemValues is a project item of an issue or pull request with
+// its field values
+type ContentProjectItemValues struct {
+	ContentProjectItem
+	FieldValues struct {
+		Nodes []ProjectV2ItemFieldValue `graphql:"nodes"`
+	} `graphql:"fieldValues(first: 20)"`
+}
+
+// GetContentProjectItemValuesQuery gets the project items of an issue or pull
+// request with their field values
+type GetContentProjectItemValuesQuery struct {
+	Repository struct {
+		IssueOrPullRequest struct {
+			TypeName string `graphql:"__typename"`
+			Issue    struct {
+				ProjectItems struct {
+					Nodes []ContentProjectItemValues `graphql:"nodes"`
+				} `graphql:"projectItems(first: 50)"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				ProjectItems struct {
+					Nodes []ContentProjectItemValues `graphql:"nodes"`
+				} `graphql:"projectItems(first: 50)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"issueOrPullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// Actor is the user or app behind a comment or event
+type Actor struct {
+	Login string `graphql:"login"`
+}
+
+// ItemComment is a comment on an issue or pull request
+type ItemComment struct {
+	CreatedAt time.Time `graphql:"createdAt"`
+	Author    *Actor    `graphql:"author"`
+	Body      string    `graphql:"body"`
+	URL       string    `graphql:"url"`
+}
+
+// ItemComments is the comments connection of an issue or pull request
+type ItemComments struct {
+	Nodes      []ItemComment `graphql:"nodes"`
+	TotalCount int           `graphql:"totalCount"`
+}
+
+// GetItemCommentsQuery gets the latest comments of an issue or pull request
+type GetItemCommentsQuery struct {
+	Repository struct {
+		IssueOrPullRequest struct {
+			TypeName string `graphql:"__typename"`
+			Issue    struct {
+				Comments ItemComments `graphql:"comments(last: $last)"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				Comments ItemComments `graphql:"comments(last: $last)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"issueOrPullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// TimelineEvent holds the fields shared by timeline events
+type TimelineEvent struct {
+	CreatedAt time.Time `graphql:"createdAt"`
+	Actor     *Actor    `graphql:"actor"`
+}
+
+// TimelineLabelEvent is a label being added to or removed from an issue or pull request
+type TimelineLabelEvent struct {
+	TimelineEvent
+	Label struct {
+		Name string `graphql:"name"`
+	} `graphql:"label"`
+}
+
+// TimelineAssigneeEvent is a user being assigned or unassigned
+type TimelineAssigneeEvent struct {
+	TimelineEvent
+	Assignee struct {
+		User Actor `graphql:"... on User"`
+	} `graphql:"assignee"`
+}
+
+// LinkedItem is an issue or pull request referenced by another one
+type LinkedItem struct {
+	Repository struct {
+		NameWithOwner string `graphql:"nameWithOwner"`
+	} `graphql:"repository"`
+	Title  string `graphql:"title"`
+	URL    string `graphql:"url"`
+	State  string `graphql:"state"`
+	Number int    `graphql:"number"`
+}
+
+// TimelineItem is an entry in the timeline of an issue or pull request. Only
+// the fragment matching TypeName is filled in.
+type TimelineItem struct {
+	TypeName     string `graphql:"__typename"`
+	IssueComment struct {
+		CreatedAt time.Time `graphql:"createdAt"`
+		Author    *Actor    `graphql:"author"`
+		URL       string    `graphql:"url"`
+	} `graphql:"... on IssueComment"`
+	ClosedEvent       TimelineEvent         `graphql:"... on ClosedEvent"`
+	ReopenedEvent     TimelineEvent         `graphql:"... on ReopenedEvent"`
+	MergedEvent       TimelineEvent         `graphql:"... on MergedEvent"`
+	LabeledEvent      TimelineLabelEvent    `graphql:"... on LabeledEvent"`
+	UnlabeledEvent    TimelineLabelEvent    `graphql:"... on UnlabeledEvent"`
+	AssignedEvent     TimelineAssigneeEvent `graphql:"... on AssignedEvent"`
+	UnassignedEvent   TimelineAssigneeEvent `graphql:"... on UnassignedEvent"`
+	RenamedTitleEvent struct {
+		TimelineEvent
+		PreviousTitle string `graphql:"previousTitle"`
+		CurrentTitle  string `graphql:"currentTitle"`
+	} `graphql:"... on RenamedTitleEvent"`
+	CrossReferencedEvent struct {
+		TimelineEvent
+		Source struct {
+			TypeName    string     `graphql:"__typename"`
+			Issue       LinkedItem `graphql:"... on Issue"`
+			PullRequest LinkedItem `graphql:"... on PullRequest"`
+		} `graphql:"source"`
+	} `graphql:"... on CrossReferencedEvent"`
+}
+
+// ItemTimeline is the timeline connection of an issue or pull request
+type ItemTimeline struct {
+	Nodes      []TimelineItem `graphql:"nodes"`
+	TotalCount int            `graphql:"totalCount"`
+}
+
+// GetItemTimelineQuery gets the latest timeline entries of an issue or pull request
+type GetItemTimelineQuery struct {
+	Repository struct {
+		IssueOrPullRequest struct {
+			TypeName string `graphql:"__typename"`
+			Issue    struct {
+				TimelineItems ItemTimeline `graphql:"timelineItems(last: $last)"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				TimelineItems ItemTimeline `graphql:"timelineItems(last: $last)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"issueOrPullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// GetLinkedItemsQuery gets the pull requests that close an issue, or the issues
+// a pull request closes
+type GetLinkedItemsQuery struct {
+	Repository struct {
+		IssueOrPullRequest struct {
+			TypeName string `graphql:"__typename"`
+			Issue    struct {
+				ClosedByPullRequestsReferences struct {
+					Nodes []LinkedItem `graphql:"nodes"`
+				} `graphql:"closedByPullRequestsReferences(first: 25, includeClosedPrs: true)"`
+			} `graphql:"... on Issue"`
+			PullRequest struct {
+				ClosingIssuesReferences struct {
+					Nodes []LinkedItem `graphql:"nodes"`
+				} `graphql:"closingIssuesReferences(first: 25)"`
+			} `graphql:"... on PullRequest"`
+		} `graphql:"issueOrPullRequest(number: $number)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
 // SearchIssuesQuery searches for issues
 type SearchIssuesQuery struct {
 	Search struct {
@@ -157,8 +370,96 @@ type ListRepositoryPullRequestsQuery struct {
 	} `graphql:"repository(owner: $owner, name: $repo)"`
 }
 
+// RepositoryIDQuery gets the ID of a repository
+type RepositoryIDQuery struct {
+	Repository struct {
+		ID string `graphql:"id"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// RepositoryLabelQuery gets a label of a repository by name
+type RepositoryLabelQuery struct {
+	Repository struct {
+		Label *struct {
+			ID   string `graphql:"id"`
+			Name string `graphql:"name"`
+		} `graphql:"label(name: $label)"`
+	} `graphql:"repository(owner: $owner, name: $repo)"`
+}
+
+// ListProjectItemsQuery lists a page of project items with their field values
+type ListProjectItemsQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Items struct {
+				PageInfo PageInfo        `graphql:"pageInfo"`
+				Nodes    []ProjectV2Item `graphql:"nodes"`
+			} `graphql:"items(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+// ProjectItemFieldValue is a project item with its value of one field
+type ProjectItemFieldValue struct {
+	ID      string `graphql:"id"`
+	Content struct {
+		TypeName string `graphql:"__typename"`
+		Issue    struct {
+			Title string `graphql:"title"`
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			Title string `graphql:"title"`
+		} `graphql:"... on PullRequest"`
+		DraftIssue struct {
+			Title string `graphql:"title"`
+		} `graphql:"... on DraftIssue"`
+	} `graphql:"content"`
+	Value *ProjectV2ItemFieldValue `graphql:"fieldValueByName(name: $fieldName)"`
+}
+
+// ListProjectItemFieldValuesQuery lists a page of project items with their value
+// of one field, however many fields the project has
+type ListProjectItemFieldValuesQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Items struct {
+				PageInfo PageInfo                `graphql:"pageInfo"`
+				Nodes    []ProjectItemFieldValue `graphql:"nodes"`
+			} `graphql:"items(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
 // Mutations
 
+// ConvertDraftIssueMutation converts a draft issue item into an issue
+type ConvertDraftIssueMutation struct {
+	ConvertProjectV2DraftIssueItemToIssue struct {
+		Item ProjectV2Item `graphql:"item"`
+	} `graphql:"convertProjectV2DraftIssueItemToIssue(input: $input)"`
+}
+
+// AddLabelsMutation adds labels to an issue or pull request
+type AddLabelsMutation struct {
+	AddLabelsToLabelable struct {
+		ClientMutationID *string `graphql:"clientMutationId"`
+	} `graphql:"addLabelsToLabelable(input: $input)"`
+}
+
+// AddAssigneesMutation adds assignees to an issue or pull request
+type AddAssigneesMutation struct {
+	AddAssigneesToAssignable struct {
+		ClientMutationID *string `graphql:"clientMutationId"`
+	} `graphql:"addAssigneesToAssignable(input: $input)"`
+}
+
+// UpdateItemPositionMutation moves an item within the manual order of a project
+type UpdateItemPositionMutation struct {
+	UpdateProjectV2ItemPosition struct {
+		ClientMutationID *string `graphql:"clientMutationId"`
+	} `graphql:"updateProjectV2ItemPosition(input: $input)"`
+}
+
 // CreateDraftIssueMutation creates a draft issue in a project
 type CreateDraftIssueMutation struct {
 	AddProjectV2DraftIssue struct {
@@ -201,6 +502,32 @@ type DeleteDraftIssueInput struct {
 	ItemID string `json:"itemId"`
 }
 
+// ConvertDraftIssueInput represents input for converting a draft issue item into an issue
+type ConvertDraftIssueInput struct {
+	ItemID       gql.ID `json:"itemId"`
+	RepositoryID gql.ID `json:"repositoryId"`
+}
+
+// AddLabelsInput represents input for adding labels to an issue or pull request
+type AddLabelsInput struct {
+	LabelableID gql.ID   `json:"labelableId"`
+	LabelIDs    []gql.ID `json:"labelIds"`
+}
+
+// AddAssigneesInput represents input for adding assignees to an issue or pull request
+type AddAssigneesInput struct {
+	AssignableID gql.ID   `json:"assignableId"`
+	AssigneeIDs  []gql.ID `json:"assigneeIds"`
+}
+
+// UpdateItemPositionInput represents input for moving a project item; without
+// AfterID the item is moved to the top
+type UpdateItemPositionInput struct {
+	AfterID   *gql.ID `json:"afterId,omitempty"`
+	ProjectID gql.ID  `json:"projectId"`
+	ItemID    gql.ID  `json:"itemId"`
+}
+
 // SearchOptions represents search options for issues/PRs
 type SearchOptions struct {
 	After *string
@@ -246,6 +573,27 @@ func BuildGetPullRequestVariables(owner, repo string, number int) map[string]int
 	}
 }
 
+// BuildGetContentProjectItemsVariables builds variables for getting the project
+// items of an issue or pull request
+func BuildGetContentProjectItemsVariables(owner, repo string, number int) map[string]interface{} {
+	return map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+	}
+}
+
+// BuildGetItemActivityVariables builds variables for getting the latest last
+// comments or timeline entries of an issue or pull request
+func BuildGetItemActivityVariables(owner, repo string, number, last int) map[string]interface{} {
+	return map[string]interface{}{
+		"owner":  owner,
+		"repo":   repo,
+		"number": number,
+		"last":   last,
+	}
+}
+
 // BuildSearchIssuesVariables builds variables for searching issues
 func BuildSearchIssuesVariables(opts SearchOptions) map[string]interface{} {
 	if opts.First <= 0 {
@@ -375,3 +723,70 @@ func BuildDeleteDraftIssueVariables(input DeleteDraftIssueInput) map[string]inte
 		},
 	}
 }
+
+// BuildRepositoryIDVariables builds variables for getting the ID of a repository
+func BuildRepositoryIDVariables(owner, repo string) map[string]interface{} {
+	return map[string]interface{}{
+		"owner": gql.String(owner),
+		"repo":  gql.String(repo),
+	}
+}
+
+// BuildRepositoryLabelVariables builds variables for getting a label of a repository
+func BuildRepositoryLabelVariables(owner, repo, label string) map[string]interface{} {
+	return map[string]interface{}{
+		"owner": gql.String(owner),
+		"repo":  gql.String(repo),
+		"label": gql.String(label),
+	}
+}
+
+// BuildListProjectItemFieldValuesVariables builds variables for listing the values
+// project items have for one field
+func BuildListProjectItemFieldValuesVariables(projectID, fieldName string, first int, after *string) map[string]interface{} {
+	variables := BuildListProjectItemsVariables(projectID, first, after)
+	variables["fieldName"] = gql.String(fieldName)
+	return variables
+}
+
+// BuildListProjectItemsVariables builds variables for listing project items
+func BuildListProjectItemsVariables(projectID string, first int, after *string) map[string]interface{} {
+	variables := map[string]interface{}{
+		"projectId": gql.ID(projectID),
+		"first":     gql.Int(first), //nolint:gosec // first is always within int32 range
+	}
+	if after != nil {
+		variables["after"] = gql.String(*after)
+	} else {
+		variables["after"] = (*gql.String)(nil)
+	}
+	return variables
+}
+
+// BuildConvertDraftIssueVariables builds variables for converting a draft issue
+func BuildConvertDraftIssueVariables(input *ConvertDraftIssueInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// BuildAddLabelsVariables builds variables for adding labels
+func BuildAddLabelsVariables(input *AddLabelsInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// BuildAddAssigneesVariables builds variables for adding assignees
+func BuildAddAssigneesVariables(input *AddAssigneesInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// BuildUpdateItemPositionVariables builds variables for moving a project item
+func BuildUpdateItemPositionVariables(input *UpdateItemPositionInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
@@ -3,6 +3,7 @@ package graphql
 import (
 	"testing"
 
+	gql "github.com/shurcooL/graphql"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -164,6 +165,19 @@ func TestItemVariableBuilders(t *testing.T) {
 		assert.Equal(t, "Updated Title", inputVar["title"])
 		assert.Equal(t, "Updated Body", inputVar["body"])
 	})
+
+	t.Run("BuildUpdateItemPositionVariables creates proper variables", func(t *testing.T) {
+		after := gql.ID("item-1")
+		variables := BuildUpdateItemPositionVariables(&UpdateItemPositionInput{
+			ProjectID: "project-id",
+			ItemID:    "item-2",
+			AfterID:   &after,
+		})
+
+		input := variables["input"].(UpdateItemPositionInput)
+		assert.Equal(t, gql.ID("item-2"), input.ItemID)
+		assert.Equal(t, &after, input.AfterID)
+	})
 }
 
 func TestSearchOptions(t *testing.T) {
@@ -35,9 +35,12 @@ type ProjectV2Field struct {
 	Name     string                 `graphql:"name"`
 	DataType ProjectV2FieldDataType `graphql:"dataType"`
 
-	Options struct {
-		Nodes []ProjectV2SingleSelectFieldOption `graphql:"nodes"`
-	} `graphql:"... on ProjectV2SingleSelectField { options(first: 20) }"`
+	SingleSelect struct {
+		Options []ProjectV2SingleSelectFieldOption `graphql:"options"`
+	} `graphql:"... on ProjectV2SingleSelectField"`
+	Iteration struct {
+		Configuration ProjectV2IterationFieldConfiguration `graphql:"configuration"`
+	} `graphql:"... on ProjectV2IterationField"`
 }
 
 // ProjectV2FieldDataType represents the data type of a field
@@ -59,6 +62,22 @@ type ProjectV2SingleSelectFieldOption struct {
 	Color       string  `graphql:"color"`
 }
 
+// ProjectV2IterationFieldConfiguration represents the iterations of an iteration field
+type ProjectV2IterationFieldConfiguration struct {
+	Iterations          []ProjectV2IterationFieldIteration `graphql:"iterations"`
+	CompletedIterations []ProjectV2IterationFieldIteration `graphql:"completedIterations"`
+	Duration            int                                `graphql:"duration"`
+	StartDay            int                                `graphql:"startDay"`
+}
+
+// ProjectV2IterationFieldIteration represents a single iteration of an iteration field
+type ProjectV2IterationFieldIteration struct {
+	ID        string `graphql:"id"`
+	Title     string `graphql:"title"`
+	StartDate string `graphql:"startDate"`
+	Duration  int    `graphql:"duration"`
+}
+
 // ProjectV2Item represents an item in a project
 type ProjectV2Item struct {
 	CreatedAt   time.Time `graphql:"createdAt"`
@@ -68,35 +87,97 @@ type ProjectV2Item struct {
 		Nodes []ProjectV2ItemFieldValue `graphql:"nodes"`
 	} `graphql:"fieldValues(first: 20)"`
 	Content struct {
-		DraftBody   *string `graphql:"... on DraftIssue { body }"`
-		PRTitle     string  `graphql:"... on PullRequest { title }"`
-		IssueURL    string  `graphql:"... on Issue { url }"`
-		IssueState  string  `graphql:"... on Issue { state }"`
-		TypeName    string  `graphql:"__typename"`
-		PRURL       string  `graphql:"... on PullRequest { url }"`
-		PRState     string  `graphql:"... on PullRequest { state }"`
-		DraftTitle  string  `graphql:"... on DraftIssue { title }"`
-		IssueTitle  string  `graphql:"... on Issue { title }"`
-		IssueNumber int     `graphql:"... on Issue { number }"`
-		PRNumber    int     `graphql:"... on PullRequest { number }"`
-		IssueClosed bool    `graphql:"... on Issue { closed }"`
-		PRClosed    bool    `graphql:"... on PullRequest { closed }"`
+		TypeName string `graphql:"__typename"`
+		Issue    struct {
+			ID       string     `graphql:"id"`
+			Title    string     `graphql:"title"`
+			URL      string     `graphql:"url"`
+			State    string     `graphql:"state"`
+			ClosedAt *time.Time `graphql:"closedAt"`
+			Parent   *struct {
+				ID string `graphql:"id"`
+			} `graphql:"parent"`
+			Assignees        ItemAssignees    `graphql:"assignees(first: 10)"`
+			Labels           ItemLabels       `graphql:"labels(first: 10)"`
+			Milestone        *ItemMilestone   `graphql:"milestone"`
+			Repository       ItemRepository   `graphql:"repository"`
+			SubIssuesSummary SubIssuesSummary `graphql:"subIssuesSummary"`
+			Number           int              `graphql:"number"`
+			Closed           bool             `graphql:"closed"`
+		} `graphql:"... on Issue"`
+		PullRequest struct {
+			ID         string         `graphql:"id"`
+			Title      string         `graphql:"title"`
+			URL        string         `graphql:"url"`
+			State      string         `graphql:"state"`
+			ClosedAt   *time.Time     `graphql:"closedAt"`
+			Assignees  ItemAssignees  `graphql:"assignees(first: 10)"`
+			Labels     ItemLabels     `graphql:"labels(first: 10)"`
+			Milestone  *ItemMilestone `graphql:"milestone"`
+			Repository ItemRepository `graphql:"repository"`
+			Number     int            `graphql:"number"`
+			Closed     bool           `graphql:"closed"`
+		} `graphql:"... on PullRequest"`
+		DraftIssue struct {
+			Body  *string `graphql:"body"`
+			Title string  `graphql:"title"`
+		} `graphql:"... on DraftIssue"`
 	} `graphql:"content"`
 }
 
+// ItemAssignees represents the assignees of an issue or pull request in a project
+type ItemAssignees struct {
+	Nodes []struct {
+		Login string `graphql:"login"`
+	} `graphql:"nodes"`
+}
+
+// ItemLabels represents the labels of an issue or pull request in a project
+type ItemLabels struct {
+	Nodes []struct {
+		Name string `graphql:"name"`
+	} `graphql:"nodes"`
+}
+
+// ItemMilestone represents the milestone of an issue or pull request in a project
+type ItemMilestone struct {
+	Title string `graphql:"title"`
+}
+
+// ItemRepository represents the repository of an issue or pull request in a project
+type ItemRepository struct {
+	NameWithOwner string `graphql:"nameWithOwner"`
+}
+
+// ViewerQuery gets the login of the authenticated user
+type ViewerQuery struct {
+	Viewer struct {
+		Login string `graphql:"login"`
+	} `graphql:"viewer"`
+}
+
 // ProjectV2ItemFieldValue represents a field value for an item
 type ProjectV2ItemFieldValue struct {
-	TextValue         *string    `graphql:"... on ProjectV2ItemFieldTextValue { text }"`
-	NumberValue       *float64   `graphql:"... on ProjectV2ItemFieldNumberValue { number }"`
-	DateValue         *time.Time `graphql:"... on ProjectV2ItemFieldDateValue { date }"`
-	SingleSelectValue *struct {
-		ID   string `graphql:"id"`
-		Name string `graphql:"name"`
-	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue { singleSelectOption }"`
-	IterationValue *struct {
-		ID    string `graphql:"id"`
-		Title string `graphql:"title"`
-	} `graphql:"... on ProjectV2ItemFieldIterationValue { iteration }"`
+	TypeName  string `graphql:"__typename"`
+	TextValue struct {
+		Text *string `graphql:"text"`
+	} `graphql:"... on ProjectV2ItemFieldTextValue"`
+	NumberValue struct {
+		Number *float64 `graphql:"number"`
+	} `graphql:"... on ProjectV2ItemFieldNumberValue"`
+	DateValue struct {
+		Date *string `graphql:"date"`
+	} `graphql:"... on ProjectV2ItemFieldDateValue"`
+	SingleSelectValue struct {
+		OptionID *string `graphql:"optionId"`
+		Name     *string `graphql:"name"`
+	} `graphql:"... on ProjectV2ItemFieldSingleSelectValue"`
+	IterationValue struct {
+		IterationID *string `graphql:"iterationId"`
+		Title       *string `graphql:"title"`
+		StartDate   *string `graphql:"startDate"`
+		Duration    *int    `graphql:"duration"`
+	} `graphql:"... on ProjectV2ItemFieldIterationValue"`
 	Field struct {
 		ID   string `graphql:"id"`
 		Name string `graphql:"name"`
@@ -139,6 +220,74 @@ type GetUserProjectQuery struct {
 	} `graphql:"user(login: $userLogin)"`
 }
 
+// ProjectV2Settings represents the settings of a project not covered by ProjectV2
+type ProjectV2Settings struct {
+	ShortDescription *string `graphql:"shortDescription"`
+	Readme           *string `graphql:"readme"`
+	ID               string  `graphql:"id"`
+	Repositories     struct {
+		Nodes []struct {
+			ID            string `graphql:"id"`
+			NameWithOwner string `graphql:"nameWithOwner"`
+		} `graphql:"nodes"`
+	} `graphql:"repositories(first: 100)"`
+	Public bool `graphql:"public"`
+}
+
+// GetProjectSettingsQuery gets the settings and linked repositories of a project
+type GetProjectSettingsQuery struct {
+	Node struct {
+		ProjectV2 ProjectV2Settings `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+// ProjectV2Links represents the repositories and teams a project is linked to
+type ProjectV2Links struct {
+	ID           string `graphql:"id"`
+	Repositories struct {
+		Nodes []LinkedRepository `graphql:"nodes"`
+	} `graphql:"repositories(first: 100)"`
+	Teams struct {
+		Nodes []LinkedTeam `graphql:"nodes"`
+	} `graphql:"teams(first: 100)"`
+}
+
+// LinkedRepository represents a repository linked to a project
+type LinkedRepository struct {
+	ID            string `graphql:"id" json:"id"`
+	NameWithOwner string `graphql:"nameWithOwner" json:"name"`
+	URL           string `graphql:"url" json:"url"`
+}
+
+// LinkedTeam represents a team linked to a project
+type LinkedTeam struct {
+	ID           string `graphql:"id" json:"id"`
+	CombinedSlug string `graphql:"combinedSlug" json:"name"`
+	URL          string `graphql:"url" json:"url"`
+}
+
+// GetProjectLinksQuery gets the repositories and teams linked to a project
+type GetProjectLinksQuery struct {
+	Node struct {
+		ProjectV2 ProjectV2Links `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+// GetTeamQuery gets a team of an organization by slug
+type GetTeamQuery struct {
+	Organization struct {
+		Team LinkedTeam `graphql:"team(slug: $slug)"`
+	} `graphql:"organization(login: $login)"`
+}
+
+// GetOwnerQuery gets the node ID of a user or organization
+type GetOwnerQuery struct {
+	RepositoryOwner struct {
+		ID    string `graphql:"id"`
+		Login string `graphql:"login"`
+	} `graphql:"repositoryOwner(login: $login)"`
+}
+
 // PageInfo represents pagination information
 type PageInfo struct {
 	StartCursor     string `graphql:"startCursor"`
@@ -170,6 +319,31 @@ type DeleteProjectMutation struct {
 	} `graphql:"deleteProjectV2(input: $input)"`
 }
 
+// CopyProjectMutation copies a project with its fields, views and workflows
+type CopyProjectMutation struct {
+	CopyProjectV2 struct {
+		ProjectV2 ProjectV2 `graphql:"projectV2"`
+	} `graphql:"copyProjectV2(input: $input)"`
+}
+
+// LinkProjectToTeamMutation links a project to a team
+type LinkProjectToTeamMutation struct {
+	LinkProjectV2ToTeam struct {
+		Team struct {
+			ID string `graphql:"id"`
+		} `graphql:"team"`
+	} `graphql:"linkProjectV2ToTeam(input: $input)"`
+}
+
+// UnlinkProjectFromTeamMutation unlinks a project from a team
+type UnlinkProjectFromTeamMutation struct {
+	UnlinkProjectV2FromTeam struct {
+		Team struct {
+			ID string `graphql:"id"`
+		} `graphql:"team"`
+	} `graphql:"unlinkProjectV2FromTeam(input: $input)"`
+}
+
 // AddItemToProjectMutation adds an item to a project
 type AddItemToProjectMutation struct {
 	AddProjectV2ItemByID struct {
@@ -184,6 +358,24 @@ type UpdateItemFieldMutation struct {
 	} `graphql:"updateProjectV2ItemFieldValue(input: $input)"`
 }
 
+// LinkProjectToRepositoryMutation links a project to a repository
+type LinkProjectToRepositoryMutation struct {
+	LinkProjectV2ToRepository struct {
+		Repository struct {
+			ID string `graphql:"id"`
+		} `graphql:"repository"`
+	} `graphql:"linkProjectV2ToRepository(input: $input)"`
+}
+
+// UnlinkProjectFromRepositoryMutation unlinks a project from a repository
+type UnlinkProjectFromRepositoryMutation struct {
+	UnlinkProjectV2FromRepository struct {
+		Repository struct {
+			ID string `graphql:"id"`
+		} `graphql:"repository"`
+	} `graphql:"unlinkProjectV2FromRepository(input: $input)"`
+}
+
 // RemoveItemFromProjectMutation removes an item from a project
 type RemoveItemFromProjectMutation struct {
 	DeleteProjectV2Item struct {
@@ -205,9 +397,32 @@ type CreateProjectInput struct {
 
 // UpdateProjectInput represents input for updating a project
 type UpdateProjectInput struct {
-	Title     *gql.String  `json:"title,omitempty"`
-	Closed    *gql.Boolean `json:"closed,omitempty"`
-	ProjectID gql.ID       `json:"projectId"`
+	Title            *gql.String  `json:"title,omitempty"`
+	ShortDescription *gql.String  `json:"shortDescription,omitempty"`
+	Readme           *gql.String  `json:"readme,omitempty"`
+	Public           *gql.Boolean `json:"public,omitempty"`
+	Closed           *gql.Boolean `json:"closed,omitempty"`
+	ProjectID        gql.ID       `json:"projectId"`
+}
+
+// ProjectRepositoryInput represents input for linking or unlinking a repository
+type ProjectRepositoryInput struct {
+	ProjectID    gql.ID `json:"projectId"`
+	RepositoryID gql.ID `json:"repositoryId"`
+}
+
+// ProjectTeamInput represents input for linking or unlinking a team
+type ProjectTeamInput struct {
+	ProjectID gql.ID `json:"projectId"`
+	TeamID    gql.ID `json:"teamId"`
+}
+
+// CopyProjectInput represents input for copying a project
+type CopyProjectInput struct {
+	ProjectID          gql.ID      `json:"projectId"`
+	OwnerID            gql.ID      `json:"ownerId"`
+	Title              gql.String  `json:"title"`
+	IncludeDraftIssues gql.Boolean `json:"includeDraftIssues"`
 }
 
 // DeleteProjectInput represents input for deleting a project
@@ -272,6 +487,56 @@ func BuildUpdateItemFieldVariables(input *UpdateItemFieldInput) map[string]inter
 	}
 }
 
+// BuildProjectRepositoryVariables builds variables for linking or unlinking a repository
+func BuildProjectRepositoryVariables(input *ProjectRepositoryInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// BuildProjectTeamVariables builds variables for linking or unlinking a team
+func BuildProjectTeamVariables(input *ProjectTeamInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// BuildGetProjectLinksVariables builds variables for getting the links of a project
+func BuildGetProjectLinksVariables(projectID string) map[string]interface{} {
+	return map[string]interface{}{
+		"projectId": gql.ID(projectID),
+	}
+}
+
+// BuildGetTeamVariables builds variables for looking up a team
+func BuildGetTeamVariables(org, slug string) map[string]interface{} {
+	return map[string]interface{}{
+		"login": gql.String(org),
+		"slug":  gql.String(slug),
+	}
+}
+
+// BuildCopyProjectVariables builds variables for copying a project
+func BuildCopyProjectVariables(input *CopyProjectInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// BuildGetOwnerVariables builds variables for looking up a user or organization
+func BuildGetOwnerVariables(login string) map[string]interface{} {
+	return map[string]interface{}{
+		"login": gql.String(login),
+	}
+}
+
+// BuildGetProjectSettingsVariables builds variables for getting project settings
+func BuildGetProjectSettingsVariables(projectID string) map[string]interface{} {
+	return map[string]interface{}{
+		"projectId": gql.ID(projectID),
+	}
+}
+
 // BuildRemoveItemVariables builds variables for removing an item
 func BuildRemoveItemVariables(input *RemoveItemInput) map[string]interface{} {
 	return map[string]interface{}{
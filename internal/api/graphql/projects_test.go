@@ -46,6 +46,12 @@ func TestProjectMutations(t *testing.T) {
 
 		assert.NotNil(t, mutation)
 	})
+
+	t.Run("CopyProject mutation structure", func(t *testing.T) {
+		mutation := &CopyProjectMutation{}
+
+		assert.NotNil(t, mutation)
+	})
 }
 
 func TestItemMutations(t *testing.T) {
@@ -81,6 +87,19 @@ func TestVariableBuilders(t *testing.T) {
 		assert.Contains(t, variables, "input")
 	})
 
+	t.Run("BuildCopyProjectVariables creates proper variables", func(t *testing.T) {
+		input := &CopyProjectInput{
+			ProjectID:          gql.ID("project-id"),
+			OwnerID:            gql.ID("owner-id"),
+			Title:              gql.String("Copy"),
+			IncludeDraftIssues: gql.Boolean(true),
+		}
+
+		variables := BuildCopyProjectVariables(input)
+
+		assert.Equal(t, *input, variables["input"])
+	})
+
 	t.Run("BuildAddItemVariables creates proper variables", func(t *testing.T) {
 		input := &AddItemInput{
 			ProjectID: gql.ID("project-id"),
@@ -0,0 +1,171 @@
+package graphql
+
+import (
+	"time"
+
+	gql "github.com/shurcooL/graphql"
+)
+
+// ProjectV2StatusUpdate represents a status update of a GitHub Project v2
+type ProjectV2StatusUpdate struct {
+	CreatedAt  time.Time                    `graphql:"createdAt" json:"createdAt"`
+	UpdatedAt  time.Time                    `graphql:"updatedAt" json:"updatedAt"`
+	Status     *ProjectV2StatusUpdateStatus `graphql:"status" json:"status"`
+	StartDate  *string                      `graphql:"startDate" json:"startDate"`
+	TargetDate *string                      `graphql:"targetDate" json:"targetDate"`
+	Creator    struct {
+		Login string `graphql:"login" json:"login"`
+	} `graphql:"creator" json:"creator"`
+	ID   string `graphql:"id" json:"id"`
+	Body string `graphql:"body" json:"body"`
+}
+
+// ProjectV2StatusUpdateStatus represents the status of a project status update
+type ProjectV2StatusUpdateStatus string
+
+const (
+	ProjectV2StatusUpdateStatusInactive ProjectV2StatusUpdateStatus = "INACTIVE"
+	ProjectV2StatusUpdateStatusOnTrack  ProjectV2StatusUpdateStatus = "ON_TRACK"
+	ProjectV2StatusUpdateStatusAtRisk   ProjectV2StatusUpdateStatus = "AT_RISK"
+	ProjectV2StatusUpdateStatusOffTrack ProjectV2StatusUpdateStatus = "OFF_TRACK"
+	ProjectV2StatusUpdateStatusComplete ProjectV2StatusUpdateStatus = "COMPLETE"
+)
+
+// Queries
+
+// ListStatusUpdatesQuery lists the status updates of a project, newest first
+type ListStatusUpdatesQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			StatusUpdates struct {
+				Nodes      []ProjectV2StatusUpdate `graphql:"nodes"`
+				TotalCount int                     `graphql:"totalCount"`
+			} `graphql:"statusUpdates(first: $first, orderBy: {field: CREATED_AT, direction: DESC})"`
+			ID string `graphql:"id"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+// GetStatusUpdateQuery gets a status update by ID
+type GetStatusUpdateQuery struct {
+	Node struct {
+		StatusUpdate ProjectV2StatusUpdate `graphql:"... on ProjectV2StatusUpdate"`
+	} `graphql:"node(id: $statusUpdateId)"`
+}
+
+// Mutations
+
+// CreateStatusUpdateMutation creates a project status update
+type CreateStatusUpdateMutation struct {
+	CreateProjectV2StatusUpdate struct {
+		StatusUpdate ProjectV2StatusUpdate `graphql:"statusUpdate"`
+	} `graphql:"createProjectV2StatusUpdate(input: $input)"`
+}
+
+// UpdateStatusUpdateMutation updates a project status update
+type UpdateStatusUpdateMutation struct {
+	UpdateProjectV2StatusUpdate struct {
+		StatusUpdate ProjectV2StatusUpdate `graphql:"statusUpdate"`
+	} `graphql:"updateProjectV2StatusUpdate(input: $input)"`
+}
+
+// DeleteStatusUpdateMutation deletes a project status update
+type DeleteStatusUpdateMutation struct {
+	DeleteProjectV2StatusUpdate struct {
+		DeletedStatusUpdateID string `graphql:"deletedStatusUpdateId"`
+	} `graphql:"deleteProjectV2StatusUpdate(input: $input)"`
+}
+
+// Input Types
+
+// CreateStatusUpdateInput represents input for creating a status update
+type CreateStatusUpdateInput struct {
+	Body       *gql.String                  `json:"body,omitempty"`
+	StartDate  *gql.String                  `json:"startDate,omitempty"`
+	TargetDate *gql.String                  `json:"targetDate,omitempty"`
+	Status     *ProjectV2StatusUpdateStatus `json:"status,omitempty"`
+	ProjectID  gql.ID                       `json:"projectId"`
+}
+
+// UpdateStatusUpdateInput represents input for updating a status update
+type UpdateStatusUpdateInput struct {
+	Body           *gql.String                  `json:"body,omitempty"`
+	StartDate      *gql.String                  `json:"startDate,omitempty"`
+	TargetDate     *gql.String                  `json:"targetDate,omitempty"`
+	Status         *ProjectV2StatusUpdateStatus `json:"status,omitempty"`
+	StatusUpdateID gql.ID                       `json:"statusUpdateId"`
+}
+
+// DeleteStatusUpdateInput represents input for deleting a status update
+type DeleteStatusUpdateInput struct {
+	StatusUpdateID gql.ID `json:"statusUpdateId"`
+}
+
+// Variable Builders
+
+// BuildListStatusUpdatesVariables builds variables for listing status updates
+func BuildListStatusUpdatesVariables(projectID string, first int) map[string]interface{} {
+	return map[string]interface{}{
+		"projectId": gql.ID(projectID),
+		"first":     gql.Int(first),
+	}
+}
+
+// BuildGetStatusUpdateVariables builds variables for getting a status update
+func BuildGetStatusUpdateVariables(statusUpdateID string) map[string]interface{} {
+	return map[string]interface{}{
+		"statusUpdateId": gql.ID(statusUpdateID),
+	}
+}
+
+// BuildCreateStatusUpdateVariables builds variables for creating a status update
+func BuildCreateStatusUpdateVariables(input *CreateStatusUpdateInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// BuildUpdateStatusUpdateVariables builds variables for updating a status update
+func BuildUpdateStatusUpdateVariables(input *UpdateStatusUpdateInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// BuildDeleteStatusUpdateVariables builds variables for deleting a status update
+func BuildDeleteStatusUpdateVariables(input *DeleteStatusUpdateInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// Helper Functions
+
+// ValidStatusUpdateStatuses returns all valid status update statuses
+func ValidStatusUpdateStatuses() []string {
+	return []string{
+		string(ProjectV2StatusUpdateStatusInactive),
+		string(ProjectV2StatusUpdateStatusOnTrack),
+		string(ProjectV2StatusUpdateStatusAtRisk),
+		string(ProjectV2StatusUpdateStatusOffTrack),
+		string(ProjectV2StatusUpdateStatusComplete),
+	}
+}
+
+// FormatStatusUpdateStatus formats a status update status for display
+func FormatStatusUpdateStatus(status ProjectV2StatusUpdateStatus) string {
+	switch status {
+	case ProjectV2StatusUpdateStatusInactive:
+		return "Inactive"
+	case ProjectV2StatusUpdateStatusOnTrack:
+		return "On track"
+	case ProjectV2StatusUpdateStatusAtRisk:
+		return "At risk"
+	case ProjectV2StatusUpdateStatusOffTrack:
+		return "Off track"
+	case ProjectV2StatusUpdateStatusComplete:
+		return "Complete"
+	default:
+		return string(status)
+	}
+}
@@ -0,0 +1,44 @@
+package graphql
+
+import (
+	"testing"
+
+	gql "github.com/shurcooL/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStatusUpdateVariableBuilders(t *testing.T) {
+	t.Run("BuildListStatusUpdatesVariables creates proper variables", func(t *testing.T) {
+		variables := BuildListStatusUpdatesVariables("project-id", 10)
+
+		assert.Equal(t, gql.ID("project-id"), variables["projectId"])
+		assert.Equal(t, gql.Int(10), variables["first"])
+	})
+
+	t.Run("BuildCreateStatusUpdateVariables creates proper variables", func(t *testing.T) {
+		status := ProjectV2StatusUpdateStatusAtRisk
+		body := gql.String("Blocked on review")
+		input := &CreateStatusUpdateInput{
+			ProjectID: gql.ID("project-id"),
+			Body:      &body,
+			Status:    &status,
+		}
+
+		variables := BuildCreateStatusUpdateVariables(input)
+
+		assert.Equal(t, *input, variables["input"])
+	})
+
+	t.Run("BuildDeleteStatusUpdateVariables creates proper variables", func(t *testing.T) {
+		variables := BuildDeleteStatusUpdateVariables(&DeleteStatusUpdateInput{StatusUpdateID: gql.ID("update-id")})
+
+		assert.Equal(t, DeleteStatusUpdateInput{StatusUpdateID: gql.ID("update-id")}, variables["input"])
+	})
+}
+
+func TestFormatStatusUpdateStatus(t *testing.T) {
+	assert.Equal(t, "On track", FormatStatusUpdateStatus(ProjectV2StatusUpdateStatusOnTrack))
+	assert.Equal(t, "Off track", FormatStatusUpdateStatus(ProjectV2StatusUpdateStatusOffTrack))
+	assert.Equal(t, "UNKNOWN", FormatStatusUpdateStatus("UNKNOWN"))
+	assert.Len(t, ValidStatusUpdateStatuses(), 5)
+}
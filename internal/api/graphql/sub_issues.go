@@ -0,0 +1,106 @@
+package graphql
+
+import (
+	gql "github.com/shurcooL/graphql"
+)
+
+// SubIssuesSummary represents the progress of an issue's sub-issues
+type SubIssuesSummary struct {
+	Total            int `graphql:"total" json:"total"`
+	Completed        int `graphql:"completed" json:"completed"`
+	PercentCompleted int `graphql:"percentCompleted" json:"percentCompleted"`
+}
+
+// SubIssue represents an issue in a sub-issue hierarchy
+type SubIssue struct {
+	Repository struct {
+		NameWithOwner string `graphql:"nameWithOwner"`
+	} `graphql:"repository"`
+	ID               string           `graphql:"id"`
+	Title            string           `graphql:"title"`
+	URL              string           `graphql:"url"`
+	State            string           `graphql:"state"`
+	SubIssuesSummary SubIssuesSummary `graphql:"subIssuesSummary"`
+	Number           int              `graphql:"number"`
+	Closed           bool             `graphql:"closed"`
+}
+
+// Queries
+
+// ListSubIssuesQuery lists a page of the sub-issues of an issue
+type ListSubIssuesQuery struct {
+	Node struct {
+		Issue struct {
+			SubIssues struct {
+				PageInfo PageInfo   `graphql:"pageInfo"`
+				Nodes    []SubIssue `graphql:"nodes"`
+			} `graphql:"subIssues(first: $first, after: $after)"`
+		} `graphql:"... on Issue"`
+	} `graphql:"node(id: $issueId)"`
+}
+
+// Mutations
+
+// AddSubIssueMutation makes an issue a sub-issue of another issue
+type AddSubIssueMutation struct {
+	AddSubIssue struct {
+		Issue struct {
+			ID string `graphql:"id"`
+		} `graphql:"issue"`
+	} `graphql:"addSubIssue(input: $input)"`
+}
+
+// RemoveSubIssueMutation removes a sub-issue from its parent issue
+type RemoveSubIssueMutation struct {
+	RemoveSubIssue struct {
+		Issue struct {
+			ID string `graphql:"id"`
+		} `graphql:"issue"`
+	} `graphql:"removeSubIssue(input: $input)"`
+}
+
+// Input Types
+
+// AddSubIssueInput represents input for adding a sub-issue; with ReplaceParent a
+// sub-issue that already has a parent is moved to the new one
+type AddSubIssueInput struct {
+	ReplaceParent *gql.Boolean `json:"replaceParent,omitempty"`
+	IssueID       gql.ID       `json:"issueId"`
+	SubIssueID    gql.ID       `json:"subIssueId"`
+}
+
+// RemoveSubIssueInput represents input for removing a sub-issue
+type RemoveSubIssueInput struct {
+	IssueID    gql.ID `json:"issueId"`
+	SubIssueID gql.ID `json:"subIssueId"`
+}
+
+// Variable Builders
+
+// BuildListSubIssuesVariables builds variables for listing the sub-issues of an issue
+func BuildListSubIssuesVariables(issueID string, first int, after *string) map[string]interface{} {
+	variables := map[string]interface{}{
+		"issueId": gql.ID(issueID),
+		"first":   gql.Int(first), //nolint:gosec // first is always within int32 range
+	}
+	if after != nil {
+		variables["after"] = gql.String(*after)
+	} else {
+		variables["after"] = (*gql.String)(nil)
+	}
+	return variables
+}
+
+// BuildAddSubIssueVariables builds variables for adding a sub-issue
+func BuildAddSubIssueVariables(input *AddSubIssueInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
+
+// BuildRemoveSubIssueVariables builds variables for removing a sub-issue
+func BuildRemoveSubIssueVariables(input *RemoveSubIssueInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
@@ -0,0 +1,157 @@
+package graphql
+
+import (
+	"fmt"
+
+	gql "github.com/shurcooL/graphql"
+)
+
+// ProjectV2ItemContent represents the issue or pull request behind a project item
+type ProjectV2ItemContent struct {
+	TypeName string `graphql:"__typename"`
+	Issue    struct {
+		Repository struct {
+			NameWithOwner string `graphql:"nameWithOwner"`
+		} `graphql:"repository"`
+		ID     string `graphql:"id"`
+		Title  string `graphql:"title"`
+		URL    string `graphql:"url"`
+		Number int    `graphql:"number"`
+	} `graphql:"... on Issue"`
+	PullRequest struct {
+		Repository struct {
+			NameWithOwner string `graphql:"nameWithOwner"`
+		} `graphql:"repository"`
+		ID     string `graphql:"id"`
+		Title  string `graphql:"title"`
+		URL    string `graphql:"url"`
+		Number int    `graphql:"number"`
+	} `graphql:"... on PullRequest"`
+}
+
+// ContentID returns the node ID of the issue or pull request, or "" for other content
+func (c *ProjectV2ItemContent) ContentID() string {
+	switch c.TypeName {
+	case "Issue":
+		return c.Issue.ID
+	case "PullRequest":
+		return c.PullRequest.ID
+	default:
+		return ""
+	}
+}
+
+// Reference returns the owner/repo#number reference of the issue or pull request
+func (c *ProjectV2ItemContent) Reference() string {
+	switch c.TypeName {
+	case "Issue":
+		return fmt.Sprintf("%s#%d", c.Issue.Repository.NameWithOwner, c.Issue.Number)
+	case "PullRequest":
+		return fmt.Sprintf("%s#%d", c.PullRequest.Repository.NameWithOwner, c.PullRequest.Number)
+	default:
+		return ""
+	}
+}
+
+// Title returns the title of the issue or pull request
+func (c *ProjectV2ItemContent) Title() string {
+	if c.TypeName == "PullRequest" {
+		return c.PullRequest.Title
+	}
+	return c.Issue.Title
+}
+
+// ProjectV2ItemSummary represents a project item with its content and archive state
+type ProjectV2ItemSummary struct {
+	Content    ProjectV2ItemContent `graphql:"content"`
+	ID         string               `graphql:"id"`
+	IsArchived bool                 `graphql:"isArchived"`
+}
+
+// Queries
+
+// SearchContentQuery searches issues and pull requests
+type SearchContentQuery struct {
+	Search struct {
+		PageInfo   PageInfo               `graphql:"pageInfo"`
+		Nodes      []ProjectV2ItemContent `graphql:"nodes"`
+		IssueCount int                    `graphql:"issueCount"`
+	} `graphql:"search(query: $query, type: ISSUE, first: $first, after: $after)"`
+}
+
+// ListProjectItemSummariesQuery lists a page of project items, including archived ones
+type ListProjectItemSummariesQuery struct {
+	Node struct {
+		ProjectV2 struct {
+			Items struct {
+				PageInfo PageInfo               `graphql:"pageInfo"`
+				Nodes    []ProjectV2ItemSummary `graphql:"nodes"`
+			} `graphql:"items(first: $first, after: $after)"`
+		} `graphql:"... on ProjectV2"`
+	} `graphql:"node(id: $projectId)"`
+}
+
+// Mutations
+
+// ArchiveProjectItemMutation archives a project item
+type ArchiveProjectItemMutation struct {
+	ArchiveProjectV2Item struct {
+		Item struct {
+			ID string `graphql:"id"`
+		} `graphql:"item"`
+	} `graphql:"archiveProjectV2Item(input: $input)"`
+}
+
+// UnarchiveProjectItemMutation restores an archived project item
+type UnarchiveProjectItemMutation struct {
+	UnarchiveProjectV2Item struct {
+		Item struct {
+			ID string `graphql:"id"`
+		} `graphql:"item"`
+	} `graphql:"unarchiveProjectV2Item(input: $input)"`
+}
+
+// Input Types
+
+// ArchiveProjectItemInput represents input for archiving or unarchiving a project item
+type ArchiveProjectItemInput struct {
+	ProjectID gql.ID `json:"projectId"`
+	ItemID    gql.ID `json:"itemId"`
+}
+
+// Variable Builders
+
+// BuildSearchContentVariables builds variables for searching issues and pull requests
+func BuildSearchContentVariables(query string, first int, after *string) map[string]interface{} {
+	variables := map[string]interface{}{
+		"query": gql.String(query),
+		"first": gql.Int(first), //nolint:gosec // first is always within int32 range
+	}
+	if after != nil {
+		variables["after"] = gql.String(*after)
+	} else {
+		variables["after"] = (*gql.String)(nil)
+	}
+	return variables
+}
+
+// BuildListProjectItemSummariesVariables builds variables for listing project items
+func BuildListProjectItemSummariesVariables(projectID string, first int, after *string) map[string]interface{} {
+	variables := map[string]interface{}{
+		"projectId": gql.ID(projectID),
+		"first":     gql.Int(first), //nolint:gosec // first is always within int32 range
+	}
+	if after != nil {
+		variables["after"] = gql.String(*after)
+	} else {
+		variables["after"] = (*gql.String)(nil)
+	}
+	return variables
+}
+
+// BuildArchiveProjectItemVariables builds variables for archiving or unarchiving a project item
+func BuildArchiveProjectItemVariables(input *ArchiveProjectItemInput) map[string]interface{} {
+	return map[string]interface{}{
+		"input": *input,
+	}
+}
@@ -91,6 +91,22 @@ type GetProjectViewQuery struct {
 	} `graphql:"node(id: $viewId)"`
 }
 
+// GetViewFieldsQuery gets the fields shown by a view, in display order
+type GetViewFieldsQuery struct {
+	Node struct {
+		ProjectV2View struct {
+			Fields struct {
+				Nodes []struct {
+					Field struct {
+						ID   string `graphql:"id"`
+						Name string `graphql:"name"`
+					} `graphql:"... on ProjectV2FieldCommon"`
+				} `graphql:"nodes"`
+			} `graphql:"fields(first: 50)"`
+		} `graphql:"... on ProjectV2View"`
+	} `graphql:"node(id: $viewId)"`
+}
+
 // Mutations
 
 // CreateProjectViewMutation creates a new view
@@ -132,9 +148,10 @@ type CreateViewInput struct {
 
 // UpdateViewInput represents input for updating a view
 type UpdateViewInput struct {
-	Name   *gql.String `json:"name,omitempty"`
-	Filter *gql.String `json:"filter,omitempty"`
-	ViewID gql.ID      `json:"viewId"`
+	Name   *gql.String          `json:"name,omitempty"`
+	Filter *gql.String          `json:"filter,omitempty"`
+	Layout *ProjectV2ViewLayout `json:"layout,omitempty"`
+	ViewID gql.ID               `json:"viewId"`
 }
 
 // DeleteViewInput represents input for deleting a view
@@ -0,0 +1,275 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+const (
+	// jwtLifetime is how long app JWTs are valid; GitHub allows at most 10 minutes
+	jwtLifetime = 9 * time.Minute
+
+	// jwtClockSkew backdates the JWT issue time to tolerate clock drift
+	jwtClockSkew = 60 * time.Second
+
+	// tokenRefreshMargin is how long before expiry an installation token is refreshed
+	tokenRefreshMargin = 5 * time.Minute
+
+	httpStatusCreated = 201
+)
+
+// AppAuth authenticates as a GitHub App installation. It mints a JWT signed with the
+// app's private key, exchanges it for an installation token and refreshes the token
+// automatically before it expires.
+type AppAuth struct {
+	expiresAt      time.Time
+	privateKey     *rsa.PrivateKey
+	now            func() time.Time
+	permissions    map[string]string
+	token          string
+	appID          int64
+	installationID int64
+	mu             sync.Mutex
+}
+
+// AppConfig holds the GitHub App settings read from the config file
+type AppConfig struct {
+	PrivateKeyFile string
+	AppID          int64
+	InstallationID int64
+}
+
+// IsSet reports whether any GitHub App setting is configured
+func (c AppConfig) IsSet() bool {
+	return c.AppID != 0 || c.InstallationID != 0 || c.PrivateKeyFile != ""
+}
+
+// Validate checks that all GitHub App settings are present
+func (c AppConfig) Validate() error {
+	switch {
+	case c.AppID == 0:
+		return errors.New("GitHub App authentication requires app.id")
+	case c.InstallationID == 0:
+		return errors.New("GitHub App authentication requires app.installation-id")
+	case c.PrivateKeyFile == "":
+		return errors.New("GitHub App authentication requires app.private-key-file")
+	default:
+		return nil
+	}
+}
+
+// NewAppAuth creates GitHub App authentication from a PEM encoded private key
+func NewAppAuth(appID, installationID int64, privateKeyPEM []byte) (*AppAuth, error) {
+	key, err := parsePrivateKey(privateKeyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AppAuth{
+		appID:          appID,
+		installationID: installationID,
+		privateKey:     key,
+		now:            time.Now,
+	}, nil
+}
+
+// LoadAppAuth creates GitHub App authentication from config, reading the private key file
+func LoadAppAuth(config AppConfig) (*AppAuth, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+
+	keyPEM, err := os.ReadFile(config.PrivateKeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GitHub App private key: %w", err)
+	}
+
+	return NewAppAuth(config.AppID, config.InstallationID, keyPEM)
+}
+
+// InstallationToken returns a valid installation token, refreshing it when it is about to expire
+func (a *AppAuth) InstallationToken() (string, error) {
+	token, err := a.Token()
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+// Token implements oauth2.TokenSource so API clients refresh the token automatically.
+// The token and its expiry are read under one lock so they always belong together.
+func (a *AppAuth) Token() (*oauth2.Token, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.token == "" || !a.now().Add(tokenRefreshMargin).Before(a.expiresAt) {
+		if err := a.refresh(); err != nil {
+			return nil, err
+		}
+	}
+	return &oauth2.Token{AccessToken: a.token, Expiry: a.expiresAt}, nil
+}
+
+// TokenInfo returns token details built from the permissions granted to the installation
+func (a *AppAuth) TokenInfo() (*TokenInfo, error) {
+	if _, err := a.InstallationToken(); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	info := &TokenInfo{
+		Type:  TokenTypeAppInstallation,
+		Login: fmt.Sprintf("app/%d", a.appID),
+	}
+	if hasPermission(a.permissions, "organization_projects") || hasPermission(a.permissions, "repository_projects") {
+		info.Permissions = append(info.Permissions, PermissionProjects)
+	}
+	if hasPermission(a.permissions, "contents") || hasPermission(a.permissions, "metadata") {
+		info.Permissions = append(info.Permissions, PermissionRepository)
+	}
+
+	return info, nil
+}
+
+// GrantedPermissions returns the installation permissions as "name:level" strings
+func (a *AppAuth) GrantedPermissions() []string {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	permissions := make([]string, 0, len(a.permissions))
+	for name, level := range a.permissions {
+		permissions = append(permissions, name+":"+level)
+	}
+	sort.Strings(permissions)
+	return permissions
+}
+
+// refresh exchanges a freshly minted JWT for a new installation token
+func (a *AppAuth) refresh() error {
+	jwt, err := a.createJWT()
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/app/installations/%d/access_tokens", restAPIURL(), a.installationID)
+	req, err := http.NewRequest(http.MethodPost, url, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", "application/vnd.github+json")
+	req.Header.Set("User-Agent", "ghx-cli")
+
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to request installation token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != httpStatusCreated {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+		_ = json.NewDecoder(resp.Body).Decode(&apiErr)
+		if apiErr.Message == "" {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+		return fmt.Errorf("failed to create installation token for installation %d: %s", a.installationID, apiErr.Message)
+	}
+
+	var result struct {
+		ExpiresAt   time.Time         `json:"expires_at"`
+		Permissions map[string]string `json:"permissions"`
+		Token       string            `json:"token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return fmt.Errorf("failed to parse installation token response: %w", err)
+	}
+	if result.Token == "" {
+		return errors.New("GitHub returned an empty installation token")
+	}
+
+	a.token = result.Token
+	a.expiresAt = result.ExpiresAt
+	a.permissions = result.Permissions
+	return nil
+}
+
+// createJWT mints an RS256 signed JWT identifying the app
+func (a *AppAuth) createJWT() (string, error) {
+	now := a.now()
+
+	header := map[string]string{"alg": "RS256", "typ": "JWT"}
+	claims := map[string]interface{}{
+		"iat": now.Add(-jwtClockSkew).Unix(),
+		"exp": now.Add(jwtLifetime).Unix(),
+		"iss": strconv.FormatInt(a.appID, 10),
+	}
+
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JWT header: %w", err)
+	}
+	claimsJSON, err := json.Marshal(claims)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode JWT claims: %w", err)
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(headerJSON) + "." +
+		base64.RawURLEncoding.EncodeToString(claimsJSON)
+
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, a.privateKey, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", fmt.Errorf("failed to sign JWT: %w", err)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// parsePrivateKey decodes a PKCS#1 or PKCS#8 PEM encoded RSA private key
+func parsePrivateKey(keyPEM []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("GitHub App private key is not PEM encoded")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse GitHub App private key: %w", err)
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("GitHub App private key must be an RSA key")
+	}
+	return key, nil
+}
+
+// hasPermission reports whether the installation was granted the named permission
+func hasPermission(permissions map[string]string, name string) bool {
+	level, ok := permissions[name]
+	return ok && level != ""
+}
@@ -0,0 +1,203 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func generateTestKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return key, keyPEM
+}
+
+func TestAppConfig(t *testing.T) {
+	t.Run("Validate requires all settings", func(t *testing.T) {
+		assert.False(t, AppConfig{}.IsSet())
+		assert.True(t, AppConfig{AppID: 1}.IsSet())
+
+		err := AppConfig{AppID: 1, PrivateKeyFile: "key.pem"}.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "app.installation-id")
+
+		assert.NoError(t, AppConfig{AppID: 1, InstallationID: 2, PrivateKeyFile: "key.pem"}.Validate())
+	})
+
+	t.Run("LoadAppAuth reads the private key file", func(t *testing.T) {
+		_, keyPEM := generateTestKey(t)
+		keyFile := filepath.Join(t.TempDir(), "app.pem")
+		require.NoError(t, os.WriteFile(keyFile, keyPEM, 0o600))
+
+		appAuth, err := LoadAppAuth(AppConfig{AppID: 1, InstallationID: 2, PrivateKeyFile: keyFile})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), appAuth.installationID)
+	})
+
+	t.Run("Rejects invalid private keys", func(t *testing.T) {
+		_, err := NewAppAuth(1, 2, []byte("not a key"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not PEM encoded")
+	})
+}
+
+func TestAppAuthJWT(t *testing.T) {
+	t.Run("JWT is signed with the app private key", func(t *testing.T) {
+		key, keyPEM := generateTestKey(t)
+		appAuth, err := NewAppAuth(12345, 1, keyPEM)
+		require.NoError(t, err)
+
+		now := time.Unix(1700000000, 0)
+		appAuth.now = func() time.Time { return now }
+
+		jwt, err := appAuth.createJWT()
+		require.NoError(t, err)
+
+		parts := strings.Split(jwt, ".")
+		require.Len(t, parts, 3)
+
+		claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+		require.NoError(t, err)
+		var claims struct {
+			Iss string `json:"iss"`
+			Iat int64  `json:"iat"`
+			Exp int64  `json:"exp"`
+		}
+		require.NoError(t, json.Unmarshal(claimsJSON, &claims))
+		assert.Equal(t, "12345", claims.Iss)
+		assert.Equal(t, now.Add(-jwtClockSkew).Unix(), claims.Iat)
+		assert.Equal(t, now.Add(jwtLifetime).Unix(), claims.Exp)
+
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		require.NoError(t, err)
+		digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+		assert.NoError(t, rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature))
+	})
+}
+
+func TestAppAuthInstallationToken(t *testing.T) {
+	t.Run("Exchanges the JWT and refreshes expiring tokens", func(t *testing.T) {
+		_, keyPEM := generateTestKey(t)
+		now := time.Now()
+		requests := 0
+
+		useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "/app/installations/42/access_tokens", r.URL.Path)
+			assert.True(t, strings.HasPrefix(r.Header.Get("Authorization"), "Bearer "))
+
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"token":       "ghs_token" + string(rune('0'+requests)),
+				"expires_at":  now.Add(time.Hour).Format(time.RFC3339),
+				"permissions": map[string]string{"organization_projects": "write", "metadata": "read"},
+			})
+		}))
+
+		appAuth, err := NewAppAuth(1, 42, keyPEM)
+		require.NoError(t, err)
+		appAuth.now = func() time.Time { return now }
+
+		token, err := appAuth.InstallationToken()
+		require.NoError(t, err)
+		assert.Equal(t, "ghs_token1", token)
+
+		// Cached while the token is still fresh
+		token, err = appAuth.InstallationToken()
+		require.NoError(t, err)
+		assert.Equal(t, "ghs_token1", token)
+		assert.Equal(t, 1, requests)
+
+		// Refreshed shortly before expiry
+		appAuth.now = func() time.Time { return now.Add(58 * time.Minute) }
+		oauthToken, err := appAuth.Token()
+		require.NoError(t, err)
+		assert.Equal(t, "ghs_token2", oauthToken.AccessToken)
+		assert.Equal(t, 2, requests)
+
+		info, err := appAuth.TokenInfo()
+		require.NoError(t, err)
+		assert.Equal(t, TokenTypeAppInstallation, info.Type)
+		assert.True(t, info.HasRequiredAccess())
+		assert.Equal(t, []string{"metadata:read", "organization_projects:write"}, appAuth.GrantedPermissions())
+	})
+
+	t.Run("Pairs each token with its own expiry under concurrent refreshes", func(t *testing.T) {
+		_, keyPEM := generateTestKey(t)
+		now := time.Now().Truncate(time.Second)
+		var mu sync.Mutex
+		requests := 0
+
+		// Every token expires within the refresh margin, so each call refreshes
+		useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			mu.Lock()
+			requests++
+			n := requests
+			mu.Unlock()
+
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"token":      fmt.Sprintf("ghs_token%d", n),
+				"expires_at": now.Add(time.Duration(n) * time.Second).Format(time.RFC3339),
+			})
+		}))
+
+		appAuth, err := NewAppAuth(1, 42, keyPEM)
+		require.NoError(t, err)
+		appAuth.now = func() time.Time { return now }
+
+		var wg sync.WaitGroup
+		tokens := make([]*oauth2.Token, 8)
+		for i := range tokens {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				tokens[i], _ = appAuth.Token()
+			}(i)
+		}
+		wg.Wait()
+
+		for _, token := range tokens {
+			require.NotNil(t, token)
+			var n int
+			_, err := fmt.Sscanf(token.AccessToken, "ghs_token%d", &n)
+			require.NoError(t, err)
+			assert.True(t, now.Add(time.Duration(n)*time.Second).Equal(token.Expiry), token.AccessToken)
+		}
+	})
+
+	t.Run("Reports GitHub errors", func(t *testing.T) {
+		_, keyPEM := generateTestKey(t)
+		useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"message":"Not Found"}`))
+		}))
+
+		appAuth, err := NewAppAuth(1, 42, keyPEM)
+		require.NoError(t, err)
+
+		_, err = appAuth.InstallationToken()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "installation 42: Not Found")
+	})
+}
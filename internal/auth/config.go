@@ -0,0 +1,38 @@
+package auth
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// Config keys for GitHub App authentication in ~/.ghx.yaml
+const (
+	configAppID             = "app.id"
+	configAppInstallationID = "app.installation-id"
+	configAppPrivateKeyFile = "app.private-key-file"
+)
+
+// loadAppConfig reads GitHub App settings from the config file or GHX_APP_* environment variables
+func loadAppConfig() AppConfig {
+	return AppConfig{
+		AppID:          viper.GetInt64(configAppID),
+		InstallationID: viper.GetInt64(configAppInstallationID),
+		PrivateKeyFile: ExpandHome(viper.GetString(configAppPrivateKeyFile)),
+	}
+}
+
+// ExpandHome replaces a leading ~ with the user's home directory
+func ExpandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~"))
+}
@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/viper"
+	"golang.org/x/oauth2"
+)
+
+// configOAuthClientID is the config key for the OAuth app used by device flow login
+const configOAuthClientID = "oauth-client-id"
+
+// DeviceLoginScopes are the OAuth scopes requested by device flow login
+var DeviceLoginScopes = []string{"repo", "project", "read:org"}
+
+// OAuthClientID returns the OAuth app client ID from config or GHX_OAUTH_CLIENT_ID
+func OAuthClientID() string {
+	return viper.GetString(configOAuthClientID)
+}
+
+// DeviceLogin authenticates with the OAuth device flow on the given host. prompt is called
+// with the user code and verification URL before polling for the token.
+func DeviceLogin(ctx context.Context, host, clientID string, prompt func(*oauth2.DeviceAuthResponse)) (string, error) {
+	if clientID == "" {
+		return "", errors.New("device flow login requires an OAuth app client ID; pass --client-id or set oauth-client-id in ~/.ghx.yaml")
+	}
+	if host == "" {
+		host = DefaultHost
+	}
+
+	config := &oauth2.Config{
+		ClientID: clientID,
+		Scopes:   DeviceLoginScopes,
+		Endpoint: oauth2.Endpoint{
+			DeviceAuthURL: fmt.Sprintf("https://%s/login/device/code", host),
+			TokenURL:      fmt.Sprintf("https://%s/login/oauth/access_token", host),
+			AuthStyle:     oauth2.AuthStyleInParams,
+		},
+	}
+
+	deviceAuth, err := config.DeviceAuth(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to start device flow: %w", err)
+	}
+
+	prompt(deviceAuth)
+
+	token, err := config.DeviceAccessToken(ctx, deviceAuth)
+	if err != nil {
+		return "", fmt.Errorf("device flow login failed: %w", err)
+	}
+	return token.AccessToken, nil
+}
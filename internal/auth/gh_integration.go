@@ -1,15 +1,11 @@
 package auth
 
 import (
-	"context"
-	"encoding/json"
 	"errors"
 	"fmt"
-	"net/http"
 	"os"
 	"os/exec"
 	"strings"
-	"time"
 )
 
 const (
@@ -20,12 +16,24 @@ const (
 
 // GitHubCLIAuth handles authentication by integrating with GitHub CLI
 type GitHubCLIAuth struct {
-	// Future: can add configuration options here
+	apiURL     string
+	graphqlURL string
 }
 
 // NewGitHubCLIAuth creates a new GitHub CLI authentication handler
 func NewGitHubCLIAuth() *GitHubCLIAuth {
-	return &GitHubCLIAuth{}
+	return &GitHubCLIAuth{
+		apiURL:     restAPIURL(),
+		graphqlURL: restAPIURL() + "/graphql",
+	}
+}
+
+// useProfileHost points token validation at the API of the profile host
+func (g *GitHubCLIAuth) useProfileHost(profile *Profile) {
+	if profile.IsEnterprise() && os.Getenv(envAPIURL) == "" {
+		g.apiURL = profile.APIURL()
+		g.graphqlURL = profile.GraphQLURL()
+	}
 }
 
 // GetToken retrieves the authentication token from GitHub CLI for the given hostname
@@ -67,62 +75,12 @@ type UserResponse struct {
 
 // ValidateToken validates the given token with GitHub API and returns scopes
 func (g *GitHubCLIAuth) ValidateToken(token string) (isValid bool, scopes []string, err error) {
-	if token == "" {
-		return false, nil, errors.New("empty token provided")
-	}
-
-	// Create HTTP client with timeout
-	const requestTimeout = 10 * time.Second
-	client := &http.Client{
-		Timeout: requestTimeout,
-	}
-
-	// Make request to GitHub API user endpoint
-	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
-	defer cancel()
-	req, err := http.NewRequestWithContext(ctx, "GET", "https://api.github.com/user", http.NoBody)
+	info, err := g.InspectToken(token, "")
 	if err != nil {
-		return false, nil, fmt.Errorf("failed to create request: %w", err)
-	}
-
-	// Set authorization header
-	req.Header.Set("Authorization", "token "+token)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "ghp-cli")
-
-	// Make the request
-	resp, err := client.Do(req)
-	if err != nil {
-		return false, nil, fmt.Errorf("failed to validate token: %w", err)
-	}
-	defer resp.Body.Close()
-
-	// Check status code
-	if resp.StatusCode == httpStatusUnauthorized {
-		return false, nil, errors.New("invalid or expired token")
-	}
-	if resp.StatusCode != httpStatusOK {
-		return false, nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
-	}
-
-	// Parse response to ensure token works
-	var user UserResponse
-	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
-		return false, nil, fmt.Errorf("failed to parse user response: %w", err)
-	}
-
-	// Parse scopes from X-OAuth-Scopes header
-	if scopeHeader := resp.Header.Get("X-OAuth-Scopes"); scopeHeader != "" {
-		scopesList := strings.Split(scopeHeader, ", ")
-		for _, scope := range scopesList {
-			scope = strings.TrimSpace(scope)
-			if scope != "" {
-				scopes = append(scopes, scope)
-			}
-		}
+		return false, nil, err
 	}
 
-	return true, scopes, nil
+	return true, info.Scopes, nil
 }
 
 // GetFallbackToken attempts to get token from environment variables
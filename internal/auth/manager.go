@@ -2,73 +2,181 @@ package auth
 
 import (
 	"fmt"
+
+	"github.com/spf13/viper"
+	"golang.org/x/oauth2"
 )
 
 // Manager handles authentication flow and provides unified access to tokens
 type Manager struct {
-	ghAuth *GitHubCLIAuth
+	ghAuth     *GitHubCLIAuth
+	appAuth    *AppAuth
+	appErr     error
+	profile    *Profile
+	profileErr error
+	owner      string
 }
 
 // NewAuthManager creates a new authentication manager
 func NewAuthManager() *Manager {
-	return &Manager{
+	am := &Manager{
 		ghAuth: NewGitHubCLIAuth(),
+		owner:  viper.GetString("org"),
+	}
+
+	// The active profile (--profile, GHX_PROFILE or `profile` in the config) selects the
+	// host, token source and default owner
+	am.profile, am.profileErr = ActiveProfile()
+	if am.profile != nil {
+		am.ghAuth.useProfileHost(am.profile)
+		if am.profile.Org != "" {
+			am.owner = am.profile.Org
+		}
+	}
+
+	// GitHub App settings take precedence over user tokens
+	if config := loadAppConfig(); config.IsSet() && am.profile == nil {
+		am.appAuth, am.appErr = LoadAppAuth(config)
+	}
+
+	return am
+}
+
+// Profile returns the active profile, or nil when no profile is selected
+func (am *Manager) Profile() *Profile {
+	return am.profile
+}
+
+// DefaultOwner returns the default owner from the active profile or the org/user settings,
+// and whether it is an organization
+func (am *Manager) DefaultOwner() (owner string, isOrg bool) {
+	if am.profile != nil {
+		if am.profile.Org != "" {
+			return am.profile.Org, true
+		}
+		if am.profile.User != "" {
+			return am.profile.User, false
+		}
+	}
+
+	if org := viper.GetString("org"); org != "" {
+		return org, true
 	}
+	return viper.GetString("user"), false
+}
+
+// usesApp reports whether GitHub App authentication is configured
+func (am *Manager) usesApp() bool {
+	return am.appAuth != nil || am.appErr != nil
 }
 
 // GetValidatedToken retrieves and validates a GitHub token from various sources
 func (am *Manager) GetValidatedToken() (string, error) {
-	var token string
-	var err error
+	if am.profileErr != nil {
+		return "", am.profileErr
+	}
+	if am.profile != nil {
+		token, err := am.profile.Token(am.ghAuth)
+		if err != nil {
+			return "", err
+		}
+		return am.validateToken(token, fmt.Sprintf("profile %q token", am.profile.Name))
+	}
+
+	if am.usesApp() {
+		return am.getAppToken()
+	}
 
 	// Try to get token from GitHub CLI first
 	if am.ghAuth.CheckGHCLIInstalled() {
-		token, err = am.ghAuth.GetToken("github.com")
+		token, err := am.ghAuth.GetToken("github.com")
 		if err == nil && token != "" {
-			// Validate the token
-			valid, scopes, validErr := am.ghAuth.ValidateToken(token)
-			if validErr != nil {
-				return "", fmt.Errorf("token validation failed: %w", validErr)
-			}
-			if !valid {
-				return "", fmt.Errorf("token is invalid")
-			}
-
-			// Check for required scopes
-			requiredScopes := []string{"repo", "project"}
-			if !HasRequiredScopes(scopes, requiredScopes) {
-				return "", fmt.Errorf("token missing required scopes. Required: %v, Available: %v", requiredScopes, scopes)
-			}
-
-			return token, nil
+			return am.validateToken(token, "token")
 		}
 	}
 
 	// If gh CLI fails, try environment variables
 	if fallbackToken := am.ghAuth.GetFallbackToken(); fallbackToken != "" {
-		// Validate the fallback token
-		valid, scopes, validErr := am.ghAuth.ValidateToken(fallbackToken)
-		if validErr != nil {
-			return "", fmt.Errorf("fallback token validation failed: %w", validErr)
-		}
-		if !valid {
-			return "", fmt.Errorf("fallback token is invalid")
-		}
+		return am.validateToken(fallbackToken, "fallback token")
+	}
 
-		// Check for required scopes
-		requiredScopes := []string{"repo", "project"}
-		if !HasRequiredScopes(scopes, requiredScopes) {
-			return "", fmt.Errorf("fallback token missing required scopes. Required: %v, Available: %v", requiredScopes, scopes)
-		}
+	return "", fmt.Errorf("no valid GitHub token found. Please authenticate with 'gh auth login' or set GITHUB_TOKEN environment variable")
+}
 
-		return fallbackToken, nil
+// validateToken checks that the token is valid and has the scopes or permissions ghx needs
+func (am *Manager) validateToken(token, label string) (string, error) {
+	info, err := am.ghAuth.InspectToken(token, am.owner)
+	if err != nil {
+		return "", fmt.Errorf("%s validation failed: %w", label, err)
 	}
 
-	return "", fmt.Errorf("no valid GitHub token found. Please authenticate with 'gh auth login' or set GITHUB_TOKEN environment variable")
+	if err := checkAccess(info, label); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// getAppToken returns a GitHub App installation token with the permissions ghx needs
+func (am *Manager) getAppToken() (string, error) {
+	if am.appErr != nil {
+		return "", fmt.Errorf("GitHub App authentication failed: %w", am.appErr)
+	}
+
+	info, err := am.appAuth.TokenInfo()
+	if err != nil {
+		return "", fmt.Errorf("GitHub App authentication failed: %w", err)
+	}
+	if err := checkAccess(info, "GitHub App installation token"); err != nil {
+		return "", err
+	}
+
+	return am.appAuth.InstallationToken()
+}
+
+// checkAccess returns an error describing the scopes or permissions the token lacks
+func checkAccess(info *TokenInfo, label string) error {
+	if info.HasRequiredAccess() {
+		return nil
+	}
+
+	if info.Type.UsesScopes() {
+		return fmt.Errorf("%s missing required scopes. Required: %v, Available: %v", label, RequiredScopes, info.Scopes)
+	}
+	return fmt.Errorf("%s (%s) missing required permissions. Required: %v, Available: %v",
+		label, info.Type, RequiredPermissions, info.Permissions)
+}
+
+// TokenSource returns a token source for API clients. GitHub App installation tokens are
+// refreshed automatically, so long-running processes keep working after the token expires.
+func (am *Manager) TokenSource() (oauth2.TokenSource, error) {
+	token, err := am.GetValidatedToken()
+	if err != nil {
+		return nil, err
+	}
+
+	if am.appAuth != nil {
+		return am.appAuth, nil
+	}
+	return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: token}), nil
 }
 
 // GetTokenWithoutValidation gets a token without validation (for testing)
 func (am *Manager) GetTokenWithoutValidation() (string, error) {
+	if am.profileErr != nil {
+		return "", am.profileErr
+	}
+	if am.profile != nil {
+		return am.profile.Token(am.ghAuth)
+	}
+
+	if am.usesApp() {
+		if am.appErr != nil {
+			return "", am.appErr
+		}
+		return am.appAuth.InstallationToken()
+	}
+
 	// Try GitHub CLI first
 	if am.ghAuth.CheckGHCLIInstalled() {
 		if token, err := am.ghAuth.GetToken("github.com"); err == nil && token != "" {
@@ -95,6 +203,11 @@ func (am *Manager) GetAuthenticationStatus() Status {
 	status := Status{
 		GHCLIInstalled: am.ghAuth.CheckGHCLIInstalled(),
 		HasEnvToken:    am.ghAuth.GetFallbackToken() != "",
+		GitHubApp:      am.usesApp(),
+	}
+	if am.profile != nil {
+		status.Profile = am.profile.Name
+		status.Host = am.profile.Hostname()
 	}
 
 	// Try to get and validate token
@@ -108,23 +221,36 @@ func (am *Manager) GetAuthenticationStatus() Status {
 	status.TokenAvailable = true
 
 	// Validate token
-	valid, scopes, err := am.ghAuth.ValidateToken(token)
+	var info *TokenInfo
+	if am.appAuth != nil {
+		info, err = am.appAuth.TokenInfo()
+	} else {
+		info, err = am.ghAuth.InspectToken(token, am.owner)
+	}
 	if err != nil {
 		status.TokenValid = false
 		status.Error = err.Error()
 		return status
 	}
 
-	status.TokenValid = valid
-	status.Scopes = scopes
+	status.TokenValid = true
+	status.TokenType = info.Type
+	status.Scopes = info.Scopes
+	status.Permissions = info.Permissions
+	if am.appAuth != nil {
+		status.Permissions = am.appAuth.GrantedPermissions()
+	}
 
-	// Check required scopes
-	requiredScopes := []string{"repo", "project"}
-	status.HasRequiredScopes = HasRequiredScopes(scopes, requiredScopes)
-	status.RequiredScopes = requiredScopes
+	// Check required scopes or permissions
+	status.HasRequiredScopes = info.HasRequiredAccess()
+	status.RequiredScopes = info.RequiredAccess()
 
 	if !status.HasRequiredScopes {
-		status.Error = fmt.Sprintf("Missing required scopes: %v", getMissingScopes(scopes, requiredScopes))
+		if info.Type.UsesScopes() {
+			status.Error = fmt.Sprintf("Missing required scopes: %v", info.MissingAccess())
+		} else {
+			status.Error = fmt.Sprintf("Missing required permissions: %v", info.MissingAccess())
+		}
 	}
 
 	return status
@@ -132,14 +258,19 @@ func (am *Manager) GetAuthenticationStatus() Status {
 
 // Status represents the current authentication status
 type Status struct {
-	Error             string   `json:"error,omitempty"`
-	Scopes            []string `json:"scopes"`
-	RequiredScopes    []string `json:"required_scopes"`
-	GHCLIInstalled    bool     `json:"gh_cli_installed"`
-	HasEnvToken       bool     `json:"has_env_token"`
-	TokenAvailable    bool     `json:"token_available"`
-	TokenValid        bool     `json:"token_valid"`
-	HasRequiredScopes bool     `json:"has_required_scopes"`
+	Error             string    `json:"error,omitempty"`
+	Profile           string    `json:"profile,omitempty"`
+	Host              string    `json:"host,omitempty"`
+	TokenType         TokenType `json:"token_type,omitempty"`
+	Scopes            []string  `json:"scopes"`
+	Permissions       []string  `json:"permissions,omitempty"`
+	RequiredScopes    []string  `json:"required_scopes"`
+	GitHubApp         bool      `json:"github_app"`
+	GHCLIInstalled    bool      `json:"gh_cli_installed"`
+	HasEnvToken       bool      `json:"has_env_token"`
+	TokenAvailable    bool      `json:"token_available"`
+	TokenValid        bool      `json:"token_valid"`
+	HasRequiredScopes bool      `json:"has_required_scopes"`
 }
 
 // IsReady returns true if authentication is fully configured
@@ -149,6 +280,14 @@ func (as *Status) IsReady() bool {
 
 // GetRecommendation returns a recommendation for fixing authentication issues
 func (as *Status) GetRecommendation() string {
+	if as.GitHubApp {
+		return as.getAppRecommendation()
+	}
+
+	if as.Profile != "" && (!as.TokenAvailable || !as.TokenValid) {
+		return fmt.Sprintf("Log in again: ghx auth login --profile %s", as.Profile)
+	}
+
 	if !as.GHCLIInstalled {
 		return "Install GitHub CLI: https://cli.github.com/manual/installation"
 	}
@@ -162,12 +301,28 @@ func (as *Status) GetRecommendation() string {
 	}
 
 	if !as.HasRequiredScopes {
+		if as.TokenType != "" && !as.TokenType.UsesScopes() {
+			return "Grant the token read and write access to Projects in its permission settings"
+		}
 		return "Grant additional scopes: gh auth refresh -s repo -s project"
 	}
 
 	return "Authentication is properly configured"
 }
 
+// getAppRecommendation returns a recommendation for GitHub App authentication issues
+func (as *Status) getAppRecommendation() string {
+	if !as.TokenAvailable || !as.TokenValid {
+		return "Check app.id, app.installation-id and app.private-key-file in ~/.ghx.yaml"
+	}
+
+	if !as.HasRequiredScopes {
+		return "Grant the GitHub App read and write access to Projects and reinstall it"
+	}
+
+	return "Authentication is properly configured"
+}
+
 // getMissingScopes returns scopes that are required but not available
 func getMissingScopes(available, required []string) []string {
 	scopeMap := make(map[string]bool)
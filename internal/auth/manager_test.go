@@ -1,9 +1,19 @@
 package auth
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
 )
 
 func TestAuthManager(t *testing.T) {
@@ -154,3 +164,59 @@ func TestGetMissingScopes(t *testing.T) {
 		assert.Equal(t, []string{"repo", "project"}, missing)
 	})
 }
+
+func TestManagerTokenSource(t *testing.T) {
+	t.Run("Refreshes a GitHub App token that expires mid-run", func(t *testing.T) {
+		_, keyPEM := generateTestKey(t)
+		now := time.Now()
+		issued := 0
+		var seen []string
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/app/installations/42/access_tokens", func(w http.ResponseWriter, _ *http.Request) {
+			issued++
+			w.WriteHeader(http.StatusCreated)
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"token":       fmt.Sprintf("ghs_token%d", issued),
+				"expires_at":  now.Add(time.Duration(issued) * time.Hour).Format(time.RFC3339),
+				"permissions": map[string]string{"organization_projects": "write", "metadata": "read"},
+			})
+		})
+		mux.HandleFunc("/graphql", func(w http.ResponseWriter, r *http.Request) {
+			token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+			seen = append(seen, token)
+			if token != fmt.Sprintf("ghs_token%d", issued) {
+				w.WriteHeader(http.StatusUnauthorized)
+				_, _ = w.Write([]byte(`{"message":"Bad credentials"}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"viewer":{"login":"app"}}}`))
+		})
+		server := httptest.NewServer(mux)
+		t.Cleanup(server.Close)
+		t.Setenv(envAPIURL, server.URL)
+
+		appAuth, err := NewAppAuth(1, 42, keyPEM)
+		require.NoError(t, err)
+		appAuth.now = func() time.Time { return now }
+		manager := &Manager{ghAuth: NewGitHubCLIAuth(), appAuth: appAuth}
+
+		tokenSource, err := manager.TokenSource()
+		require.NoError(t, err)
+		client := api.NewClientWithTokenSource(tokenSource)
+
+		var query struct {
+			Viewer struct {
+				Login string
+			}
+		}
+		require.NoError(t, client.Query(context.Background(), &query, nil))
+
+		// The first installation token expires while the process keeps running
+		appAuth.now = func() time.Time { return now.Add(61 * time.Minute) }
+		require.NoError(t, client.Query(context.Background(), &query, nil))
+
+		assert.Equal(t, []string{"ghs_token1", "ghs_token2"}, seen)
+		assert.Equal(t, 2, issued)
+	})
+}
@@ -0,0 +1,414 @@
+package auth
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultHost is the GitHub host used when a profile does not set one
+const DefaultHost = "github.com"
+
+// Config keys for named profiles in ~/.ghx.yaml
+const (
+	configProfile  = "profile"
+	configProfiles = "profiles"
+)
+
+// ProfileTokenSource identifies where a profile gets its token from
+type ProfileTokenSource string
+
+const (
+	ProfileTokenSourceGH    ProfileTokenSource = "gh"
+	ProfileTokenSourceEnv   ProfileTokenSource = "env"
+	ProfileTokenSourceFile  ProfileTokenSource = "file"
+	ProfileTokenSourceOAuth ProfileTokenSource = "oauth"
+)
+
+// ValidProfileTokenSources returns all supported profile token sources
+func ValidProfileTokenSources() []ProfileTokenSource {
+	return []ProfileTokenSource{
+		ProfileTokenSourceGH,
+		ProfileTokenSourceEnv,
+		ProfileTokenSourceFile,
+		ProfileTokenSourceOAuth,
+	}
+}
+
+// ValidateProfileTokenSource validates a profile token source name
+func ValidateProfileTokenSource(source string) (ProfileTokenSource, error) {
+	normalized := ProfileTokenSource(strings.ToLower(strings.TrimSpace(source)))
+	for _, valid := range ValidProfileTokenSources() {
+		if normalized == valid {
+			return normalized, nil
+		}
+	}
+	return "", fmt.Errorf("invalid token source '%s', must be one of: %v", source, ValidProfileTokenSources())
+}
+
+// profileNamePattern matches valid profile names; viper lowercases config keys
+var profileNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// ValidateProfileName validates a profile name
+func ValidateProfileName(name string) error {
+	if !profileNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid profile name '%s': use lowercase letters, digits, '-' and '_'", name)
+	}
+	return nil
+}
+
+// Profile is a named set of credentials and defaults stored under `profiles` in ~/.ghx.yaml
+type Profile struct {
+	Name        string             `yaml:"-" mapstructure:"-"`
+	Host        string             `yaml:"host,omitempty" mapstructure:"host"`
+	TokenSource ProfileTokenSource `yaml:"token-source,omitempty" mapstructure:"token-source"`
+	TokenEnv    string             `yaml:"token-env,omitempty" mapstructure:"token-env"`
+	TokenFile   string             `yaml:"token-file,omitempty" mapstructure:"token-file"`
+	Org         string             `yaml:"org,omitempty" mapstructure:"org"`
+	User        string             `yaml:"user,omitempty" mapstructure:"user"`
+}
+
+// Hostname returns the profile host, defaulting to github.com
+func (p *Profile) Hostname() string {
+	if p.Host == "" {
+		return DefaultHost
+	}
+	return p.Host
+}
+
+// IsEnterprise reports whether the profile points at a GitHub Enterprise Server host
+func (p *Profile) IsEnterprise() bool {
+	return p.Hostname() != DefaultHost
+}
+
+// APIURL returns the REST API base URL for the profile host
+func (p *Profile) APIURL() string {
+	if !p.IsEnterprise() {
+		return "https://api.github.com"
+	}
+	return fmt.Sprintf("https://%s/api/v3", p.Hostname())
+}
+
+// GraphQLURL returns the GraphQL API endpoint for the profile host
+func (p *Profile) GraphQLURL() string {
+	if !p.IsEnterprise() {
+		return "https://api.github.com/graphql"
+	}
+	return fmt.Sprintf("https://%s/api/graphql", p.Hostname())
+}
+
+// TokenFilePath returns the file holding the profile token for file and oauth sources
+func (p *Profile) TokenFilePath() (string, error) {
+	if p.TokenFile != "" {
+		return ExpandHome(p.TokenFile), nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find home directory: %w", err)
+	}
+	return filepath.Join(home, ".ghx", "tokens", p.Name), nil
+}
+
+// Token returns the profile token from its configured source
+func (p *Profile) Token(gh *GitHubCLIAuth) (string, error) {
+	switch p.TokenSource {
+	case ProfileTokenSourceGH, "":
+		return gh.GetToken(p.Hostname())
+	case ProfileTokenSourceEnv:
+		if p.TokenEnv == "" {
+			if token := gh.GetFallbackToken(); token != "" {
+				return token, nil
+			}
+			return "", fmt.Errorf("profile %q: GH_TOKEN and GITHUB_TOKEN are not set", p.Name)
+		}
+		if token := os.Getenv(p.TokenEnv); token != "" {
+			return token, nil
+		}
+		return "", fmt.Errorf("profile %q: %s is not set", p.Name, p.TokenEnv)
+	case ProfileTokenSourceFile, ProfileTokenSourceOAuth:
+		path, err := p.TokenFilePath()
+		if err != nil {
+			return "", err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) && p.TokenSource == ProfileTokenSourceOAuth {
+				return "", fmt.Errorf("profile %q is logged out; run 'ghx auth login --profile %s'", p.Name, p.Name)
+			}
+			return "", fmt.Errorf("profile %q: failed to read token file: %w", p.Name, err)
+		}
+		token := strings.TrimSpace(string(data))
+		if token == "" {
+			return "", fmt.Errorf("profile %q: token file %s is empty", p.Name, path)
+		}
+		return token, nil
+	default:
+		return "", fmt.Errorf("profile %q: invalid token source '%s', must be one of: %v", p.Name, p.TokenSource, ValidProfileTokenSources())
+	}
+}
+
+// Validate checks that the profile token is valid and has the access ghx needs
+func (p *Profile) Validate() (*TokenInfo, error) {
+	ghAuth := NewGitHubCLIAuth()
+	ghAuth.useProfileHost(p)
+
+	token, err := p.Token(ghAuth)
+	if err != nil {
+		return nil, err
+	}
+	return p.validateToken(ghAuth, token)
+}
+
+// ValidateToken checks that a token, such as one about to be stored with SaveToken,
+// works for the profile's host and has the required access
+func (p *Profile) ValidateToken(token string) (*TokenInfo, error) {
+	ghAuth := NewGitHubCLIAuth()
+	ghAuth.useProfileHost(p)
+	return p.validateToken(ghAuth, token)
+}
+
+func (p *Profile) validateToken(ghAuth *GitHubCLIAuth, token string) (*TokenInfo, error) {
+	info, err := ghAuth.InspectToken(token, p.Org)
+	if err != nil {
+		return nil, fmt.Errorf("profile %q token validation failed: %w", p.Name, err)
+	}
+	if err := checkAccess(info, fmt.Sprintf("profile %q token", p.Name)); err != nil {
+		return info, err
+	}
+	return info, nil
+}
+
+// SaveToken stores a token in the profile token file, readable only by the current user
+func (p *Profile) SaveToken(token string) error {
+	path, err := p.TokenFilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("failed to create token directory: %w", err)
+	}
+	if err := os.WriteFile(path, []byte(token+"\n"), 0o600); err != nil {
+		return fmt.Errorf("failed to write token file: %w", err)
+	}
+	return nil
+}
+
+// RemoveToken deletes a token stored by SaveToken
+func (p *Profile) RemoveToken() error {
+	path, err := p.TokenFilePath()
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove token file: %w", err)
+	}
+	return nil
+}
+
+// LoadProfiles returns the profiles defined in the config file
+func LoadProfiles() (map[string]*Profile, error) {
+	raw := map[string]*Profile{}
+	if err := viper.UnmarshalKey(configProfiles, &raw); err != nil {
+		return nil, fmt.Errorf("failed to parse profiles: %w", err)
+	}
+
+	for name, profile := range raw {
+		if profile == nil {
+			profile = &Profile{}
+			raw[name] = profile
+		}
+		profile.Name = name
+	}
+	return raw, nil
+}
+
+// ProfileNames returns the configured profile names in sorted order
+func ProfileNames(profiles map[string]*Profile) []string {
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ActiveProfileName returns the profile selected by --profile, GHX_PROFILE or the config file
+func ActiveProfileName() string {
+	return viper.GetString(configProfile)
+}
+
+// ActiveProfile returns the active profile, or nil when no profile is selected
+func ActiveProfile() (*Profile, error) {
+	name := ActiveProfileName()
+	if name == "" {
+		return nil, nil
+	}
+
+	profiles, err := LoadProfiles()
+	if err != nil {
+		return nil, err
+	}
+
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q not found in config; available profiles: %v", name, ProfileNames(profiles))
+	}
+	return profile, nil
+}
+
+// ConfigFile edits ~/.ghx.yaml in place, preserving comments and unrelated settings
+type ConfigFile struct {
+	root *yaml.Node
+	path string
+}
+
+// ConfigFilePath returns the config file in use, defaulting to ~/.ghx.yaml
+func ConfigFilePath() (string, error) {
+	if path := viper.ConfigFileUsed(); path != "" {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find home directory: %w", err)
+	}
+	return filepath.Join(home, ".ghx.yaml"), nil
+}
+
+// LoadConfigFile reads the config file for editing; a missing file is treated as empty
+func LoadConfigFile(path string) (*ConfigFile, error) {
+	root := &yaml.Node{Kind: yaml.DocumentNode}
+
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	if len(data) > 0 {
+		if err := yaml.Unmarshal(data, root); err != nil {
+			return nil, fmt.Errorf("failed to parse config file: %w", err)
+		}
+	}
+
+	if len(root.Content) == 0 {
+		root.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+	if root.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("config file %s must contain a YAML mapping", path)
+	}
+
+	return &ConfigFile{path: path, root: root}, nil
+}
+
+// ActiveProfile returns the active profile stored in the config file
+func (c *ConfigFile) ActiveProfile() string {
+	value := mappingValue(c.root.Content[0], configProfile)
+	if value == nil || value.Kind != yaml.ScalarNode {
+		return ""
+	}
+	return value.Value
+}
+
+// SetActiveProfile sets the top-level `profile` key; an empty name removes it
+func (c *ConfigFile) SetActiveProfile(name string) {
+	doc := c.root.Content[0]
+	if name == "" {
+		removeMappingKey(doc, configProfile)
+		return
+	}
+
+	value := &yaml.Node{}
+	if err := value.Encode(name); err == nil {
+		setMappingValue(doc, configProfile, value)
+	}
+}
+
+// SetProfile adds or replaces a profile
+func (c *ConfigFile) SetProfile(profile *Profile) error {
+	value := &yaml.Node{}
+	if err := value.Encode(profile); err != nil {
+		return fmt.Errorf("failed to encode profile: %w", err)
+	}
+
+	doc := c.root.Content[0]
+	profiles := mappingValue(doc, configProfiles)
+	if profiles == nil || profiles.Kind != yaml.MappingNode {
+		profiles = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+		setMappingValue(doc, configProfiles, profiles)
+	}
+	setMappingValue(profiles, profile.Name, value)
+	return nil
+}
+
+// RemoveProfile removes a profile and reports whether it existed
+func (c *ConfigFile) RemoveProfile(name string) bool {
+	profiles := mappingValue(c.root.Content[0], configProfiles)
+	if profiles == nil || profiles.Kind != yaml.MappingNode {
+		return false
+	}
+	removed := removeMappingKey(profiles, name)
+	if len(profiles.Content) == 0 {
+		removeMappingKey(c.root.Content[0], configProfiles)
+	}
+	return removed
+}
+
+// Save writes the config file, readable only by the current user
+func (c *ConfigFile) Save() error {
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(c.root); err != nil {
+		return fmt.Errorf("failed to encode config file: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("failed to encode config file: %w", err)
+	}
+	if err := os.WriteFile(c.path, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+	return nil
+}
+
+// mappingValue returns the value node for key in a YAML mapping
+func mappingValue(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// setMappingValue sets key to value in a YAML mapping, keeping the key position if it exists
+func setMappingValue(mapping *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content[i+1] = value
+			return
+		}
+	}
+	mapping.Content = append(mapping.Content,
+		&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key},
+		value,
+	)
+}
+
+// removeMappingKey removes key from a YAML mapping and reports whether it was present
+func removeMappingKey(mapping *yaml.Node, key string) bool {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,226 @@
+package auth
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useTestConfig loads config YAML into viper for the duration of the test
+func useTestConfig(t *testing.T, config string) {
+	t.Helper()
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	path := filepath.Join(t.TempDir(), ".ghx.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+	viper.SetConfigFile(path)
+	require.NoError(t, viper.ReadInConfig())
+}
+
+func TestProfile(t *testing.T) {
+	t.Run("Hosts map to API endpoints", func(t *testing.T) {
+		profile := &Profile{}
+		assert.Equal(t, "github.com", profile.Hostname())
+		assert.Equal(t, "https://api.github.com/graphql", profile.GraphQLURL())
+
+		profile.Host = "github.example.com"
+		assert.True(t, profile.IsEnterprise())
+		assert.Equal(t, "https://github.example.com/api/v3", profile.APIURL())
+		assert.Equal(t, "https://github.example.com/api/graphql", profile.GraphQLURL())
+	})
+
+	t.Run("Env source reads the configured variable", func(t *testing.T) {
+		t.Setenv("WORK_TOKEN", "ghp_work")
+		profile := &Profile{Name: "work", TokenSource: ProfileTokenSourceEnv, TokenEnv: "WORK_TOKEN"}
+
+		token, err := profile.Token(NewGitHubCLIAuth())
+		require.NoError(t, err)
+		assert.Equal(t, "ghp_work", token)
+
+		profile.TokenEnv = "MISSING_TOKEN"
+		_, err = profile.Token(NewGitHubCLIAuth())
+		assert.ErrorContains(t, err, "MISSING_TOKEN is not set")
+	})
+
+	t.Run("Saved tokens are read back and removed", func(t *testing.T) {
+		profile := &Profile{
+			Name:        "personal",
+			TokenSource: ProfileTokenSourceOAuth,
+			TokenFile:   filepath.Join(t.TempDir(), "tokens", "personal"),
+		}
+
+		require.NoError(t, profile.SaveToken("gho_saved"))
+		info, err := os.Stat(profile.TokenFile)
+		require.NoError(t, err)
+		assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+		token, err := profile.Token(NewGitHubCLIAuth())
+		require.NoError(t, err)
+		assert.Equal(t, "gho_saved", token)
+
+		require.NoError(t, profile.RemoveToken())
+		_, err = profile.Token(NewGitHubCLIAuth())
+		assert.ErrorContains(t, err, "is logged out")
+	})
+
+	t.Run("Validates names and token sources", func(t *testing.T) {
+		assert.NoError(t, ValidateProfileName("work-bot"))
+		assert.Error(t, ValidateProfileName("Work"))
+		assert.Error(t, ValidateProfileName(""))
+
+		source, err := ValidateProfileTokenSource("OAuth")
+		require.NoError(t, err)
+		assert.Equal(t, ProfileTokenSourceOAuth, source)
+		_, err = ValidateProfileTokenSource("keychain")
+		assert.Error(t, err)
+	})
+}
+
+func TestActiveProfile(t *testing.T) {
+	t.Run("Loads the active profile from config", func(t *testing.T) {
+		useTestConfig(t, `
+profile: work
+profiles:
+  work:
+    host: github.example.com
+    token-source: env
+    token-env: WORK_TOKEN
+    org: mycorp
+  personal:
+    token-source: gh
+`)
+
+		profile, err := ActiveProfile()
+		require.NoError(t, err)
+		require.NotNil(t, profile)
+		assert.Equal(t, "work", profile.Name)
+		assert.Equal(t, "github.example.com", profile.Host)
+		assert.Equal(t, ProfileTokenSourceEnv, profile.TokenSource)
+		assert.Equal(t, "mycorp", profile.Org)
+
+		// --profile overrides the stored profile
+		viper.Set("profile", "personal")
+		profile, err = ActiveProfile()
+		require.NoError(t, err)
+		assert.Equal(t, "personal", profile.Name)
+	})
+
+	t.Run("Unknown profiles are reported", func(t *testing.T) {
+		useTestConfig(t, "profile: missing\nprofiles:\n  work:\n    token-source: gh\n")
+
+		_, err := ActiveProfile()
+		assert.ErrorContains(t, err, `profile "missing" not found`)
+	})
+
+	t.Run("No profile selected", func(t *testing.T) {
+		useTestConfig(t, "org: myorg\n")
+
+		profile, err := ActiveProfile()
+		require.NoError(t, err)
+		assert.Nil(t, profile)
+	})
+}
+
+func TestConfigFile(t *testing.T) {
+	t.Run("Edits profiles and preserves other settings", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), ".ghx.yaml")
+		require.NoError(t, os.WriteFile(path, []byte("# my settings\nformat: json\n"), 0o600))
+
+		config, err := LoadConfigFile(path)
+		require.NoError(t, err)
+		require.NoError(t, config.SetProfile(&Profile{Name: "work", TokenSource: ProfileTokenSourceGH, Org: "mycorp"}))
+		require.NoError(t, config.SetProfile(&Profile{Name: "personal", TokenSource: ProfileTokenSourceOAuth}))
+		config.SetActiveProfile("work")
+		require.NoError(t, config.Save())
+
+		data, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Contains(t, string(data), "# my settings")
+		assert.Contains(t, string(data), "format: json")
+		assert.Contains(t, string(data), "profile: work")
+		assert.Contains(t, string(data), "token-source: gh")
+
+		config, err = LoadConfigFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, "work", config.ActiveProfile())
+		assert.True(t, config.RemoveProfile("work"))
+		assert.False(t, config.RemoveProfile("work"))
+		config.SetActiveProfile("")
+		require.NoError(t, config.Save())
+
+		data, err = os.ReadFile(path)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "work")
+		assert.Contains(t, string(data), "personal:")
+	})
+
+	t.Run("Missing config file starts empty", func(t *testing.T) {
+		config, err := LoadConfigFile(filepath.Join(t.TempDir(), "missing.yaml"))
+		require.NoError(t, err)
+		assert.Empty(t, config.ActiveProfile())
+	})
+}
+
+func TestManagerWithProfile(t *testing.T) {
+	t.Run("Uses the profile token and default owner", func(t *testing.T) {
+		useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "token ghp_work", r.Header.Get("Authorization"))
+			w.Header().Set("X-OAuth-Scopes", "repo, project")
+			_, _ = w.Write([]byte(`{"login":"work-bot"}`))
+		}))
+		useTestConfig(t, "profile: work\nprofiles:\n  work:\n    token-source: env\n    token-env: WORK_TOKEN\n    org: mycorp\n")
+		t.Setenv("WORK_TOKEN", "ghp_work")
+
+		manager := NewAuthManager()
+		token, err := manager.GetValidatedToken()
+		require.NoError(t, err)
+		assert.Equal(t, "ghp_work", token)
+
+		owner, isOrg := manager.DefaultOwner()
+		assert.Equal(t, "mycorp", owner)
+		assert.True(t, isOrg)
+
+		status := manager.GetAuthenticationStatus()
+		assert.Equal(t, "work", status.Profile)
+		assert.True(t, status.IsReady())
+	})
+
+	t.Run("Reports unknown profiles", func(t *testing.T) {
+		useTestConfig(t, "profile: missing\n")
+
+		_, err := NewAuthManager().GetValidatedToken()
+		assert.ErrorContains(t, err, `profile "missing" not found`)
+	})
+}
+
+func TestProfileValidateToken(t *testing.T) {
+	useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "token ghp_good" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Bad credentials"}`))
+			return
+		}
+		w.Header().Set("X-OAuth-Scopes", "repo, project")
+		_, _ = w.Write([]byte(`{"login":"octocat"}`))
+	}))
+	t.Setenv("HOME", t.TempDir())
+
+	profile := &Profile{Name: "work", TokenSource: ProfileTokenSourceFile}
+
+	info, err := profile.ValidateToken("ghp_good")
+	require.NoError(t, err)
+	assert.Equal(t, "octocat", info.Login)
+
+	// Validation does not read or write the token file
+	_, err = profile.ValidateToken("ghp_bad")
+	assert.ErrorContains(t, err, `profile "work" token validation failed`)
+	path, err := profile.TokenFilePath()
+	require.NoError(t, err)
+	assert.NoFileExists(t, path)
+}
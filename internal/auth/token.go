@@ -0,0 +1,279 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// apiBaseURL is the GitHub REST API endpoint used for token validation and probing
+var apiBaseURL = "https://api.github.com"
+
+// envAPIURL overrides the GitHub API base URL for every host, e.g. for a local fake server
+const envAPIURL = "GHX_API_URL"
+
+// restAPIURL returns the REST API base URL, preferring the GHX_API_URL override
+func restAPIURL() string {
+	if base := os.Getenv(envAPIURL); base != "" {
+		return strings.TrimSuffix(base, "/")
+	}
+	return apiBaseURL
+}
+
+// TokenType identifies the kind of GitHub token
+type TokenType string
+
+const (
+	TokenTypeClassic         TokenType = "classic"
+	TokenTypeOAuth           TokenType = "oauth"
+	TokenTypeFineGrained     TokenType = "fine-grained"
+	TokenTypeAppInstallation TokenType = "app-installation"
+	TokenTypeAppUser         TokenType = "app-user"
+	TokenTypeUnknown         TokenType = "unknown"
+)
+
+// Permissions that ghx needs from tokens without classic OAuth scopes
+const (
+	PermissionProjects   = "projects"
+	PermissionRepository = "repository"
+)
+
+// RequiredScopes are the classic OAuth scopes ghx needs
+var RequiredScopes = []string{"repo", "project"}
+
+// RequiredPermissions are the permissions ghx needs from fine-grained and GitHub App tokens
+var RequiredPermissions = []string{PermissionProjects}
+
+// DetectTokenType determines the token type from its prefix
+func DetectTokenType(token string) TokenType {
+	switch {
+	case strings.HasPrefix(token, "ghp_"):
+		return TokenTypeClassic
+	case strings.HasPrefix(token, "gho_"):
+		return TokenTypeOAuth
+	case strings.HasPrefix(token, "github_pat_"):
+		return TokenTypeFineGrained
+	case strings.HasPrefix(token, "ghs_"):
+		return TokenTypeAppInstallation
+	case strings.HasPrefix(token, "ghu_"):
+		return TokenTypeAppUser
+	default:
+		return TokenTypeUnknown
+	}
+}
+
+// UsesScopes reports whether tokens of this type are authorized by classic OAuth scopes
+func (t TokenType) UsesScopes() bool {
+	switch t {
+	case TokenTypeClassic, TokenTypeOAuth, TokenTypeUnknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// TokenInfo describes what a token is and what it is allowed to do
+type TokenInfo struct {
+	Type        TokenType
+	Login       string
+	Scopes      []string
+	Permissions []string
+}
+
+// HasRequiredAccess reports whether the token can be used for GitHub Projects
+func (ti *TokenInfo) HasRequiredAccess() bool {
+	return len(ti.MissingAccess()) == 0
+}
+
+// MissingAccess returns the scopes or permissions the token lacks
+func (ti *TokenInfo) MissingAccess() []string {
+	if ti.Type.UsesScopes() {
+		return getMissingScopes(ti.Scopes, RequiredScopes)
+	}
+	return getMissingScopes(ti.Permissions, RequiredPermissions)
+}
+
+// RequiredAccess returns the scopes or permissions required for the token type
+func (ti *TokenInfo) RequiredAccess() []string {
+	if ti.Type.UsesScopes() {
+		return RequiredScopes
+	}
+	return RequiredPermissions
+}
+
+// InspectToken validates the token with the GitHub API and determines its access.
+// Classic tokens report their scopes in the X-OAuth-Scopes header; fine-grained and
+// GitHub App tokens don't, so their permissions are probed with read-only requests.
+// owner is the user or organization used for the projects probe; empty means the viewer.
+func (g *GitHubCLIAuth) InspectToken(token, owner string) (*TokenInfo, error) {
+	if token == "" {
+		return nil, errors.New("empty token provided")
+	}
+
+	info := &TokenInfo{Type: DetectTokenType(token)}
+
+	if info.Type == TokenTypeAppInstallation {
+		return g.inspectInstallationToken(token, owner, info)
+	}
+
+	resp, body, err := doRequest(http.MethodGet, g.apiURL+"/user", token, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate token: %w", err)
+	}
+	if resp.StatusCode == httpStatusUnauthorized {
+		return nil, errors.New("invalid or expired token")
+	}
+	if resp.StatusCode != httpStatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var user UserResponse
+	if err := json.Unmarshal(body, &user); err != nil {
+		return nil, fmt.Errorf("failed to parse user response: %w", err)
+	}
+	info.Login = user.Login
+
+	// Classic tokens always send the header, even when it is empty
+	if scopeHeader, ok := resp.Header["X-Oauth-Scopes"]; ok {
+		if info.Type == TokenTypeUnknown {
+			info.Type = TokenTypeClassic
+		}
+		info.Scopes = parseScopes(strings.Join(scopeHeader, ", "))
+		return info, nil
+	}
+
+	if info.Type.UsesScopes() {
+		info.Type = TokenTypeFineGrained
+	}
+	if g.probeProjects(token, owner) {
+		info.Permissions = append(info.Permissions, PermissionProjects)
+	}
+	if g.probe(token, g.apiURL+"/user/repos?per_page=1") {
+		info.Permissions = append(info.Permissions, PermissionRepository)
+	}
+
+	return info, nil
+}
+
+// inspectInstallationToken probes a GitHub App installation token, which cannot access /user
+func (g *GitHubCLIAuth) inspectInstallationToken(token, owner string, info *TokenInfo) (*TokenInfo, error) {
+	resp, body, err := doRequest(http.MethodGet, g.apiURL+"/installation/repositories?per_page=1", token, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate token: %w", err)
+	}
+	if resp.StatusCode == httpStatusUnauthorized {
+		return nil, errors.New("invalid or expired token")
+	}
+	if resp.StatusCode != httpStatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var repos struct {
+		Repositories []struct {
+			Owner struct {
+				Login string `json:"login"`
+			} `json:"owner"`
+		} `json:"repositories"`
+		TotalCount int `json:"total_count"`
+	}
+	if err := json.Unmarshal(body, &repos); err != nil {
+		return nil, fmt.Errorf("failed to parse installation repositories: %w", err)
+	}
+
+	if repos.TotalCount > 0 {
+		info.Permissions = append(info.Permissions, PermissionRepository)
+	}
+	if owner == "" && len(repos.Repositories) > 0 {
+		owner = repos.Repositories[0].Owner.Login
+	}
+	if owner != "" && g.probeProjects(token, owner) {
+		info.Permissions = append(info.Permissions, PermissionProjects)
+	}
+
+	return info, nil
+}
+
+// probeProjects checks whether the token can read the projects of owner (or the viewer)
+func (g *GitHubCLIAuth) probeProjects(token, owner string) bool {
+	request := map[string]interface{}{
+		"query": "query { viewer { projectsV2(first: 1) { totalCount } } }",
+	}
+	if owner != "" {
+		request = map[string]interface{}{
+			"query":     "query($login: String!) { repositoryOwner(login: $login) { ... on ProjectV2Owner { projectsV2(first: 1) { totalCount } } } }",
+			"variables": map[string]interface{}{"login": owner},
+		}
+	}
+
+	payload, err := json.Marshal(request)
+	if err != nil {
+		return false
+	}
+
+	resp, body, err := doRequest(http.MethodPost, g.graphqlURL, token, payload)
+	if err != nil || resp.StatusCode != httpStatusOK {
+		return false
+	}
+
+	var result struct {
+		Errors []json.RawMessage `json:"errors"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil {
+		return false
+	}
+	return len(result.Errors) == 0
+}
+
+// probe reports whether a GET request to url succeeds with the token
+func (g *GitHubCLIAuth) probe(token, url string) bool {
+	resp, _, err := doRequest(http.MethodGet, url, token, nil)
+	return err == nil && resp.StatusCode == httpStatusOK
+}
+
+// doRequest performs an authenticated GitHub API request and returns the response and body
+func doRequest(method, url, token string, payload []byte) (*http.Response, []byte, error) {
+	const requestTimeout = 10 * time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Authorization", "token "+token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "ghx-cli")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := (&http.Client{Timeout: requestTimeout}).Do(req)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer resp.Body.Close()
+
+	var buf bytes.Buffer
+	if _, err := buf.ReadFrom(resp.Body); err != nil {
+		return nil, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	return resp, buf.Bytes(), nil
+}
+
+// parseScopes splits an X-OAuth-Scopes header value
+func parseScopes(header string) []string {
+	scopes := []string{}
+	for _, scope := range strings.Split(header, ",") {
+		scope = strings.TrimSpace(scope)
+		if scope != "" {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
@@ -0,0 +1,142 @@
+package auth
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// useTestAPI points token validation at a test server for the duration of the test
+func useTestAPI(t *testing.T, handler http.Handler) {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	original := apiBaseURL
+	apiBaseURL = server.URL
+	t.Cleanup(func() {
+		apiBaseURL = original
+		server.Close()
+	})
+}
+
+func TestDetectTokenType(t *testing.T) {
+	t.Run("Detects token types from prefixes", func(t *testing.T) {
+		assert.Equal(t, TokenTypeClassic, DetectTokenType("ghp_abc"))
+		assert.Equal(t, TokenTypeOAuth, DetectTokenType("gho_abc"))
+		assert.Equal(t, TokenTypeFineGrained, DetectTokenType("github_pat_abc"))
+		assert.Equal(t, TokenTypeAppInstallation, DetectTokenType("ghs_abc"))
+		assert.Equal(t, TokenTypeAppUser, DetectTokenType("ghu_abc"))
+		assert.Equal(t, TokenTypeUnknown, DetectTokenType("0123456789abcdef"))
+	})
+
+	t.Run("Only classic and OAuth tokens use scopes", func(t *testing.T) {
+		assert.True(t, TokenTypeClassic.UsesScopes())
+		assert.True(t, TokenTypeOAuth.UsesScopes())
+		assert.False(t, TokenTypeFineGrained.UsesScopes())
+		assert.False(t, TokenTypeAppInstallation.UsesScopes())
+	})
+}
+
+func TestTokenInfoAccess(t *testing.T) {
+	t.Run("Classic tokens require scopes", func(t *testing.T) {
+		info := &TokenInfo{Type: TokenTypeClassic, Scopes: []string{"repo"}}
+		assert.False(t, info.HasRequiredAccess())
+		assert.Equal(t, []string{"project"}, info.MissingAccess())
+		assert.Equal(t, RequiredScopes, info.RequiredAccess())
+	})
+
+	t.Run("Fine-grained tokens require permissions", func(t *testing.T) {
+		info := &TokenInfo{Type: TokenTypeFineGrained, Permissions: []string{PermissionProjects}}
+		assert.True(t, info.HasRequiredAccess())
+		assert.Equal(t, RequiredPermissions, info.RequiredAccess())
+	})
+}
+
+func TestInspectToken(t *testing.T) {
+	t.Run("Classic token scopes come from the header", func(t *testing.T) {
+		useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/user", r.URL.Path)
+			w.Header().Set("X-OAuth-Scopes", "repo, project, read:org")
+			_, _ = w.Write([]byte(`{"login":"octocat"}`))
+		}))
+
+		info, err := NewGitHubCLIAuth().InspectToken("ghp_test", "")
+		require.NoError(t, err)
+		assert.Equal(t, TokenTypeClassic, info.Type)
+		assert.Equal(t, "octocat", info.Login)
+		assert.Equal(t, []string{"repo", "project", "read:org"}, info.Scopes)
+		assert.True(t, info.HasRequiredAccess())
+	})
+
+	t.Run("Fine-grained token permissions are probed", func(t *testing.T) {
+		var probedLogin string
+		useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/user":
+				_, _ = w.Write([]byte(`{"login":"octocat"}`))
+			case "/graphql":
+				var request struct {
+					Variables map[string]string `json:"variables"`
+				}
+				_ = json.NewDecoder(r.Body).Decode(&request)
+				probedLogin = request.Variables["login"]
+				_, _ = w.Write([]byte(`{"data":{"repositoryOwner":{"projectsV2":{"totalCount":3}}}}`))
+			default:
+				w.WriteHeader(http.StatusForbidden)
+			}
+		}))
+
+		info, err := NewGitHubCLIAuth().InspectToken("github_pat_test", "myorg")
+		require.NoError(t, err)
+		assert.Equal(t, TokenTypeFineGrained, info.Type)
+		assert.Equal(t, "myorg", probedLogin)
+		assert.Equal(t, []string{PermissionProjects}, info.Permissions)
+		assert.True(t, info.HasRequiredAccess())
+	})
+
+	t.Run("Fine-grained token without project access is rejected", func(t *testing.T) {
+		useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/graphql" {
+				_, _ = w.Write([]byte(`{"errors":[{"type":"FORBIDDEN","message":"Resource not accessible by personal access token"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"login":"octocat"}`))
+		}))
+
+		info, err := NewGitHubCLIAuth().InspectToken("github_pat_test", "")
+		require.NoError(t, err)
+		assert.False(t, info.HasRequiredAccess())
+		assert.Equal(t, []string{PermissionProjects}, info.MissingAccess())
+	})
+
+	t.Run("Installation tokens probe the installation owner", func(t *testing.T) {
+		useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/installation/repositories":
+				_, _ = w.Write([]byte(`{"total_count":1,"repositories":[{"owner":{"login":"myorg"}}]}`))
+			case "/graphql":
+				_, _ = w.Write([]byte(`{"data":{"repositoryOwner":{"projectsV2":{"totalCount":1}}}}`))
+			default:
+				w.WriteHeader(http.StatusForbidden)
+			}
+		}))
+
+		info, err := NewGitHubCLIAuth().InspectToken("ghs_test", "")
+		require.NoError(t, err)
+		assert.Equal(t, TokenTypeAppInstallation, info.Type)
+		assert.ElementsMatch(t, []string{PermissionRepository, PermissionProjects}, info.Permissions)
+	})
+
+	t.Run("Invalid tokens return an error", func(t *testing.T) {
+		useTestAPI(t, http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+		}))
+
+		_, err := NewGitHubCLIAuth().InspectToken("ghp_bad", "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid or expired token")
+	})
+}
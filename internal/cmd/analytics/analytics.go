@@ -18,6 +18,7 @@ for GitHub Projects v2. You can generate various types of reports including:
 • Item distribution by status, assignee, labels, and milestones  
 • Velocity and performance metrics
 • Timeline analysis and milestone tracking
+• Templated Markdown reports, optionally published as discussions
 • Export project data in multiple formats (JSON, CSV, XML)
 • Import project data with merge strategies
 • Bulk operations on project items
@@ -27,6 +28,8 @@ Analytics Types:
   velocity     - Team velocity and performance metrics over time
   timeline     - Project timeline with milestones and activity analysis
   distribution - Item distribution across statuses, assignees, and labels
+  report       - Markdown status report rendered from a template
+  burndown     - Burndown or burnup chart of an iteration
 
 Export Formats:
   json         - JSON format for programmatic access
@@ -47,6 +50,8 @@ Import Strategies:
 Examples:
   ghx analytics overview octocat/123
   ghx analytics velocity octocat/123 --period monthly
+  ghx analytics report octocat/123 --template weekly.md.tmpl
+  ghx analytics burndown octocat/123 --iteration @current
   ghx analytics export octocat/123 --format json --include-all
   ghx analytics import octocat/123 --file data.json --strategy merge
   ghx analytics bulk-update octocat/123 --items item1,item2 --field status --value Done`,
@@ -59,6 +64,8 @@ Examples:
 	cmd.AddCommand(NewVelocityCmd())
 	cmd.AddCommand(NewTimelineCmd())
 	cmd.AddCommand(NewDistributionCmd())
+	cmd.AddCommand(NewReportCmd())
+	cmd.AddCommand(NewBurndownCmd())
 	cmd.AddCommand(NewExportCmd())
 	cmd.AddCommand(NewImportCmd())
 	cmd.AddCommand(NewBulkUpdateCmd())
@@ -10,6 +10,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -57,7 +58,8 @@ Examples:
   ghx analytics bulk-update octocat/123 --items item1,item2,item3 --labels bug,urgent --format json
   ghx analytics bulk-update --org myorg/456 --items item1,item2 --field-custom-field "Custom Value"`,
 
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ProjectRef = args[0]
 			opts.Format = cmd.Flag("format").Value.String()
@@ -133,13 +135,13 @@ func runBulkUpdate(ctx context.Context, opts *BulkUpdateOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and services
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
 	analyticsService := service.NewAnalyticsService(client)
 
@@ -0,0 +1,180 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+const (
+	formatTerminal = "terminal"
+	formatCSV      = "csv"
+	formatSVG      = "svg"
+)
+
+// BurndownOptions holds options for the burndown command
+type BurndownOptions struct {
+	ProjectRef  string
+	Iteration   string
+	Field       string
+	Estimate    string
+	Chart       string
+	Output      string
+	StatusField string
+	DoneOption  string
+	Format      string
+}
+
+// NewBurndownCmd creates the burndown command
+func NewBurndownCmd() *cobra.Command {
+	opts := &BurndownOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "burndown <owner/project-number>",
+		Short: "Chart the burndown or burnup of an iteration",
+		Long: `Chart the remaining or completed work of an iteration day by day.
+
+The items of the iteration are fetched and the work at the start of each day
+is reconstructed: items are in scope from when they were added to the project
+and completed when their issue or pull request was closed, or when they were
+last updated if they are done by status. Work is counted in items, or summed
+from a number field with --estimate.
+
+Charts:
+  burndown     - Remaining work against an ideal line down to zero (default)
+  burnup       - Completed work against the scope
+
+Formats:
+  table        - Chart drawn in the terminal (default; terminal is an alias)
+  svg          - Standalone SVG image
+  csv          - Scope, completed, remaining and ideal work per day
+  json         - The same data as JSON
+
+Examples:
+  ghx analytics burndown octocat/123
+  ghx analytics burndown octocat/123 --iteration @previous --estimate Points
+  ghx analytics burndown octocat/123 --chart burnup --format svg --output burnup.svg
+  ghx analytics burndown octocat/123 --iteration "Sprint 4" --format csv`,
+
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runBurndown(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Iteration, "iteration", service.IterationRefCurrent, "Iteration ID, title, @current, @previous or @next")
+	cmd.Flags().StringVar(&opts.Field, "field", "", "Iteration field (default: first iteration field)")
+	cmd.Flags().StringVar(&opts.Estimate, "estimate", "", "Number field to sum instead of counting items")
+	cmd.Flags().StringVar(&opts.Chart, "chart", service.ChartBurndown, "Chart: burndown or burnup")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "Write the chart or data to a file")
+	cmd.Flags().StringVar(&opts.StatusField, "status-field", "", "Single select field holding item status (default: Status)")
+	cmd.Flags().StringVar(&opts.DoneOption, "done-option", "", "Status option marking items as done (default: Done)")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"field":        completion.Fields(completion.Arg(0), "Iteration"),
+		"estimate":     completion.Fields(completion.Arg(0), "Number"),
+		"status-field": completion.Fields(completion.Arg(0), "Single Select"),
+		"done-option":  completion.Options(completion.Arg(0), completion.Flag("status-field", "Status")),
+	})
+
+	return cmd
+}
+
+func runBurndown(ctx context.Context, opts *BurndownOptions) error {
+	switch opts.Format {
+	case FormatTable, formatTerminal, formatSVG, formatCSV, FormatJSON:
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+	chart := strings.ToLower(opts.Chart)
+	if chart != service.ChartBurndown && chart != service.ChartBurnup {
+		return fmt.Errorf("invalid chart: %s (use burndown or burnup)", opts.Chart)
+	}
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	client := api.NewClientWithTokenSource(tokenSource)
+	project, err := service.NewProjectService(client).GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	burndown, err := service.NewAnalyticsService(client).BuildBurndown(ctx, project, time.Now(), service.BurndownOptions{
+		Field:       opts.Field,
+		Iteration:   opts.Iteration,
+		Estimate:    opts.Estimate,
+		StatusField: opts.StatusField,
+		DoneOption:  opts.DoneOption,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to compute burndown: %w", err)
+	}
+
+	var out io.Writer = os.Stdout
+	if opts.Output != "" {
+		file, err := os.Create(opts.Output)
+		if err != nil {
+			return fmt.Errorf("failed to create output file: %w", err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	switch opts.Format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(burndown, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	case formatCSV:
+		return service.WriteBurndownCSV(out, burndown)
+	case formatSVG:
+		_, err := fmt.Fprint(out, service.RenderBurndownSVG(burndown, chart))
+		return err
+	default:
+		return outputBurndownChart(out, burndown, chart)
+	}
+}
+
+func outputBurndownChart(out io.Writer, burndown *service.Burndown, chart string) error {
+	unit := "items"
+	if burndown.Estimate != "" {
+		unit = burndown.Estimate
+	}
+	legend := "█ remaining  · ideal"
+	if chart == service.ChartBurnup {
+		legend = "█ completed  · scope"
+	}
+
+	_, err := fmt.Fprintf(out, "📉 %s %s (%s to %s), %d item(s), %s %s\n\n%s\n  %s\n",
+		burndown.Field, burndown.Iteration,
+		burndown.Start.Format(service.DateLayout), burndown.End.AddDate(0, 0, -1).Format(service.DateLayout),
+		burndown.Items, strings.TrimSuffix(fmt.Sprintf("%g", burndown.Total), ".0"), unit,
+		service.FormatBurndownChart(burndown, chart), legend)
+	return err
+}
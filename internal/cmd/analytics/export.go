@@ -10,6 +10,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -58,7 +59,8 @@ Examples:
   ghx analytics export octocat/123 --format xml --filter "status:open" --output json
   ghx analytics export --org myorg/456 --format json --include-workflows`,
 
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ProjectRef = args[0]
 			opts.OutputFormat = cmd.Flag("format").Value.String()
@@ -108,13 +110,13 @@ func runExport(ctx context.Context, opts *ExportOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and services
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
 	analyticsService := service.NewAnalyticsService(client)
 
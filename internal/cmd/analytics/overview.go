@@ -11,6 +11,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -49,7 +50,8 @@ Examples:
   ghx analytics overview octocat/123 --format json
   ghx analytics overview --org myorg/456 --format table`,
 
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ProjectRef = args[0]
 			opts.Format = cmd.Flag("format").Value.String()
@@ -77,13 +79,13 @@ func runOverview(ctx context.Context, opts *OverviewOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and services
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
 	analyticsService := service.NewAnalyticsService(client)
 
@@ -0,0 +1,200 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+const (
+	defaultReportDays      = 7
+	defaultReportStaleDays = 14
+	hoursPerDay            = 24
+)
+
+// ReportOptions holds options for the report command
+type ReportOptions struct {
+	ProjectRef   string
+	Template     string
+	Output       string
+	Publish      string
+	Category     string
+	Title        string
+	StatusField  string
+	DoneOption   string
+	BlockedLabel string
+	Format       string
+	Days         int
+	StaleDays    int
+}
+
+// NewReportCmd creates the report command
+func NewReportCmd() *cobra.Command {
+	opts := &ReportOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "report <owner/project-number>",
+		Short: "Generate a Markdown project report from a template",
+		Long: `Generate a project report from a Go template.
+
+Every item of the project is fetched and the computed data is passed to the
+template. A built-in Markdown template is used unless --template is given.
+The template can use:
+
+  .Project      Title, URL, Owner and Number of the project
+  .Since .Until The reporting window (see --days)
+  .Total .Open .Done
+                Item counts
+  .ByStatus     Status and Count for each status option
+  .Completed    Items closed or marked done within the window
+  .Added        Items added to the project within the window
+  .Assignees    Assignee with their Open and Done item counts
+  .Iteration    The current iteration of the first iteration field, with
+                Field, Title, Start, End, Items, Total and Done (nil if none)
+  .Stale        Open items not updated for --stale-days
+  .Blocked      Open items with a status containing "blocked" or the
+                --blocked-label label
+
+Items have Title, URL, Number, Status, Assignees, Labels, UpdatedAt and Done.
+The date, join and percent functions format times as YYYY-MM-DD, join
+strings and show a count as a percentage of a total.
+
+With --publish the report is posted as a discussion in the given repository
+and category. Use --format json to see the data passed to the template.
+
+Examples:
+  ghx analytics report octocat/123
+  ghx analytics report octocat/123 --template weekly.md.tmpl --output weekly.md
+  ghx analytics report octocat/123 --days 14 --stale-days 30
+  ghx analytics report octocat/123 --publish octocat/app --category announcements`,
+
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runReport(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Template, "template", "t", "", "Go template file for the report (default: built-in Markdown)")
+	cmd.Flags().StringVarP(&opts.Output, "output", "o", "", "Write the report to a file")
+	cmd.Flags().StringVar(&opts.Publish, "publish", "", "Post the report as a discussion in a repository (owner/repo)")
+	cmd.Flags().StringVar(&opts.Category, "category", "announcements", "Discussion category slug for --publish")
+	cmd.Flags().StringVar(&opts.Title, "title", "", "Discussion title for --publish (default: project title and date)")
+	cmd.Flags().IntVar(&opts.Days, "days", defaultReportDays, "Number of days completed and added items are counted for")
+	cmd.Flags().IntVar(&opts.StaleDays, "stale-days", defaultReportStaleDays, "Days without updates after which open items are stale")
+	cmd.Flags().StringVar(&opts.StatusField, "status-field", "", "Single select field holding item status (default: Status)")
+	cmd.Flags().StringVar(&opts.DoneOption, "done-option", "", "Status option marking items as done (default: Done)")
+	cmd.Flags().StringVar(&opts.BlockedLabel, "blocked-label", "", "Label marking items as blocked (default: blocked)")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"category":     completion.Categories(completion.Flag("publish", "")),
+		"status-field": completion.Fields(completion.Arg(0), "Single Select"),
+		"done-option":  completion.Options(completion.Arg(0), completion.Flag("status-field", "Status")),
+	})
+
+	return cmd
+}
+
+func runReport(ctx context.Context, opts *ReportOptions) error {
+	if opts.Format != FormatTable && opts.Format != FormatJSON {
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+	if opts.Days <= 0 || opts.StaleDays <= 0 {
+		return fmt.Errorf("--days and --stale-days must be greater than 0")
+	}
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+	var repoOwner, repoName string
+	if opts.Publish != "" {
+		if repoOwner, repoName, err = service.ParseRepositoryReference(opts.Publish); err != nil {
+			return err
+		}
+	}
+	text, err := service.LoadReportTemplate(opts.Template)
+	if err != nil {
+		return err
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	client := api.NewClientWithTokenSource(tokenSource)
+	project, err := service.NewProjectService(client).GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	now := time.Now()
+	report, err := service.NewAnalyticsService(client).BuildProjectReport(ctx, project, now, service.ReportOptions{
+		StatusField:  opts.StatusField,
+		DoneOption:   opts.DoneOption,
+		BlockedLabel: opts.BlockedLabel,
+		Window:       time.Duration(opts.Days) * hoursPerDay * time.Hour,
+		StaleAfter:   time.Duration(opts.StaleDays) * hoursPerDay * time.Hour,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build report: %w", err)
+	}
+
+	if opts.Format == FormatJSON {
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	body, err := service.RenderProjectReport(text, report)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case opts.Output != "":
+		if err := os.WriteFile(opts.Output, []byte(body), 0o600); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+		fmt.Printf("✅ Wrote report to %s\n", opts.Output)
+	case opts.Publish == "":
+		fmt.Print(body)
+	}
+
+	if opts.Publish == "" {
+		return nil
+	}
+	title := opts.Title
+	if title == "" {
+		title = fmt.Sprintf("%s report %s", project.Title, now.Format(service.DateLayout))
+	}
+	discussion, err := service.NewDiscussionService(client).CreateDiscussion(ctx, service.CreateDiscussionOptions{
+		Owner:    repoOwner,
+		Repo:     repoName,
+		Category: opts.Category,
+		Title:    title,
+		Body:     body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to publish report: %w", err)
+	}
+	fmt.Printf("✅ Published report as discussion #%d\n", discussion.Number)
+	fmt.Printf("URL: %s\n", discussion.URL)
+	return nil
+}
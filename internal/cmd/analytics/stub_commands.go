@@ -18,7 +18,7 @@ This command analyzes team velocity metrics including:
 • Velocity trends and patterns over time
 • Lead time and cycle time analysis
 • Throughput and capacity utilization
-• Burndown and burnup charts data
+• Burndown and burnup charts (available now as analytics burndown)
 
 Examples:
   ghx analytics velocity octocat/123
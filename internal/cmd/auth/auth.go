@@ -13,24 +13,39 @@ func NewAuthCmd() *cobra.Command {
 
 This command group provides authentication management capabilities including:
 
+• Log in and save named profiles for different accounts and hosts
+• Switch between profiles and log out
 • Check authentication status and token validity
 • View available and required scopes
 • Get recommendations for authentication setup
 
 Authentication is handled through GitHub CLI integration with fallback to
-environment variables (GITHUB_TOKEN or GH_TOKEN).
+environment variables (GITHUB_TOKEN or GH_TOKEN). Classic personal access
+tokens need the repo and project scopes; fine-grained tokens and GitHub App
+installation tokens need read and write access to Projects.
+
+For headless use, ghx can authenticate as a GitHub App installation. Set
+app.id, app.installation-id and app.private-key-file in ~/.ghx.yaml (or
+GHX_APP_ID, GHX_APP_INSTALLATION_ID and GHX_APP_PRIVATE_KEY_FILE) and ghx
+mints installation tokens and refreshes them automatically.
 
 For initial setup, authenticate with GitHub CLI:
   gh auth login
 
 For more information about GitHub CLI authentication:
 https://docs.github.com/en/github-cli/github-cli/about-github-cli`,
-		Example: `  ghx auth status                     # Check authentication status
-  ghx auth status --format json       # Show status as JSON`,
+		Example: `  ghx auth login --profile work       # Log in and save the "work" profile
+  ghx auth switch personal            # Make "personal" the active profile
+  ghx auth status                     # Check authentication status
+  ghx auth status --format json       # Show status as JSON
+  ghx project list --profile work     # Use a profile for a single command`,
 	}
 
 	// Add subcommands
+	cmd.AddCommand(NewLoginCmd())
+	cmd.AddCommand(NewLogoutCmd())
 	cmd.AddCommand(NewStatusCmd())
+	cmd.AddCommand(NewSwitchCmd())
 
 	return cmd
 }
@@ -0,0 +1,187 @@
+package auth
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/oauth2"
+
+	"github.com/roboco-io/ghx-cli/internal/auth"
+)
+
+// LoginOptions holds options for the login command
+type LoginOptions struct {
+	Profile   string
+	Host      string
+	Source    string
+	TokenEnv  string
+	TokenFile string
+	Org       string
+	User      string
+	ClientID  string
+	WithToken bool
+}
+
+// NewLoginCmd creates the login command
+func NewLoginCmd() *cobra.Command {
+	opts := &LoginOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Log in and save a named profile",
+		Long: `Log in to GitHub and save the credentials as a named profile in ~/.ghx.yaml.
+
+Each profile has a host, a token source and an optional default owner:
+
+• oauth  Log in with the OAuth device flow (default); the token is stored
+         in ~/.ghx/tokens/<profile>
+• gh     Use the token of the GitHub CLI for the profile host
+• env    Read the token from an environment variable (--token-env)
+• file   Read the token from a file (--token-file); use --with-token to
+         store a token read from standard input
+
+The profile becomes the active profile. Use 'ghx auth switch' to change the
+active profile, or --profile to select one for a single command.
+
+Device flow login needs the client ID of an OAuth app with device flow
+enabled, given with --client-id or oauth-client-id in ~/.ghx.yaml.`,
+		Example: `  ghx auth login --profile personal --client-id Iv1.abc123
+  ghx auth login --profile work-bot --source env --token-env WORK_BOT_TOKEN --org mycorp
+  ghx auth login --profile ghes --host github.example.com --source gh
+  echo "$TOKEN" | ghx auth login --profile ci --source file --with-token`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			opts.Profile = auth.ActiveProfileName()
+			return runLogin(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Host, "host", auth.DefaultHost, "GitHub host (e.g. a GitHub Enterprise Server hostname)")
+	cmd.Flags().StringVar(&opts.Source, "source", string(auth.ProfileTokenSourceOAuth), "Token source: oauth, gh, env, file")
+	cmd.Flags().StringVar(&opts.TokenEnv, "token-env", "", "Environment variable holding the token (env source)")
+	cmd.Flags().StringVar(&opts.TokenFile, "token-file", "", "File holding the token (file source)")
+	cmd.Flags().StringVar(&opts.Org, "org", "", "Default organization for the profile")
+	cmd.Flags().StringVar(&opts.User, "user", "", "Default user for the profile")
+	cmd.Flags().StringVar(&opts.ClientID, "client-id", "", "OAuth app client ID for device flow login")
+	cmd.Flags().BoolVar(&opts.WithToken, "with-token", false, "Read a token from standard input and store it (file source)")
+
+	return cmd
+}
+
+func runLogin(ctx context.Context, opts *LoginOptions) error {
+	if opts.Profile == "" {
+		return fmt.Errorf("profile name is required; pass --profile <name>")
+	}
+	if err := auth.ValidateProfileName(opts.Profile); err != nil {
+		return err
+	}
+
+	source, err := auth.ValidateProfileTokenSource(opts.Source)
+	if err != nil {
+		return err
+	}
+	if opts.WithToken && source != auth.ProfileTokenSourceFile {
+		return fmt.Errorf("--with-token requires --source file")
+	}
+	if source == auth.ProfileTokenSourceFile && opts.TokenFile == "" && !opts.WithToken {
+		return fmt.Errorf("--token-file or --with-token is required for the file source")
+	}
+
+	profile := &auth.Profile{
+		Name:        opts.Profile,
+		TokenSource: source,
+		TokenEnv:    opts.TokenEnv,
+		TokenFile:   opts.TokenFile,
+		Org:         opts.Org,
+		User:        opts.User,
+	}
+	if opts.Host != auth.DefaultHost {
+		profile.Host = opts.Host
+	}
+
+	// A token from device login or standard input is only stored once it is
+	// known to work, so a rejected token never lingers on disk
+	var token string
+	switch {
+	case source == auth.ProfileTokenSourceOAuth:
+		clientID := opts.ClientID
+		if clientID == "" {
+			clientID = auth.OAuthClientID()
+		}
+		token, err = auth.DeviceLogin(ctx, profile.Hostname(), clientID, promptDeviceCode)
+		if err != nil {
+			return err
+		}
+	case opts.WithToken:
+		token, err = readTokenFromStdin()
+		if err != nil {
+			return err
+		}
+	}
+
+	var info *auth.TokenInfo
+	if token != "" {
+		info, err = profile.ValidateToken(token)
+		if err != nil {
+			return err
+		}
+		if err := profile.SaveToken(token); err != nil {
+			return err
+		}
+	} else {
+		info, err = profile.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := saveProfile(profile); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Logged in to %s as %s (%s token)\n", profile.Hostname(), info.Login, info.Type)
+	fmt.Printf("✓ Profile %s saved and active\n", profile.Name)
+
+	return nil
+}
+
+// saveProfile writes the profile to the config file and makes it active
+func saveProfile(profile *auth.Profile) error {
+	path, err := auth.ConfigFilePath()
+	if err != nil {
+		return err
+	}
+
+	config, err := auth.LoadConfigFile(path)
+	if err != nil {
+		return err
+	}
+	if err := config.SetProfile(profile); err != nil {
+		return err
+	}
+	config.SetActiveProfile(profile.Name)
+
+	return config.Save()
+}
+
+func promptDeviceCode(deviceAuth *oauth2.DeviceAuthResponse) {
+	fmt.Fprintf(os.Stderr, "! First copy your one-time code: %s\n", deviceAuth.UserCode)
+	fmt.Fprintf(os.Stderr, "  Then open %s in your browser and paste the code.\n", deviceAuth.VerificationURI)
+	fmt.Fprintf(os.Stderr, "  Waiting for authorization...\n")
+}
+
+func readTokenFromStdin() (string, error) {
+	line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	token := strings.TrimSpace(line)
+	if token == "" {
+		if err != nil {
+			return "", fmt.Errorf("failed to read token from standard input: %w", err)
+		}
+		return "", fmt.Errorf("no token provided on standard input")
+	}
+	return token, nil
+}
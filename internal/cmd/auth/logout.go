@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/auth"
+)
+
+// NewLogoutCmd creates the logout command
+func NewLogoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logout",
+		Short: "Log out of a profile",
+		Long: `Remove a profile from ~/.ghx.yaml.
+
+Tokens stored by device flow login are deleted. Tokens provided by the
+GitHub CLI, environment variables or token files you manage are left alone.
+Without --profile, the active profile is logged out.`,
+		Example: `  ghx auth logout                  # Log out of the active profile
+  ghx auth logout --profile work-bot`,
+		Args: cobra.NoArgs,
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runLogout(auth.ActiveProfileName())
+		},
+	}
+
+	return cmd
+}
+
+func runLogout(name string) error {
+	if name == "" {
+		return fmt.Errorf("no active profile; pass --profile <name>")
+	}
+
+	profiles, err := auth.LoadProfiles()
+	if err != nil {
+		return err
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return fmt.Errorf("profile %q not found; available profiles: %v", name, auth.ProfileNames(profiles))
+	}
+
+	if profile.TokenSource == auth.ProfileTokenSourceOAuth {
+		if err := profile.RemoveToken(); err != nil {
+			return err
+		}
+	}
+
+	path, err := auth.ConfigFilePath()
+	if err != nil {
+		return err
+	}
+	config, err := auth.LoadConfigFile(path)
+	if err != nil {
+		return err
+	}
+	config.RemoveProfile(name)
+
+	if config.ActiveProfile() == name {
+		config.SetActiveProfile("")
+	}
+	if err := config.Save(); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Logged out of profile %s (%s)\n", profile.Name, profile.Hostname())
+	return nil
+}
@@ -28,7 +28,8 @@ This command checks:
 • GitHub CLI installation
 • Token availability (from gh CLI or environment)
 • Token validity with GitHub API
-• Required scopes for GitHub Projects
+• Token type (classic, fine-grained, GitHub App installation)
+• Required scopes or permissions for GitHub Projects
 
 Examples:
   ghx auth status                 # Show status in table format
@@ -72,6 +73,14 @@ func outputStatusTable(status auth.Status) error {
 	fmt.Printf("\nDetails:\n")
 	fmt.Printf("--------\n")
 
+	if status.Profile != "" {
+		fmt.Printf("ℹ️  Profile: %s (%s)\n", status.Profile, status.Host)
+	}
+
+	if status.GitHubApp {
+		fmt.Printf("✅ GitHub App: Configured\n")
+	}
+
 	if status.GHCLIInstalled {
 		fmt.Printf("✅ GitHub CLI: Installed\n")
 	} else {
@@ -98,20 +107,32 @@ func outputStatusTable(status auth.Status) error {
 		fmt.Printf("➖ Token Validity: N/A\n")
 	}
 
+	if status.TokenType != "" {
+		fmt.Printf("ℹ️  Token Type: %s\n", status.TokenType)
+	}
+
+	access := "Scopes"
+	if status.TokenType != "" && !status.TokenType.UsesScopes() {
+		access = "Permissions"
+	}
+
 	if status.HasRequiredScopes {
-		fmt.Printf("✅ Required Scopes: Available\n")
+		fmt.Printf("✅ Required %s: Available\n", access)
 	} else if status.TokenValid {
-		fmt.Printf("❌ Required Scopes: Missing\n")
+		fmt.Printf("❌ Required %s: Missing\n", access)
 	} else {
-		fmt.Printf("➖ Required Scopes: N/A\n")
+		fmt.Printf("➖ Required %s: N/A\n", access)
 	}
 
 	// Scopes information
 	if len(status.Scopes) > 0 {
 		fmt.Printf("\nAvailable Scopes: %v\n", status.Scopes)
 	}
+	if len(status.Permissions) > 0 {
+		fmt.Printf("\nAvailable Permissions: %v\n", status.Permissions)
+	}
 	if len(status.RequiredScopes) > 0 {
-		fmt.Printf("Required Scopes: %v\n", status.RequiredScopes)
+		fmt.Printf("Required %s: %v\n", access, status.RequiredScopes)
 	}
 
 	// Error information
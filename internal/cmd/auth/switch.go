@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/auth"
+)
+
+// NewSwitchCmd creates the switch command
+func NewSwitchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "switch <profile>",
+		Short: "Switch the active profile",
+		Long: `Make a saved profile the active profile.
+
+The active profile is stored as 'profile' in ~/.ghx.yaml and is used by all
+commands unless --profile or GHX_PROFILE selects another one.`,
+		Example: `  ghx auth switch work-bot
+  ghx auth switch personal`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(_ *cobra.Command, args []string) error {
+			return runSwitch(args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runSwitch(name string) error {
+	profiles, err := auth.LoadProfiles()
+	if err != nil {
+		return err
+	}
+	profile, ok := profiles[name]
+	if !ok {
+		return fmt.Errorf("profile %q not found; available profiles: %v", name, auth.ProfileNames(profiles))
+	}
+
+	path, err := auth.ConfigFilePath()
+	if err != nil {
+		return err
+	}
+	config, err := auth.LoadConfigFile(path)
+	if err != nil {
+		return err
+	}
+	config.SetActiveProfile(name)
+	if err := config.Save(); err != nil {
+		return err
+	}
+
+	fmt.Printf("✓ Switched to profile %s (%s)\n", profile.Name, profile.Hostname())
+	return nil
+}
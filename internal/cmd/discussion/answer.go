@@ -62,13 +62,13 @@ func runAnswer(ctx context.Context, opts *AnswerOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	discussionService := service.NewDiscussionService(client)
 
 	if opts.Unmark {
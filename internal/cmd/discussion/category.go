@@ -69,13 +69,13 @@ func runCategoryList(ctx context.Context, opts *CategoryListOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	discussionService := service.NewDiscussionService(client)
 
 	// List categories
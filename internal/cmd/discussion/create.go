@@ -9,6 +9,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -50,6 +51,10 @@ Use 'ghp discussion category list' to see available categories.`,
 	_ = cmd.MarkFlagRequired("title")
 	_ = cmd.MarkFlagRequired("body")
 
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"category": completion.Categories(completion.Arg(0)),
+	})
+
 	return cmd
 }
 
@@ -62,13 +67,13 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	discussionService := service.NewDiscussionService(client)
 
 	// Create discussion
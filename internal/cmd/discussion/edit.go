@@ -10,6 +10,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -69,6 +70,10 @@ At least one of --title, --body, or --category must be specified.`,
 	cmd.Flags().StringVarP(&category, "category", "c", "", "New category slug")
 	cmd.Flags().StringVar(&opts.Format, "format", formatDetails, "Output format: details, json")
 
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"category": completion.Categories(completion.Arg(0)),
+	})
+
 	return cmd
 }
 
@@ -81,13 +86,13 @@ func runEdit(ctx context.Context, opts *EditOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	discussionService := service.NewDiscussionService(client)
 
 	// Update discussion
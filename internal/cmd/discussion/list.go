@@ -10,6 +10,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -68,6 +69,10 @@ The --answered and --unanswered flags are mutually exclusive.`,
 	cmd.Flags().BoolVar(&answered, "answered", false, "Show only answered discussions")
 	cmd.Flags().BoolVar(&unanswered, "unanswered", false, "Show only unanswered discussions")
 
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"category": completion.Categories(completion.Arg(0)),
+	})
+
 	return cmd
 }
 
@@ -80,13 +85,13 @@ func runList(ctx context.Context, opts *ListOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	discussionService := service.NewDiscussionService(client)
 
 	// List discussions
@@ -9,12 +9,14 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // AddOptionOptions holds options for the add-option command
 type AddOptionOptions struct {
 	FieldID     string
+	ProjectRef  string
 	Name        string
 	Color       string
 	Description string
@@ -26,21 +28,24 @@ func NewAddOptionCmd() *cobra.Command {
 	opts := &AddOptionOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "add-option <field-id> <name>",
+		Use:   "add-option <field> <name>",
 		Short: "Add option to single select field",
 		Long: `Add a new option to a single select field.
 
 This command only works with single select fields. You can specify the color
-and an optional description for the new option.
+and an optional description for the new option. The field is given by ID, or
+by name together with --project owner/number.
 
 Available colors: gray, red, orange, yellow, green, blue, purple, pink
 
 Examples:
   ghx field add-option field-id "Critical"
   ghx field add-option field-id "High" --color red
+  ghx field add-option Priority "Low" --project octocat/1
   ghx field add-option field-id "Urgent" --color red --description "Requires immediate attention"`,
 
-		Args: cobra.ExactArgs(2),
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", ""), "Single Select"), nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.FieldID = args[0]
 			opts.Name = args[1]
@@ -51,6 +56,9 @@ Examples:
 
 	cmd.Flags().StringVar(&opts.Color, "color", "gray", "Color for the option (gray, red, orange, yellow, green, blue, purple, pink)")
 	cmd.Flags().StringVar(&opts.Description, "description", "", "Optional description for the option")
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) to look up the field by name")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{"project": completion.Projects})
 
 	return cmd
 }
@@ -66,15 +74,20 @@ func runAddOption(ctx context.Context, opts *AddOptionOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	fieldService := service.NewFieldService(client)
 
+	fieldID, err := service.NewResolver(client).FieldID(ctx, opts.ProjectRef, opts.FieldID)
+	if err != nil {
+		return err
+	}
+
 	// Create field option
 	var description *string
 	if opts.Description != "" {
@@ -82,7 +95,7 @@ func runAddOption(ctx context.Context, opts *AddOptionOptions) error {
 	}
 
 	input := service.CreateFieldOptionInput{
-		FieldID:     opts.FieldID,
+		FieldID:     fieldID,
 		Name:        opts.Name,
 		Color:       normalizedColor,
 		Description: description,
@@ -0,0 +1,203 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// ConvertOptions holds options for the convert command
+type ConvertOptions struct {
+	FieldRef   string
+	ProjectRef string
+	To         string
+	Name       string
+	Duration   string
+	Format     string
+	Mappings   []string
+	Replace    bool
+	DryRun     bool
+	Force      bool
+}
+
+// NewConvertCmd creates the convert command
+func NewConvertCmd() *cobra.Command {
+	opts := &ConvertOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "convert <field> --project <owner/number> --to <type>",
+		Short: "Convert a field to another type",
+		Long: `Convert a field to another data type, copying every item's value.
+
+The type of a field cannot be changed on GitHub, so a new field is created
+and each item's value is converted and copied to it:
+
+  single_select - Options are made from the distinct values; options of a
+                  single select field keep their order and color
+  number        - Values are parsed as numbers; thousands separators are allowed
+  date          - Values are parsed as YYYY-MM-DD dates or timestamps
+  iteration     - Dates fall in the iteration containing them, and other values
+                  have to match an iteration title; iterations start at the
+                  earliest date and cover the latest one
+  text          - Values are copied as they are displayed
+
+Values are replaced with --map Old=New before they are converted; mapping a
+value to nothing leaves items with it empty. Values that cannot be converted
+are reported and left out.
+
+The new field is named after the old one and its new type unless --name is
+given. With --replace the old field is deleted and the new field takes over
+its name once every value has been converted; if any value fails, the old
+field is kept.
+
+Examples:
+  ghx field convert Priority --project octocat/1 --to single_select
+  ghx field convert Estimate --project octocat/1 --to number --map "XL=8" --map "n/a="
+  ghx field convert Due --project octocat/1 --to iteration --duration 2w --name Sprint
+  ghx field convert Priority --project octocat/1 --to single_select --replace --force`,
+
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", "")), nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.FieldRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runConvert(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) the field belongs to (required)")
+	cmd.Flags().StringVar(&opts.To, "to", "", "Type to convert to: single_select, number, date, iteration or text (required)")
+	cmd.Flags().StringVar(&opts.Name, "name", "", "Name of the new field")
+	cmd.Flags().StringArrayVar(&opts.Mappings, "map", nil, "Replace a value before converting it, as Old=New (repeatable)")
+	cmd.Flags().StringVar(&opts.Duration, "duration", "", "Iteration length for --to iteration, e.g. 2w")
+	cmd.Flags().BoolVar(&opts.Replace, "replace", false, "Delete the old field and give its name to the new one")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would be converted without changing anything")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+	_ = cmd.MarkFlagRequired("project")
+	_ = cmd.MarkFlagRequired("to")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"project": completion.Projects,
+	})
+
+	return cmd
+}
+
+func runConvert(ctx context.Context, opts *ConvertOptions) error {
+	dataType, err := service.ValidateFieldType(opts.To)
+	if err != nil {
+		return err
+	}
+	if opts.Replace && opts.Name != "" {
+		return fmt.Errorf("cannot use --name with --replace")
+	}
+	if opts.Format != formatTable && opts.Format != formatJSON {
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+	mapping := make(map[string]string, len(opts.Mappings))
+	for _, m := range opts.Mappings {
+		old, value, parseErr := service.ParseFieldValueMapping(m)
+		if parseErr != nil {
+			return parseErr
+		}
+		mapping[old] = value
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	client := api.NewClientWithTokenSource(tokenSource)
+	project, field, err := loadProjectField(ctx, client, opts.ProjectRef, opts.FieldRef)
+	if err != nil {
+		return err
+	}
+
+	if opts.Replace && !opts.DryRun && !opts.Force {
+		fmt.Printf("⚠️  Field '%s' will be deleted once all its values are converted to %s.\n",
+			field.Name, service.FormatFieldDataType(dataType))
+		fmt.Printf("Type 'DELETE' to confirm: ")
+
+		var confirmation string
+		if _, scanErr := fmt.Scanln(&confirmation); scanErr != nil {
+			fmt.Println("❌ Failed to read confirmation.")
+			return scanErr
+		}
+		if confirmation != "DELETE" {
+			fmt.Println("❌ Conversion canceled.")
+			return nil
+		}
+	}
+
+	result, err := service.NewFieldService(client).ConvertField(ctx, service.FieldConversionInput{
+		Project:  project,
+		Field:    field,
+		Mapping:  mapping,
+		DataType: dataType,
+		Name:     opts.Name,
+		Duration: opts.Duration,
+		Replace:  opts.Replace,
+		DryRun:   opts.DryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to convert field: %w", err)
+	}
+
+	if opts.Format == formatJSON {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	typeName := service.FormatFieldDataType(dataType)
+	if opts.DryRun {
+		fmt.Printf("Would create %s field '%s' and convert %d value(s)\n", typeName, result.Name, result.Converted)
+	} else {
+		fmt.Printf("✅ Created %s field '%s' and converted %d value(s)\n", typeName, result.Name, result.Converted)
+	}
+	if len(result.Options) > 0 {
+		fmt.Printf("  Options: %s\n", strings.Join(result.Options, ", "))
+	}
+	if len(result.Failures) > 0 {
+		fmt.Printf("\n⚠️  %d value(s) could not be converted:\n", len(result.Failures))
+		for _, failure := range result.Failures {
+			fmt.Printf("  %s (%q): %s\n", failure.Title, failure.Value, failure.Reason)
+		}
+	}
+	switch {
+	case result.Replaced:
+		fmt.Printf("\nField '%s' was deleted and replaced.\n", field.Name)
+	case opts.Replace && !opts.DryRun:
+		fmt.Printf("\nField '%s' was kept because some values could not be converted.\n", field.Name)
+	}
+	return nil
+}
+
+// loadProjectField loads a project and one of its fields, given by ID or name
+func loadProjectField(ctx context.Context, client *api.Client, projectRef, fieldRef string) (*graphql.ProjectV2, *graphql.ProjectV2Field, error) {
+	resolver := service.NewResolver(client)
+	project, err := resolver.Project(ctx, projectRef)
+	if err != nil {
+		return nil, nil, err
+	}
+	field, err := resolver.Field(ctx, projectRef, fieldRef)
+	if err != nil {
+		return nil, nil, err
+	}
+	return project, field, nil
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -23,6 +25,7 @@ type CreateOptions struct {
 	Format     string
 	Options    []string
 	Duration   string
+	Start      string
 	Number     int
 	Org        bool
 }
@@ -47,7 +50,9 @@ Field Types:
   iteration    - Iteration field for sprint/cycle planning
 
 For single select fields, you can provide initial options using --options.
-For iteration fields, you can specify duration using --duration.
+For iteration fields, you can specify the duration with --duration and the
+start of the first iteration with --start. Three iterations are created;
+use 'ghx field iterations' to manage them afterwards.
 
 Examples:
   # Traditional syntax
@@ -61,7 +66,8 @@ Examples:
   ghx field create octocat/123 "Status" single_select --options "Todo,In Progress,Done"
   ghx field create --org myorg/456 "Sprint" iteration`,
 
-		Args: cobra.MaximumNArgs(3),
+		Args:              cobra.MaximumNArgs(3),
+		ValidArgsFunction: completion.Args(completion.Projects, nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Support both traditional args and new flag-based syntax
 			if len(args) == 3 {
@@ -81,7 +87,8 @@ Examples:
 	cmd.Flags().StringVar(&opts.ProjectID, "project-id", "", "Project ID (alternative to owner/number)")
 	cmd.Flags().StringVar(&opts.Name, "name", "", "Field name")
 	cmd.Flags().StringVar(&opts.FieldType, "type", "", "Field type (text, number, date, single_select, iteration)")
-	cmd.Flags().StringVar(&opts.Duration, "duration", "", "Duration for iteration field (e.g., 2w, 1m)")
+	cmd.Flags().StringVar(&opts.Duration, "duration", "", "Duration for iteration field (e.g., 2w, 10d)")
+	cmd.Flags().StringVar(&opts.Start, "start", "", "Start date of the first iteration (YYYY-MM-DD, default today)")
 
 	return cmd
 }
@@ -125,15 +132,22 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 		return err
 	}
 
+	var iterationStart time.Time
+	if opts.Start != "" {
+		if iterationStart, err = service.ParseDate(opts.Start); err != nil {
+			return err
+		}
+	}
+
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and services
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	fieldService := service.NewFieldService(client)
 	projectService := service.NewProjectService(client)
 
@@ -162,6 +176,7 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 		DataType:            dataType,
 		SingleSelectOptions: opts.Options,
 		Duration:            opts.Duration,
+		IterationStart:      iterationStart,
 	}
 
 	field, err := fieldService.CreateField(ctx, input)
@@ -193,9 +208,9 @@ func outputCreatedFieldTable(field *graphql.ProjectV2Field, projectName string)
 	fmt.Printf("  Type: %s\n", service.FormatFieldDataType(field.DataType))
 
 	// Show options if single select field
-	if len(field.Options.Nodes) > 0 {
+	if len(field.SingleSelect.Options) > 0 {
 		fmt.Printf("  Options:\n")
-		for _, option := range field.Options.Nodes {
+		for _, option := range field.SingleSelect.Options {
 			fmt.Printf("    • %s (%s)", option.Name, service.FormatColor(option.Color))
 			if option.Description != nil && *option.Description != "" {
 				fmt.Printf(" - %s", *option.Description)
@@ -204,6 +219,14 @@ func outputCreatedFieldTable(field *graphql.ProjectV2Field, projectName string)
 		}
 	}
 
+	// Show iterations if iteration field
+	if iterations := field.Iteration.Configuration.Iterations; len(iterations) > 0 {
+		fmt.Printf("  Iterations:\n")
+		for _, iteration := range iterations {
+			fmt.Printf("    • %s (starts %s, %d days)\n", iteration.Title, iteration.StartDate, iteration.Duration)
+		}
+	}
+
 	return nil
 }
 
@@ -213,9 +236,9 @@ func outputCreatedFieldJSON(field *graphql.ProjectV2Field) error {
 	fmt.Printf("  \"name\": \"%s\",\n", field.Name)
 	fmt.Printf("  \"dataType\": \"%s\"", field.DataType)
 
-	if len(field.Options.Nodes) > 0 {
+	if len(field.SingleSelect.Options) > 0 {
 		fmt.Printf(",\n  \"options\": [\n")
-		for i, option := range field.Options.Nodes {
+		for i, option := range field.SingleSelect.Options {
 			fmt.Printf("    {\n")
 			fmt.Printf("      \"id\": \"%s\",\n", option.ID)
 			fmt.Printf("      \"name\": \"%s\",\n", option.Name)
@@ -224,7 +247,7 @@ func outputCreatedFieldJSON(field *graphql.ProjectV2Field) error {
 				fmt.Printf(",\n      \"description\": \"%s\"", *option.Description)
 			}
 			fmt.Printf("\n    }")
-			if i < len(field.Options.Nodes)-1 {
+			if i < len(field.SingleSelect.Options)-1 {
 				fmt.Printf(",")
 			}
 			fmt.Printf("\n")
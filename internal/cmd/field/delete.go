@@ -6,16 +6,19 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // NewDeleteCmd creates the delete command
 func NewDeleteCmd() *cobra.Command {
 	config := DeleteCommandConfig{
-		Use:   "delete <field-id>",
+		Use:   "delete <field>",
 		Short: "Delete a project field",
 		Long: `Delete a project field and all its data.
 
+The field is given by ID, or by name together with --project owner/number.
+
 ⚠️  WARNING: This action is irreversible. All field data for project items
 will be permanently lost. Use with caution.
 
@@ -24,8 +27,11 @@ the confirmation prompt.
 
 Examples:
   ghx field delete field-id
+  ghx field delete Priority --project octocat/1
   ghx field delete field-id --force`,
 		ItemType: "field",
+		Resolve:  (*service.Resolver).FieldID,
+		Complete: completion.Args(completion.Fields(completion.Flag("project", ""))),
 		ServiceAction: func(ctx context.Context, client *api.Client, fieldID string) error {
 			fieldService := service.NewFieldService(client)
 			input := service.DeleteFieldInput{
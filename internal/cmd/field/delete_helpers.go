@@ -8,21 +8,27 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // CommonDeleteOptions represents common options for delete operations
 type CommonDeleteOptions struct {
-	ID    string
-	Force bool
+	ID         string
+	ProjectRef string
+	Force      bool
 }
 
 // DeleteCommandConfig holds configuration for creating delete commands
 type DeleteCommandConfig struct {
 	ServiceAction func(context.Context, *api.Client, string) error
-	Use           string
-	Short         string
-	Long          string
-	ItemType      string
+	// Resolve translates the argument into a node ID, using the --project flag
+	Resolve  func(*service.Resolver, context.Context, string, string) (string, error)
+	Complete cobra.CompletionFunc
+	Use      string
+	Short    string
+	Long     string
+	ItemType string
 }
 
 // createDeleteCmd creates a standardized delete command
@@ -30,40 +36,49 @@ func createDeleteCmd(config DeleteCommandConfig) *cobra.Command {
 	opts := &CommonDeleteOptions{}
 
 	cmd := &cobra.Command{
-		Use:   config.Use,
-		Short: config.Short,
-		Long:  config.Long,
-		Args:  cobra.ExactArgs(1),
+		Use:               config.Use,
+		Short:             config.Short,
+		Long:              config.Long,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: config.Complete,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ID = args[0]
-			return executeDelete(cmd.Context(), opts, config.ItemType, config.ServiceAction)
+			return executeDelete(cmd.Context(), opts, config)
 		},
 	}
 
 	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) to look up the "+config.ItemType+" by name")
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{"project": completion.Projects})
 	return cmd
 }
 
 // executeDelete handles the common delete workflow
-func executeDelete(
-	ctx context.Context,
-	opts *CommonDeleteOptions,
-	itemType string,
-	serviceAction func(context.Context, *api.Client, string) error,
-) error {
+func executeDelete(ctx context.Context, opts *CommonDeleteOptions, config DeleteCommandConfig) error {
+	itemType := config.ItemType
+
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
+
+	id, err := config.Resolve(service.NewResolver(client), ctx, opts.ProjectRef, opts.ID)
+	if err != nil {
+		return err
+	}
+	label := opts.ID
+	if id != opts.ID {
+		label = fmt.Sprintf("%s (%s)", opts.ID, id)
+	}
 
 	// Show confirmation unless --force is used
 	if !opts.Force {
-		fmt.Printf("⚠️  You are about to delete %s: %s\n", itemType, opts.ID)
+		fmt.Printf("⚠️  You are about to delete %s: %s\n", itemType, label)
 		fmt.Printf("\nThis action cannot be undone. All %s data will be permanently lost.\n", itemType)
 		fmt.Printf("Type 'DELETE' to confirm: ")
 
@@ -81,11 +96,11 @@ func executeDelete(
 	}
 
 	// Execute the service action
-	err = serviceAction(ctx, client, opts.ID)
+	err = config.ServiceAction(ctx, client, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete %s: %w", itemType, err)
 	}
 
-	fmt.Printf("✅ %s %s deleted successfully.\n", itemType, opts.ID)
+	fmt.Printf("✅ %s %s deleted successfully.\n", itemType, label)
 	return nil
 }
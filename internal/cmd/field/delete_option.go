@@ -12,20 +12,26 @@ import (
 // NewDeleteOptionCmd creates the delete-option command
 func NewDeleteOptionCmd() *cobra.Command {
 	config := DeleteCommandConfig{
-		Use:   "delete-option <option-id>",
+		Use:   "delete-option <option>",
 		Short: "Delete single select field option",
 		Long: `Delete an option from a single select field.
 
+The option is given by ID, or as Field:Option together with --project
+owner/number.
+
 ⚠️  WARNING: This action is irreversible. Items that currently have this
-option selected will lose their field value. Use with caution.
+option selected will lose their field value. Use with caution, or use
+merge-option to move those items to another option first.
 
 By default, this command will prompt for confirmation. Use --force to skip
 the confirmation prompt.
 
 Examples:
   ghx field delete-option option-id
+  ghx field delete-option "Priority:Low" --project octocat/1
   ghx field delete-option option-id --force`,
 		ItemType: "field option",
+		Resolve:  (*service.Resolver).OptionID,
 		ServiceAction: func(ctx context.Context, client *api.Client, optionID string) error {
 			fieldService := service.NewFieldService(client)
 			input := service.DeleteFieldOptionInput{
@@ -22,6 +22,9 @@ This command group provides comprehensive field management capabilities:
 • Update field names and properties
 • Delete fields from projects
 • Manage single select field options (add, update, delete)
+• Merge, reorder and sync single select options from a file
+• Manage the iterations of iteration fields
+• Convert fields to another type, copying their values
 
 Field Types:
   text         - Text field for arbitrary text input
@@ -38,7 +41,11 @@ https://docs.github.com/en/issues/planning-and-tracking-with-projects`,
   ghx field create octocat/123 "Status" single_select --options "Todo,In Progress,Done"
   ghx field update field-id --name "New Priority"  # Rename field
   ghx field delete field-id --force                # Delete field
-  ghx field add-option field-id "Critical" --color red  # Add select option`,
+  ghx field add-option field-id "Critical" --color red  # Add select option
+  ghx field merge-option Status --project octocat/123 --from "In Review" --into Review
+  ghx field set-options Status -f status.yaml --project octocat/123  # Sync options
+  ghx field iterations list field-id               # List iterations
+  ghx field convert Priority --project octocat/123 --to single_select  # Change type`,
 	}
 
 	// Add subcommands
@@ -49,6 +56,11 @@ https://docs.github.com/en/issues/planning-and-tracking-with-projects`,
 	cmd.AddCommand(NewAddOptionCmd())
 	cmd.AddCommand(NewUpdateOptionCmd())
 	cmd.AddCommand(NewDeleteOptionCmd())
+	cmd.AddCommand(NewMergeOptionCmd())
+	cmd.AddCommand(NewReorderOptionsCmd())
+	cmd.AddCommand(NewSetOptionsCmd())
+	cmd.AddCommand(NewIterationsCmd())
+	cmd.AddCommand(NewConvertCmd())
 
 	return cmd
 }
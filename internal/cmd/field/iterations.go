@@ -0,0 +1,573 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+const iterationsSeparatorWidth = 80
+
+// IterationsOptions holds options shared by the iterations commands
+type IterationsOptions struct {
+	FieldRef   string
+	ProjectRef string
+	Format     string
+	Force      bool
+}
+
+// NewIterationsCmd creates the iterations command group
+func NewIterationsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iterations <command>",
+		Short: "Manage the iterations of an iteration field",
+		Long: `Manage the iterations of an iteration field.
+
+Iterations are listed as completed, current or upcoming relative to today.
+You can add upcoming iterations, insert breaks, change titles, start dates
+and durations, and remove iterations that are no longer needed.
+
+The field is given by ID, or by name together with --project owner/number.
+Iterations are given by ID, title, or one of @current, @next and @previous.
+
+GitHub recreates all iterations whenever the iterations of a field change:
+every iteration gets a new ID and all items lose their iteration value, even
+for iterations that did not change. The add, update and remove commands ask
+for confirmation when items have a value; use --force to skip the prompt.`,
+		Example: `  ghx field iterations list PVTIF_123
+  ghx field iterations list Sprint --project octocat/1
+  ghx field iterations add PVTIF_123 --count 2
+  ghx field iterations add PVTIF_123 --break 1w --title "Sprint 10"
+  ghx field iterations update PVTIF_123 @current --duration 3w --shift
+  ghx field iterations remove PVTIF_123 --completed`,
+	}
+
+	cmd.AddCommand(NewIterationsListCmd())
+	cmd.AddCommand(NewIterationsAddCmd())
+	cmd.AddCommand(NewIterationsUpdateCmd())
+	cmd.AddCommand(NewIterationsRemoveCmd())
+
+	return cmd
+}
+
+func addIterationFieldFlags(cmd *cobra.Command, opts *IterationsOptions) {
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) to look up the field by name")
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{"project": completion.Projects})
+}
+
+// NewIterationsListCmd creates the iterations list command
+func NewIterationsListCmd() *cobra.Command {
+	opts := &IterationsOptions{}
+	var state string
+
+	cmd := &cobra.Command{
+		Use:   "list <field>",
+		Short: "List the iterations of an iteration field",
+		Long: `List the completed, current and upcoming iterations of an iteration field.
+
+Examples:
+  ghx field iterations list PVTIF_123
+  ghx field iterations list Sprint --project octocat/1 --state upcoming
+  ghx field iterations list PVTIF_123 --format json`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", ""), "Iteration"), nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.FieldRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runIterationsList(cmd.Context(), opts, state)
+		},
+	}
+
+	addIterationFieldFlags(cmd, opts)
+	cmd.Flags().StringVar(&state, "state", "", "Only show completed, current or upcoming iterations")
+
+	return cmd
+}
+
+func runIterationsList(ctx context.Context, opts *IterationsOptions, state string) error {
+	switch state {
+	case "", service.IterationStateCompleted, service.IterationStateCurrent, service.IterationStateUpcoming:
+	default:
+		return fmt.Errorf("invalid state: %s (valid states: completed, current, upcoming)", state)
+	}
+
+	client, err := newIterationClient()
+	if err != nil {
+		return err
+	}
+
+	field, err := loadIterationField(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var iterations []service.IterationInfo
+	for _, iteration := range field.Iterations {
+		if state == "" || iteration.State(now) == state {
+			iterations = append(iterations, iteration)
+		}
+	}
+
+	return outputIterations(field, iterations, opts.Format, now)
+}
+
+// NewIterationsAddCmd creates the iterations add command
+func NewIterationsAddCmd() *cobra.Command {
+	opts := &IterationsOptions{}
+	var title, start, duration, gap string
+	var count int
+
+	cmd := &cobra.Command{
+		Use:   "add <field>",
+		Short: "Add iterations to an iteration field",
+		Long: `Add iterations after the last iteration of an iteration field.
+
+New iterations use the field's default duration unless --duration is given.
+Use --break to leave a gap (for example a holiday break) before the new
+iterations, or --start to place the first one on a specific date.
+
+Items lose their iteration value when iterations are added, so this asks for
+confirmation when items have a value unless --force is given.
+
+Examples:
+  ghx field iterations add PVTIF_123                     # Add the next iteration
+  ghx field iterations add PVTIF_123 --count 3           # Add three iterations
+  ghx field iterations add PVTIF_123 --break 1w          # Add one after a one week break
+  ghx field iterations add Sprint --project octocat/1 --title "Hardening" --duration 1w`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", ""), "Iteration"), nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.FieldRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runIterationsAdd(cmd.Context(), opts, title, start, duration, gap, count)
+		},
+	}
+
+	addIterationFieldFlags(cmd, opts)
+	cmd.Flags().StringVar(&title, "title", "", "Title of the new iteration (numbered when adding several)")
+	cmd.Flags().StringVar(&start, "start", "", "Start date of the first new iteration (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&duration, "duration", "", "Duration of the new iterations (e.g., 2w, 10d)")
+	cmd.Flags().StringVar(&gap, "break", "", "Break before the first new iteration (e.g., 1w, 3d)")
+	cmd.Flags().IntVar(&count, "count", 1, "Number of iterations to add")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runIterationsAdd(ctx context.Context, opts *IterationsOptions, title, start, duration, gap string, count int) error {
+	if count < 1 {
+		return fmt.Errorf("--count must be at least 1")
+	}
+	if start != "" && gap != "" {
+		return fmt.Errorf("--start and --break cannot be used together")
+	}
+
+	client, err := newIterationClient()
+	if err != nil {
+		return err
+	}
+	fieldService := service.NewFieldService(client)
+
+	field, err := loadIterationField(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+
+	template := service.IterationInfo{Title: title, Duration: field.Duration}
+	if duration != "" {
+		if template.Duration, err = service.ParseIterationDuration(duration); err != nil {
+			return err
+		}
+	}
+	if start != "" {
+		if template.StartDate, err = service.ParseDate(start); err != nil {
+			return err
+		}
+	}
+	if start == "" && len(field.Iterations) == 0 {
+		template.StartDate = service.Today()
+	}
+	breakDays := 0
+	if gap != "" {
+		if breakDays, err = service.ParseIterationDuration(gap); err != nil {
+			return fmt.Errorf("invalid break: %w", err)
+		}
+	}
+
+	if ok, confirmErr := confirmIterationChange(ctx, fieldService, field, opts.Force, "Adding iterations"); !ok || confirmErr != nil {
+		return confirmErr
+	}
+
+	iterations := service.AppendIterations(field.Iterations, template, count, breakDays)
+	updated, err := fieldService.SetIterations(ctx, field.ID, field.Duration, iterations)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Added %d iteration(s) to field '%s'\n\n", count, updated.Name)
+	return outputIterations(updated, updated.Iterations, opts.Format, time.Now())
+}
+
+// NewIterationsUpdateCmd creates the iterations update command
+func NewIterationsUpdateCmd() *cobra.Command {
+	opts := &IterationsOptions{}
+	var title, start, duration string
+	var shift bool
+
+	cmd := &cobra.Command{
+		Use:   "update <field> <iteration>",
+		Short: "Update an iteration",
+		Long: `Change the title, start date or duration of an iteration.
+
+With --shift, the iterations after it move by the same number of days so
+their spacing is kept. Without it, moving an iteration later opens a break
+before it, and the change fails if iterations would overlap.
+
+Items lose their iteration value when an iteration is updated, so this asks
+for confirmation when items have a value unless --force is given.
+
+Examples:
+  ghx field iterations update PVTIF_123 "Iteration 4" --title "Sprint 4"
+  ghx field iterations update PVTIF_123 @current --duration 3w --shift
+  ghx field iterations update PVTIF_123 @next --start 2025-01-06 --shift`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", ""), "Iteration"), nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.FieldRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runIterationsUpdate(cmd.Context(), opts, args[1], title, start, duration, shift)
+		},
+	}
+
+	addIterationFieldFlags(cmd, opts)
+	cmd.Flags().StringVar(&title, "title", "", "New title")
+	cmd.Flags().StringVar(&start, "start", "", "New start date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&duration, "duration", "", "New duration (e.g., 2w, 10d)")
+	cmd.Flags().BoolVar(&shift, "shift", false, "Move the following iterations by the same number of days")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runIterationsUpdate(
+	ctx context.Context,
+	opts *IterationsOptions,
+	ref, title, start, duration string,
+	shift bool,
+) error {
+	if title == "" && start == "" && duration == "" {
+		return fmt.Errorf("nothing to update: use --title, --start or --duration")
+	}
+
+	client, err := newIterationClient()
+	if err != nil {
+		return err
+	}
+	fieldService := service.NewFieldService(client)
+
+	field, err := loadIterationField(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+
+	target, err := service.ResolveIteration(field.Iterations, ref, time.Now())
+	if err != nil {
+		return err
+	}
+
+	updated := *target
+	if title != "" {
+		updated.Title = title
+	}
+	if start != "" {
+		if updated.StartDate, err = service.ParseDate(start); err != nil {
+			return err
+		}
+	}
+	if duration != "" {
+		if updated.Duration, err = service.ParseIterationDuration(duration); err != nil {
+			return err
+		}
+	}
+
+	iterations := field.Iterations
+	if shift {
+		days := int(updated.EndDate().Sub(target.EndDate()).Hours() / 24)
+		iterations = service.ShiftIterations(iterations, target.EndDate(), days)
+	}
+	for i := range iterations {
+		if iterations[i].ID == target.ID {
+			iterations[i] = updated
+		}
+	}
+
+	if ok, confirmErr := confirmIterationChange(ctx, fieldService, field, opts.Force, "Updating an iteration"); !ok || confirmErr != nil {
+		return confirmErr
+	}
+
+	result, err := fieldService.SetIterations(ctx, field.ID, field.Duration, iterations)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Iteration '%s' updated\n\n", updated.Title)
+	return outputIterations(result, result.Iterations, opts.Format, time.Now())
+}
+
+// NewIterationsRemoveCmd creates the iterations remove command
+func NewIterationsRemoveCmd() *cobra.Command {
+	opts := &IterationsOptions{}
+	var completed bool
+
+	cmd := &cobra.Command{
+		Use:   "remove <field> [iteration...]",
+		Short: "Remove iterations from an iteration field",
+		Long: `Remove iterations from an iteration field.
+
+All items lose their iteration value, including items assigned to iterations
+that are kept. By default, this command prompts for confirmation. Use --force
+to skip the prompt.
+
+Examples:
+  ghx field iterations remove PVTIF_123 "Iteration 2"
+  ghx field iterations remove PVTIF_123 --completed
+  ghx field iterations remove Sprint --project octocat/1 @previous --force`,
+		Args:              cobra.MinimumNArgs(1),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", ""), "Iteration"), nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.FieldRef = args[0]
+			return runIterationsRemove(cmd.Context(), opts, args[1:], completed)
+		},
+	}
+
+	addIterationFieldFlags(cmd, opts)
+	cmd.Flags().BoolVar(&completed, "completed", false, "Remove all completed iterations")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runIterationsRemove(ctx context.Context, opts *IterationsOptions, refs []string, completed bool) error {
+	if len(refs) == 0 && !completed {
+		return fmt.Errorf("specify the iterations to remove or use --completed")
+	}
+
+	client, err := newIterationClient()
+	if err != nil {
+		return err
+	}
+	fieldService := service.NewFieldService(client)
+
+	field, err := loadIterationField(ctx, client, opts)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	remove := map[string]bool{}
+	for _, ref := range refs {
+		iteration, resolveErr := service.ResolveIteration(field.Iterations, ref, now)
+		if resolveErr != nil {
+			return resolveErr
+		}
+		remove[iteration.ID] = true
+	}
+
+	var kept []service.IterationInfo
+	var titles []string
+	for _, iteration := range field.Iterations {
+		if remove[iteration.ID] || (completed && iteration.State(now) == service.IterationStateCompleted) {
+			titles = append(titles, iteration.Title)
+			continue
+		}
+		kept = append(kept, iteration)
+	}
+
+	if len(titles) == 0 {
+		fmt.Println("No iterations to remove")
+		return nil
+	}
+	if len(kept) == 0 {
+		return fmt.Errorf("cannot remove every iteration of field '%s'", field.Name)
+	}
+
+	if !opts.Force {
+		inUse, countErr := fieldService.CountIterationItems(ctx, field.ID)
+		if countErr != nil {
+			return countErr
+		}
+		fmt.Printf("⚠️  You are about to remove %d iteration(s) from field '%s': %s\n",
+			len(titles), field.Name, strings.Join(titles, ", "))
+		if inUse > 0 {
+			fmt.Printf("\n%d item(s) will lose their iteration value, including items in iterations that are kept.\n", inUse)
+		}
+		fmt.Printf("Type 'DELETE' to confirm: ")
+
+		var confirmation string
+		if _, scanErr := fmt.Scanln(&confirmation); scanErr != nil {
+			fmt.Println("❌ Failed to read confirmation.")
+			return scanErr
+		}
+		if confirmation != "DELETE" {
+			fmt.Println("❌ Removal canceled.")
+			return nil
+		}
+	}
+
+	if _, err := fieldService.SetIterations(ctx, field.ID, field.Duration, kept); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Removed %d iteration(s) from field '%s'\n", len(titles), field.Name)
+	return nil
+}
+
+// confirmIterationChange warns that changing the iterations of a field clears the
+// iteration value of its items and asks for confirmation when any item has one
+func confirmIterationChange(
+	ctx context.Context,
+	fieldService *service.FieldService,
+	field *service.IterationFieldInfo,
+	force bool,
+	action string,
+) (bool, error) {
+	if force {
+		return true, nil
+	}
+
+	inUse, err := fieldService.CountIterationItems(ctx, field.ID)
+	if err != nil {
+		return false, err
+	}
+	if inUse == 0 {
+		return true, nil
+	}
+
+	fmt.Printf("⚠️  %s recreates all iterations of field '%s' with new IDs.\n", action, field.Name)
+	fmt.Printf("%d item(s) will lose their iteration value.\n", inUse)
+	fmt.Printf("Type 'yes' to continue: ")
+
+	var confirmation string
+	if _, scanErr := fmt.Scanln(&confirmation); scanErr != nil {
+		fmt.Println("❌ Failed to read confirmation.")
+		return false, scanErr
+	}
+	if confirmation != "yes" {
+		fmt.Println("❌ Update canceled.")
+		return false, nil
+	}
+	return true, nil
+}
+
+func newIterationClient() (*api.Client, error) {
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w", err)
+	}
+
+	return api.NewClientWithTokenSource(tokenSource), nil
+}
+
+// loadIterationField loads the field by ID, or by name within --project
+func loadIterationField(ctx context.Context, client *api.Client, opts *IterationsOptions) (*service.IterationFieldInfo, error) {
+	if opts.ProjectRef == "" {
+		return service.NewFieldService(client).GetIterationField(ctx, opts.FieldRef)
+	}
+
+	field, err := service.NewResolver(client).Field(ctx, opts.ProjectRef, opts.FieldRef)
+	if err != nil {
+		return nil, err
+	}
+	return service.NewIterationFieldInfo(field)
+}
+
+// iterationJSON is the JSON representation of an iteration
+type iterationJSON struct {
+	ID        string `json:"id"`
+	Title     string `json:"title"`
+	StartDate string `json:"startDate"`
+	EndDate   string `json:"endDate"`
+	State     string `json:"state"`
+	Duration  int    `json:"duration"`
+}
+
+func outputIterations(
+	field *service.IterationFieldInfo,
+	iterations []service.IterationInfo,
+	format string,
+	now time.Time,
+) error {
+	switch format {
+	case formatJSON:
+		return outputIterationsJSON(iterations, now)
+	case formatTable:
+		return outputIterationsTable(field, iterations, now)
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+func outputIterationsTable(field *service.IterationFieldInfo, iterations []service.IterationInfo, now time.Time) error {
+	if len(iterations) == 0 {
+		fmt.Println("No iterations found")
+		return nil
+	}
+
+	fmt.Printf("Iterations of '%s' (default duration %d days):\n\n", field.Name, field.Duration)
+	fmt.Printf("%-20s %-12s %-12s %-6s %-10s %s\n", "TITLE", "START", "END", "DAYS", "STATE", "ID")
+	fmt.Println(strings.Repeat("-", iterationsSeparatorWidth))
+
+	for i, iteration := range iterations {
+		if i > 0 {
+			if gap := iteration.StartDate.Sub(iterations[i-1].EndDate()).Hours() / 24; gap > 0 {
+				fmt.Printf("%-20s %d day break\n", "", int(gap))
+			}
+		}
+		fmt.Printf("%-20s %-12s %-12s %-6d %-10s %s\n",
+			truncate(iteration.Title, fieldNameTruncateLength),
+			iteration.StartDate.Format(service.DateLayout),
+			lastDay(iteration).Format(service.DateLayout),
+			iteration.Duration,
+			iteration.State(now),
+			iteration.ID)
+	}
+
+	return nil
+}
+
+func outputIterationsJSON(iterations []service.IterationInfo, now time.Time) error {
+	result := make([]iterationJSON, len(iterations))
+	for i, iteration := range iterations {
+		result[i] = iterationJSON{
+			ID:        iteration.ID,
+			Title:     iteration.Title,
+			StartDate: iteration.StartDate.Format(service.DateLayout),
+			EndDate:   lastDay(iteration).Format(service.DateLayout),
+			Duration:  iteration.Duration,
+			State:     iteration.State(now),
+		}
+	}
+
+	data, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal iterations: %w", err)
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+// lastDay returns the last day of an iteration
+func lastDay(iteration service.IterationInfo) time.Time {
+	return iteration.EndDate().AddDate(0, 0, -1)
+}
@@ -9,6 +9,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -44,7 +45,8 @@ Examples:
   ghx field list --org myorg/456    # List fields in org project 456
   ghx field list octocat/123 --format json  # JSON output`,
 
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ProjectRef = args[0]
 			opts.Format = cmd.Flag("format").Value.String()
@@ -71,13 +73,13 @@ func runList(ctx context.Context, opts *ListOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	fieldService := service.NewFieldService(client)
 
 	// Get project fields
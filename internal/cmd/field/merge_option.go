@@ -0,0 +1,113 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// MergeOptionOptions holds options for the merge-option command
+type MergeOptionOptions struct {
+	FieldRef   string
+	ProjectRef string
+	From       string
+	Into       string
+	Format     string
+}
+
+// NewMergeOptionCmd creates the merge-option command
+func NewMergeOptionCmd() *cobra.Command {
+	opts := &MergeOptionOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "merge-option <field> --project <owner/number> --from <option> --into <option>",
+		Short: "Merge one single select option into another",
+		Long: `Merge one option of a single select field into another.
+
+Every item with the --from option is moved to the --into option, and the
+--from option is deleted afterwards. Unlike delete-option, no item loses its
+value. If an item cannot be moved, the --from option is kept so the merge
+can be run again.
+
+Options are given by name or ID.
+
+Examples:
+  ghx field merge-option Status --project octocat/1 --from "In Review" --into Review
+  ghx field merge-option Priority --project octocat/1 --from P0 --into Critical --format json`,
+
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", ""), "Single Select"), nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.FieldRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runMergeOption(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) the field belongs to (required)")
+	cmd.Flags().StringVar(&opts.From, "from", "", "Option to merge and delete (required)")
+	cmd.Flags().StringVar(&opts.Into, "into", "", "Option to move items to (required)")
+	_ = cmd.MarkFlagRequired("project")
+	_ = cmd.MarkFlagRequired("from")
+	_ = cmd.MarkFlagRequired("into")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"project": completion.Projects,
+		"from":    completion.Options(completion.Flag("project", ""), completion.Arg(0)),
+		"into":    completion.Options(completion.Flag("project", ""), completion.Arg(0)),
+	})
+
+	return cmd
+}
+
+func runMergeOption(ctx context.Context, opts *MergeOptionOptions) error {
+	if opts.Format != formatTable && opts.Format != formatJSON {
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	client := api.NewClientWithTokenSource(tokenSource)
+	project, field, err := loadProjectField(ctx, client, opts.ProjectRef, opts.FieldRef)
+	if err != nil {
+		return err
+	}
+
+	result, err := service.NewFieldService(client).MergeFieldOption(ctx, service.MergeFieldOptionInput{
+		Project: project,
+		Field:   field,
+		From:    opts.From,
+		Into:    opts.Into,
+	})
+	if err != nil {
+		if result != nil && result.Moved > 0 {
+			fmt.Printf("Moved %d item(s) before the merge failed.\n", result.Moved)
+		}
+		return fmt.Errorf("failed to merge option: %w", err)
+	}
+
+	if opts.Format == formatJSON {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Printf("✅ Merged option '%s' into '%s' in field '%s'\n", result.From, result.Into, field.Name)
+	fmt.Printf("  Moved %d item(s)\n", result.Moved)
+	return nil
+}
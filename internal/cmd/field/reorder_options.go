@@ -0,0 +1,114 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// ReorderOptionsOptions holds options for the reorder-options command
+type ReorderOptionsOptions struct {
+	FieldRef   string
+	ProjectRef string
+	Options    []string
+	Format     string
+}
+
+// NewReorderOptionsCmd creates the reorder-options command
+func NewReorderOptionsCmd() *cobra.Command {
+	opts := &ReorderOptionsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "reorder-options <field> <option>... --project <owner/number>",
+		Short: "Change the order of single select options",
+		Long: `Change the order of the options of a single select field.
+
+The listed options, given by name or ID, come first in the given order. Options
+that are not listed follow in their current order. Items keep their values.
+
+Examples:
+  ghx field reorder-options Status Todo "In Progress" Review Done --project octocat/1
+  ghx field reorder-options Priority Critical --project octocat/1`,
+
+		Args:              cobra.MinimumNArgs(2),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", ""), "Single Select"), completion.Options(completion.Flag("project", ""), completion.Arg(0))),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.FieldRef = args[0]
+			opts.Options = args[1:]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runReorderOptions(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) the field belongs to (required)")
+	_ = cmd.MarkFlagRequired("project")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"project": completion.Projects,
+	})
+
+	return cmd
+}
+
+func runReorderOptions(ctx context.Context, opts *ReorderOptionsOptions) error {
+	if opts.Format != formatTable && opts.Format != formatJSON {
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	client := api.NewClientWithTokenSource(tokenSource)
+	_, field, err := loadProjectField(ctx, client, opts.ProjectRef, opts.FieldRef)
+	if err != nil {
+		return err
+	}
+
+	updated, err := service.NewFieldService(client).ReorderFieldOptions(ctx, field, opts.Options)
+	if err != nil {
+		return fmt.Errorf("failed to reorder options: %w", err)
+	}
+
+	if opts.Format == formatJSON {
+		data, err := json.MarshalIndent(optionsJSON(updated), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Printf("✅ Reordered options of field '%s'\n", updated.Name)
+	for i, option := range updated.SingleSelect.Options {
+		fmt.Printf("  %d. %s (%s)\n", i+1, option.Name, service.FormatColor(option.Color))
+	}
+	return nil
+}
+
+// optionJSON is an option of a single select field in JSON output
+type optionJSON struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Color       string  `json:"color"`
+	Description *string `json:"description,omitempty"`
+}
+
+func optionsJSON(field *graphql.ProjectV2Field) []optionJSON {
+	options := make([]optionJSON, len(field.SingleSelect.Options))
+	for i, option := range field.SingleSelect.Options {
+		options[i] = optionJSON{ID: option.ID, Name: option.Name, Color: option.Color, Description: option.Description}
+	}
+	return options
+}
@@ -0,0 +1,178 @@
+package field
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// SetOptionsOptions holds options for the set-options command
+type SetOptionsOptions struct {
+	FieldRef   string
+	ProjectRef string
+	File       string
+	Format     string
+	DryRun     bool
+	Force      bool
+}
+
+// NewSetOptionsCmd creates the set-options command
+func NewSetOptionsCmd() *cobra.Command {
+	opts := &SetOptionsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "set-options <field> -f <file> --project <owner/number>",
+		Short: "Sync single select options from a file",
+		Long: `Set the options of a single select field from a YAML file.
+
+The names, colors, descriptions and order of all options are updated in one
+change. Options are matched by name, or by renamedFrom when an option is
+renamed, and keep their items. An option that leaves out its color or
+description keeps the current one; new options are gray.
+
+Options missing from the file are deleted and items that have them lose their
+value, so this asks for confirmation unless --force is given. Use merge-option
+first to move those items to another option.
+
+The file lists the options under an options key:
+
+  options:
+    - name: Todo
+      color: gray
+    - name: Review
+      renamedFrom: In Review
+      color: yellow
+      description: Waiting for a reviewer
+    - name: Done
+      color: green
+
+Examples:
+  ghx field set-options Status -f status.yaml --project octocat/1 --dry-run
+  ghx field set-options Status -f status.yaml --project octocat/1 --force`,
+
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", ""), "Single Select"), nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.FieldRef = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runSetOptions(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) the field belongs to (required)")
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "YAML file listing the options (required)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show what would change without changing anything")
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+	_ = cmd.MarkFlagRequired("project")
+	_ = cmd.MarkFlagRequired("file")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"project": completion.Projects,
+	})
+
+	return cmd
+}
+
+func runSetOptions(ctx context.Context, opts *SetOptionsOptions) error {
+	if opts.Format != formatTable && opts.Format != formatJSON {
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+	specs, err := service.LoadOptionSpecs(opts.File)
+	if err != nil {
+		return err
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	client := api.NewClientWithTokenSource(tokenSource)
+	_, field, err := loadProjectField(ctx, client, opts.ProjectRef, opts.FieldRef)
+	if err != nil {
+		return err
+	}
+
+	plan, err := service.PlanFieldOptions(field, specs)
+	if err != nil {
+		return err
+	}
+
+	if opts.DryRun {
+		return outputOptionsPlan(plan, field.Name, opts.Format)
+	}
+
+	if len(plan.Removed) > 0 && !opts.Force {
+		fmt.Printf("⚠️  Options %s will be deleted and items that have them will lose their value.\n",
+			strings.Join(plan.Removed, ", "))
+		fmt.Printf("Type 'DELETE' to confirm: ")
+
+		var confirmation string
+		if _, scanErr := fmt.Scanln(&confirmation); scanErr != nil {
+			fmt.Println("❌ Failed to read confirmation.")
+			return scanErr
+		}
+		if confirmation != "DELETE" {
+			fmt.Println("❌ Update canceled.")
+			return nil
+		}
+	}
+
+	updated, err := service.NewFieldService(client).SetFieldOptions(ctx, field, plan)
+	if err != nil {
+		return fmt.Errorf("failed to set options: %w", err)
+	}
+
+	if opts.Format == formatJSON {
+		data, err := json.MarshalIndent(optionsJSON(updated), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Printf("✅ Set %d option(s) of field '%s'\n", len(updated.SingleSelect.Options), updated.Name)
+	outputOptionChanges(plan)
+	return nil
+}
+
+func outputOptionsPlan(plan *service.FieldOptionsPlan, fieldName, format string) error {
+	if format == formatJSON {
+		data, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	fmt.Printf("Would set %d option(s) of field '%s'\n", len(plan.Options), fieldName)
+	outputOptionChanges(plan)
+	return nil
+}
+
+func outputOptionChanges(plan *service.FieldOptionsPlan) {
+	for _, change := range []struct {
+		label string
+		names []string
+	}{
+		{"Created", plan.Created},
+		{"Updated", plan.Updated},
+		{"Deleted", plan.Removed},
+	} {
+		if len(change.names) > 0 {
+			fmt.Printf("  %s: %s\n", change.label, strings.Join(change.names, ", "))
+		}
+	}
+}
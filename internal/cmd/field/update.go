@@ -9,14 +9,16 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // UpdateOptions holds options for the update command
 type UpdateOptions struct {
-	FieldID string
-	Name    string
-	Format  string
+	FieldID    string
+	ProjectRef string
+	Name       string
+	Format     string
 }
 
 // NewUpdateCmd creates the update command
@@ -24,18 +26,22 @@ func NewUpdateCmd() *cobra.Command {
 	opts := &UpdateOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "update <field-id>",
+		Use:   "update <field>",
 		Short: "Update a project field",
 		Long: `Update properties of an existing project field.
 
 Currently, you can update the field name. Other field properties like
 data type cannot be changed after creation.
 
+The field is given by ID, or by name together with --project owner/number.
+
 Examples:
   ghx field update field-id --name "New Priority"
+  ghx field update Priority --project octocat/1 --name "Urgency"
   ghx field update field-id --name "Status Category" --format json`,
 
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completion.Fields(completion.Flag("project", ""))),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.FieldID = args[0]
 			opts.Format = cmd.Flag("format").Value.String()
@@ -44,8 +50,11 @@ Examples:
 	}
 
 	cmd.Flags().StringVar(&opts.Name, "name", "", "New name for the field")
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) to look up the field by name")
 	_ = cmd.MarkFlagRequired("name")
 
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{"project": completion.Projects})
+
 	return cmd
 }
 
@@ -57,18 +66,23 @@ func runUpdate(ctx context.Context, opts *UpdateOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	fieldService := service.NewFieldService(client)
 
+	fieldID, err := service.NewResolver(client).FieldID(ctx, opts.ProjectRef, opts.FieldID)
+	if err != nil {
+		return err
+	}
+
 	// Update field
 	input := service.UpdateFieldInput{
-		FieldID: opts.FieldID,
+		FieldID: fieldID,
 		Name:    &opts.Name,
 	}
 
@@ -9,12 +9,14 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // UpdateOptionOptions holds options for the update-option command
 type UpdateOptionOptions struct {
 	OptionID    string
+	ProjectRef  string
 	Name        string
 	Color       string
 	Description string
@@ -26,18 +28,20 @@ func NewUpdateOptionCmd() *cobra.Command {
 	opts := &UpdateOptionOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "update-option <option-id>",
+		Use:   "update-option <option>",
 		Short: "Update single select field option",
 		Long: `Update properties of a single select field option.
 
 You can update the name, color, and description of existing options.
-At least one property must be specified.
+At least one property must be specified. The option is given by ID, or as
+Field:Option together with --project owner/number.
 
 Available colors: gray, red, orange, yellow, green, blue, purple, pink
 
 Examples:
   ghx field update-option option-id --name "Very High"
   ghx field update-option option-id --color red
+  ghx field update-option "Priority:High" --project octocat/1 --color orange
   ghx field update-option option-id --name "Critical" --color red --description "Highest priority"`,
 
 		Args: cobra.ExactArgs(1),
@@ -51,6 +55,9 @@ Examples:
 	cmd.Flags().StringVar(&opts.Name, "name", "", "New name for the option")
 	cmd.Flags().StringVar(&opts.Color, "color", "", "New color for the option")
 	cmd.Flags().StringVar(&opts.Description, "description", "", "New description for the option")
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) to look up the option as Field:Option")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{"project": completion.Projects})
 
 	return cmd
 }
@@ -73,18 +80,23 @@ func runUpdateOption(ctx context.Context, opts *UpdateOptionOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	fieldService := service.NewFieldService(client)
 
+	optionID, err := service.NewResolver(client).OptionID(ctx, opts.ProjectRef, opts.OptionID)
+	if err != nil {
+		return err
+	}
+
 	// Prepare input
 	input := service.UpdateFieldOptionInput{
-		OptionID: opts.OptionID,
+		OptionID: optionID,
 	}
 
 	if opts.Name != "" {
@@ -8,6 +8,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -18,12 +19,13 @@ const (
 
 // AddOptions holds options for the add command
 type AddOptions struct {
-	ProjectRef string
-	ItemRef    string
-	Title      string
-	Body       string
-	Format     string
-	Draft      bool
+	ProjectRef    string
+	ItemRef       string
+	Title         string
+	Body          string
+	Format        string
+	Draft         bool
+	WithSubIssues bool
 }
 
 // NewAddCmd creates the add command
@@ -42,11 +44,16 @@ Item references can be in the following formats:
 
 Project references should be in owner/number format (e.g., octocat/1).
 
+With --with-sub-issues, the sub-issues of an issue are added as well, down to
+the last level, so an epic can be added with all of its children at once.
+
 Examples:
   ghx item add octocat/1 octocat/Hello-World#123     # Add issue to project
   ghx item add myorg/2 myorg/repo#456 --format json  # Add PR with JSON output
-  ghx item add octocat/1 --draft --title "New task"  # Create draft issue`,
-		Args: cobra.RangeArgs(1, 2),
+  ghx item add octocat/1 --draft --title "New task"  # Create draft issue
+  ghx item add myorg/2 myorg/repo#42 --with-sub-issues  # Add an epic and its sub-issues`,
+		Args:              cobra.RangeArgs(1, 2),
+		ValidArgsFunction: completion.Args(completion.Projects, nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ProjectRef = args[0]
 			if len(args) > 1 {
@@ -59,6 +66,7 @@ Examples:
 	cmd.Flags().BoolVar(&opts.Draft, "draft", false, "Create a draft issue instead of adding existing item")
 	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title for draft issue (required when --draft is used)")
 	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Body for draft issue")
+	cmd.Flags().BoolVar(&opts.WithSubIssues, "with-sub-issues", false, "Also add the sub-issues of the issue, recursively")
 	cmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json")
 
 	return cmd
@@ -72,6 +80,9 @@ func validateAddOptions(opts *AddOptions) error {
 		if opts.ItemRef != "" {
 			return fmt.Errorf("cannot specify both --draft and item reference")
 		}
+		if opts.WithSubIssues {
+			return fmt.Errorf("--with-sub-issues cannot be used with --draft")
+		}
 	} else if opts.ItemRef == "" {
 		return fmt.Errorf("item reference is required (or use --draft to create draft issue)")
 	}
@@ -80,12 +91,12 @@ func validateAddOptions(opts *AddOptions) error {
 
 func setupAddServices(_ context.Context) (*api.Client, *service.ItemService, *service.ProjectService, error) {
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return nil, nil, nil, fmt.Errorf("authentication failed: %w", err)
 	}
 
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	itemService := service.NewItemService(client)
 	projectService := service.NewProjectService(client)
 
@@ -102,7 +113,8 @@ func addDraftIssue(ctx context.Context, itemService *service.ItemService, projec
 	return outputAddedItem(item, format, "DraftIssue", title)
 }
 
-func addExistingItem(ctx context.Context, itemService *service.ItemService, projectID, itemRef, format string) error {
+func addExistingItem(ctx context.Context, itemService *service.ItemService, projectID string, opts *AddOptions) error {
+	itemRef, format := opts.ItemRef, opts.Format
 	itemOwner, itemRepo, itemNumber, err := service.ParseItemReference(itemRef)
 	if err != nil {
 		return fmt.Errorf("invalid item reference: %w", err)
@@ -121,6 +133,9 @@ func addExistingItem(ctx context.Context, itemService *service.ItemService, proj
 		if prErr != nil {
 			return fmt.Errorf("failed to find issue or pull request: %w", prErr)
 		}
+		if opts.WithSubIssues {
+			return fmt.Errorf("--with-sub-issues only applies to issues, %s is a pull request", itemRef)
+		}
 		contentID = pr.ID
 		itemType = "PullRequest"
 		itemTitle = pr.Title
@@ -132,6 +147,13 @@ func addExistingItem(ctx context.Context, itemService *service.ItemService, proj
 	}
 
 	fmt.Printf("✅ %s added to project!\n\n", itemType)
+	if opts.WithSubIssues && issue.SubIssuesSummary.Total > 0 {
+		subIssues, err := itemService.AddSubIssuesToProject(ctx, projectID, contentID)
+		if err != nil {
+			return fmt.Errorf("failed to add sub-issues: %w", err)
+		}
+		fmt.Printf("✅ %d sub-issue(s) added to project!\n\n", len(subIssues))
+	}
 	return outputAddedItem(item, format, itemType, itemTitle)
 }
 
@@ -166,7 +188,7 @@ func runAdd(ctx context.Context, opts *AddOptions) error {
 		return addDraftIssue(ctx, itemService, project.ID, opts.Title, body, opts.Format)
 	}
 
-	return addExistingItem(ctx, itemService, project.ID, opts.ItemRef, opts.Format)
+	return addExistingItem(ctx, itemService, project.ID, opts)
 }
 
 func outputAddedItem(item interface{}, format, itemType, title string) error {
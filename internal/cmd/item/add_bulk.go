@@ -136,12 +136,12 @@ func collectItemsToAdd(ctx context.Context, opts bulkAddOptions) ([]string, erro
 // initializeServices initializes authentication and services
 func initializeServices() (*service.ItemService, *service.ProjectService, error) {
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return nil, nil, fmt.Errorf("authentication failed: %w", err)
 	}
 
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	return service.NewItemService(client), service.NewProjectService(client), nil
 }
 
@@ -224,13 +224,13 @@ func parseNumberRange(rangeStr string) ([]string, error) {
 func getIssuesByLabel(ctx context.Context, label string) ([]string, error) {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return nil, fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	itemService := service.NewItemService(client)
 
 	// Use the service method to get items by label
@@ -0,0 +1,118 @@
+package item
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// ConvertOptions holds options for the convert command
+type ConvertOptions struct {
+	ProjectRef string
+	ItemID     string
+	Repository string
+	Format     string
+	Labels     []string
+	Assignees  []string
+}
+
+// NewConvertCmd creates the convert command
+func NewConvertCmd() *cobra.Command {
+	opts := &ConvertOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "convert <project> <item-id> --repo <owner>/<repo>",
+		Short: "Convert a draft issue into an issue",
+		Long: `Convert a draft issue in a project into an issue in a repository.
+
+The draft's title, body and assignees move to the new issue. The project item
+stays in the project, so its field values such as Status or Priority are kept.
+Labels and further assignees can be added to the new issue with --label and
+--assignee. Use convert-bulk to convert every draft matching a filter.
+
+Examples:
+  ghx item convert octocat/1 PVTI_lADOANN5s84ACbL0zgBZrOY --repo octocat/app
+  ghx item convert myorg/2 PVTI_lADOANN5s84ACbL0zgBZrOY --repo myorg/api --label bug --assignee @octocat`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completion.Args(completion.Projects, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.ItemID = args[1]
+			return runConvert(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Repository, "repo", "", "Repository to create the issue in as owner/repo (required)")
+	cmd.Flags().StringArrayVar(&opts.Labels, "label", nil, "Label to add to the new issue (repeatable)")
+	cmd.Flags().StringArrayVar(&opts.Assignees, "assignee", nil, "User to assign to the new issue (repeatable)")
+	cmd.Flags().StringVar(&opts.Format, "format", "", "Output format: json")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func runConvert(ctx context.Context, opts *ConvertOptions) error {
+	if opts.Format != "" && opts.Format != formatJSON {
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClientWithTokenSource(tokenSource)
+	itemService := service.NewItemService(client)
+
+	resolver := service.NewResolver(client)
+
+	if _, err = resolver.Project(ctx, opts.ProjectRef); err != nil {
+		return err
+	}
+	itemID, err := resolver.ItemID(ctx, opts.ProjectRef, opts.ItemID)
+	if err != nil {
+		return err
+	}
+
+	target, err := itemService.ResolveConversionTarget(ctx, opts.Repository, opts.Labels, opts.Assignees)
+	if err != nil {
+		return err
+	}
+
+	item, err := itemService.ConvertDraftIssue(ctx, itemID, target)
+	if item == nil {
+		return err
+	}
+	if err != nil {
+		fmt.Printf("⚠️  %v\n", err)
+	}
+
+	issue := item.Content.Issue
+	if opts.Format == formatJSON {
+		data, marshalErr := json.MarshalIndent(service.ConvertedDraftIssue{
+			ItemID:      item.ID,
+			Title:       issue.Title,
+			IssueURL:    issue.URL,
+			IssueNumber: issue.Number,
+		}, "", "  ")
+		if marshalErr != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", marshalErr)
+		}
+		fmt.Println(string(data))
+		return err
+	}
+
+	fmt.Printf("✅ Converted draft issue into %s#%d: %s\n", target.Repository, issue.Number, issue.Title)
+	fmt.Printf("   URL: %s\n", issue.URL)
+	return err
+}
@@ -0,0 +1,150 @@
+package item
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// ConvertBulkOptions holds options for the convert-bulk command
+type ConvertBulkOptions struct {
+	ProjectRef string
+	Filter     string
+	Repository string
+	Format     string
+	Labels     []string
+	Assignees  []string
+	DryRun     bool
+}
+
+// NewConvertBulkCmd creates the convert-bulk command
+func NewConvertBulkCmd() *cobra.Command {
+	opts := &ConvertBulkOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "convert-bulk <project> --repo <owner>/<repo>",
+		Short: "Convert draft issues matching a filter into issues",
+		Long: `Convert every draft issue in a project that matches a filter into an issue.
+
+The filter uses the syntax of project view filters, e.g. "status:Todo",
+"priority:High,Urgent", "-status:Done" or "no:priority". Terms are combined
+with AND; without --filter every draft is converted. Items keep their field
+values, and --label and --assignee are applied to each new issue.
+
+A draft that fails to convert is reported and the others are still converted.
+Use --dry-run to list the drafts that would be converted.
+
+Examples:
+  ghx item convert-bulk octocat/1 --repo octocat/app --filter "status:Todo" --dry-run
+  ghx item convert-bulk myorg/2 --repo myorg/api --filter "priority:High -status:Done" --label planned`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runConvertBulk(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Filter selecting the drafts to convert (e.g., 'status:Todo')")
+	cmd.Flags().StringVar(&opts.Repository, "repo", "", "Repository to create the issues in as owner/repo (required)")
+	cmd.Flags().StringArrayVar(&opts.Labels, "label", nil, "Label to add to the new issues (repeatable)")
+	cmd.Flags().StringArrayVar(&opts.Assignees, "assignee", nil, "User to assign to the new issues (repeatable)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "List the drafts that would be converted without converting them")
+	cmd.Flags().StringVar(&opts.Format, "format", "", "Output format: json")
+	_ = cmd.MarkFlagRequired("repo")
+
+	return cmd
+}
+
+func runConvertBulk(ctx context.Context, opts *ConvertBulkOptions) error {
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+	filter, err := service.ParseItemFilter(opts.Filter)
+	if err != nil {
+		return fmt.Errorf("invalid filter: %w", err)
+	}
+	if opts.Format != "" && opts.Format != formatJSON {
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClientWithTokenSource(tokenSource)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	target, err := itemService.ResolveConversionTarget(ctx, opts.Repository, opts.Labels, opts.Assignees)
+	if err != nil {
+		return err
+	}
+
+	result, err := itemService.ConvertDraftIssues(ctx, project.ID, filter, target, opts.DryRun)
+	if err != nil {
+		return fmt.Errorf("failed to convert draft issues: %w", err)
+	}
+
+	if opts.Format == formatJSON {
+		if result.Converted == nil {
+			result.Converted = []service.ConvertedDraftIssue{}
+		}
+		if result.Warnings == nil {
+			result.Warnings = []string{}
+		}
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		printConvertBulkResult(result, target.Repository, opts.DryRun)
+	}
+
+	if result.Failed > 0 {
+		return fmt.Errorf("%d draft issue(s) could not be converted", result.Failed)
+	}
+	return nil
+}
+
+func printConvertBulkResult(result *service.ConvertDraftIssuesResult, repository string, dryRun bool) {
+	switch {
+	case len(result.Converted) == 0 && result.Failed == 0:
+		fmt.Println("No draft issues match the filter.")
+	case dryRun:
+		fmt.Printf("Would convert %d draft issue(s) into issues in %s:\n\n", len(result.Converted), repository)
+		for _, converted := range result.Converted {
+			fmt.Printf("  • %s\n", converted.Title)
+		}
+	default:
+		fmt.Printf("✅ Converted %d draft issue(s) into issues in %s:\n\n", len(result.Converted), repository)
+		for _, converted := range result.Converted {
+			fmt.Printf("  #%-6d %s\n", converted.IssueNumber, converted.Title)
+		}
+	}
+
+	if len(result.Warnings) > 0 {
+		fmt.Printf("\n⚠️  %d warning(s):\n", len(result.Warnings))
+		for _, warning := range result.Warnings {
+			fmt.Printf("  • %s\n", warning)
+		}
+	}
+}
@@ -3,19 +3,20 @@ package item
 import (
 	"context"
 	"fmt"
-	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // EditOptions holds options for the edit command
 type EditOptions struct {
 	ProjectRef string
-	ItemID     string
+	ItemRef    string
 	FieldName  string
 	Value      string
 	Format     string
@@ -26,31 +27,54 @@ func NewEditCmd() *cobra.Command {
 	opts := &EditOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "edit <project> <item-id> --field <field-name> --value <value>",
+		Use:   "edit [<project>] <item> --field <field-name> --value <value>",
 		Short: "Edit item field values",
 		Long: `Edit field values for items in a project.
 
 This command allows you to update custom field values for project items.
-You need to specify the project-specific item ID (not the issue/PR ID).
+The item is given as the issue or pull request it holds (owner/repo#number or
+its URL), or by its project item ID. The project is given as the first
+argument, or with --project when only an issue or pull request reference is
+passed.
 
 Field values can be:
 • Text values for text fields
 • Numbers for number fields
 • Option names for single-select fields
 • Dates in YYYY-MM-DD format for date fields
+• Iteration titles, or @current, @next and @previous for iteration fields
 
 Examples:
-  ghx item edit octocat/1 PVTI_123 --field "Status" --value "In Progress"
-  ghx item edit myorg/2 item-456 --field "Priority" --value "High"
-  ghx item edit octocat/1 PVTI_789 --field "Due Date" --value "2024-12-31"`,
-		Args: cobra.ExactArgs(2),
+  ghx item edit octocat/1 octocat/app#42 --field "Status" --value "In Progress"
+  ghx item edit octocat/app#42 --project octocat/1 --field "Status" --value "Done"
+  ghx item edit myorg/2 https://github.com/myorg/api/issues/7 --field "Priority" --value "High"
+  ghx item edit octocat/1 PVTI_789 --field "Due Date" --value "2024-12-31"
+  ghx item edit octocat/1 PVTI_789 --field "Sprint" --value @next`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			if opts.ProjectRef != "" {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+			if len(args) != 2 {
+				return fmt.Errorf("accepts <project> <item>, or <item> with --project, received %d arg(s)", len(args))
+			}
+			return nil
+		},
+		ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			if opts.ProjectRef != "" {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+			return completion.Args(completion.Projects, nil)(cmd, args, toComplete)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
-			opts.ProjectRef = args[0]
-			opts.ItemID = args[1]
+			opts.ItemRef = args[len(args)-1]
+			if len(args) == 2 {
+				opts.ProjectRef = args[0]
+			}
 			return runEdit(cmd.Context(), opts)
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) when only the item is given")
 	cmd.Flags().StringVar(&opts.FieldName, "field", "", "Field name to update (required)")
 	cmd.Flags().StringVar(&opts.Value, "value", "", "New field value (required)")
 	cmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json")
@@ -58,62 +82,52 @@ Examples:
 	_ = cmd.MarkFlagRequired("field")
 	_ = cmd.MarkFlagRequired("value")
 
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"project": completion.Projects,
+		"field":   completion.Fields(completion.FlagOr("project", completion.Arg(0))),
+		"value":   completion.Options(completion.FlagOr("project", completion.Arg(0)), completion.Flag("field", "")),
+	})
+
 	return cmd
 }
 
 func runEdit(ctx context.Context, opts *EditOptions) error {
-	// Parse project reference
-	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
-	if err != nil {
-		return fmt.Errorf("invalid project reference: %w", err)
-	}
-
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and services
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
+	resolver := service.NewResolver(client)
 
-	// Get project details to find field ID
-	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	project, err := resolver.Project(ctx, opts.ProjectRef)
 	if err != nil {
-		return fmt.Errorf("failed to get project: %w", err)
+		return err
 	}
-
-	// Find the field by name
-	var fieldID string
-	for _, field := range project.Fields.Nodes {
-		if field.Name == opts.FieldName {
-			fieldID = field.ID
-			break
-		}
+	field, err := resolver.Field(ctx, opts.ProjectRef, opts.FieldName)
+	if err != nil {
+		return err
 	}
-
-	if fieldID == "" {
-		return fmt.Errorf("field '%s' not found in project. Available fields", opts.FieldName)
+	itemID, err := resolver.ItemID(ctx, opts.ProjectRef, opts.ItemRef)
+	if err != nil {
+		return err
 	}
 
-	// Prepare field value based on field type
-	var fieldValue interface{}
-	// For now, we'll treat all values as strings
-	// In a full implementation, we'd parse based on field type
-	fieldValue = opts.Value
-
-	// If value looks like a number, try to convert it
-	if numValue, parseErr := strconv.ParseFloat(opts.Value, 64); parseErr == nil {
-		fieldValue = numValue
+	// Convert the value based on the field type
+	fieldValue, err := service.BuildFieldValue(field, opts.Value, time.Now())
+	if err != nil {
+		return err
 	}
 
 	// Update item field
 	input := service.UpdateItemFieldInput{
 		ProjectID: project.ID,
-		ItemID:    opts.ItemID,
-		FieldID:   fieldID,
+		ItemID:    itemID,
+		FieldID:   field.ID,
 		Value:     fieldValue,
 	}
 
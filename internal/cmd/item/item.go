@@ -18,10 +18,13 @@ This command group provides comprehensive item management capabilities:
 
 • Add existing issues and pull requests to projects
 • Create draft issues directly in projects
+• Convert draft issues into repository issues
 • List and search items across repositories
 • View detailed item information
 • Remove items from projects
 • Update item field values
+• Move items within a project's manual order
+• Link issues as sub-issues of a parent issue
 
 For more information about GitHub Projects, visit:
 https://docs.github.com/en/issues/planning-and-tracking-with-projects`,
@@ -35,9 +38,14 @@ https://docs.github.com/en/issues/planning-and-tracking-with-projects`,
 	// Add subcommands
 	cmd.AddCommand(NewAddCmd())
 	cmd.AddCommand(NewAddBulkCmd())
+	cmd.AddCommand(NewConvertCmd())
+	cmd.AddCommand(NewConvertBulkCmd())
 	cmd.AddCommand(NewEditCmd())
 	cmd.AddCommand(NewListCmd())
+	cmd.AddCommand(NewMoveCmd())
 	cmd.AddCommand(NewRemoveCmd())
+	cmd.AddCommand(NewReorderCmd())
+	cmd.AddCommand(NewSubIssueCmd())
 	cmd.AddCommand(NewUpdateBulkCmd())
 	cmd.AddCommand(NewViewCmd())
 
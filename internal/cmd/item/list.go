@@ -2,6 +2,7 @@ package item
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -9,6 +10,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -33,6 +35,7 @@ const (
 // ListOptions holds options for the list command
 type ListOptions struct {
 	Repository string
+	Project    string
 	Search     string
 	Type       string
 	State      string
@@ -41,6 +44,7 @@ type ListOptions struct {
 	Format     string
 	Labels     []string
 	Limit      int
+	Tree       bool
 }
 
 // NewListCmd creates the list command
@@ -53,14 +57,18 @@ func NewListCmd() *cobra.Command {
 		Long: `List issues and pull requests from repositories or search across GitHub.
 
 You can list items from a specific repository or search across all of GitHub
-using various filters.
+using various filters. With --project, the items of a project are listed in
+their manual order with their position and item ID, and --tree nests the
+items of sub-issues under the items of their parent issues.
 
 Examples:
   ghx item list octocat/Hello-World                    # List items from repository
   ghx item list octocat/Hello-World --type issue       # List only issues
   ghx item list --search "is:issue is:open bug"       # Search across GitHub
   ghx item list --author octocat --state open          # Find items by author
-  ghx item list --assignee @me --type pr               # Find PRs assigned to you`,
+  ghx item list --assignee @me --type pr               # Find PRs assigned to you
+  ghx item list --project octocat/1                    # List project items in manual order
+  ghx item list --project octocat/1 --tree             # Nest sub-issues under their parents`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) > 0 {
@@ -70,6 +78,8 @@ Examples:
 		},
 	}
 
+	cmd.Flags().StringVar(&opts.Project, "project", "", "List the items of a project (owner/number) in manual order")
+	cmd.Flags().BoolVar(&opts.Tree, "tree", false, "Show project items as a tree of parent issues and sub-issues (with --project)")
 	cmd.Flags().StringVar(&opts.Search, "search", "", "Search query (GitHub search syntax)")
 	cmd.Flags().StringVar(&opts.Type, "type", "", "Item type: issue, pr, pullrequest")
 	cmd.Flags().StringVar(&opts.State, "state", "", "Item state: open, closed, merged")
@@ -79,21 +89,36 @@ Examples:
 	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", defaultListLimit, "Maximum number of items to list")
 	cmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json")
 
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"project": completion.Projects,
+	})
+
 	return cmd
 }
 
 func runList(ctx context.Context, opts *ListOptions) error {
+	if opts.Project != "" && (opts.Repository != "" || opts.Search != "") {
+		return fmt.Errorf("--project cannot be combined with a repository or --search")
+	}
+	if opts.Tree && opts.Project == "" {
+		return fmt.Errorf("--tree requires --project")
+	}
+
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	itemService := service.NewItemService(client)
 
+	if opts.Project != "" {
+		return listProjectItems(ctx, client, opts)
+	}
+
 	var items []service.ItemInfo
 
 	if opts.Repository != "" {
@@ -111,6 +136,82 @@ func runList(ctx context.Context, opts *ListOptions) error {
 	return outputItems(items, opts.Format)
 }
 
+func listProjectItems(ctx context.Context, client *api.Client, opts *ListOptions) error {
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.Project)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	project, err := service.NewProjectService(client).GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	items, err := service.NewItemService(client).ListPositionedItems(ctx, project.ID)
+	if err != nil {
+		return fmt.Errorf("failed to list items: %w", err)
+	}
+	if opts.Limit > 0 && len(items) > opts.Limit {
+		items = items[:opts.Limit]
+	}
+
+	if opts.Tree {
+		return outputProjectItemTree(service.BuildProjectItemTree(items), opts.Format)
+	}
+
+	switch opts.Format {
+	case formatJSON:
+		data, err := json.MarshalIndent(items, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	case formatTable:
+		if len(items) == 0 {
+			fmt.Println("No items found")
+			return nil
+		}
+		fmt.Printf("%-4s %-10s %-8s %-6s %-30s %s\n", "POS", "TYPE", "STATE", "NUMBER", "TITLE", "ID")
+		fmt.Println(strings.Repeat("-", tableHeaderSeparatorWidth))
+		for i := range items {
+			item := &items[i]
+			number := ""
+			if item.Number != 0 {
+				number = formatItemNumber(&item.Number)
+			}
+			fmt.Printf("%-4d %-10s %-8s %-6s %-30s %s\n", item.Position,
+				truncateString(item.Type, maxItemTypeLength, itemTypeTruncateLength),
+				truncateString(item.State, maxStateLength, stateTruncateLength),
+				number,
+				truncateString(item.Title, maxTitleLength, listTitleTruncateLength),
+				item.ID)
+		}
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+	return nil
+}
+
+func outputProjectItemTree(tree []service.ProjectItemNode, format string) error {
+	switch format {
+	case formatJSON:
+		data, err := json.MarshalIndent(tree, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	case formatTable:
+		if len(tree) == 0 {
+			fmt.Println("No items found")
+			return nil
+		}
+		printProjectItemTree(tree)
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+	return nil
+}
+
 func listRepositoryItems(ctx context.Context, itemService *service.ItemService, opts *ListOptions) ([]service.ItemInfo, error) {
 	// Parse repository reference
 	parts := strings.Split(opts.Repository, "/")
@@ -0,0 +1,104 @@
+package item
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// MoveOptions holds options for the move command
+type MoveOptions struct {
+	ProjectRef string
+	ItemRef    string
+	AfterRef   string
+	Top        bool
+}
+
+// NewMoveCmd creates the move command
+func NewMoveCmd() *cobra.Command {
+	opts := &MoveOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "move <project> <item> (--after <item> | --top)",
+		Short: "Move an item within the project's manual order",
+		Long: `Move an item within the manual order of a project.
+
+The manual order is the row order of table and board views that are not
+sorted by a field, which prioritized backlogs rely on. Use --after to place
+the item right after another item, or --top to make it the first item.
+Items are given as the issue or pull request they hold (owner/repo#number or
+its URL), or by project item ID as shown by 'ghx item list --project'.
+
+Examples:
+  ghx item move octocat/1 octocat/app#42 --top
+  ghx item move octocat/1 octocat/app#42 --after octocat/app#7
+  ghx item move octocat/1 PVTI_lADOANN5s84ACbL0zgBZrOY --top
+  ghx item move myorg/2 PVTI_lADOANN5s84ACbL0zgBZrOY --after PVTI_lADOANN5s84ACbL0zgBZrOZ`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completion.Args(completion.Projects, nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.ItemRef = args[1]
+			return runMove(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.AfterRef, "after", "", "Item to place the item after")
+	cmd.Flags().BoolVar(&opts.Top, "top", false, "Move the item to the top")
+
+	return cmd
+}
+
+func runMove(ctx context.Context, opts *MoveOptions) error {
+	if (opts.AfterRef != "") == opts.Top {
+		return fmt.Errorf("specify either --after or --top")
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClientWithTokenSource(tokenSource)
+	itemService := service.NewItemService(client)
+	resolver := service.NewResolver(client)
+
+	project, err := resolver.Project(ctx, opts.ProjectRef)
+	if err != nil {
+		return err
+	}
+	itemID, err := resolver.ItemID(ctx, opts.ProjectRef, opts.ItemRef)
+	if err != nil {
+		return err
+	}
+	var afterID string
+	if opts.AfterRef != "" {
+		afterID, err = resolver.ItemID(ctx, opts.ProjectRef, opts.AfterRef)
+		if err != nil {
+			return err
+		}
+		if afterID == itemID {
+			return fmt.Errorf("cannot move an item after itself")
+		}
+	}
+
+	if err := itemService.MoveItem(ctx, project.ID, itemID, afterID); err != nil {
+		return err
+	}
+
+	if opts.Top {
+		fmt.Printf("✅ Moved item %s to the top of %s\n", opts.ItemRef, project.Title)
+	} else {
+		fmt.Printf("✅ Moved item %s after %s\n", opts.ItemRef, opts.AfterRef)
+	}
+	return nil
+}
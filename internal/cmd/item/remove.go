@@ -8,13 +8,14 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // RemoveOptions holds options for the remove command
 type RemoveOptions struct {
 	ProjectRef string
-	ItemID     string
+	ItemRef    string
 	Force      bool
 }
 
@@ -23,23 +24,25 @@ func NewRemoveCmd() *cobra.Command {
 	opts := &RemoveOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "remove <project> <item-id>",
+		Use:   "remove <project> <item>",
 		Short: "Remove an item from a project",
-		Long: `Remove an item from a project by its project item ID.
+		Long: `Remove an item from a project.
 
-The item ID should be the project-specific item ID, not the issue or PR ID.
-You can find item IDs by listing project items or using the GitHub web interface.
+The item is given as the issue or pull request it holds (owner/repo#number or
+its URL), or by its project item ID as shown by 'ghx item list --project'.
 
 ⚠️  WARNING: This action cannot be undone. The item will be removed from the project
 but the underlying issue or PR will remain unchanged.
 
 Examples:
-  ghx item remove octocat/1 PVTI_lADOANN5s84ACbL0zgBZrOY    # Remove item from project
-  ghx item remove myorg/2 item-123 --force                  # Skip confirmation`,
-		Args: cobra.ExactArgs(2),
+  ghx item remove octocat/1 octocat/app#42                  # Remove issue's item from project
+  ghx item remove octocat/1 PVTI_lADOANN5s84ACbL0zgBZrOY    # Remove item by ID
+  ghx item remove myorg/2 myorg/api#7 --force               # Skip confirmation`,
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completion.Args(completion.Projects, nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ProjectRef = args[0]
-			opts.ItemID = args[1]
+			opts.ItemRef = args[1]
 			return runRemove(cmd.Context(), opts)
 		},
 	}
@@ -50,33 +53,31 @@ Examples:
 }
 
 func runRemove(ctx context.Context, opts *RemoveOptions) error {
-	// Parse project reference
-	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
-	if err != nil {
-		return fmt.Errorf("invalid project reference: %w", err)
-	}
-
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and services
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	itemService := service.NewItemService(client)
-	projectService := service.NewProjectService(client)
+	resolver := service.NewResolver(client)
 
 	// Get project details
-	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	project, err := resolver.Project(ctx, opts.ProjectRef)
+	if err != nil {
+		return err
+	}
+	itemID, err := resolver.ItemID(ctx, opts.ProjectRef, opts.ItemRef)
 	if err != nil {
-		return fmt.Errorf("failed to get project: %w", err)
+		return err
 	}
 
 	// Show confirmation unless --force is used
 	if !opts.Force {
-		fmt.Printf("⚠️  You are about to remove item %s from project:\n\n", opts.ItemID)
+		fmt.Printf("⚠️  You are about to remove item %s from project:\n\n", opts.ItemRef)
 		fmt.Printf("Project: %s (#%d)\n", project.Title, project.Number)
 		fmt.Printf("Owner: %s\n", project.Owner.Login)
 		fmt.Printf("\n⚠️  This action cannot be undone. The item will be removed from the project.\n")
@@ -96,11 +97,11 @@ func runRemove(ctx context.Context, opts *RemoveOptions) error {
 	}
 
 	// Remove item from project
-	err = itemService.RemoveItemFromProject(ctx, project.ID, opts.ItemID)
+	err = itemService.RemoveItemFromProject(ctx, project.ID, itemID)
 	if err != nil {
 		return fmt.Errorf("failed to remove item from project: %w", err)
 	}
 
-	fmt.Printf("✅ Item %s removed from project successfully.\n", opts.ItemID)
+	fmt.Printf("✅ Item %s removed from project successfully.\n", opts.ItemRef)
 	return nil
 }
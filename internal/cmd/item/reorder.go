@@ -0,0 +1,118 @@
+package item
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// ReorderOptions holds options for the reorder command
+type ReorderOptions struct {
+	ProjectRef string
+	By         string
+	Format     string
+	DryRun     bool
+}
+
+// NewReorderCmd creates the reorder command
+func NewReorderCmd() *cobra.Command {
+	opts := &ReorderOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "reorder <project> --by <fields>",
+		Short: "Rewrite the project's manual order from field values",
+		Long: `Rewrite the manual order of a project's items from their field values.
+
+Items are sorted by the comma separated fields given with --by; prefix a field
+with - to sort it in descending order. Single select fields sort in the order
+of their options, iterations by start date, numbers numerically and other
+fields alphabetically. Items without a value come last, and items with equal
+values keep their current order.
+
+Only the items that are out of order are moved, so running reorder again on
+a sorted project changes nothing. Use --dry-run to preview the new order.
+
+Examples:
+  ghx item reorder octocat/1 --by "Priority,Story Points"
+  ghx item reorder myorg/2 --by "Priority,-Story Points" --dry-run`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runReorder(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.By, "by", "", "Comma separated fields to order by, - for descending (required)")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the new order without moving items")
+	cmd.Flags().StringVar(&opts.Format, "format", "", "Output format: json")
+	_ = cmd.MarkFlagRequired("by")
+
+	return cmd
+}
+
+func runReorder(ctx context.Context, opts *ReorderOptions) error {
+	projectOwner, projectNumber, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+	keys, err := service.ParseItemSortKeys(opts.By)
+	if err != nil {
+		return err
+	}
+	if opts.Format != "" && opts.Format != formatJSON {
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClientWithTokenSource(tokenSource)
+	itemService := service.NewItemService(client)
+	projectService := service.NewProjectService(client)
+
+	project, err := projectService.GetProjectWithOwnerDetection(ctx, projectOwner, projectNumber)
+	if err != nil {
+		return fmt.Errorf("failed to get project: %w", err)
+	}
+
+	result, err := itemService.ReorderItems(ctx, project, keys, opts.DryRun)
+	if err != nil {
+		return fmt.Errorf("failed to reorder items: %w", err)
+	}
+
+	if opts.Format == formatJSON {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	switch {
+	case result.Moved == 0:
+		fmt.Println("✅ Items are already in order. Nothing to move.")
+		return nil
+	case opts.DryRun:
+		fmt.Printf("Would move %d item(s). New order:\n\n", result.Moved)
+	default:
+		fmt.Printf("✅ Moved %d item(s). New order:\n\n", result.Moved)
+	}
+	for i, title := range result.Order {
+		fmt.Printf("  %4d  %s\n", i+1, title)
+	}
+	return nil
+}
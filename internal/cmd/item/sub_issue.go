@@ -0,0 +1,145 @@
+package item
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// SubIssueOptions holds options for the sub-issue commands
+type SubIssueOptions struct {
+	ParentRef     string
+	SubIssueRefs  []string
+	ReplaceParent bool
+}
+
+// NewSubIssueCmd creates the sub-issue command group
+func NewSubIssueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sub-issue <command>",
+		Short: "Manage sub-issues of an issue",
+		Long: `Link issues to a parent issue as sub-issues, or remove the links.
+
+Sub-issues break an epic into smaller issues. An issue has at most one parent
+and sub-issues can be nested. The parent and sub-issue tree of an issue are
+shown by 'ghx item view', and 'ghx item list --project --tree' shows the items
+of a project as a tree.`,
+		Example: `  ghx item sub-issue add myorg/repo#10 myorg/repo#11 myorg/repo#12
+  ghx item sub-issue add myorg/repo#20 myorg/repo#11 --replace-parent
+  ghx item sub-issue remove myorg/repo#10 myorg/repo#12`,
+	}
+
+	cmd.AddCommand(NewSubIssueAddCmd())
+	cmd.AddCommand(NewSubIssueRemoveCmd())
+
+	return cmd
+}
+
+// NewSubIssueAddCmd creates the sub-issue add command
+func NewSubIssueAddCmd() *cobra.Command {
+	opts := &SubIssueOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "add <parent-issue> <sub-issue>...",
+		Short: "Add sub-issues to an issue",
+		Long: `Add one or more issues as sub-issues of a parent issue.
+
+An issue that already has a parent is only moved to the new parent with
+--replace-parent.
+
+Examples:
+  ghx item sub-issue add myorg/repo#10 myorg/repo#11 myorg/repo#12
+  ghx item sub-issue add myorg/repo#20 myorg/repo#11 --replace-parent`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ParentRef = args[0]
+			opts.SubIssueRefs = args[1:]
+			return runSubIssue(cmd.Context(), opts, true)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.ReplaceParent, "replace-parent", false, "Move sub-issues that already have a parent")
+
+	return cmd
+}
+
+// NewSubIssueRemoveCmd creates the sub-issue remove command
+func NewSubIssueRemoveCmd() *cobra.Command {
+	opts := &SubIssueOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "remove <parent-issue> <sub-issue>...",
+		Short: "Remove sub-issues from an issue",
+		Long: `Remove one or more sub-issues from their parent issue.
+
+The issues themselves are not changed, only the link to the parent is removed.
+
+Examples:
+  ghx item sub-issue remove myorg/repo#10 myorg/repo#12`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ParentRef = args[0]
+			opts.SubIssueRefs = args[1:]
+			return runSubIssue(cmd.Context(), opts, false)
+		},
+	}
+
+	return cmd
+}
+
+func runSubIssue(ctx context.Context, opts *SubIssueOptions, add bool) error {
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	itemService := service.NewItemService(api.NewClientWithTokenSource(tokenSource))
+
+	parent, err := getIssueByReference(ctx, itemService, opts.ParentRef)
+	if err != nil {
+		return err
+	}
+
+	for _, ref := range opts.SubIssueRefs {
+		subIssue, err := getIssueByReference(ctx, itemService, ref)
+		if err != nil {
+			return err
+		}
+
+		if add {
+			if err := itemService.AddSubIssue(ctx, parent.ID, subIssue.ID, opts.ReplaceParent); err != nil {
+				return fmt.Errorf("%s: %w", ref, err)
+			}
+			fmt.Printf("✅ Added %s as a sub-issue of %s\n", ref, opts.ParentRef)
+			continue
+		}
+
+		if err := itemService.RemoveSubIssue(ctx, parent.ID, subIssue.ID); err != nil {
+			return fmt.Errorf("%s: %w", ref, err)
+		}
+		fmt.Printf("✅ Removed sub-issue %s from %s\n", ref, opts.ParentRef)
+	}
+
+	return nil
+}
+
+func getIssueByReference(ctx context.Context, itemService *service.ItemService, ref string) (*graphql.Issue, error) {
+	owner, repo, number, err := service.ParseItemReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item reference: %w", err)
+	}
+
+	issue, err := itemService.GetIssue(ctx, owner, repo, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get issue %s: %w", ref, err)
+	}
+	return issue, nil
+}
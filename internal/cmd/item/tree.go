@@ -0,0 +1,72 @@
+package item
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// printTree prints nodes as the branches of a tree, with the columns of each node
+// printed before the branch lines and its label after them
+func printTree[T any](nodes []T, indent string, columns, label func(T) string, children func(T) []T) {
+	for i, node := range nodes {
+		connector, childIndent := "├─ ", "│  "
+		if i == len(nodes)-1 {
+			connector, childIndent = "└─ ", "   "
+		}
+		fmt.Printf("%s%s%s%s\n", columns(node), indent, connector, label(node))
+		printTree(children(node), indent+childIndent, columns, label, children)
+	}
+}
+
+func printSubIssueTree(nodes []service.SubIssueNode) {
+	printTree(nodes, "  ",
+		func(service.SubIssueNode) string { return "" },
+		func(node service.SubIssueNode) string {
+			issue := node.Issue
+			mark := "○"
+			if issue.Closed {
+				mark = "✓"
+			}
+			return fmt.Sprintf("%s %s#%d %s%s", mark, issue.Repository.NameWithOwner, issue.Number, issue.Title,
+				formatSubIssueProgress(issue.SubIssuesSummary))
+		},
+		func(node service.SubIssueNode) []service.SubIssueNode { return node.SubIssues })
+}
+
+func printProjectItemTree(nodes []service.ProjectItemNode) {
+	fmt.Printf("%-4s %-30s %s\n", "POS", "ID", "TITLE")
+	fmt.Println(strings.Repeat("-", tableHeaderSeparatorWidth))
+
+	columns := func(node service.ProjectItemNode) string {
+		return fmt.Sprintf("%-4d %-30s ", node.Item.Position, node.Item.ID)
+	}
+	label := func(node service.ProjectItemNode) string {
+		item := node.Item
+		label := item.Title
+		if item.Number != 0 {
+			label = fmt.Sprintf("#%d %s", item.Number, item.Title)
+		}
+		if item.SubIssues != nil {
+			label += formatSubIssueProgress(*item.SubIssues)
+		}
+		return label
+	}
+	children := func(node service.ProjectItemNode) []service.ProjectItemNode { return node.SubIssues }
+
+	for _, root := range nodes {
+		fmt.Printf("%s%s\n", columns(root), label(root))
+		printTree(root.SubIssues, "", columns, label, children)
+	}
+}
+
+// formatSubIssueProgress formats the sub-issue progress of an issue, or "" when
+// it has no sub-issues
+func formatSubIssueProgress(summary graphql.SubIssuesSummary) string {
+	if summary.Total == 0 {
+		return ""
+	}
+	return fmt.Sprintf(" (%d/%d done)", summary.Completed, summary.Total)
+}
@@ -81,13 +81,13 @@ func runUpdateBulk(ctx context.Context, projectRef, filter, items, fieldName, va
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and services
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	itemService := service.NewItemService(client)
 	projectService := service.NewProjectService(client)
 
@@ -2,6 +2,7 @@ package item
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -15,13 +16,32 @@ import (
 
 const (
 	maxBodyDisplayLength = 500
+
+	// maxViewComments and maxViewTimelineEvents limit --comments and --timeline
+	// to the latest entries
+	maxViewComments       = 30
+	maxViewTimelineEvents = 50
 )
 
 // ViewOptions holds options for the view command
 type ViewOptions struct {
-	ItemRef string
-	Format  string
-	Web     bool
+	ItemRef   string
+	Format    string
+	Web       bool
+	Comments  bool
+	Timeline  bool
+	LinkedPRs bool
+}
+
+// viewDetails holds what item view shows besides the issue or pull request
+// itself: its projects and the sections asked for with flags
+type viewDetails struct {
+	Projects      []service.ItemProjectMembership
+	Comments      []service.ItemCommentInfo
+	Timeline      []service.ItemTimelineEvent
+	Linked        []service.LinkedItemInfo
+	CommentCount  int
+	TimelineCount int
 }
 
 // NewViewCmd creates the view command
@@ -33,6 +53,14 @@ func NewViewCmd() *cobra.Command {
 		Short: "View details of an issue or pull request",
 		Long: `View detailed information about a specific issue or pull request.
 
+For issues, the parent issue and the tree of sub-issues with their progress
+are shown. Every project the issue or pull request is in is listed with its
+field values there, such as Status, Iteration or Priority.
+
+Use --comments, --timeline and --linked-prs to also show the latest comments,
+the latest timeline events, and the pull requests that close the issue (or,
+for a pull request, the issues it closes).
+
 Item references can be in the following formats:
 • owner/repo#123 (issue or PR reference)
 • https://github.com/owner/repo/issues/123 (GitHub issue URL)
@@ -42,6 +70,8 @@ Examples:
   ghx item view octocat/Hello-World#123              # View issue details
   ghx item view https://github.com/cli/cli/pull/456  # View PR from URL
   ghx item view myorg/repo#789 --format json         # View in JSON format
+  ghx item view octocat/Hello-World#123 --comments   # Include the latest comments
+  ghx item view octocat/Hello-World#123 --timeline --linked-prs
   ghx item view octocat/Hello-World#123 --web        # Open in browser`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -52,6 +82,9 @@ Examples:
 
 	cmd.Flags().StringVar(&opts.Format, "format", "details", "Output format: details, json")
 	cmd.Flags().BoolVar(&opts.Web, "web", false, "Open item in web browser")
+	cmd.Flags().BoolVar(&opts.Comments, "comments", false, "Show the latest comments")
+	cmd.Flags().BoolVar(&opts.Timeline, "timeline", false, "Show the latest timeline events")
+	cmd.Flags().BoolVar(&opts.LinkedPRs, "linked-prs", false, "Show linked pull requests, or the issues a pull request closes")
 
 	return cmd
 }
@@ -65,13 +98,13 @@ func runView(ctx context.Context, opts *ViewOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	itemService := service.NewItemService(client)
 
 	// Try to get as issue first
@@ -81,7 +114,19 @@ func runView(ctx context.Context, opts *ViewOptions) error {
 			fmt.Printf("Opening issue in browser: %s\n", issue.URL)
 			return nil
 		}
-		return outputIssueDetails(issue, opts.Format)
+
+		var subIssues []service.SubIssueNode
+		if issue.SubIssuesSummary.Total > 0 && opts.Format == "details" {
+			subIssues, err = itemService.GetSubIssueTree(ctx, issue.ID)
+			if err != nil {
+				return err
+			}
+		}
+		details, err := loadViewDetails(ctx, itemService, opts, owner, repo, number)
+		if err != nil {
+			return err
+		}
+		return outputIssueDetails(issue, subIssues, details, opts.Format)
 	}
 
 	// Try as pull request
@@ -95,32 +140,67 @@ func runView(ctx context.Context, opts *ViewOptions) error {
 		return nil
 	}
 
-	return outputPullRequestDetails(pr, opts.Format)
+	details, err := loadViewDetails(ctx, itemService, opts, owner, repo, number)
+	if err != nil {
+		return err
+	}
+	return outputPullRequestDetails(pr, details, opts.Format)
 }
 
-func outputIssueDetails(issue *graphql.Issue, format string) error {
+// loadViewDetails fetches the projects of an issue or pull request and the
+// sections asked for with flags
+func loadViewDetails(ctx context.Context, itemService *service.ItemService, opts *ViewOptions, owner, repo string, number int) (*viewDetails, error) {
+	details := &viewDetails{}
+	var err error
+
+	details.Projects, err = itemService.GetProjectMemberships(ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Comments {
+		details.Comments, details.CommentCount, err = itemService.GetComments(ctx, owner, repo, number, maxViewComments)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if opts.Timeline {
+		details.Timeline, details.TimelineCount, err = itemService.GetTimeline(ctx, owner, repo, number, maxViewTimelineEvents)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if opts.LinkedPRs {
+		details.Linked, err = itemService.GetLinkedItems(ctx, owner, repo, number)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return details, nil
+}
+
+func outputIssueDetails(issue *graphql.Issue, subIssues []service.SubIssueNode, details *viewDetails, format string) error {
 	switch format {
 	case "json":
-		return outputIssueDetailsJSON(issue)
+		return outputIssueDetailsJSON(issue, details)
 	case "details":
-		return outputIssueDetailsTable(issue)
+		return outputIssueDetailsTable(issue, subIssues, details)
 	default:
 		return fmt.Errorf("unknown format: %s", format)
 	}
 }
 
-func outputPullRequestDetails(pr *graphql.PullRequest, format string) error {
+func outputPullRequestDetails(pr *graphql.PullRequest, details *viewDetails, format string) error {
 	switch format {
 	case "json":
-		return outputPullRequestDetailsJSON(pr)
+		return outputPullRequestDetailsJSON(pr, details)
 	case "details":
-		return outputPullRequestDetailsTable(pr)
+		return outputPullRequestDetailsTable(pr, details)
 	default:
 		return fmt.Errorf("unknown format: %s", format)
 	}
 }
 
-func outputIssueDetailsTable(issue *graphql.Issue) error {
+func outputIssueDetailsTable(issue *graphql.Issue, subIssues []service.SubIssueNode, details *viewDetails) error {
 	fmt.Printf("Issue #%d\n", issue.Number)
 	fmt.Printf("Title: %s\n", issue.Title)
 	fmt.Printf("Repository: %s\n", issue.Repository.NameWithOwner)
@@ -134,6 +214,15 @@ func outputIssueDetailsTable(issue *graphql.Issue) error {
 	fmt.Printf("URL: %s\n", issue.URL)
 	fmt.Printf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
 	fmt.Printf("Updated: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
+	if issue.Parent != nil {
+		fmt.Printf("Parent: %s#%d %s\n", issue.Parent.Repository.NameWithOwner, issue.Parent.Number, issue.Parent.Title)
+	}
+
+	// Sub-issues
+	if summary := issue.SubIssuesSummary; summary.Total > 0 {
+		fmt.Printf("\nSub-issues: %d/%d done (%d%%)\n", summary.Completed, summary.Total, summary.PercentCompleted)
+		printSubIssueTree(subIssues)
+	}
 
 	// Labels
 	if len(issue.Labels.Nodes) > 0 {
@@ -151,6 +240,8 @@ func outputIssueDetailsTable(issue *graphql.Issue) error {
 		}
 	}
 
+	printProjectMemberships(details.Projects)
+
 	// Body
 	if issue.Body != "" {
 		fmt.Printf("\nDescription:\n")
@@ -169,10 +260,11 @@ func outputIssueDetailsTable(issue *graphql.Issue) error {
 		}
 	}
 
+	printViewSections(details, "Linked Pull Requests")
 	return nil
 }
 
-func outputPullRequestDetailsTable(pr *graphql.PullRequest) error {
+func outputPullRequestDetailsTable(pr *graphql.PullRequest, details *viewDetails) error {
 	fmt.Printf("Pull Request #%d\n", pr.Number)
 	fmt.Printf("Title: %s\n", pr.Title)
 	fmt.Printf("Repository: %s\n", pr.Repository.NameWithOwner)
@@ -212,6 +304,8 @@ func outputPullRequestDetailsTable(pr *graphql.PullRequest) error {
 		}
 	}
 
+	printProjectMemberships(details.Projects)
+
 	// Body
 	if pr.Body != "" {
 		fmt.Printf("\nDescription:\n")
@@ -230,10 +324,11 @@ func outputPullRequestDetailsTable(pr *graphql.PullRequest) error {
 		}
 	}
 
+	printViewSections(details, "Closes")
 	return nil
 }
 
-func outputIssueDetailsJSON(issue *graphql.Issue) error {
+func outputIssueDetailsJSON(issue *graphql.Issue, details *viewDetails) error {
 	fmt.Printf("{\n")
 	fmt.Printf("  \"type\": \"Issue\",\n")
 	fmt.Printf("  \"number\": %d,\n", issue.Number)
@@ -242,14 +337,22 @@ func outputIssueDetailsJSON(issue *graphql.Issue) error {
 	fmt.Printf("  \"author\": \"%s\",\n", issue.Author.Login)
 	fmt.Printf("  \"state\": \"%s\",\n", issue.State)
 	fmt.Printf("  \"closed\": %t,\n", issue.Closed)
+	if issue.Parent != nil {
+		fmt.Printf("  \"parent\": \"%s#%d\",\n", issue.Parent.Repository.NameWithOwner, issue.Parent.Number)
+	}
+	fmt.Printf("  \"sub_issues\": {\"total\": %d, \"completed\": %d, \"percent_completed\": %d},\n",
+		issue.SubIssuesSummary.Total, issue.SubIssuesSummary.Completed, issue.SubIssuesSummary.PercentCompleted)
 	fmt.Printf("  \"url\": \"%s\",\n", issue.URL)
 	fmt.Printf("  \"created_at\": \"%s\",\n", issue.CreatedAt.Format("2006-01-02T15:04:05Z"))
-	fmt.Printf("  \"updated_at\": \"%s\"\n", issue.UpdatedAt.Format("2006-01-02T15:04:05Z"))
-	fmt.Printf("}\n")
+	fmt.Printf("  \"updated_at\": \"%s\"", issue.UpdatedAt.Format("2006-01-02T15:04:05Z"))
+	if err := printViewSectionsJSON(details, "linked_pull_requests"); err != nil {
+		return err
+	}
+	fmt.Printf("\n}\n")
 	return nil
 }
 
-func outputPullRequestDetailsJSON(pr *graphql.PullRequest) error {
+func outputPullRequestDetailsJSON(pr *graphql.PullRequest, details *viewDetails) error {
 	fmt.Printf("{\n")
 	fmt.Printf("  \"type\": \"PullRequest\",\n")
 	fmt.Printf("  \"number\": %d,\n", pr.Number)
@@ -261,7 +364,94 @@ func outputPullRequestDetailsJSON(pr *graphql.PullRequest) error {
 	fmt.Printf("  \"merged\": %t,\n", pr.Merged)
 	fmt.Printf("  \"url\": \"%s\",\n", pr.URL)
 	fmt.Printf("  \"created_at\": \"%s\",\n", pr.CreatedAt.Format("2006-01-02T15:04:05Z"))
-	fmt.Printf("  \"updated_at\": \"%s\"\n", pr.UpdatedAt.Format("2006-01-02T15:04:05Z"))
-	fmt.Printf("}\n")
+	fmt.Printf("  \"updated_at\": \"%s\"", pr.UpdatedAt.Format("2006-01-02T15:04:05Z"))
+	if err := printViewSectionsJSON(details, "closing_issues"); err != nil {
+		return err
+	}
+	fmt.Printf("\n}\n")
+	return nil
+}
+
+// printProjectMemberships prints the projects of an issue or pull request with
+// its field values in each
+func printProjectMemberships(memberships []service.ItemProjectMembership) {
+	if len(memberships) == 0 {
+		return
+	}
+
+	fmt.Printf("\nProjects:\n")
+	for _, membership := range memberships {
+		fmt.Printf("  %s (%s)\n", membership.Title, membership.Project)
+		if len(membership.Fields) == 0 {
+			fmt.Printf("    No field values\n")
+		}
+		for _, field := range membership.Fields {
+			fmt.Printf("    %s: %s\n", field.Field, field.Value)
+		}
+	}
+}
+
+// printViewSections prints the linked items, comments and timeline asked for
+// with flags; linkedTitle names the linked items section
+func printViewSections(details *viewDetails, linkedTitle string) {
+	if details.Linked != nil {
+		fmt.Printf("\n%s:\n", linkedTitle)
+		if len(details.Linked) == 0 {
+			fmt.Printf("  None\n")
+		}
+		for _, linked := range details.Linked {
+			fmt.Printf("  • %s %s (%s)\n", linked.Reference, linked.Title, linked.State)
+		}
+	}
+
+	if details.Comments != nil {
+		fmt.Printf("\nComments (%s):\n", latestOf(len(details.Comments), details.CommentCount))
+		for _, comment := range details.Comments {
+			fmt.Printf("\n%s commented on %s\n", comment.Author, comment.CreatedAt.Format("2006-01-02 15:04"))
+			for _, line := range strings.Split(strings.TrimSpace(comment.Body), "\n") {
+				fmt.Printf("  %s\n", line)
+			}
+		}
+	}
+
+	if details.Timeline != nil {
+		fmt.Printf("\nTimeline (%s):\n", latestOf(len(details.Timeline), details.TimelineCount))
+		for _, event := range details.Timeline {
+			fmt.Printf("  %s  %s %s\n", event.CreatedAt.Format("2006-01-02 15:04"), event.Actor, event.Description)
+		}
+	}
+}
+
+// latestOf describes how many of the total entries are shown
+func latestOf(shown, total int) string {
+	if shown >= total {
+		return fmt.Sprintf("%d", total)
+	}
+	return fmt.Sprintf("latest %d of %d", shown, total)
+}
+
+// printViewSectionsJSON prints the projects and the sections asked for with
+// flags as further members of a JSON object; linkedKey names the linked items
+func printViewSectionsJSON(details *viewDetails, linkedKey string) error {
+	sections := []struct {
+		value interface{}
+		key   string
+		shown bool
+	}{
+		{key: "projects", value: details.Projects, shown: true},
+		{key: linkedKey, value: details.Linked, shown: details.Linked != nil},
+		{key: "comments", value: details.Comments, shown: details.Comments != nil},
+		{key: "timeline", value: details.Timeline, shown: details.Timeline != nil},
+	}
+	for _, section := range sections {
+		if !section.shown {
+			continue
+		}
+		data, err := json.MarshalIndent(section.value, "  ", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Printf(",\n  %q: %s", section.key, data)
+	}
 	return nil
 }
@@ -0,0 +1,65 @@
+package mcp
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Config keys of the MCP server
+const (
+	configReadOnly = "mcp.read-only"
+	configTools    = "mcp.tools"
+)
+
+// NewMCPCmd creates the mcp command
+func NewMCPCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mcp",
+		Short: "Expose ghx operations to AI assistants over MCP",
+		Long: `Expose ghx operations as Model Context Protocol (MCP) tools.
+
+AI assistants that speak MCP can start ghx as a server and use its tools to
+triage projects and discussions with your GitHub credentials.
+
+Commands:
+  serve        - Serve the tools over stdio
+  tools        - List the tools and whether they are enabled
+
+Config (~/.ghx.yaml):
+  mcp:
+    read-only: true           # Leave out tools that change anything
+    tools:                    # Only expose these tools
+      - list_project_items
+      - update_item_field
+
+Examples:
+  ghx mcp serve
+  ghx mcp serve --read-only
+  ghx mcp tools`,
+
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewServeCmd())
+	cmd.AddCommand(NewToolsCmd())
+
+	return cmd
+}
+
+// addToolFlags adds the flags selecting the tools of the server
+func addToolFlags(cmd *cobra.Command, readOnly *bool, tools *[]string) {
+	cmd.Flags().BoolVar(readOnly, "read-only", false, "Leave out tools that change anything (default: mcp.read-only)")
+	cmd.Flags().StringSliceVar(tools, "tools", nil, "Only expose these tools (default: mcp.tools)")
+}
+
+// toolSelection returns the read-only mode and allowlist given by flags, falling
+// back to the config file
+func toolSelection(cmd *cobra.Command, readOnly bool, tools []string) (bool, []string) {
+	if !cmd.Flags().Changed("read-only") {
+		readOnly = viper.GetBool(configReadOnly)
+	}
+	if !cmd.Flags().Changed("tools") {
+		tools = viper.GetStringSlice(configTools)
+	}
+	return readOnly, tools
+}
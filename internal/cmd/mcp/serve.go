@@ -0,0 +1,93 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// ServeOptions holds options for the serve command
+type ServeOptions struct {
+	Version  string
+	Tools    []string
+	ReadOnly bool
+}
+
+// NewServeCmd creates the serve command
+func NewServeCmd() *cobra.Command {
+	opts := &ServeOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Serve ghx tools over MCP on stdio",
+		Long: `Serve ghx tools to an MCP client over stdin and stdout.
+
+The client starts this command and exchanges newline-delimited JSON-RPC
+messages with it. Tools use the credentials of the active profile.
+
+Tools:
+  list_projects, view_project, list_project_items
+  update_item_field, add_item, create_draft_issue
+  list_discussions, view_discussion
+  create_discussion, comment_on_discussion, answer_discussion
+
+With --read-only or mcp.read-only in the config file, only the list_ and
+view_ tools are served. --tools or mcp.tools limits the tools further.
+
+Client configuration:
+  {
+    "mcpServers": {
+      "ghx": {"command": "ghx", "args": ["mcp", "serve", "--read-only"]}
+    }
+  }
+
+Examples:
+  ghx mcp serve
+  ghx mcp serve --read-only
+  ghx mcp serve --tools list_project_items,update_item_field`,
+
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ReadOnly, opts.Tools = toolSelection(cmd, opts.ReadOnly, opts.Tools)
+			opts.Version = cmd.Root().Version
+			return runServe(cmd.Context(), opts)
+		},
+	}
+
+	addToolFlags(cmd, &opts.ReadOnly, &opts.Tools)
+
+	return cmd
+}
+
+func runServe(ctx context.Context, opts *ServeOptions) error {
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	server, err := service.NewMCPServer(api.NewClientWithTokenSource(tokenSource), service.MCPServerOptions{
+		Version:  opts.Version,
+		Tools:    opts.Tools,
+		ReadOnly: opts.ReadOnly,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	// stdout carries the protocol, so messages go to stderr
+	fmt.Fprintf(os.Stderr, "ghx MCP server ready with %d tool(s)\n", len(server.ToolNames()))
+	return server.Serve(ctx, os.Stdin, os.Stdout)
+}
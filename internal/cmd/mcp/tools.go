@@ -0,0 +1,98 @@
+package mcp
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// ToolsOptions holds options for the tools command
+type ToolsOptions struct {
+	Format   string
+	Tools    []string
+	ReadOnly bool
+}
+
+type toolJSON struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ReadOnly    bool   `json:"readOnly"`
+	Enabled     bool   `json:"enabled"`
+}
+
+// NewToolsCmd creates the tools command
+func NewToolsCmd() *cobra.Command {
+	opts := &ToolsOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "tools",
+		Short: "List the MCP tools and whether they are enabled",
+		Long: `List the tools ghx can serve over MCP and whether they are enabled by the
+read-only mode and allowlist of the config file or flags.
+
+Examples:
+  ghx mcp tools
+  ghx mcp tools --read-only
+  ghx mcp tools --format json`,
+
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ReadOnly, opts.Tools = toolSelection(cmd, opts.ReadOnly, opts.Tools)
+			opts.Format = cmd.Flag("format").Value.String()
+			return runTools(opts)
+		},
+	}
+
+	addToolFlags(cmd, &opts.ReadOnly, &opts.Tools)
+
+	return cmd
+}
+
+func runTools(opts *ToolsOptions) error {
+	tools := service.MCPTools(nil)
+	selected, err := service.SelectMCPTools(tools, opts.Tools, opts.ReadOnly)
+	if err != nil {
+		return err
+	}
+	enabled := make(map[string]bool, len(selected))
+	for i := range selected {
+		enabled[selected[i].Name] = true
+	}
+
+	result := make([]toolJSON, len(tools))
+	for i := range tools {
+		result[i] = toolJSON{
+			Name:        tools[i].Name,
+			Description: tools[i].Description,
+			ReadOnly:    tools[i].ReadOnly,
+			Enabled:     enabled[tools[i].Name],
+		}
+	}
+
+	switch opts.Format {
+	case "json":
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	case "table":
+		fmt.Printf("%-24s %-10s %-8s\n", "TOOL", "ACCESS", "ENABLED")
+		for _, tool := range result {
+			access, state := "write", "no"
+			if tool.ReadOnly {
+				access = "read"
+			}
+			if tool.Enabled {
+				state = "yes"
+			}
+			fmt.Printf("%-24s %-10s %-8s\n", tool.Name, access, state)
+		}
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package project
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// NewApplyCmd creates the apply command
+func NewApplyCmd() *cobra.Command {
+	opts := &PlanOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "apply <owner>/<number> -f <spec-file>",
+		Short: "Make a project match a spec",
+		Long: `Apply a YAML project spec to a project.
+
+Only the changes shown by 'ghx project plan' are made: fields, options and views
+are created, renamed or updated, and repositories are linked. Deletions of
+anything not in the spec are only made with --prune.
+
+The plan is shown before anything is changed and has to be confirmed unless
+--force is used.
+
+Examples:
+  ghx project apply octocat/1 -f project.yaml
+  ghx project apply myorg/2 -f project.yaml --prune
+  ghx project apply myorg/2 -f project.yaml --force`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runApply(cmd.Context(), opts)
+		},
+	}
+
+	addPlanFlags(cmd, opts)
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func runApply(ctx context.Context, opts *PlanOptions) error {
+	specService, plan, err := buildPlan(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	printPlan(plan)
+	if len(plan.Changes) == 0 {
+		return nil
+	}
+
+	// Confirm the plan unless --force is used
+	if !opts.Force {
+		fmt.Printf("\nType 'yes' to apply these changes: ")
+
+		var confirmation string
+		_, scanErr := fmt.Scanln(&confirmation)
+		if scanErr != nil {
+			fmt.Println("❌ Failed to read confirmation.")
+			return scanErr
+		}
+
+		if confirmation != "yes" {
+			fmt.Println("❌ Apply canceled.")
+			return nil
+		}
+	}
+
+	fmt.Println()
+	applied, err := specService.Apply(ctx, plan, func(change service.PlanChange) {
+		fmt.Printf("✓ %s %s %s\n", planSymbol(change.Action), change.Kind, change.Target)
+	})
+	if err != nil {
+		fmt.Printf("❌ Applied %d of %d change(s).\n", applied, len(plan.Changes))
+		return err
+	}
+
+	fmt.Printf("\n✅ Applied %d change(s).\n", applied)
+	return nil
+}
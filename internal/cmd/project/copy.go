@@ -0,0 +1,139 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// CopyOptions holds options for the copy command
+type CopyOptions struct {
+	ProjectRef    string
+	ToOwner       string
+	Title         string
+	Format        string
+	IncludeDrafts bool
+	IncludeItems  bool
+	CopyValues    bool
+	LinkRepos     bool
+}
+
+// NewCopyCmd creates the copy command
+func NewCopyCmd() *cobra.Command {
+	opts := &CopyOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "copy <owner>/<number>",
+		Short: "Copy a project",
+		Long: `Copy a project, optionally to another user or organization.
+
+GitHub copies the fields, views and workflows of the project, and its draft issues
+with --include-drafts. Issues and pull requests are not part of the copy; use
+--include-items to add them to the new project and --copy-values to also copy
+their field values. Values are matched to the new project by field and option
+name. Use --link-repos to link the new project to the repositories the source
+project is linked to.
+
+Examples:
+  ghx project copy octocat/1 --title "Roadmap 2026"
+  ghx project copy octocat/1 --to-owner myorg --include-drafts
+  ghx project copy myorg/2 --include-items --copy-values --link-repos`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runCopy(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ToOwner, "to-owner", "", "Login of the user or organization to own the copy (default: source owner)")
+	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "Title of the copy (default: source title)")
+	cmd.Flags().BoolVar(&opts.IncludeDrafts, "include-drafts", false, "Copy draft issues")
+	cmd.Flags().BoolVar(&opts.IncludeItems, "include-items", false, "Add the source's issues and pull requests to the copy")
+	cmd.Flags().BoolVar(&opts.CopyValues, "copy-values", false, "Copy field values of added items (requires --include-items)")
+	cmd.Flags().BoolVar(&opts.LinkRepos, "link-repos", false, "Link the copy to the source's repositories")
+	cmd.Flags().StringVar(&opts.Format, "format", formatDetails, "Output format: details, json")
+
+	return cmd
+}
+
+func runCopy(ctx context.Context, opts *CopyOptions) error {
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	if opts.CopyValues && !opts.IncludeItems {
+		return fmt.Errorf("--copy-values requires --include-items")
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	client := api.NewClientWithTokenSource(tokenSource)
+	projectService := service.NewProjectService(client)
+
+	result, err := projectService.CopyProject(ctx, service.CopyProjectOptions{
+		SourceOwner:        owner,
+		SourceNumber:       number,
+		TargetOwner:        opts.ToOwner,
+		Title:              opts.Title,
+		IncludeDraftIssues: opts.IncludeDrafts,
+		IncludeItems:       opts.IncludeItems,
+		CopyFieldValues:    opts.CopyValues,
+		LinkRepositories:   opts.LinkRepos,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to copy project: %w", err)
+	}
+
+	switch opts.Format {
+	case formatJSON:
+		data, err := json.MarshalIndent(map[string]interface{}{
+			"project":            result.Project,
+			"itemsAdded":         result.ItemsAdded,
+			"valuesCopied":       result.ValuesCopied,
+			"repositoriesLinked": result.RepositoriesLinked,
+			"warnings":           result.Warnings,
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatDetails:
+		project := result.Project
+		fmt.Printf("✅ Copied %s to %s\n\n", opts.ProjectRef, service.FormatProjectReference(project.Owner.Login, project.Number))
+		fmt.Printf("Title: %s\n", project.Title)
+		fmt.Printf("URL: %s\n", project.URL)
+		if opts.IncludeItems {
+			fmt.Printf("Items added: %d\n", result.ItemsAdded)
+		}
+		if opts.CopyValues {
+			fmt.Printf("Field values copied: %d\n", result.ValuesCopied)
+		}
+		if opts.LinkRepos {
+			fmt.Printf("Repositories linked: %d\n", result.RepositoriesLinked)
+		}
+		if len(result.Warnings) > 0 {
+			fmt.Printf("\n⚠️  %d warning(s):\n", len(result.Warnings))
+			for _, warning := range result.Warnings {
+				fmt.Printf("  • %s\n", warning)
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+}
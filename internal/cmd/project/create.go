@@ -75,13 +75,13 @@ func runCreate(ctx context.Context, opts *CreateOptions, args []string) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
 
 	// Create project
@@ -113,7 +113,7 @@ func runCreate(ctx context.Context, opts *CreateOptions, args []string) error {
 func outputCreatedProject(project *graphql.ProjectV2, format string) error {
 	switch format {
 	case formatJSON:
-		return outputProjectDetailsJSON(project)
+		return outputProjectDetailsJSON(project, nil, nil)
 	case formatDetails:
 		fmt.Printf("Project #%d\n", project.Number)
 		fmt.Printf("Title: %s\n", project.Title)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -36,7 +37,8 @@ Examples:
   ghx project delete 123 --force           # Delete project 123 (with confirmation)
   ghx project delete octocat/123 --force   # Delete project owned by octocat
   ghx project delete myorg/456 --org --force  # Delete org project`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runDelete(cmd.Context(), opts, args)
 		},
@@ -71,13 +73,13 @@ func runDelete(ctx context.Context, opts *DeleteOptions, args []string) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
 
 	// First, get the current project to obtain its ID and show details
@@ -0,0 +1,165 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// errProjectsDiffer is returned with --exit-code when differences are found
+var errProjectsDiffer = errors.New("projects differ")
+
+// DiffOptions holds options for the diff command
+type DiffOptions struct {
+	Source   string
+	Target   string
+	Format   string
+	Items    bool
+	ExitCode bool
+}
+
+// NewDiffCmd creates the diff command
+func NewDiffCmd() *cobra.Command {
+	opts := &DiffOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "diff <owner>/<number | export-file> <owner>/<number | export-file>",
+		Short: "Compare two projects or a project and an export file",
+		Long: `Compare the configuration of two projects. Either side can be a live
+project or a file written by 'ghx project export'.
+
+The diff reports fields that exist on one side only or were renamed, single
+select option differences (name, color, description), and view differences
+(layout, filter, sort, group). With --items it also compares which items are in
+each project and their field values.
+
+Differences are described from the first project towards the second. With
+--exit-code the command exits with status 1 when differences are found, which
+makes it usable as a CI check.
+
+Examples:
+  ghx project diff myorg/1 myorg/2
+  ghx project diff myorg/1 template.json --items
+  ghx project diff myorg/1 myorg/2 --format json --exit-code`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.Source = args[0]
+			opts.Target = args[1]
+			err := runDiff(cmd.Context(), opts)
+			if errors.Is(err, errProjectsDiffer) {
+				// Differences are an outcome, not a usage mistake
+				cmd.SilenceUsage = true
+			}
+			return err
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Items, "items", false, "Also compare items and their field values")
+	cmd.Flags().BoolVar(&opts.ExitCode, "exit-code", false, "Exit with status 1 when the projects differ")
+	cmd.Flags().StringVar(&opts.Format, "format", formatTable, "Output format: table, json")
+
+	return cmd
+}
+
+func runDiff(ctx context.Context, opts *DiffOptions) error {
+	loader := &snapshotLoader{items: opts.Items}
+
+	source, err := loader.load(ctx, opts.Source)
+	if err != nil {
+		return err
+	}
+	target, err := loader.load(ctx, opts.Target)
+	if err != nil {
+		return err
+	}
+
+	diff := service.DiffProjects(source, target, service.ProjectDiffOptions{Items: opts.Items})
+
+	switch opts.Format {
+	case formatJSON:
+		data, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	case formatTable:
+		printDiff(diff)
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	if opts.ExitCode && len(diff.Differences) > 0 {
+		return errProjectsDiffer
+	}
+	return nil
+}
+
+// snapshotLoader reads projects from export files or the API, authenticating
+// only when a live project is needed
+type snapshotLoader struct {
+	projectService *service.ProjectService
+	items          bool
+}
+
+func (l *snapshotLoader) load(ctx context.Context, ref string) (*service.ExportedProject, error) {
+	if info, err := os.Stat(ref); err == nil && !info.IsDir() {
+		return service.LoadProjectExport(ref)
+	}
+
+	if _, _, err := service.ParseProjectReference(ref); err != nil {
+		return nil, fmt.Errorf("%s is neither an export file nor a project reference: %w", ref, err)
+	}
+
+	if l.projectService == nil {
+		authManager := auth.NewAuthManager()
+		tokenSource, err := authManager.TokenSource()
+		if err != nil {
+			return nil, fmt.Errorf("authentication failed: %w", err)
+		}
+		l.projectService = service.NewProjectService(api.NewClientWithTokenSource(tokenSource))
+	}
+
+	return l.projectService.BuildProjectExport(ctx, &service.ProjectExportData{
+		ProjectID:     ref,
+		IncludeFields: true,
+		IncludeViews:  true,
+		IncludeItems:  l.items,
+	})
+}
+
+func printDiff(diff *service.ProjectDiff) {
+	fmt.Printf("--- %s\n", diff.Source)
+	fmt.Printf("+++ %s\n\n", diff.Target)
+
+	if len(diff.Differences) == 0 {
+		fmt.Println("No differences.")
+		return
+	}
+
+	for _, entry := range diff.Differences {
+		fmt.Printf("  %s %s %s\n", diffSymbol(entry.Change), entry.Kind, entry.Name)
+		for _, detail := range entry.Details {
+			fmt.Printf("      %s\n", detail)
+		}
+	}
+	fmt.Printf("\n%d difference(s)\n", len(diff.Differences))
+}
+
+func diffSymbol(change string) string {
+	switch change {
+	case service.DiffOnlyInSource:
+		return "-"
+	case service.DiffOnlyInTarget:
+		return "+"
+	default:
+		return "~"
+	}
+}
@@ -3,6 +3,8 @@ package project
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
 	"strings"
 
@@ -11,41 +13,69 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // EditOptions holds options for the edit command
 type EditOptions struct {
-	Owner  string
-	Title  string
-	Format string
-	Number int
-	Org    bool
-	Close  bool
-	Reopen bool
+	// Description and Readme are nil unless set, so they can be cleared with ""
+	Description *string
+	Readme      *string
+	Owner       string
+	Title       string
+	ReadmeFile  string
+	Visibility  string
+	Format      string
+	Number      int
+	Org         bool
+	Close       bool
+	Reopen      bool
+	EditReadme  bool
 }
 
 // NewEditCmd creates the edit command
 func NewEditCmd() *cobra.Command {
 	opts := &EditOptions{}
+	var description, readme string
 
 	cmd := &cobra.Command{
 		Use:   "edit {<owner>/<number> | <number>}",
 		Short: "Edit a project",
 		Long: `Edit an existing project.
 
+The README can be given inline with --readme, read from a file with
+--readme-file ("-" reads standard input), or edited in $VISUAL or $EDITOR with
+--edit-readme, which starts from the current README.
+
 Examples:
-  ghx project edit 123 --title "New Title"      # Edit project title
-  ghx project edit octocat/123 --close          # Close project
-  ghx project edit myorg/456 --reopen --org     # Reopen org project`,
-		Args: cobra.ExactArgs(1),
+  ghx project edit octocat/123 --title "New Title"              # Edit project title
+  ghx project edit octocat/123 --description "What we ship"     # Set the short description
+  ghx project edit octocat/123 --readme-file README.md          # Replace the README
+  ghx project edit octocat/123 --edit-readme                    # Edit the README in $EDITOR
+  ghx project edit myorg/456 --visibility private --org         # Make an org project private
+  ghx project edit octocat/123 --close                          # Close project
+  ghx project edit myorg/456 --reopen --org                     # Reopen org project`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("description") {
+				opts.Description = &description
+			}
+			if cmd.Flags().Changed("readme") {
+				opts.Readme = &readme
+			}
 			return runEdit(cmd.Context(), opts, args)
 		},
 	}
 
 	cmd.Flags().BoolVar(&opts.Org, "org", false, "Project belongs to an organization")
 	cmd.Flags().StringVarP(&opts.Title, "title", "t", "", "New project title")
+	cmd.Flags().StringVar(&description, "description", "", "New short description")
+	cmd.Flags().StringVar(&readme, "readme", "", "New README content (Markdown)")
+	cmd.Flags().StringVar(&opts.ReadmeFile, "readme-file", "", "Read the README from a file (\"-\" for standard input)")
+	cmd.Flags().BoolVar(&opts.EditReadme, "edit-readme", false, "Edit the README in $VISUAL or $EDITOR")
+	cmd.Flags().StringVar(&opts.Visibility, "visibility", "", "Project visibility (public, private)")
 	cmd.Flags().BoolVar(&opts.Close, "close", false, "Close the project")
 	cmd.Flags().BoolVar(&opts.Reopen, "reopen", false, "Reopen the project")
 	cmd.Flags().StringVar(&opts.Format, "format", "details", "Output format: details, json")
@@ -79,20 +109,49 @@ func runEdit(ctx context.Context, opts *EditOptions, args []string) error {
 		return fmt.Errorf("cannot specify both --close and --reopen")
 	}
 
+	readmeSources := 0
+	for _, set := range []bool{opts.Readme != nil, opts.ReadmeFile != "", opts.EditReadme} {
+		if set {
+			readmeSources++
+		}
+	}
+	if readmeSources > 1 {
+		return fmt.Errorf("only one of --readme, --readme-file and --edit-readme can be used")
+	}
+
+	var public *bool
+	if opts.Visibility != "" {
+		value, err := service.ParseVisibility(opts.Visibility)
+		if err != nil {
+			return err
+		}
+		public = &value
+	}
+
+	if opts.ReadmeFile != "" {
+		content, err := readTextFile(opts.ReadmeFile, "README")
+		if err != nil {
+			return err
+		}
+		opts.Readme = &content
+	}
+
 	// Check if any changes are specified
-	if opts.Title == "" && !opts.Close && !opts.Reopen {
-		return fmt.Errorf("no changes specified (use --title, --close, or --reopen)")
+	if opts.Title == "" && opts.Description == nil && opts.Readme == nil && !opts.EditReadme &&
+		public == nil && !opts.Close && !opts.Reopen {
+		return fmt.Errorf("no changes specified (use --title, --description, --readme, --readme-file, " +
+			"--edit-readme, --visibility, --close, or --reopen)")
 	}
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
 
 	// First, get the current project to obtain its ID
@@ -101,9 +160,34 @@ func runEdit(ctx context.Context, opts *EditOptions, args []string) error {
 		return fmt.Errorf("failed to get project: %w", err)
 	}
 
+	// Edit the current README
+	if opts.EditReadme {
+		settings, err := projectService.GetProjectSettings(ctx, currentProject.ID)
+		if err != nil {
+			return err
+		}
+		current := ""
+		if settings.Readme != nil {
+			current = *settings.Readme
+		}
+		edited, err := editText(current, "README-*.md")
+		if err != nil {
+			return err
+		}
+		if edited != current {
+			opts.Readme = &edited
+		} else if opts.Title == "" && opts.Description == nil && public == nil && !opts.Close && !opts.Reopen {
+			fmt.Println("README unchanged, nothing to update.")
+			return nil
+		}
+	}
+
 	// Prepare update input
 	updateInput := service.UpdateProjectInput{
-		ProjectID: currentProject.ID,
+		ProjectID:        currentProject.ID,
+		ShortDescription: opts.Description,
+		Readme:           opts.Readme,
+		Public:           public,
 	}
 
 	if opts.Title != "" {
@@ -124,15 +208,36 @@ func runEdit(ctx context.Context, opts *EditOptions, args []string) error {
 		return fmt.Errorf("failed to update project: %w", err)
 	}
 
+	settings, err := projectService.GetProjectSettings(ctx, updatedProject.ID)
+	if err != nil {
+		return err
+	}
+
 	// Output updated project
 	fmt.Printf("✅ Project updated successfully!\n\n")
-	return outputUpdatedProject(updatedProject, opts.Format)
+	return outputUpdatedProject(updatedProject, settings, opts.Format)
+}
+
+// readTextFile reads text such as a README from a file, or from standard input
+// for "-"; what names the text in errors
+func readTextFile(path, what string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path) //nolint:gosec // reading the user-provided file is intended
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", what, err)
+	}
+	return string(data), nil
 }
 
-func outputUpdatedProject(project *graphql.ProjectV2, format string) error {
+func outputUpdatedProject(project *graphql.ProjectV2, settings *graphql.ProjectV2Settings, format string) error {
 	switch format {
 	case formatJSON:
-		return outputProjectDetailsJSON(project)
+		return outputProjectDetailsJSON(project, settings, nil)
 	case "details":
 		fmt.Printf("Project #%d\n", project.Number)
 		fmt.Printf("Title: %s\n", project.Title)
@@ -149,6 +254,7 @@ func outputUpdatedProject(project *graphql.ProjectV2, format string) error {
 			state = "Closed"
 		}
 		fmt.Printf("State: %s\n", state)
+		printProjectSettings(settings)
 
 		fmt.Printf("Updated: %s\n", project.UpdatedAt.Format("2006-01-02 15:04:05"))
 
@@ -0,0 +1,55 @@
+package project
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// editText opens text in the user's editor ($VISUAL, $EDITOR, or a platform
+// default) and returns the saved content. pattern names the temporary file,
+// e.g. "README-*.md", so editors can pick the right syntax.
+func editText(text, pattern string) (string, error) {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+		if runtime.GOOS == "windows" {
+			editor = "notepad"
+		}
+	}
+
+	file, err := os.CreateTemp("", pattern)
+	if err != nil {
+		return "", fmt.Errorf("failed to create temporary file: %w", err)
+	}
+	defer os.Remove(file.Name())
+
+	if _, err := file.WriteString(text); err != nil {
+		file.Close()
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return "", fmt.Errorf("failed to write temporary file: %w", err)
+	}
+
+	// The editor may include arguments, e.g. "code --wait"
+	args := strings.Fields(editor)
+	cmd := exec.Command(args[0], append(args[1:], file.Name())...) //nolint:gosec // running the user's editor is intended
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("editor %s failed: %w", args[0], err)
+	}
+
+	data, err := os.ReadFile(file.Name())
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited file: %w", err)
+	}
+	return string(data), nil
+}
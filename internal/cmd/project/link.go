@@ -2,18 +2,24 @@ package project
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
-// LinkOptions holds options for the link command
+// LinkOptions holds options for the link and unlink commands
 type LinkOptions struct {
+	ProjectRef string
 	Repository string
+	Team       string
 	Format     string
 }
 
@@ -22,53 +28,199 @@ func NewLinkCmd() *cobra.Command {
 	opts := &LinkOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "link PROJECT_ID",
-		Short: "Link a project to a repository",
-		Long: `Link an existing project to a GitHub repository.
+		Use:   "link <owner>/<number> {--repo <owner>/<repo> | --team <org>/<team>}",
+		Short: "Link a project to a repository or team",
+		Long: `Link an existing project to a GitHub repository or organization team.
 
-This allows the project to automatically track issues and pull requests from the repository.
+Linking a repository lists the project in the repository's Projects tab; linking
+a team lists it in the team's Projects tab and gives the team access to it.
+Teams can only be linked to projects owned by their organization.
+
+The project can be given as owner/number or as a project node ID.
 
 Examples:
-  ghx project link myorg/123 --repo owner/repo    # Link project to repository
-  ghx project link user/456 --repo myuser/myrepo  # Link to personal repository`,
-		Args: cobra.ExactArgs(1),
+  ghx project link myorg/123 --repo owner/repo      # Link project to repository
+  ghx project link user/456 --repo myuser/myrepo    # Link to personal repository
+  ghx project link myorg/123 --team myorg/platform  # Link project to a team
+  ghx project link list myorg/123                   # List linked repositories and teams`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runLink(cmd.Context(), opts, args)
+			opts.ProjectRef = args[0]
+			return runLink(cmd.Context(), opts, true)
 		},
 	}
 
-	cmd.Flags().StringVar(&opts.Repository, "repo", "", "Repository to link (owner/repo)")
-	cmd.Flags().StringVar(&opts.Format, "format", "table", "Output format: table, json, yaml")
+	addLinkTargetFlags(cmd, opts)
+	cmd.AddCommand(NewLinkListCmd())
+
+	return cmd
+}
+
+// NewUnlinkCmd creates the unlink command
+func NewUnlinkCmd() *cobra.Command {
+	opts := &LinkOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "unlink <owner>/<number> {--repo <owner>/<repo> | --team <org>/<team>}",
+		Short: "Unlink a project from a repository or team",
+		Long: `Remove the link between a project and a repository or organization team.
 
-	_ = cmd.MarkFlagRequired("repo")
+The project can be given as owner/number or as a project node ID.
+
+Examples:
+  ghx project unlink myorg/123 --repo owner/repo
+  ghx project unlink myorg/123 --team myorg/platform`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runLink(cmd.Context(), opts, false)
+		},
+	}
+
+	addLinkTargetFlags(cmd, opts)
 
 	return cmd
 }
 
-func runLink(ctx context.Context, opts *LinkOptions, args []string) error {
-	projectID := args[0]
+// NewLinkListCmd creates the link list command
+func NewLinkListCmd() *cobra.Command {
+	opts := &LinkOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <owner>/<number>",
+		Short: "List the repositories and teams linked to a project",
+		Long: `List the repositories and teams a project is linked to.
 
-	if opts.Repository == "" {
-		return fmt.Errorf("repository is required")
+Examples:
+  ghx project link list myorg/123
+  ghx project link list myorg/123 --format json`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runLinkList(cmd.Context(), opts)
+		},
 	}
 
+	cmd.Flags().StringVar(&opts.Format, "format", formatTable, "Output format: table, json")
+
+	return cmd
+}
+
+func addLinkTargetFlags(cmd *cobra.Command, opts *LinkOptions) {
+	cmd.Flags().StringVar(&opts.Repository, "repo", "", "Repository (owner/repo)")
+	cmd.Flags().StringVar(&opts.Team, "team", "", "Organization team (org/team)")
+}
+
+func newLinkProjectService() (*service.ProjectService, error) {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
-		return fmt.Errorf("authentication failed: %w", err)
+		return nil, fmt.Errorf("authentication failed: %w", err)
 	}
 
-	// Create client and service
-	client := api.NewClient(token)
-	projectService := service.NewProjectService(client)
+	return service.NewProjectService(api.NewClientWithTokenSource(tokenSource)), nil
+}
 
-	// Link project to repository
-	err = projectService.LinkProjectToRepository(ctx, projectID, opts.Repository)
+func runLink(ctx context.Context, opts *LinkOptions, link bool) error {
+	if (opts.Repository == "") == (opts.Team == "") {
+		return fmt.Errorf("specify either --repo or --team")
+	}
+
+	projectService, err := newLinkProjectService()
 	if err != nil {
-		return fmt.Errorf("failed to link project to repository: %w", err)
+		return err
 	}
 
-	fmt.Printf("✅ Successfully linked project %s to repository %s\n", projectID, opts.Repository)
+	projectID, err := projectService.ResolveProjectID(ctx, opts.ProjectRef)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case link && opts.Repository != "":
+		err = projectService.LinkProjectToRepository(ctx, projectID, opts.Repository)
+	case link:
+		err = projectService.LinkProjectToTeam(ctx, projectID, opts.Team)
+	case opts.Repository != "":
+		err = projectService.UnlinkProjectFromRepository(ctx, projectID, opts.Repository)
+	default:
+		err = projectService.UnlinkProjectFromTeam(ctx, projectID, opts.Team)
+	}
+	if err != nil {
+		return err
+	}
+
+	target := "repository " + opts.Repository
+	if opts.Team != "" {
+		target = "team " + opts.Team
+	}
+	if link {
+		fmt.Printf("✅ Successfully linked project %s to %s\n", opts.ProjectRef, target)
+	} else {
+		fmt.Printf("✅ Successfully unlinked project %s from %s\n", opts.ProjectRef, target)
+	}
 	return nil
 }
+
+func runLinkList(ctx context.Context, opts *LinkOptions) error {
+	projectService, err := newLinkProjectService()
+	if err != nil {
+		return err
+	}
+
+	projectID, err := projectService.ResolveProjectID(ctx, opts.ProjectRef)
+	if err != nil {
+		return err
+	}
+
+	links, err := projectService.GetProjectLinks(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case formatJSON:
+		data, err := json.MarshalIndent(map[string]interface{}{
+			"repositories": nonNilSlice(links.Repositories.Nodes),
+			"teams":        nonNilSlice(links.Teams.Nodes),
+		}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		printProjectLinks(links)
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+}
+
+func printProjectLinks(links *graphql.ProjectV2Links) {
+	if len(links.Repositories.Nodes) == 0 && len(links.Teams.Nodes) == 0 {
+		fmt.Println("No linked repositories or teams.")
+		return
+	}
+
+	fmt.Printf("%-12s %-35s %s\n", "TYPE", "NAME", "URL")
+	fmt.Println(strings.Repeat("-", tableSeparatorWidth))
+	for _, repo := range links.Repositories.Nodes {
+		fmt.Printf("%-12s %-35s %s\n", "repository", repo.NameWithOwner, repo.URL)
+	}
+	for _, team := range links.Teams.Nodes {
+		fmt.Printf("%-12s %-35s %s\n", "team", team.CombinedSlug, team.URL)
+	}
+}
+
+// nonNilSlice returns an empty slice for nil so it is encoded as [] rather than null
+func nonNilSlice[T any](values []T) []T {
+	if values == nil {
+		return []T{}
+	}
+	return values
+}
@@ -32,7 +32,7 @@ func NewListCmd() *cobra.Command {
 		Long: `List projects for a user or organization.
 
 Examples:
-  ghx project list              # List projects for authenticated user
+  ghx project list              # List projects for the default owner of the profile
   ghx project list octocat      # List projects for user octocat
   ghx project list --org myorg  # List projects for organization myorg`,
 		Args: cobra.MaximumNArgs(1),
@@ -58,20 +58,23 @@ func runList(ctx context.Context, opts *ListOptions, args []string) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
 
-	// Get current user if no owner specified
+	// Fall back to the default owner of the active profile or config
 	if opts.Owner == "" {
-		// For now, we'll require an owner to be specified
-		// In the future, we can implement a viewer query to get the current user
-		return fmt.Errorf("owner must be specified")
+		owner, isOrg := authManager.DefaultOwner()
+		if owner == "" {
+			return fmt.Errorf("owner must be specified")
+		}
+		opts.Owner = owner
+		opts.Org = opts.Org || isOrg
 	}
 
 	var projects []service.ProjectInfo
@@ -0,0 +1,157 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// PlanOptions holds options for the plan and apply commands
+type PlanOptions struct {
+	ProjectRef string
+	File       string
+	Format     string
+	Prune      bool
+	Force      bool
+}
+
+// NewPlanCmd creates the plan command
+func NewPlanCmd() *cobra.Command {
+	opts := &PlanOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "plan <owner>/<number> -f <spec-file>",
+		Short: "Show the changes needed to make a project match a spec",
+		Long: `Compare a project with a YAML spec and show the changes that
+'ghx project apply' would make.
+
+The spec describes the project title, README, short description, visibility,
+linked repositories, fields with their options and iteration settings, and views
+with their layout, filter, sort and grouping. Anything left out of the spec is
+not managed.
+
+Fields, options and views are matched by name. Set renamedFrom to rename an
+existing one instead of creating a new one.
+
+Deleting fields, options, views and repository links that are not in the spec
+requires --prune; without it they are listed as skipped.
+
+Examples:
+  ghx project plan octocat/1 -f project.yaml
+  ghx project plan myorg/2 -f project.yaml --prune
+  ghx project plan myorg/2 -f project.yaml --format json`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runPlan(cmd.Context(), opts)
+		},
+	}
+
+	addPlanFlags(cmd, opts)
+	cmd.Flags().StringVar(&opts.Format, "format", formatTable, "Output format: table, json")
+
+	return cmd
+}
+
+func addPlanFlags(cmd *cobra.Command, opts *PlanOptions) {
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "Project spec file (YAML)")
+	cmd.Flags().BoolVar(&opts.Prune, "prune", false, "Delete fields, options, views and links not in the spec")
+
+	_ = cmd.MarkFlagRequired("file")
+}
+
+func runPlan(ctx context.Context, opts *PlanOptions) error {
+	_, plan, err := buildPlan(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case formatJSON:
+		data, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		printPlan(plan)
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+}
+
+// buildPlan loads the spec and compares it with the project
+func buildPlan(ctx context.Context, opts *PlanOptions) (*service.ProjectSpecService, *service.ProjectPlan, error) {
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	spec, err := service.LoadProjectSpec(opts.File)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return nil, nil, fmt.Errorf("authentication failed: %w", err)
+	}
+
+	client := api.NewClientWithTokenSource(tokenSource)
+	specService := service.NewProjectSpecService(client)
+
+	plan, err := specService.Plan(ctx, owner, number, spec, opts.Prune)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to plan changes: %w", err)
+	}
+
+	return specService, plan, nil
+}
+
+func printPlan(plan *service.ProjectPlan) {
+	if len(plan.Changes) == 0 {
+		fmt.Println("No changes. The project matches the spec.")
+	} else {
+		fmt.Printf("%d change(s) to apply:\n\n", len(plan.Changes))
+		for _, change := range plan.Changes {
+			printPlanChange(change)
+		}
+	}
+
+	if len(plan.Skipped) > 0 {
+		fmt.Printf("\n%d deletion(s) skipped (use --prune to apply):\n\n", len(plan.Skipped))
+		for _, change := range plan.Skipped {
+			printPlanChange(change)
+		}
+	}
+}
+
+func printPlanChange(change service.PlanChange) {
+	fmt.Printf("  %s %s %s\n", planSymbol(change.Action), change.Kind, change.Target)
+	for _, detail := range change.Details {
+		fmt.Printf("      %s\n", detail)
+	}
+}
+
+func planSymbol(action string) string {
+	switch action {
+	case service.PlanActionCreate:
+		return "+"
+	case service.PlanActionDelete:
+		return "-"
+	default:
+		return "~"
+	}
+}
@@ -16,7 +16,10 @@ This command group provides comprehensive project management capabilities includ
 
 • List, view, create, edit, and delete projects
 • Manage project items (issues, pull requests, draft issues)
+• Keep project items in sync with a search query
 • Configure custom fields and views
+• Post status updates, optionally generated from project metrics
+• Keep project configuration in a YAML spec with plan and apply
 • Bulk operations and automation
 
 For more information about GitHub Projects, visit:
@@ -26,7 +29,8 @@ https://docs.github.com/en/issues/planning-and-tracking-with-projects`,
   ghx project view octocat/123        # View project details
   ghx project create "My Project"     # Create a new project
   ghx project edit 123 --title "New"  # Edit project title
-  ghx project delete 123 --force      # Delete a project`,
+  ghx project delete 123 --force      # Delete a project
+  ghx project apply octocat/123 -f project.yaml  # Apply a project spec`,
 	}
 
 	// Add subcommands
@@ -35,11 +39,18 @@ https://docs.github.com/en/issues/planning-and-tracking-with-projects`,
 	cmd.AddCommand(NewCreateCmd())
 	cmd.AddCommand(NewEditCmd())
 	cmd.AddCommand(NewDeleteCmd())
+	cmd.AddCommand(NewCopyCmd())
+	cmd.AddCommand(NewSyncCmd())
+	cmd.AddCommand(NewStatusUpdateCmd())
 	cmd.AddCommand(NewLinkCmd())
+	cmd.AddCommand(NewUnlinkCmd())
 	cmd.AddCommand(NewExportCmd())
 	cmd.AddCommand(NewImportCmd())
 	cmd.AddCommand(NewWorkflowCmd())
 	cmd.AddCommand(NewTemplateCmd())
+	cmd.AddCommand(NewPlanCmd())
+	cmd.AddCommand(NewApplyCmd())
+	cmd.AddCommand(NewDiffCmd())
 
 	return cmd
 }
@@ -0,0 +1,514 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+const (
+	defaultStatusUpdateLimit = 10
+	statusUpdateBodyWidth    = 40
+)
+
+// StatusUpdateOptions holds options for the status-update commands
+type StatusUpdateOptions struct {
+	ProjectRef     string
+	StatusUpdateID string
+	Status         string
+	Body           string
+	BodyFile       string
+	StartDate      string
+	TargetDate     string
+	StatusField    string
+	DoneOption     string
+	DueField       string
+	Format         string
+	Limit          int
+	Generate       bool
+	Force          bool
+}
+
+// NewStatusUpdateCmd creates the status-update command group
+func NewStatusUpdateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "status-update <command>",
+		Aliases: []string{"status"},
+		Short:   "Manage project status updates",
+		Long: `Post and manage status updates of a project.
+
+A status update records the health of a project (inactive, on-track, at-risk,
+off-track or complete) with an optional Markdown body and start and target
+dates. The latest status update is shown by 'ghx project view'.`,
+		Example: `  ghx project status-update create myorg/1 --status on-track --body "Beta shipped"
+  ghx project status-update create myorg/1 --generate
+  ghx project status-update list myorg/1
+  ghx project status-update edit PVTSU_xxx --status at-risk
+  ghx project status-update delete PVTSU_xxx --force`,
+	}
+
+	cmd.AddCommand(NewStatusUpdateCreateCmd())
+	cmd.AddCommand(NewStatusUpdateListCmd())
+	cmd.AddCommand(NewStatusUpdateViewCmd())
+	cmd.AddCommand(NewStatusUpdateEditCmd())
+	cmd.AddCommand(NewStatusUpdateDeleteCmd())
+
+	return cmd
+}
+
+// NewStatusUpdateCreateCmd creates the status-update create command
+func NewStatusUpdateCreateCmd() *cobra.Command {
+	opts := &StatusUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "create <owner>/<number>",
+		Short: "Post a status update",
+		Long: `Post a status update on a project.
+
+With --generate the body is generated from the project's items: how many items
+are done, which were done in the last 7 days, which open items are overdue or
+due within 7 days, and the number of items per status. Items are done when
+their Status is Done or their issue or pull request is closed. Use
+--status-field, --done-option and --due-field when the project uses other
+names; the due date defaults to the first date field. Without --status the
+status is suggested from the same metrics. Text given with --body is placed
+above the generated summary.
+
+Examples:
+  ghx project status-update create myorg/1 --status on-track --body "Beta shipped"
+  ghx project status-update create myorg/1 --status at-risk --body-file notes.md --target-date 2026-06-30
+  ghx project status-update create myorg/1 --generate
+  ghx project status-update create myorg/1 --generate --due-field "Target" --done-option "Shipped"`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runStatusUpdateCreate(cmd, opts)
+		},
+	}
+
+	addStatusUpdateFlags(cmd, opts)
+	cmd.Flags().BoolVar(&opts.Generate, "generate", false, "Generate the body from project metrics")
+	cmd.Flags().StringVar(&opts.StatusField, "status-field", "", "Single select field holding item status (default: Status)")
+	cmd.Flags().StringVar(&opts.DoneOption, "done-option", "", "Status option marking items as done (default: Done)")
+	cmd.Flags().StringVar(&opts.DueField, "due-field", "", "Date field holding due dates (default: first date field)")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"status-field": completion.Fields(completion.Arg(0), "Single Select"),
+	})
+
+	return cmd
+}
+
+// NewStatusUpdateListCmd creates the status-update list command
+func NewStatusUpdateListCmd() *cobra.Command {
+	opts := &StatusUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "list <owner>/<number>",
+		Short: "List status updates",
+		Long: `List the status updates of a project, newest first.
+
+Examples:
+  ghx project status-update list myorg/1
+  ghx project status-update list myorg/1 --limit 3 --format json`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runStatusUpdateList(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().IntVarP(&opts.Limit, "limit", "L", defaultStatusUpdateLimit, "Maximum number of status updates to list")
+	cmd.Flags().StringVar(&opts.Format, "format", formatTable, "Output format: table, json")
+
+	return cmd
+}
+
+// NewStatusUpdateViewCmd creates the status-update view command
+func NewStatusUpdateViewCmd() *cobra.Command {
+	opts := &StatusUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "view <status-update-id>",
+		Short: "View a status update",
+		Long: `View a status update with its full body.
+
+Examples:
+  ghx project status-update view PVTSU_xxx
+  ghx project status-update view PVTSU_xxx --format json`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.StatusUpdateID = args[0]
+			return runStatusUpdateView(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.Format, "format", formatDetails, "Output format: details, json")
+
+	return cmd
+}
+
+// NewStatusUpdateEditCmd creates the status-update edit command
+func NewStatusUpdateEditCmd() *cobra.Command {
+	opts := &StatusUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "edit <status-update-id>",
+		Short: "Edit a status update",
+		Long: `Change the status, body or dates of a status update.
+
+Examples:
+  ghx project status-update edit PVTSU_xxx --status off-track
+  ghx project status-update edit PVTSU_xxx --body-file update.md --target-date 2026-07-15`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.StatusUpdateID = args[0]
+			return runStatusUpdateEdit(cmd, opts)
+		},
+	}
+
+	addStatusUpdateFlags(cmd, opts)
+
+	return cmd
+}
+
+// NewStatusUpdateDeleteCmd creates the status-update delete command
+func NewStatusUpdateDeleteCmd() *cobra.Command {
+	opts := &StatusUpdateOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "delete <status-update-id>",
+		Short: "Delete a status update",
+		Long: `Delete a status update.
+
+Examples:
+  ghx project status-update delete PVTSU_xxx
+  ghx project status-update delete PVTSU_xxx --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.StatusUpdateID = args[0]
+			return runStatusUpdateDelete(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.Force, "force", false, "Delete without confirmation")
+
+	return cmd
+}
+
+func addStatusUpdateFlags(cmd *cobra.Command, opts *StatusUpdateOptions) {
+	cmd.Flags().StringVarP(&opts.Status, "status", "s", "", "Status: inactive, on-track, at-risk, off-track, complete")
+	cmd.Flags().StringVarP(&opts.Body, "body", "b", "", "Body of the status update (Markdown)")
+	cmd.Flags().StringVarP(&opts.BodyFile, "body-file", "F", "", "Read the body from a file (use \"-\" for standard input)")
+	cmd.Flags().StringVar(&opts.StartDate, "start-date", "", "Start date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.TargetDate, "target-date", "", "Target date (YYYY-MM-DD)")
+	cmd.Flags().StringVar(&opts.Format, "format", formatDetails, "Output format: details, json")
+}
+
+func newStatusUpdateClient() (*api.Client, error) {
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return nil, fmt.Errorf("authentication failed: %w", err)
+	}
+
+	return api.NewClientWithTokenSource(tokenSource), nil
+}
+
+// statusUpdateFields holds the validated status, body and dates given on the
+// command line; nil means not given
+type statusUpdateFields struct {
+	status     *graphql.ProjectV2StatusUpdateStatus
+	body       *string
+	startDate  *string
+	targetDate *string
+}
+
+func parseStatusUpdateFields(cmd *cobra.Command, opts *StatusUpdateOptions) (*statusUpdateFields, error) {
+	fields := &statusUpdateFields{}
+
+	if opts.Status != "" {
+		status, err := service.ValidateStatusUpdateStatus(opts.Status)
+		if err != nil {
+			return nil, err
+		}
+		fields.status = &status
+	}
+
+	if opts.BodyFile != "" && cmd.Flags().Changed("body") {
+		return nil, fmt.Errorf("--body and --body-file cannot be used together")
+	}
+	switch {
+	case opts.BodyFile != "":
+		body, err := readTextFile(opts.BodyFile, "body")
+		if err != nil {
+			return nil, err
+		}
+		fields.body = &body
+	case cmd.Flags().Changed("body"):
+		fields.body = &opts.Body
+	}
+
+	if opts.StartDate != "" {
+		if err := service.ValidateStatusUpdateDate(opts.StartDate); err != nil {
+			return nil, err
+		}
+		fields.startDate = &opts.StartDate
+	}
+	if opts.TargetDate != "" {
+		if err := service.ValidateStatusUpdateDate(opts.TargetDate); err != nil {
+			return nil, err
+		}
+		fields.targetDate = &opts.TargetDate
+	}
+
+	return fields, nil
+}
+
+func runStatusUpdateCreate(cmd *cobra.Command, opts *StatusUpdateOptions) error {
+	ctx := cmd.Context()
+	fields, err := parseStatusUpdateFields(cmd, opts)
+	if err != nil {
+		return err
+	}
+
+	client, err := newStatusUpdateClient()
+	if err != nil {
+		return err
+	}
+	projectService := service.NewProjectService(client)
+
+	var projectID string
+	if opts.Generate {
+		owner, number, parseErr := service.ParseProjectReference(opts.ProjectRef)
+		if parseErr != nil {
+			return fmt.Errorf("--generate requires a project reference in owner/number format: %w", parseErr)
+		}
+		project, getErr := projectService.GetProjectWithOwnerDetection(ctx, owner, number)
+		if getErr != nil {
+			return getErr
+		}
+		projectID = project.ID
+
+		metrics, metricsErr := service.NewStatusUpdateService(client).BuildStatusMetrics(ctx, project, time.Now(), service.StatusMetricsOptions{
+			StatusField: opts.StatusField,
+			DoneOption:  opts.DoneOption,
+			DueField:    opts.DueField,
+		})
+		if metricsErr != nil {
+			return fmt.Errorf("failed to compute project metrics: %w", metricsErr)
+		}
+
+		body := service.BuildStatusUpdateBody(metrics)
+		if fields.body != nil && strings.TrimSpace(*fields.body) != "" {
+			body = strings.TrimRight(*fields.body, "\n") + "\n\n" + body
+		}
+		fields.body = &body
+		if fields.status == nil {
+			status := metrics.SuggestStatus()
+			fields.status = &status
+		}
+	} else {
+		projectID, err = projectService.ResolveProjectID(ctx, opts.ProjectRef)
+		if err != nil {
+			return err
+		}
+	}
+
+	update, err := service.NewStatusUpdateService(client).CreateStatusUpdate(ctx, service.CreateStatusUpdateInput{
+		ProjectID:  projectID,
+		Status:     fields.status,
+		Body:       fields.body,
+		StartDate:  fields.startDate,
+		TargetDate: fields.targetDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.Format == formatDetails {
+		fmt.Printf("✅ Posted status update %s on project %s\n\n", update.ID, opts.ProjectRef)
+	}
+	return outputStatusUpdate(update, opts.Format)
+}
+
+func runStatusUpdateList(ctx context.Context, opts *StatusUpdateOptions) error {
+	if opts.Limit <= 0 {
+		return fmt.Errorf("--limit must be positive")
+	}
+
+	client, err := newStatusUpdateClient()
+	if err != nil {
+		return err
+	}
+
+	projectID, err := service.NewProjectService(client).ResolveProjectID(ctx, opts.ProjectRef)
+	if err != nil {
+		return err
+	}
+
+	updates, err := service.NewStatusUpdateService(client).ListStatusUpdates(ctx, projectID, opts.Limit)
+	if err != nil {
+		return err
+	}
+
+	switch opts.Format {
+	case formatJSON:
+		data, err := json.MarshalIndent(nonNilSlice(updates), "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatTable:
+		if len(updates) == 0 {
+			fmt.Println("No status updates found.")
+			return nil
+		}
+		fmt.Printf("%-22s %-10s %-11s %-11s %s\n", "ID", "STATUS", "CREATED", "TARGET", "BODY")
+		fmt.Println(strings.Repeat("-", tableSeparatorWidth))
+		for i := range updates {
+			update := &updates[i]
+			target := "-"
+			if update.TargetDate != nil {
+				target = *update.TargetDate
+			}
+			fmt.Printf("%-22s %-10s %-11s %-11s %s\n", update.ID, service.FormatStatusUpdateStatus(update.Status),
+				update.CreatedAt.Format("2006-01-02"), target, firstLine(update.Body, statusUpdateBodyWidth))
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+}
+
+func runStatusUpdateView(ctx context.Context, opts *StatusUpdateOptions) error {
+	client, err := newStatusUpdateClient()
+	if err != nil {
+		return err
+	}
+
+	update, err := service.NewStatusUpdateService(client).GetStatusUpdate(ctx, opts.StatusUpdateID)
+	if err != nil {
+		return err
+	}
+
+	return outputStatusUpdate(update, opts.Format)
+}
+
+func runStatusUpdateEdit(cmd *cobra.Command, opts *StatusUpdateOptions) error {
+	fields, err := parseStatusUpdateFields(cmd, opts)
+	if err != nil {
+		return err
+	}
+	if fields.status == nil && fields.body == nil && fields.startDate == nil && fields.targetDate == nil {
+		return fmt.Errorf("nothing to update; specify --status, --body, --body-file, --start-date or --target-date")
+	}
+
+	client, err := newStatusUpdateClient()
+	if err != nil {
+		return err
+	}
+
+	update, err := service.NewStatusUpdateService(client).UpdateStatusUpdate(cmd.Context(), service.UpdateStatusUpdateInput{
+		StatusUpdateID: opts.StatusUpdateID,
+		Status:         fields.status,
+		Body:           fields.body,
+		StartDate:      fields.startDate,
+		TargetDate:     fields.targetDate,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.Format == formatDetails {
+		fmt.Printf("✅ Status update %s updated\n\n", update.ID)
+	}
+	return outputStatusUpdate(update, opts.Format)
+}
+
+func runStatusUpdateDelete(ctx context.Context, opts *StatusUpdateOptions) error {
+	client, err := newStatusUpdateClient()
+	if err != nil {
+		return err
+	}
+	statusUpdateService := service.NewStatusUpdateService(client)
+
+	if !opts.Force {
+		update, err := statusUpdateService.GetStatusUpdate(ctx, opts.StatusUpdateID)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Are you sure you want to delete the %s status update of %s? (y/N): ",
+			service.FormatStatusUpdateStatus(update.Status), update.CreatedAt.Format("2006-01-02"))
+		var confirmation string
+		_, _ = fmt.Scanln(&confirmation)
+		if confirmation != "y" && confirmation != "Y" && confirmation != "yes" {
+			fmt.Println("❌ Deletion canceled.")
+			return nil
+		}
+	}
+
+	if err := statusUpdateService.DeleteStatusUpdate(ctx, opts.StatusUpdateID); err != nil {
+		return err
+	}
+
+	fmt.Printf("✅ Deleted status update %s\n", opts.StatusUpdateID)
+	return nil
+}
+
+func outputStatusUpdate(update *graphql.ProjectV2StatusUpdate, format string) error {
+	switch format {
+	case formatJSON:
+		data, err := json.MarshalIndent(update, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatDetails:
+		fmt.Printf("ID: %s\n", update.ID)
+		fmt.Printf("Status: %s\n", service.FormatStatusUpdateStatus(update.Status))
+		if update.StartDate != nil {
+			fmt.Printf("Start date: %s\n", *update.StartDate)
+		}
+		if update.TargetDate != nil {
+			fmt.Printf("Target date: %s\n", *update.TargetDate)
+		}
+		fmt.Printf("Author: %s\n", update.Creator.Login)
+		fmt.Printf("Created: %s\n", update.CreatedAt.Format("2006-01-02 15:04:05"))
+		if !update.UpdatedAt.Equal(update.CreatedAt) {
+			fmt.Printf("Updated: %s\n", update.UpdatedAt.Format("2006-01-02 15:04:05"))
+		}
+		if body := strings.TrimSpace(update.Body); body != "" {
+			fmt.Printf("\n%s\n", body)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown format: %s", format)
+	}
+}
+
+// firstLine returns the first line of text, truncated to width runes
+func firstLine(text string, width int) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(text), "\n")
+	runes := []rune(line)
+	if len(runes) > width {
+		return string(runes[:width-3]) + "..."
+	}
+	return line
+}
@@ -0,0 +1,157 @@
+package project
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// SyncOptions holds options for the sync command
+type SyncOptions struct {
+	ProjectRef string
+	Query      string
+	Format     string
+	Set        []string
+	Archive    bool
+	DryRun     bool
+}
+
+// NewSyncCmd creates the sync command
+func NewSyncCmd() *cobra.Command {
+	opts := &SyncOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "sync <owner>/<number> --query <search-query>",
+		Short: "Keep a project in step with a search query",
+		Long: `Add the issues and pull requests matching a GitHub search query to a project.
+
+Like the auto-add workflow of GitHub Projects, but across any number of
+repositories. Matches that are not in the project yet are added and get the
+field values given with --set. Items already in the project are left as they
+are, so sync can be run again, e.g. on a schedule, and only adds new matches.
+
+With --archive, issues and pull requests in the project that no longer match
+the query are archived, and archived items that match again are restored.
+Draft issues are never archived. Use --dry-run to see the changes first.
+
+Examples:
+  ghx project sync myorg/1 --query "org:myorg is:open label:team-a"
+  ghx project sync myorg/1 --query "repo:myorg/api repo:myorg/web is:open" --set "Status=Todo"
+  ghx project sync myorg/1 --query "org:myorg is:open label:team-a" --archive --dry-run`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			return runSync(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.Query, "query", "q", "", "GitHub search query selecting issues and pull requests (required)")
+	cmd.Flags().StringArrayVar(&opts.Set, "set", nil, "Field value for added items as Field=Value (repeatable)")
+	cmd.Flags().BoolVar(&opts.Archive, "archive", false, "Archive items that no longer match and restore items that match again")
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false, "Show the changes without making them")
+	cmd.Flags().StringVar(&opts.Format, "format", formatDetails, "Output format: details, json")
+	_ = cmd.MarkFlagRequired("query")
+
+	return cmd
+}
+
+func runSync(ctx context.Context, opts *SyncOptions) error {
+	owner, number, err := service.ParseProjectReference(opts.ProjectRef)
+	if err != nil {
+		return fmt.Errorf("invalid project reference: %w", err)
+	}
+
+	values := make([]service.SyncFieldValue, 0, len(opts.Set))
+	for _, set := range opts.Set {
+		value, err := service.ParseSyncFieldValue(set)
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+
+	if opts.Format != formatDetails && opts.Format != formatJSON {
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	projectService := service.NewProjectService(api.NewClientWithTokenSource(tokenSource))
+	result, err := projectService.SyncProject(ctx, service.SyncProjectOptions{
+		Owner:   owner,
+		Number:  number,
+		Query:   opts.Query,
+		Values:  values,
+		Archive: opts.Archive,
+		DryRun:  opts.DryRun,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to sync project: %w", err)
+	}
+
+	if opts.Format == formatJSON {
+		result.Changes = nonNilSlice(result.Changes)
+		result.Warnings = nonNilSlice(result.Warnings)
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	printSyncResult(result, opts.DryRun)
+	return nil
+}
+
+func printSyncResult(result *service.SyncProjectResult, dryRun bool) {
+	fmt.Printf("%d issue(s) and pull request(s) match the query, %d already in the project.\n",
+		result.Matched, result.Unchanged)
+
+	if len(result.Changes) == 0 {
+		fmt.Println("No changes. The project is in sync.")
+	} else {
+		verb := "Made"
+		if dryRun {
+			verb = "Would make"
+		}
+		fmt.Printf("\n%s %d change(s):\n\n", verb, len(result.Changes))
+		for _, change := range result.Changes {
+			fmt.Printf("  %s %-8s %-30s %s\n", syncSymbol(change.Action), change.Action, change.Reference, change.Title)
+		}
+		if result.ValuesSet > 0 {
+			fmt.Printf("\nField values set: %d\n", result.ValuesSet)
+		}
+	}
+
+	if len(result.Warnings) > 0 {
+		fmt.Printf("\n⚠️  %d warning(s):\n", len(result.Warnings))
+		for _, warning := range result.Warnings {
+			fmt.Printf("  • %s\n", warning)
+		}
+	}
+}
+
+func syncSymbol(action string) string {
+	switch action {
+	case service.SyncActionAdd:
+		return "+"
+	case service.SyncActionArchive:
+		return "-"
+	default:
+		return "~"
+	}
+}
@@ -377,13 +377,13 @@ type TemplateImportOptions struct {
 func runTemplateList(ctx context.Context, opts *TemplateOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	templateService := service.NewTemplateService(client)
 
 	templates, err := templateService.ListTemplates(ctx)
@@ -409,13 +409,13 @@ func runTemplateList(ctx context.Context, opts *TemplateOptions) error {
 func runTemplateCreate(ctx context.Context, opts TemplateCreateOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	templateService := service.NewTemplateService(client)
 
 	template, err := templateService.CreateTemplate(ctx, service.CreateTemplateInput{
@@ -448,13 +448,13 @@ func runTemplateCreate(ctx context.Context, opts TemplateCreateOptions) error {
 func runTemplateApply(ctx context.Context, opts TemplateApplyOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	templateService := service.NewTemplateService(client)
 
 	project, err := templateService.ApplyTemplate(ctx, service.ApplyTemplateInput{
@@ -484,13 +484,13 @@ func runTemplateApply(ctx context.Context, opts TemplateApplyOptions) error {
 func runTemplateUpdate(ctx context.Context, opts TemplateUpdateOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	templateService := service.NewTemplateService(client)
 
 	template, err := templateService.UpdateTemplate(ctx, service.UpdateTemplateInput{
@@ -526,13 +526,13 @@ func runTemplateDelete(ctx context.Context, templateID string, force bool) error
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	templateService := service.NewTemplateService(client)
 
 	err = templateService.DeleteTemplate(ctx, templateID)
@@ -547,13 +547,13 @@ func runTemplateDelete(ctx context.Context, templateID string, force bool) error
 func runTemplateExport(ctx context.Context, opts TemplateExportOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	templateService := service.NewTemplateService(client)
 
 	err = templateService.ExportTemplate(ctx, service.ExportTemplateInput{
@@ -572,13 +572,13 @@ func runTemplateExport(ctx context.Context, opts TemplateExportOptions) error {
 func runTemplateImport(ctx context.Context, opts TemplateImportOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	templateService := service.NewTemplateService(client)
 
 	template, err := templateService.ImportTemplate(ctx, service.ImportTemplateInput{
@@ -2,6 +2,7 @@ package project
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -11,6 +12,7 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -38,7 +40,8 @@ Examples:
   ghx project view 123               # View project 123 in current repository context
   ghx project view octocat/123       # View project 123 owned by octocat
   ghx project view --org myorg/456   # View project 456 owned by organization myorg`,
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.ProjectArg,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runView(cmd.Context(), opts, args)
 		},
@@ -77,13 +80,13 @@ func runView(ctx context.Context, opts *ViewOptions, args []string) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
 
 	// Get project details
@@ -99,22 +102,47 @@ func runView(ctx context.Context, opts *ViewOptions, args []string) error {
 		return nil
 	}
 
+	settings, err := projectService.GetProjectSettings(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+
+	latest, err := service.NewStatusUpdateService(client).GetLatestStatusUpdate(ctx, project.ID)
+	if err != nil {
+		return err
+	}
+
 	// Output project details
-	return outputProjectDetails(project, opts)
+	return outputProjectDetails(project, settings, latest, opts)
 }
 
-func outputProjectDetails(project *graphql.ProjectV2, opts *ViewOptions) error {
+func outputProjectDetails(project *graphql.ProjectV2, settings *graphql.ProjectV2Settings, latest *graphql.ProjectV2StatusUpdate, opts *ViewOptions) error {
 	switch opts.Format {
 	case "json":
-		return outputProjectDetailsJSON(project)
+		return outputProjectDetailsJSON(project, settings, latest)
 	case "details":
-		return outputProjectDetailsTable(project, opts)
+		return outputProjectDetailsTable(project, settings, latest, opts)
 	default:
 		return fmt.Errorf("unknown format: %s", opts.Format)
 	}
 }
 
-func outputProjectDetailsTable(project *graphql.ProjectV2, opts *ViewOptions) error {
+// printProjectSettings prints the short description and visibility of a project
+// and the size of its README
+func printProjectSettings(settings *graphql.ProjectV2Settings) {
+	if settings.ShortDescription != nil && *settings.ShortDescription != "" {
+		fmt.Printf("Short description: %s\n", *settings.ShortDescription)
+	}
+	fmt.Printf("Visibility: %s\n", service.FormatVisibility(settings.Public))
+
+	if settings.Readme == nil || strings.TrimSpace(*settings.Readme) == "" {
+		fmt.Printf("README: none\n")
+	} else {
+		fmt.Printf("README: %d line(s)\n", strings.Count(strings.TrimRight(*settings.Readme, "\n"), "\n")+1)
+	}
+}
+
+func outputProjectDetailsTable(project *graphql.ProjectV2, settings *graphql.ProjectV2Settings, latest *graphql.ProjectV2StatusUpdate, opts *ViewOptions) error {
 	// Basic project information
 	fmt.Printf("Project #%d\n", project.Number)
 	fmt.Printf("Title: %s\n", project.Title)
@@ -131,6 +159,7 @@ func outputProjectDetailsTable(project *graphql.ProjectV2, opts *ViewOptions) er
 		state = "Closed"
 	}
 	fmt.Printf("State: %s\n", state)
+	printProjectSettings(settings)
 
 	fmt.Printf("Created: %s\n", project.CreatedAt.Format("2006-01-02 15:04:05"))
 	fmt.Printf("Updated: %s\n", project.UpdatedAt.Format("2006-01-02 15:04:05"))
@@ -138,6 +167,26 @@ func outputProjectDetailsTable(project *graphql.ProjectV2, opts *ViewOptions) er
 	fmt.Printf("Items: %d\n", len(project.Items.Nodes))
 	fmt.Printf("Fields: %d\n", len(project.Fields.Nodes))
 
+	if latest != nil {
+		fmt.Printf("\nLatest status: %s (%s by %s)\n",
+			service.FormatStatusUpdateStatus(latest.Status), latest.CreatedAt.Format("2006-01-02"), latest.Creator.Login)
+		if latest.TargetDate != nil {
+			fmt.Printf("Target date: %s\n", *latest.TargetDate)
+		}
+		if body := strings.TrimSpace(latest.Body); body != "" {
+			for _, line := range strings.Split(body, "\n") {
+				fmt.Printf("  %s\n", line)
+			}
+		}
+	}
+
+	if settings.Readme != nil && strings.TrimSpace(*settings.Readme) != "" {
+		fmt.Printf("\nREADME:\n")
+		for _, line := range strings.Split(strings.TrimRight(*settings.Readme, "\n"), "\n") {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+
 	// Show fields if requested
 	if opts.Fields && len(project.Fields.Nodes) > 0 {
 		fmt.Printf("\nFields:\n")
@@ -145,7 +194,7 @@ func outputProjectDetailsTable(project *graphql.ProjectV2, opts *ViewOptions) er
 		fmt.Println(strings.Repeat("-", fieldsTableWidth))
 
 		for _, field := range project.Fields.Nodes {
-			optionCount := len(field.Options.Nodes)
+			optionCount := len(field.SingleSelect.Options)
 			optionsStr := ""
 			if optionCount > 0 {
 				optionsStr = fmt.Sprintf("%d options", optionCount)
@@ -167,15 +216,15 @@ func outputProjectDetailsTable(project *graphql.ProjectV2, opts *ViewOptions) er
 
 			switch item.Content.TypeName {
 			case "Issue":
-				title = item.Content.IssueTitle
-				state = item.Content.IssueState
-				url = item.Content.IssueURL
+				title = item.Content.Issue.Title
+				state = item.Content.Issue.State
+				url = item.Content.Issue.URL
 			case "PullRequest":
-				title = item.Content.PRTitle
-				state = item.Content.PRState
-				url = item.Content.PRURL
+				title = item.Content.PullRequest.Title
+				state = item.Content.PullRequest.State
+				url = item.Content.PullRequest.URL
 			case "DraftIssue":
-				title = item.Content.DraftTitle
+				title = item.Content.DraftIssue.Title
 				state = "Draft"
 				url = "-"
 			default:
@@ -196,7 +245,9 @@ func outputProjectDetailsTable(project *graphql.ProjectV2, opts *ViewOptions) er
 	return nil
 }
 
-func outputProjectDetailsJSON(project *graphql.ProjectV2) error {
+// outputProjectDetailsJSON prints a project as JSON; settings and the latest status
+// update are included when known
+func outputProjectDetailsJSON(project *graphql.ProjectV2, settings *graphql.ProjectV2Settings, latest *graphql.ProjectV2StatusUpdate) error {
 	// Simplified JSON output
 	state := "open"
 	if project.Closed {
@@ -220,6 +271,18 @@ func outputProjectDetailsJSON(project *graphql.ProjectV2) error {
 	fmt.Printf("    \"login\": \"%s\",\n", project.Owner.Login)
 	fmt.Printf("    \"type\": \"%s\"\n", project.Owner.Type)
 	fmt.Printf("  },\n")
+	if settings != nil {
+		fmt.Printf("  \"shortDescription\": %s,\n", quoteOrNull(settings.ShortDescription))
+		fmt.Printf("  \"readme\": %s,\n", quoteOrNull(settings.Readme))
+		fmt.Printf("  \"visibility\": \"%s\",\n", service.FormatVisibility(settings.Public))
+	}
+	if latest != nil {
+		data, err := json.Marshal(latest)
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Printf("  \"latestStatusUpdate\": %s,\n", data)
+	}
 	fmt.Printf("  \"createdAt\": \"%s\",\n", project.CreatedAt.Format("2006-01-02T15:04:05Z"))
 	fmt.Printf("  \"updatedAt\": \"%s\",\n", project.UpdatedAt.Format("2006-01-02T15:04:05Z"))
 	fmt.Printf("  \"itemCount\": %d,\n", len(project.Items.Nodes))
@@ -228,3 +291,12 @@ func outputProjectDetailsJSON(project *graphql.ProjectV2) error {
 
 	return nil
 }
+
+// quoteOrNull encodes an optional string as a JSON value
+func quoteOrNull(value *string) string {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "null"
+	}
+	return string(data)
+}
@@ -244,13 +244,13 @@ type WorkflowUpdateOptions struct {
 func runWorkflowList(ctx context.Context, opts *WorkflowOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	workflowService := service.NewWorkflowService(client)
 
 	workflows, err := workflowService.ListWorkflows(ctx, opts.ProjectID)
@@ -276,13 +276,13 @@ func runWorkflowList(ctx context.Context, opts *WorkflowOptions) error {
 func runWorkflowCreate(ctx context.Context, opts WorkflowCreateOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	workflowService := service.NewWorkflowService(client)
 
 	workflow, err := workflowService.CreateWorkflow(ctx, service.CreateWorkflowInput{
@@ -313,13 +313,13 @@ func runWorkflowCreate(ctx context.Context, opts WorkflowCreateOptions) error {
 func runWorkflowUpdate(ctx context.Context, opts WorkflowUpdateOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	workflowService := service.NewWorkflowService(client)
 
 	workflow, err := workflowService.UpdateWorkflow(ctx, service.UpdateWorkflowInput{
@@ -343,13 +343,13 @@ func runWorkflowUpdate(ctx context.Context, opts WorkflowUpdateOptions) error {
 func runWorkflowDelete(ctx context.Context, workflowID string) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	workflowService := service.NewWorkflowService(client)
 
 	err = workflowService.DeleteWorkflow(ctx, workflowID)
@@ -364,13 +364,13 @@ func runWorkflowDelete(ctx context.Context, workflowID string) error {
 func runWorkflowStatus(ctx context.Context, opts *WorkflowOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	workflowService := service.NewWorkflowService(client)
 
 	status, err := workflowService.GetWorkflowStatus(ctx, opts.ProjectID)
@@ -0,0 +1,111 @@
+package serve
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// SendWebhookOptions holds options for the send-webhook command
+type SendWebhookOptions struct {
+	PayloadFile string
+	URL         string
+	Event       string
+	Secret      string
+	DeliveryID  string
+	Format      string
+}
+
+// NewSendWebhookCmd creates the send-webhook command
+func NewSendWebhookCmd() *cobra.Command {
+	opts := &SendWebhookOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "send-webhook <payload-file>",
+		Short: "Send a signed sample webhook to a webhook server",
+		Long: `Send a sample payload to a webhook server the way GitHub delivers it.
+
+The payload is signed with the secret in X-Hub-Signature-256 and sent with the
+event name in X-GitHub-Event and a random delivery ID in X-GitHub-Delivery,
+so handlers can be tried locally. Use - to read the payload from stdin.
+Sending the same --delivery twice shows the deduplication at work.
+
+Examples:
+  ghx serve send-webhook issue-opened.json --event issues --secret "$WEBHOOK_SECRET"
+  ghx serve send-webhook item.json --event projects_v2_item --url http://127.0.0.1:9000/github
+  gh api repos/myorg/app/issues/1 | jq '{action: "opened", issue: .}' | ghx serve send-webhook - --event issues`,
+
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.PayloadFile = args[0]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runSendWebhook(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.URL, "url", "http://localhost"+defaultWebhookAddr+defaultWebhookPath, "URL of the webhook server")
+	cmd.Flags().StringVar(&opts.Event, "event", "", "Event name, e.g. issues or projects_v2_item")
+	cmd.Flags().StringVar(&opts.Secret, "secret", "", "Webhook secret (default: $GHX_WEBHOOK_SECRET)")
+	cmd.Flags().StringVar(&opts.DeliveryID, "delivery", "", "Delivery ID (default: random)")
+
+	_ = cmd.MarkFlagRequired("event")
+
+	return cmd
+}
+
+func runSendWebhook(ctx context.Context, opts *SendWebhookOptions) error {
+	secret := webhookSecret(opts.Secret)
+	if secret == "" {
+		return fmt.Errorf("a webhook secret is required: use --secret or %s", envWebhookSecret)
+	}
+
+	var payload []byte
+	var err error
+	if opts.PayloadFile == "-" {
+		payload, err = io.ReadAll(os.Stdin)
+	} else {
+		payload, err = os.ReadFile(opts.PayloadFile)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read payload: %w", err)
+	}
+	if !json.Valid(payload) {
+		return fmt.Errorf("payload is not valid JSON")
+	}
+
+	result, err := service.SendWebhook(ctx, service.SendWebhookInput{
+		URL:        opts.URL,
+		Secret:     secret,
+		Event:      opts.Event,
+		DeliveryID: opts.DeliveryID,
+		Payload:    payload,
+	})
+	if err != nil {
+		return err
+	}
+
+	if opts.Format == "json" {
+		data, err := json.MarshalIndent(result, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+	} else {
+		fmt.Printf("Delivery %s: %s\n", result.DeliveryID, result.Status)
+		if result.Body != "" {
+			fmt.Println(result.Body)
+		}
+	}
+
+	if result.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook server answered %s", result.Status)
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package serve
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewServeCmd creates the serve command
+func NewServeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "serve",
+		Short: "Run ghx as a server",
+		Long: `Run ghx as a long-running server that reacts to GitHub events.
+
+Servers:
+  webhooks     - Receive GitHub webhooks and dispatch them to handlers
+
+Examples:
+  ghx serve webhooks --file hooks.yaml --secret "$WEBHOOK_SECRET"
+  ghx serve send-webhook sample.json --event issues --secret "$WEBHOOK_SECRET"`,
+
+		Args: cobra.NoArgs,
+	}
+
+	cmd.AddCommand(NewWebhooksCmd())
+	cmd.AddCommand(NewSendWebhookCmd())
+
+	return cmd
+}
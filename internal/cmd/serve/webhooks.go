@@ -0,0 +1,188 @@
+package serve
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+const (
+	// envWebhookSecret names the environment variable holding the webhook secret
+	envWebhookSecret = "GHX_WEBHOOK_SECRET"
+
+	defaultWebhookAddr = ":8080"
+	defaultWebhookPath = "/webhooks"
+	logFormatJSON      = "json"
+	logFormatText      = "text"
+
+	readHeaderTimeout = 10 * time.Second
+	shutdownTimeout   = 30 * time.Second
+)
+
+// WebhooksOptions holds options for the webhooks command
+type WebhooksOptions struct {
+	File      string
+	Addr      string
+	Path      string
+	Secret    string
+	LogFormat string
+}
+
+// NewWebhooksCmd creates the webhooks command
+func NewWebhooksCmd() *cobra.Command {
+	opts := &WebhooksOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "webhooks",
+		Short: "Receive GitHub webhooks and dispatch them to handlers",
+		Long: `Receive GitHub webhooks and dispatch them to the handlers of a config file.
+
+Deliveries of projects_v2_item, issues, pull_request and discussion events are
+verified against X-Hub-Signature-256 and passed to every matching handler.
+A handler either runs a shell command with the event JSON on stdin, adds the
+issue or pull request of the event to a project, or sets a field on its
+project item, adding it first when needed. Deliveries are deduplicated by
+X-GitHub-Delivery; when a handler fails the delivery is answered with an
+error so it can be redelivered. Logs are written to stderr.
+
+The secret is taken from --secret, GHX_WEBHOOK_SECRET or the config file.
+
+Config file:
+  handlers:
+    - name: notify
+      events: [issues, pull_request]
+      actions: [opened]
+      run: ./notify.sh        # GHX_EVENT, GHX_ACTION, GHX_DELIVERY are set
+    - name: intake
+      events: [issues]
+      actions: [opened]
+      addToProject: myorg/1
+    - name: start
+      events: [projects_v2_item]
+      actions: [created]
+      setField:
+        project: myorg/1
+        field: Status
+        value: Todo
+
+Examples:
+  ghx serve webhooks --file hooks.yaml --secret "$WEBHOOK_SECRET"
+  ghx serve webhooks -f hooks.yaml --addr 127.0.0.1:9000 --path /github
+  ghx serve webhooks -f hooks.yaml --log-format text`,
+
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runWebhooks(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVarP(&opts.File, "file", "f", "", "Handler config file (YAML)")
+	cmd.Flags().StringVar(&opts.Addr, "addr", defaultWebhookAddr, "Address to listen on")
+	cmd.Flags().StringVar(&opts.Path, "path", defaultWebhookPath, "URL path receiving webhooks")
+	cmd.Flags().StringVar(&opts.Secret, "secret", "", "Webhook secret (default: $GHX_WEBHOOK_SECRET)")
+	cmd.Flags().StringVar(&opts.LogFormat, "log-format", logFormatJSON, "Log format: json or text")
+
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func runWebhooks(ctx context.Context, opts *WebhooksOptions) error {
+	logger, err := newLogger(opts.LogFormat)
+	if err != nil {
+		return err
+	}
+
+	config, err := service.LoadWebhookConfig(opts.File)
+	if err != nil {
+		return err
+	}
+	secret := webhookSecret(opts.Secret)
+	if secret == "" {
+		secret = config.Secret
+	}
+
+	// Only handlers changing projects need a token
+	var client *api.Client
+	if config.NeedsAPI() {
+		authManager := auth.NewAuthManager()
+		tokenSource, err := authManager.TokenSource()
+		if err != nil {
+			return fmt.Errorf("authentication failed: %w", err)
+		}
+		client = api.NewClientWithTokenSource(tokenSource)
+	}
+
+	receiver, err := service.NewWebhookReceiver(ctx, client, config, secret, logger)
+	if err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(opts.Path, receiver)
+	server := &http.Server{
+		Addr:              opts.Addr,
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		logger.Info("listening", "addr", opts.Addr, "path", opts.Path, "handlers", len(config.Handlers))
+		errCh <- server.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("webhook server failed: %w", err)
+	case <-ctx.Done():
+	}
+
+	logger.Info("shutting down")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("failed to shut down webhook server: %w", err)
+	}
+	if err := <-errCh; err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("webhook server failed: %w", err)
+	}
+	// Handlers of accepted deliveries still run after the response was sent
+	receiver.Wait()
+	return nil
+}
+
+func newLogger(format string) (*slog.Logger, error) {
+	switch format {
+	case logFormatJSON:
+		return slog.New(slog.NewJSONHandler(os.Stderr, nil)), nil
+	case logFormatText:
+		return slog.New(slog.NewTextHandler(os.Stderr, nil)), nil
+	default:
+		return nil, fmt.Errorf("invalid log format: %s (use json or text)", format)
+	}
+}
+
+// webhookSecret returns the secret given on the command line or in the
+// environment
+func webhookSecret(flag string) string {
+	if flag != "" {
+		return flag
+	}
+	return os.Getenv(envWebhookSecret)
+}
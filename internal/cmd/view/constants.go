@@ -2,10 +2,14 @@ package view
 
 const (
 	// Format constants
-	formatJSON  = "json"
-	formatTable = "table"
+	formatJSON     = "json"
+	formatTable    = "table"
+	formatTerminal = "terminal"
+	formatMarkdown = "markdown"
+	formatCSV      = "csv"
 
 	// Display constants
-	tableSeparatorWidth  = 6
-	maxDescriptionLength = 50
+	tableSeparatorWidth   = 6
+	maxDescriptionLength  = 50
+	maxRenderedCellLength = 40
 )
@@ -3,23 +3,23 @@ package view
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // CopyOptions holds options for the copy command
 type CopyOptions struct {
-	ViewID     string
-	ProjectRef string
-	Name       string
-	Format     string
+	ViewID           string
+	ProjectRef       string
+	SourceProjectRef string
+	Name             string
+	Format           string
 }
 
 // NewCopyCmd creates the copy command
@@ -27,7 +27,7 @@ func NewCopyCmd() *cobra.Command {
 	opts := &CopyOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "copy <view-id> <new-name> [project-ref]",
+		Use:   "copy <view> <new-name> [project-ref]",
 		Short: "Copy a project view",
 		Long: `Create a copy of an existing project view.
 
@@ -35,12 +35,18 @@ The copied view will have the same layout, filter, sorting, and grouping
 configuration as the original view. You can optionally copy the view to
 a different project by specifying the target project reference.
 
+The view is given by ID, or by number or name within the project given with
+--project. Without --project, names and numbers are looked up in the target
+project.
+
 Examples:
-  ghx view copy view-id "Sprint 2 Board"
+  ghx view copy Board "Sprint 2 Board" --project octocat/1
+  ghx view copy 2 "Sprint 2 Board" octocat/1
   ghx view copy view-id "Bug Dashboard" octocat/456
   ghx view copy view-id "Roadmap Copy" --format json`,
 
-		Args: cobra.RangeArgs(2, 3),
+		Args:              cobra.RangeArgs(2, 3),
+		ValidArgsFunction: completion.Args(completion.ViewRefs(completion.Flag("project", "")), nil, completion.Projects, nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ViewID = args[0]
 			opts.Name = args[1]
@@ -53,6 +59,8 @@ Examples:
 	}
 
 	cmd.Flags().Bool("org", false, "Copy to organization project")
+	cmd.Flags().StringVar(&opts.SourceProjectRef, "project", "", "Project (owner/number) of the view to copy")
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{"project": completion.Projects})
 
 	return cmd
 }
@@ -65,53 +73,47 @@ func runCopy(ctx context.Context, opts *CopyOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and services
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	viewService := service.NewViewService(client)
+	resolver := service.NewResolver(client)
 
+	sourceProjectRef := opts.SourceProjectRef
+	if sourceProjectRef == "" && !service.IsNodeID(opts.ViewID) {
+		sourceProjectRef = opts.ProjectRef
+	}
+	if sourceProjectRef == "" && opts.ProjectRef == "" {
+		return fmt.Errorf("give the project of view %s with --project owner/number", opts.ViewID)
+	}
+
+	viewID := opts.ViewID
 	var projectID string
+	if sourceProjectRef != "" {
+		sourceView, viewErr := resolver.View(ctx, sourceProjectRef, opts.ViewID)
+		if viewErr != nil {
+			return fmt.Errorf("failed to get source view: %w", viewErr)
+		}
+		viewID, projectID = sourceView.ID, sourceView.ProjectID
+	}
 
 	if opts.ProjectRef != "" {
 		// Copy to different project
-		projectService := service.NewProjectService(client)
-
-		// Parse project reference
-		parts := strings.Split(opts.ProjectRef, "/")
-		if len(parts) != 2 {
-			return fmt.Errorf("invalid project reference format. Use: owner/project-number")
-		}
-
-		owner := parts[0]
-		projectNumber, parseErr := strconv.Atoi(parts[1])
-		if parseErr != nil {
-			return fmt.Errorf("invalid project number: %s", parts[1])
-		}
-
-		// Get target project (with automatic owner detection)
-		project, getErr := projectService.GetProjectWithOwnerDetection(ctx, owner, projectNumber)
+		project, getErr := resolver.Project(ctx, opts.ProjectRef)
 		if getErr != nil {
 			return fmt.Errorf("failed to get target project: %w", getErr)
 		}
-
 		projectID = project.ID
-	} else {
-		// Copy within same project - get source view to determine project
-		sourceView, viewErr := viewService.GetView(ctx, opts.ViewID)
-		if viewErr != nil {
-			return fmt.Errorf("failed to get source view: %w", viewErr)
-		}
-		projectID = sourceView.ProjectID
 	}
 
 	// Copy view
 	input := service.CopyViewInput{
 		ProjectID: projectID,
-		ViewID:    opts.ViewID,
+		ViewID:    viewID,
 		Name:      opts.Name,
 	}
 
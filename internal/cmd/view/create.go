@@ -11,6 +11,7 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -46,7 +47,8 @@ Examples:
   ghx view create --org myorg/456 "Release Roadmap" roadmap
   ghx view create octocat/123 "High Priority" table --filter "priority:high" --format json`,
 
-		Args: cobra.ExactArgs(3),
+		Args:              cobra.ExactArgs(3),
+		ValidArgsFunction: completion.Args(completion.Projects, nil, cobra.FixedCompletions([]string{"table", "board", "roadmap"}, cobra.ShellCompDirectiveNoFileComp)),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ProjectRef = args[0]
 			opts.Name = args[1]
@@ -88,13 +90,13 @@ func runCreate(ctx context.Context, opts *CreateOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and services
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	projectService := service.NewProjectService(client)
 	viewService := service.NewViewService(client)
 
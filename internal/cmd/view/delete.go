@@ -11,14 +11,16 @@ import (
 
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // DeleteOptions holds options for the delete command
 type DeleteOptions struct {
-	ViewID string
-	Format string
-	Force  bool
+	ViewID     string
+	ProjectRef string
+	Format     string
+	Force      bool
 }
 
 // NewDeleteCmd creates the delete command
@@ -26,11 +28,12 @@ func NewDeleteCmd() *cobra.Command {
 	opts := &DeleteOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "delete <view-id>",
+		Use:   "delete <view>",
 		Short: "Delete a project view",
 		Long: `Delete an existing project view.
 
-This operation cannot be undone. By default, you will be prompted for
+The view is given by ID, or by number or name together with --project
+owner/number. This operation cannot be undone. By default, you will be prompted for
 confirmation unless you use the --force flag.
 
 WARNING: Deleting a view will remove all its configuration including
@@ -38,10 +41,12 @@ filters, sorting, and grouping settings.
 
 Examples:
   ghx view delete view-id
+  ghx view delete Board --project octocat/1
   ghx view delete view-id --force
   ghx view delete view-id --format json`,
 
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completion.ViewRefs(completion.Flag("project", "")), nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ViewID = args[0]
 			opts.Format = cmd.Flag("format").Value.String()
@@ -50,6 +55,8 @@ Examples:
 	}
 
 	cmd.Flags().BoolVar(&opts.Force, "force", false, "Skip confirmation prompt")
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) to look up the view by number or name")
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{"project": completion.Projects})
 
 	return cmd
 }
@@ -57,19 +64,19 @@ Examples:
 func runDelete(ctx context.Context, opts *DeleteOptions) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	viewService := service.NewViewService(client)
 
 	// Get view details for confirmation
-	viewInfo, err := viewService.GetView(ctx, opts.ViewID)
+	viewInfo, err := findView(ctx, client, opts.ProjectRef, opts.ViewID)
 	if err != nil {
-		return fmt.Errorf("failed to get view details: %w", err)
+		return err
 	}
 
 	// Confirm deletion unless force flag is used
@@ -92,7 +99,7 @@ func runDelete(ctx context.Context, opts *DeleteOptions) error {
 
 	// Delete view
 	input := service.DeleteViewInput{
-		ViewID: opts.ViewID,
+		ViewID: viewInfo.ID,
 	}
 
 	err = viewService.DeleteView(ctx, input)
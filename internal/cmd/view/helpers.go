@@ -9,6 +9,7 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
@@ -82,13 +83,30 @@ func outputViewDetailsJSON(view *graphql.ProjectV2View) {
 	fmt.Printf("\n")
 }
 
+// findView returns the view given by ID, or by number or name within the project
+// when one is given
+func findView(ctx context.Context, client *api.Client, projectRef, viewRef string) (*service.ViewInfo, error) {
+	if projectRef != "" {
+		return service.NewResolver(client).View(ctx, projectRef, viewRef)
+	}
+	if !service.IsNodeID(viewRef) {
+		return nil, fmt.Errorf("view %s is not a view ID; give the project with --project owner/number to find it by number or name", viewRef)
+	}
+	viewInfo, err := service.NewViewService(client).GetView(ctx, viewRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get view details: %w", err)
+	}
+	return viewInfo, nil
+}
+
 // ConfigurationOptions represents common options for view configuration commands
 type ConfigurationOptions struct {
-	ViewID    string
-	FieldID   string
-	Direction string
-	Format    string
-	Clear     bool
+	ViewID     string
+	FieldID    string
+	ProjectRef string
+	Direction  string
+	Format     string
+	Clear      bool
 }
 
 // ConfigurationConfig holds configuration for view configuration commands
@@ -106,10 +124,11 @@ func createViewConfigurationCmd(config *ConfigurationConfig) *cobra.Command {
 	opts := &ConfigurationOptions{}
 
 	cmd := &cobra.Command{
-		Use:   config.Use,
-		Short: config.Short,
-		Long:  config.Long,
-		Args:  cobra.ExactArgs(1),
+		Use:               config.Use,
+		Short:             config.Short,
+		Long:              config.Long,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completion.ViewRefs(completion.Flag("project", "")), nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ViewID = args[0]
 			opts.Format = cmd.Flag("format").Value.String()
@@ -117,9 +136,15 @@ func createViewConfigurationCmd(config *ConfigurationConfig) *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVar(&opts.FieldID, "field", "", "Field ID to "+config.OperationType+" by")
+	cmd.Flags().StringVar(&opts.FieldID, "field", "", "Field ID, or name with --project, to "+config.OperationType+" by")
 	cmd.Flags().StringVar(&opts.Direction, "direction", "asc", config.OperationType+" direction (asc, desc)")
 	cmd.Flags().BoolVar(&opts.Clear, "clear", false, "Clear "+config.OperationType+" from the view")
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) to look up the view and field by number or name")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"project": completion.Projects,
+		"field":   completion.Fields(completion.Flag("project", "")),
+	})
 
 	return cmd
 }
@@ -146,37 +171,50 @@ func runViewConfiguration(ctx context.Context, opts *ConfigurationOptions, confi
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	viewService := service.NewViewService(client)
 
+	resolver := service.NewResolver(client)
+	viewID, err := resolver.ViewID(ctx, opts.ProjectRef, opts.ViewID)
+	if err != nil {
+		return err
+	}
+	fieldID := opts.FieldID
+	if fieldID != "" {
+		fieldID, err = resolver.FieldID(ctx, opts.ProjectRef, fieldID)
+		if err != nil {
+			return err
+		}
+	}
+
 	// Create input and execute update
-	input := config.CreateInput(opts.ViewID, opts.FieldID, direction)
+	input := config.CreateInput(viewID, fieldID, direction)
 	err = config.UpdateFunction(ctx, viewService, input)
 	if err != nil {
 		return fmt.Errorf("failed to update view %s: %w", config.OperationType, err)
 	}
 
 	// Output result using shared helper
-	return outputViewConfigurationResult(ctx, opts.ViewID, config.OperationType, opts.Clear, opts.Format)
+	return outputViewConfigurationResult(ctx, viewID, config.OperationType, opts.Clear, opts.Format)
 }
 
 // ViewConfigurationResult handles common view configuration result output
 func outputViewConfigurationResult(ctx context.Context, viewID, operationType string, cleared bool, format string) error {
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	viewService := service.NewViewService(client)
 
 	// Get updated view for output
@@ -265,7 +303,7 @@ func createViewOperationCmd(operation, short, long string,
 	config := &ConfigurationConfig{
 		UpdateFunction: updateFunc,
 		CreateInput:    createInputFunc,
-		Use:            operation + " <view-id>",
+		Use:            operation + " <view>",
 		Short:          short,
 		OperationType:  operation,
 		Long:           long,
@@ -279,7 +317,8 @@ func groupLongDescription() string {
 
 You can set the field to group by and the group direction. Use --clear to
 remove grouping from the view. Grouping is particularly useful for board
-and roadmap views.
+and roadmap views. With --project, the view can be given by number or name
+and the field by name.
 
 Group Directions:
   asc, ascending    - Group in ascending order (A-Z, 1-9, oldest first)
@@ -288,6 +327,7 @@ Group Directions:
 Examples:
   ghx view group view-id --field status-field-id --direction asc
   ghx view group view-id --field assignee-field-id --direction desc
+  ghx view group Board --project octocat/1 --field Status
   ghx view group view-id --clear
   ghx view group view-id --field priority-field-id --direction desc --format json`
 }
@@ -297,7 +337,8 @@ func sortLongDescription() string {
 	return `Configure sorting for a project view.
 
 You can set the field to sort by and the sort direction. Use --clear to
-remove sorting from the view.
+remove sorting from the view. With --project, the view can be given by number
+or name and the field by name.
 
 Sort Directions:
   asc, ascending    - Sort in ascending order (A-Z, 1-9, oldest first)
@@ -306,6 +347,7 @@ Sort Directions:
 Examples:
   ghx view sort view-id --field priority-field-id --direction desc
   ghx view sort view-id --field status-field-id --direction asc
+  ghx view sort 2 --project octocat/1 --field Priority --direction desc
   ghx view sort view-id --clear
   ghx view sort view-id --field due-date-field-id --direction asc --format json`
 }
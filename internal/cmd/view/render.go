@@ -0,0 +1,203 @@
+package view
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// RenderOptions holds options for the render command
+type RenderOptions struct {
+	ProjectRef string
+	ViewRef    string
+	DateField  string
+	Format     string
+}
+
+// NewRenderCmd creates the render command
+func NewRenderCmd() *cobra.Command {
+	opts := &RenderOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "render <owner/project-number> <view>",
+		Short: "Show what a project view displays",
+		Long: `Render a project view locally.
+
+Fetches every item of the project and applies the view's filter, sort and
+grouping the way the web UI does, so a view can be shared in a standup or
+pasted into a document without opening the browser. The view is given by
+its number, ID or name.
+
+Layouts:
+  table       - The view's visible fields, grouped by its group-by field
+  board       - One column per value of the column field (Status by default)
+  roadmap     - Lanes of items ordered by date; the first iteration field,
+                or else the first date field, is used unless --date-field
+                is given
+
+Formats:
+  table       - Terminal output (default; terminal is an alias)
+  markdown    - Markdown tables, or lists for board columns
+  csv         - One row per item, with its group in the first column
+  json        - The rendered groups and items
+
+Examples:
+  ghx view render octocat/123 "Sprint Board"
+  ghx view render octocat/123 2 --format markdown
+  ghx view render octocat/123 Roadmap --date-field "Target Date"
+  ghx view render octocat/123 "Open Bugs" --format csv > bugs.csv`,
+
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completion.Args(completion.Projects, completion.Views(completion.Arg(0)), nil),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts.ProjectRef = args[0]
+			opts.ViewRef = args[1]
+			opts.Format = cmd.Flag("format").Value.String()
+			return runRender(cmd.Context(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.DateField, "date-field", "", "Date or iteration field to order a roadmap view by")
+
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{
+		"date-field": completion.Fields(completion.Arg(0), "Date", "Iteration"),
+	})
+
+	return cmd
+}
+
+func runRender(ctx context.Context, opts *RenderOptions) error {
+	switch opts.Format {
+	case formatTable, formatTerminal, formatMarkdown, formatCSV, formatJSON:
+	default:
+		return fmt.Errorf("unknown format: %s", opts.Format)
+	}
+
+	// Initialize authentication
+	authManager := auth.NewAuthManager()
+	tokenSource, err := authManager.TokenSource()
+	if err != nil {
+		return fmt.Errorf("authentication failed: %w", err)
+	}
+
+	// Create client and services
+	client := api.NewClientWithTokenSource(tokenSource)
+	viewService := service.NewViewService(client)
+	resolver := service.NewResolver(client)
+
+	project, err := resolver.Project(ctx, opts.ProjectRef)
+	if err != nil {
+		return err
+	}
+	view, err := resolver.View(ctx, opts.ProjectRef, opts.ViewRef)
+	if err != nil {
+		return err
+	}
+
+	rendered, err := viewService.RenderView(ctx, project, view, service.RenderViewOptions{DateField: opts.DateField})
+	if err != nil {
+		return fmt.Errorf("failed to render view: %w", err)
+	}
+
+	switch opts.Format {
+	case formatJSON:
+		data, err := json.MarshalIndent(rendered, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal JSON: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	case formatMarkdown:
+		fmt.Print(service.FormatRenderedViewMarkdown(rendered))
+		return nil
+	case formatCSV:
+		return service.WriteRenderedViewCSV(os.Stdout, rendered)
+	default:
+		outputRenderedView(rendered, project.Title)
+		return nil
+	}
+}
+
+func outputRenderedView(view *service.RenderedView, projectTitle string) {
+	total := 0
+	for _, group := range view.Groups {
+		total += len(group.Items)
+	}
+
+	fmt.Printf("View '%s' (%s) in project '%s'\n", view.Name, view.Layout, projectTitle)
+	if view.Filter != "" {
+		fmt.Printf("Filter: %s\n", view.Filter)
+	}
+	if view.DateField != "" {
+		fmt.Printf("Dates: %s\n", view.DateField)
+	}
+	fmt.Printf("%d item(s)\n", total)
+
+	for _, group := range view.Groups {
+		fmt.Println()
+		if group.Name != "" {
+			fmt.Printf("%s (%d)\n", group.Name, len(group.Items))
+		}
+
+		if view.Layout == service.RenderedLayoutBoard {
+			for i := range group.Items {
+				fmt.Printf("  • %s\n", service.RenderedViewRow(view, &group.Items[i])[0])
+			}
+			continue
+		}
+
+		rows := [][]string{service.RenderedViewColumns(view)}
+		for i := range group.Items {
+			rows = append(rows, service.RenderedViewRow(view, &group.Items[i]))
+		}
+		outputRenderedRows(rows)
+	}
+}
+
+// outputRenderedRows prints rows as aligned columns, the first row being the
+// header
+func outputRenderedRows(rows [][]string) {
+	widths := make([]int, len(rows[0]))
+	for _, row := range rows {
+		for i, cell := range row {
+			row[i] = truncateCell(cell)
+			widths[i] = max(widths[i], utf8.RuneCountInString(row[i]))
+		}
+	}
+
+	printRow := func(row []string) {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			cells[i] = cell + strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+		}
+		fmt.Printf("  %s\n", strings.TrimRight(strings.Join(cells, "  "), " "))
+	}
+
+	printRow(rows[0])
+	separators := make([]string, len(widths))
+	for i, width := range widths {
+		separators[i] = strings.Repeat("-", width)
+	}
+	printRow(separators)
+	for _, row := range rows[1:] {
+		printRow(row)
+	}
+}
+
+func truncateCell(cell string) string {
+	cell = strings.ReplaceAll(cell, "\n", " ")
+	if utf8.RuneCountInString(cell) <= maxRenderedCellLength {
+		return cell
+	}
+	return string([]rune(cell)[:maxRenderedCellLength-3]) + "..."
+}
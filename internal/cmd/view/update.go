@@ -9,15 +9,17 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api"
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/completion"
 	"github.com/roboco-io/ghx-cli/internal/service"
 )
 
 // UpdateOptions holds options for the update command
 type UpdateOptions struct {
-	ViewID string
-	Name   string
-	Filter string
-	Format string
+	ViewID     string
+	ProjectRef string
+	Name       string
+	Filter     string
+	Format     string
 }
 
 // NewUpdateCmd creates the update command
@@ -25,20 +27,23 @@ func NewUpdateCmd() *cobra.Command {
 	opts := &UpdateOptions{}
 
 	cmd := &cobra.Command{
-		Use:   "update <view-id>",
+		Use:   "update <view>",
 		Short: "Update a project view",
 		Long: `Update properties of an existing project view.
 
 You can update the view name and filter. At least one property must be specified.
-The view layout cannot be changed after creation.
+The view layout cannot be changed after creation. The view is given by ID, or
+by number or name together with --project owner/number.
 
 Examples:
   ghx view update view-id --name "Updated Dashboard"
   ghx view update view-id --filter "status:todo"
+  ghx view update Board --project octocat/1 --filter "status:todo"
   ghx view update view-id --name "Sprint Board" --filter "milestone:sprint-1"
   ghx view update view-id --name "Bug Tracking" --format json`,
 
-		Args: cobra.ExactArgs(1),
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completion.Args(completion.ViewRefs(completion.Flag("project", "")), nil),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			opts.ViewID = args[0]
 			opts.Format = cmd.Flag("format").Value.String()
@@ -48,6 +53,8 @@ Examples:
 
 	cmd.Flags().StringVar(&opts.Name, "name", "", "New name for the view")
 	cmd.Flags().StringVar(&opts.Filter, "filter", "", "Filter expression for the view")
+	cmd.Flags().StringVar(&opts.ProjectRef, "project", "", "Project (owner/number) to look up the view by number or name")
+	completion.Flags(cmd, map[string]cobra.CompletionFunc{"project": completion.Projects})
 
 	return cmd
 }
@@ -67,18 +74,23 @@ func runUpdate(ctx context.Context, opts *UpdateOptions) error {
 
 	// Initialize authentication
 	authManager := auth.NewAuthManager()
-	token, err := authManager.GetValidatedToken()
+	tokenSource, err := authManager.TokenSource()
 	if err != nil {
 		return fmt.Errorf("authentication failed: %w", err)
 	}
 
 	// Create client and service
-	client := api.NewClient(token)
+	client := api.NewClientWithTokenSource(tokenSource)
 	viewService := service.NewViewService(client)
 
+	viewID, err := service.NewResolver(client).ViewID(ctx, opts.ProjectRef, opts.ViewID)
+	if err != nil {
+		return err
+	}
+
 	// Prepare input
 	input := service.UpdateViewInput{
-		ViewID: opts.ViewID,
+		ViewID: viewID,
 	}
 
 	if opts.Name != "" {
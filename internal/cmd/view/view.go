@@ -40,7 +40,8 @@ View Operations:
   copy        - Create a copy of an existing view
   delete      - Delete a project view
   sort        - Configure view sorting options
-  group       - Configure view grouping options`,
+  group       - Configure view grouping options
+  render      - Show what a view displays`,
 
 		Example: `  # List all views in a project
   ghx view list octocat/123
@@ -58,7 +59,10 @@ View Operations:
   ghx view update view-id --name "Updated Dashboard"
 
   # Configure view sorting
-  ghx view sort view-id --field priority --direction desc`,
+  ghx view sort view-id --field priority --direction desc
+
+  # Show a board view as Markdown
+  ghx view render octocat/123 "Sprint Board" --format markdown`,
 	}
 
 	// Add format flag to all subcommands
@@ -72,6 +76,7 @@ View Operations:
 	cmd.AddCommand(NewDeleteCmd())
 	cmd.AddCommand(NewSortCmd())
 	cmd.AddCommand(NewGroupCmd())
+	cmd.AddCommand(NewRenderCmd())
 
 	return cmd
 }
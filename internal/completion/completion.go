@@ -0,0 +1,255 @@
+// Package completion provides dynamic shell completion of projects, fields,
+// options, views and discussion categories for ghx commands.
+//
+// Candidates are looked up on GitHub and cached for a short time, so pressing
+// tab repeatedly does not authenticate and query the API every time. Lookups
+// that fail, e.g. because the user is not logged in, complete nothing.
+package completion
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/auth"
+	"github.com/roboco-io/ghx-cli/internal/service"
+)
+
+// Cache settings in the config file
+const (
+	configCacheEnabled   = "cache.enabled"
+	configCacheTTL       = "cache.ttl"
+	configCacheDirectory = "cache.directory"
+)
+
+// Source finds a value given earlier on the command line, such as the project
+// whose fields are completed
+type Source func(cmd *cobra.Command, args []string) string
+
+// Arg returns a source reading the positional argument at index i
+func Arg(i int) Source {
+	return func(_ *cobra.Command, args []string) string {
+		if i < len(args) {
+			return args[i]
+		}
+		return ""
+	}
+}
+
+// Flag returns a source reading a flag, or fallback when the flag is not set
+func Flag(name, fallback string) Source {
+	return func(cmd *cobra.Command, _ []string) string {
+		if flag := cmd.Flag(name); flag != nil && flag.Value.String() != "" {
+			return flag.Value.String()
+		}
+		return fallback
+	}
+}
+
+// FlagOr returns a source reading a flag, or the source fallback when the
+// flag is not set
+func FlagOr(name string, fallback Source) Source {
+	return func(cmd *cobra.Command, args []string) string {
+		if value := Flag(name, "")(cmd, args); value != "" {
+			return value
+		}
+		return fallback(cmd, args)
+	}
+}
+
+// Args completes each positional argument with the function at its position.
+// The last function also completes any further arguments; a nil function
+// completes nothing.
+func Args(fns ...cobra.CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(fns) == 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		fn := fns[len(fns)-1]
+		if len(args) < len(fns) {
+			fn = fns[len(args)]
+		}
+		if fn == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return fn(cmd, args, toComplete)
+	}
+}
+
+// ProjectArg completes a project reference as the only positional argument
+var ProjectArg = Args(Projects)
+
+// Flags registers completion functions for the flags of cmd
+func Flags(cmd *cobra.Command, fns map[string]cobra.CompletionFunc) {
+	for name, fn := range fns {
+		_ = cmd.RegisterFlagCompletionFunc(name, fn)
+	}
+}
+
+// Projects completes owner/number project references. The owner is taken from
+// the text typed so far, or else from the default organization or user.
+func Projects(cmd *cobra.Command, _ []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	owner, isOrg := auth.NewAuthManager().DefaultOwner()
+	if i := strings.Index(toComplete, "/"); i > 0 {
+		owner, isOrg = toComplete[:i], false
+	}
+	if owner == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return lookup(cmd, []string{"projects", owner}, func(ctx context.Context, s *service.CompletionService) ([]service.Completion, error) {
+		return s.Projects(ctx, owner, isOrg)
+	})
+}
+
+// Fields completes the names of the fields of a project, optionally only those
+// of the given data types
+func Fields(project Source, dataTypes ...string) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+		ref := project(cmd, args)
+		if ref == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		key := append([]string{"fields", ref}, dataTypes...)
+		return lookup(cmd, key, func(ctx context.Context, s *service.CompletionService) ([]service.Completion, error) {
+			return s.Fields(ctx, ref, dataTypes...)
+		})
+	}
+}
+
+// Options completes the option names of a single select field of a project
+func Options(project, field Source) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+		ref, name := project(cmd, args), field(cmd, args)
+		if ref == "" || name == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return lookup(cmd, []string{"options", ref, strings.ToLower(name)}, func(ctx context.Context, s *service.CompletionService) ([]service.Completion, error) {
+			return s.FieldOptions(ctx, ref, name)
+		})
+	}
+}
+
+// Views completes the names of the views of a project
+func Views(project Source) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+		ref := project(cmd, args)
+		if ref == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return lookup(cmd, []string{"views", ref}, func(ctx context.Context, s *service.CompletionService) ([]service.Completion, error) {
+			return s.Views(ctx, ref)
+		})
+	}
+}
+
+// ViewIDs completes view IDs. Commands that take a view ID without a project
+// get the views of all open projects of the default organization or user.
+func ViewIDs(cmd *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	owner, isOrg := auth.NewAuthManager().DefaultOwner()
+	if owner == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return lookup(cmd, []string{"owner-views", owner}, func(ctx context.Context, s *service.CompletionService) ([]service.Completion, error) {
+		return s.OwnerViews(ctx, owner, isOrg)
+	})
+}
+
+// ViewRefs completes the names of the views of the project given by source, or
+// view IDs when no project is given
+func ViewRefs(project Source) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if project(cmd, args) == "" {
+			return ViewIDs(cmd, args, toComplete)
+		}
+		return Views(project)(cmd, args, toComplete)
+	}
+}
+
+// Categories completes the discussion category slugs of a repository
+func Categories(repo Source) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+		ref := repo(cmd, args)
+		if ref == "" {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return lookup(cmd, []string{"categories", ref}, func(ctx context.Context, s *service.CompletionService) ([]service.Completion, error) {
+			return s.Categories(ctx, ref)
+		})
+	}
+}
+
+// lookup returns the cached candidates for key, fetching and caching them when
+// they are missing or expired. Caching is skipped with --no-cache or when the
+// cache is disabled in the config file.
+func lookup(cmd *cobra.Command, key []string, fetch func(context.Context, *service.CompletionService) ([]service.Completion, error)) ([]string, cobra.ShellCompDirective) {
+	cacheKey := service.CompletionCacheKey(append([]string{viper.GetString("profile")}, key...)...)
+
+	cache := newCache()
+	if cache != nil {
+		if completions, ok := cache.Load(cacheKey); ok {
+			return format(completions), cobra.ShellCompDirectiveNoFileComp
+		}
+	}
+
+	tokenSource, err := auth.NewAuthManager().TokenSource()
+	if err != nil {
+		cobra.CompDebugln("authentication failed: "+err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	ctx := cmd.Context()
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	completions, err := fetch(ctx, service.NewCompletionService(api.NewClientWithTokenSource(tokenSource)))
+	if err != nil {
+		cobra.CompDebugln(err.Error(), false)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	if cache != nil {
+		if err := cache.Store(cacheKey, completions); err != nil {
+			cobra.CompDebugln(err.Error(), false)
+		}
+	}
+	return format(completions), cobra.ShellCompDirectiveNoFileComp
+}
+
+// newCache returns the completion cache configured by the cache settings, or nil
+// when caching is disabled
+func newCache() *service.CompletionCache {
+	if viper.GetBool("no-cache") || (viper.IsSet(configCacheEnabled) && !viper.GetBool(configCacheEnabled)) {
+		return nil
+	}
+
+	ttl := service.DefaultCompletionCacheTTL
+	if viper.IsSet(configCacheTTL) {
+		ttl = viper.GetDuration(configCacheTTL)
+	}
+	dir := viper.GetString(configCacheDirectory)
+	if dir != "" {
+		dir = filepath.Join(auth.ExpandHome(dir), "completion")
+	} else {
+		var err error
+		if dir, err = service.DefaultCompletionCacheDir(); err != nil {
+			return nil
+		}
+	}
+	return service.NewCompletionCache(dir, ttl)
+}
+
+// format renders candidates the way cobra expects, with tab-separated descriptions
+func format(completions []service.Completion) []string {
+	values := make([]string, len(completions))
+	for i, completion := range completions {
+		values[i] = completion.Value
+		if completion.Description != "" {
+			values[i] += "\t" + strings.Join(strings.Fields(completion.Description), " ")
+		}
+	}
+	return values
+}
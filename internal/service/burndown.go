@@ -0,0 +1,395 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"html"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// Chart kinds
+const (
+	ChartBurndown = "burndown"
+	ChartBurnup   = "burnup"
+)
+
+const (
+	burndownChartHeight = 10
+	burndownSVGWidth    = 640
+	burndownSVGHeight   = 320
+	burndownSVGMargin   = 48
+)
+
+// BurndownOptions represents options for computing a burndown
+type BurndownOptions struct {
+	// Field is the iteration field; the first iteration field is used when empty
+	Field string
+	// Iteration is an iteration ID, title or relative reference; it defaults to
+	// @current
+	Iteration string
+	// Estimate is a number field holding the work of each item; items count as
+	// one each when empty
+	Estimate    string
+	StatusField string
+	DoneOption  string
+}
+
+// Burndown represents the work of an iteration day by day
+type Burndown struct {
+	Start     time.Time     `json:"start"`
+	End       time.Time     `json:"end"`
+	Field     string        `json:"field"`
+	Iteration string        `json:"iteration"`
+	Estimate  string        `json:"estimate,omitempty"`
+	Days      []BurndownDay `json:"days"`
+	Items     int           `json:"items"`
+	Total     float64       `json:"total"`
+}
+
+// BurndownDay represents the work at the start of a day of an iteration. Actual
+// values are nil for days that have not started yet.
+type BurndownDay struct {
+	Date      time.Time `json:"date"`
+	Scope     *float64  `json:"scope,omitempty"`
+	Completed *float64  `json:"completed,omitempty"`
+	Remaining *float64  `json:"remaining,omitempty"`
+	Ideal     float64   `json:"ideal"`
+}
+
+// burndownItem is the work of an item and when it was added and completed
+type burndownItem struct {
+	added     time.Time
+	completed *time.Time
+	work      float64
+}
+
+// BuildBurndown fetches every item of a project and computes the burndown of an
+// iteration
+func (s *AnalyticsService) BuildBurndown(ctx context.Context, project *graphql.ProjectV2, now time.Time, opts BurndownOptions) (*Burndown, error) {
+	items, err := NewItemService(s.client).ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	return ComputeBurndown(project, items, now, opts)
+}
+
+// ComputeBurndown computes the remaining and completed work of the items in an
+// iteration at the start of each of its days, and the day after it ends. Items
+// are in scope from when they were added to the project, or from the start if
+// they were added on its first day. They are completed when their issue or pull
+// request was closed or, for items that are done by status, when they were last
+// updated. The ideal line goes from the current scope to zero over the
+// iteration.
+func ComputeBurndown(project *graphql.ProjectV2, items []graphql.ProjectV2Item, now time.Time, opts BurndownOptions) (*Burndown, error) {
+	field, err := burndownIterationField(project, opts.Field)
+	if err != nil {
+		return nil, err
+	}
+	iterations, err := IterationsFromField(field)
+	if err != nil {
+		return nil, err
+	}
+	ref := opts.Iteration
+	if ref == "" {
+		ref = IterationRefCurrent
+	}
+	iteration, err := ResolveIteration(iterations, ref, now)
+	if err != nil {
+		return nil, err
+	}
+
+	var estimate *graphql.ProjectV2Field
+	if opts.Estimate != "" {
+		if estimate = projectFieldByName(project, opts.Estimate); estimate == nil {
+			return nil, fmt.Errorf("field %s not found in project", opts.Estimate)
+		}
+		if estimate.DataType != graphql.ProjectV2FieldDataTypeNumber {
+			return nil, fmt.Errorf("field %s is not a number field", estimate.Name)
+		}
+	}
+	statusName := opts.StatusField
+	if statusName == "" {
+		statusName = defaultStatusFieldName
+	}
+	doneOption := opts.DoneOption
+	if doneOption == "" {
+		doneOption = defaultDoneOptionName
+	}
+	statusField := projectFieldByName(project, statusName)
+	if statusField == nil && opts.StatusField != "" {
+		return nil, fmt.Errorf("field %s not found in project", opts.StatusField)
+	}
+
+	burndown := &Burndown{
+		Field:     field.Name,
+		Iteration: iteration.Title,
+		Start:     iteration.StartDate,
+		End:       iteration.EndDate(),
+	}
+	if estimate != nil {
+		burndown.Estimate = estimate.Name
+	}
+
+	var work []burndownItem
+	for i := range items {
+		item := &items[i]
+		if itemIterationID(item, field.ID) != iteration.ID {
+			continue
+		}
+		entry := burndownItem{added: item.CreatedAt, work: 1}
+		if estimate != nil {
+			entry.work = itemNumberValue(item, estimate.ID)
+		}
+		status := ""
+		if statusField != nil {
+			status = itemFieldValue(item, statusField.Name)
+		}
+		switch closedAt := projectItemClosedAt(item); {
+		case closedAt != nil:
+			entry.completed = closedAt
+		case projectItemClosed(item) || (status != "" && strings.EqualFold(status, doneOption)):
+			updatedAt := item.UpdatedAt
+			entry.completed = &updatedAt
+		}
+		work = append(work, entry)
+		burndown.Total += entry.work
+	}
+	burndown.Items = len(work)
+
+	// Items planned on the first day of the iteration are in scope from its start
+	firstDayEnd := iteration.StartDate.AddDate(0, 0, 1)
+	for day := 0; day <= iteration.Duration; day++ {
+		date := iteration.StartDate.AddDate(0, 0, day)
+		point := BurndownDay{
+			Date:  date,
+			Ideal: burndown.Total * float64(iteration.Duration-day) / float64(iteration.Duration),
+		}
+		if !date.After(now) {
+			var scope, completed float64
+			for _, entry := range work {
+				if entry.added.Before(date) || entry.added.Before(firstDayEnd) {
+					scope += entry.work
+				}
+				if entry.completed != nil && entry.completed.Before(date) {
+					completed += entry.work
+				}
+			}
+			remaining := max(scope-completed, 0)
+			point.Scope, point.Completed, point.Remaining = &scope, &completed, &remaining
+		}
+		burndown.Days = append(burndown.Days, point)
+	}
+	return burndown, nil
+}
+
+// burndownIterationField finds an iteration field by name, or the first one
+func burndownIterationField(project *graphql.ProjectV2, name string) (*graphql.ProjectV2Field, error) {
+	if name != "" {
+		field := projectFieldByName(project, name)
+		if field == nil {
+			return nil, fmt.Errorf("field %s not found in project", name)
+		}
+		if field.DataType != graphql.ProjectV2FieldDataTypeIteration {
+			return nil, fmt.Errorf("field %s is not an iteration field", field.Name)
+		}
+		return field, nil
+	}
+	for i := range project.Fields.Nodes {
+		if project.Fields.Nodes[i].DataType == graphql.ProjectV2FieldDataTypeIteration {
+			return &project.Fields.Nodes[i], nil
+		}
+	}
+	return nil, fmt.Errorf("project has no iteration field")
+}
+
+func itemIterationID(item *graphql.ProjectV2Item, fieldID string) string {
+	for _, value := range item.FieldValues.Nodes {
+		if value.Field.ID == fieldID && value.IterationValue.IterationID != nil {
+			return *value.IterationValue.IterationID
+		}
+	}
+	return ""
+}
+
+func itemNumberValue(item *graphql.ProjectV2Item, fieldID string) float64 {
+	for _, value := range item.FieldValues.Nodes {
+		if value.Field.ID == fieldID && value.NumberValue.Number != nil {
+			return *value.NumberValue.Number
+		}
+	}
+	return 0
+}
+
+// actual returns the remaining work of a burndown or the completed work of a
+// burnup on a day
+func (d *BurndownDay) actual(kind string) *float64 {
+	if kind == ChartBurnup {
+		return d.Completed
+	}
+	return d.Remaining
+}
+
+// guide returns the ideal line of a burndown or the scope of a burnup on a day;
+// the scope of days that have not started is the last known scope
+func (b *Burndown) guide(kind string, day int) float64 {
+	if kind != ChartBurnup {
+		return b.Days[day].Ideal
+	}
+	for i := day; i >= 0; i-- {
+		if b.Days[i].Scope != nil {
+			return *b.Days[i].Scope
+		}
+	}
+	return b.Total
+}
+
+func (b *Burndown) chartMax(kind string) float64 {
+	top := b.Total
+	for i := range b.Days {
+		if scope := b.Days[i].Scope; scope != nil {
+			top = max(top, *scope)
+		}
+		if kind != ChartBurnup {
+			if remaining := b.Days[i].Remaining; remaining != nil {
+				top = max(top, *remaining)
+			}
+		}
+	}
+	if top == 0 {
+		return 1
+	}
+	return top
+}
+
+// FormatBurndownChart draws a burndown or burnup as a terminal chart: bars show
+// the remaining or completed work and dots the ideal line or the scope
+func FormatBurndownChart(b *Burndown, kind string) string {
+	top := b.chartMax(kind)
+	labelWidth := len(formatChartValue(top))
+
+	var sb strings.Builder
+	for row := burndownChartHeight; row >= 1; row-- {
+		low := top * float64(row-1) / burndownChartHeight
+		high := top * float64(row) / burndownChartHeight
+
+		axis := ""
+		switch row {
+		case burndownChartHeight:
+			axis = formatChartValue(top)
+		case 1:
+			axis = "0"
+		}
+		fmt.Fprintf(&sb, "%*s │", labelWidth, axis)
+
+		for i := range b.Days {
+			guide := b.guide(kind, i)
+			actual := b.Days[i].actual(kind)
+			switch {
+			case actual != nil && *actual >= high:
+				sb.WriteString("█ ")
+			case actual != nil && *actual > low:
+				sb.WriteString("▄ ")
+			case guide > low && guide <= high, row == 1 && guide == 0:
+				sb.WriteString("· ")
+			default:
+				sb.WriteString("  ")
+			}
+		}
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "%*s └%s\n", labelWidth, "", strings.Repeat("──", len(b.Days)))
+	start, end := b.Start.Format("01-02"), b.End.Format("01-02")
+	gap := max(len(b.Days)*2-len(start)-len(end), 1)
+	fmt.Fprintf(&sb, "%*s  %s%s%s\n", labelWidth, "", start, strings.Repeat(" ", gap), end)
+	return sb.String()
+}
+
+// WriteBurndownCSV writes one row per day with the scope, completed, remaining
+// and ideal work; actual values of days that have not started are empty
+func WriteBurndownCSV(w io.Writer, b *Burndown) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"date", "scope", "completed", "remaining", "ideal"}); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	optional := func(v *float64) string {
+		if v == nil {
+			return ""
+		}
+		return formatChartValue(*v)
+	}
+	for _, day := range b.Days {
+		row := []string{
+			day.Date.Format(DateLayout),
+			optional(day.Scope),
+			optional(day.Completed),
+			optional(day.Remaining),
+			formatChartValue(day.Ideal),
+		}
+		if err := writer.Write(row); err != nil {
+			return fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+// RenderBurndownSVG draws a burndown or burnup as a standalone SVG image with the
+// actual line over a dashed ideal or scope line
+func RenderBurndownSVG(b *Burndown, kind string) string {
+	top := b.chartMax(kind)
+	plotWidth := float64(burndownSVGWidth - 2*burndownSVGMargin)
+	plotHeight := float64(burndownSVGHeight - 2*burndownSVGMargin)
+	steps := float64(max(len(b.Days)-1, 1))
+	x := func(i int) float64 { return burndownSVGMargin + plotWidth*float64(i)/steps }
+	y := func(v float64) float64 { return burndownSVGMargin + plotHeight*(1-v/top) }
+
+	var guide, actual []string
+	for i := range b.Days {
+		guide = append(guide, fmt.Sprintf("%.1f,%.1f", x(i), y(b.guide(kind, i))))
+		if v := b.Days[i].actual(kind); v != nil {
+			actual = append(actual, fmt.Sprintf("%.1f,%.1f", x(i), y(*v)))
+		}
+	}
+
+	title := fmt.Sprintf("%s %s: %s", b.Field, b.Iteration, kind)
+	bottom := burndownSVGHeight - burndownSVGMargin
+	right := burndownSVGWidth - burndownSVGMargin
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		burndownSVGWidth, burndownSVGHeight, burndownSVGWidth, burndownSVGHeight)
+	fmt.Fprintf(&sb, `  <rect width="100%%" height="100%%" fill="#ffffff"/>`+"\n")
+	fmt.Fprintf(&sb, `  <text x="%d" y="%d" font-size="14" font-weight="bold">%s</text>`+"\n",
+		burndownSVGMargin, burndownSVGMargin/2, html.EscapeString(title))
+	fmt.Fprintf(&sb, `  <path d="M%d %d V%d H%d" fill="none" stroke="#57606a"/>`+"\n",
+		burndownSVGMargin, burndownSVGMargin, bottom, right)
+	fmt.Fprintf(&sb, `  <text x="%d" y="%d" text-anchor="end">%s</text>`+"\n",
+		burndownSVGMargin-6, burndownSVGMargin+4, formatChartValue(top))
+	fmt.Fprintf(&sb, `  <text x="%d" y="%d" text-anchor="end">0</text>`+"\n", burndownSVGMargin-6, bottom+4)
+	fmt.Fprintf(&sb, `  <text x="%d" y="%d">%s</text>`+"\n", burndownSVGMargin, bottom+18, b.Start.Format(DateLayout))
+	fmt.Fprintf(&sb, `  <text x="%d" y="%d" text-anchor="end">%s</text>`+"\n", right, bottom+18, b.End.Format(DateLayout))
+	fmt.Fprintf(&sb, `  <polyline points="%s" fill="none" stroke="#8c959f" stroke-width="2" stroke-dasharray="6 4"/>`+"\n",
+		strings.Join(guide, " "))
+	if len(actual) > 0 {
+		fmt.Fprintf(&sb, `  <polyline points="%s" fill="none" stroke="#0969da" stroke-width="2.5"/>`+"\n",
+			strings.Join(actual, " "))
+	}
+	sb.WriteString("</svg>\n")
+	return sb.String()
+}
+
+// formatChartValue formats work without trailing zeros
+func formatChartValue(v float64) string {
+	return strconv.FormatFloat(math.Round(v*10)/10, 'f', -1, 64)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestBurndownAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	store := server.Store
+	store.SetClock(func() time.Time { return start.Add(9 * time.Hour) })
+
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+	status := project.Field("Status")
+	points := store.AddField(project, "Points", "NUMBER")
+	sprint := store.AddField(project, "Sprint", "ITERATION")
+	sprint.Iterations = nil
+	current := store.AddIteration(sprint, "Sprint 1", start, 5)
+	store.AddIteration(sprint, "Sprint 2", start.AddDate(0, 0, 5), 5)
+
+	addItem := func(title string, estimate float64) *fake.Item {
+		issue := store.AddIssue(repo, title)
+		item := store.AddItem(project, issue)
+		store.SetValue(item, sprint, fake.Value{IterationID: current.ID})
+		store.SetValue(item, points, fake.Value{Number: estimate})
+		return item
+	}
+
+	// Planned on the first day; one closed on day 2, one done by status on day 3
+	closed := addItem("Login", 3)
+	closedAt := start.AddDate(0, 0, 1).Add(15 * time.Hour)
+	closed.Issue.Closed, closed.Issue.ClosedAt = true, &closedAt
+	done := addItem("Docs", 2)
+	store.SetValue(done, status, fake.Value{OptionID: status.Option("Done").ID})
+	done.UpdatedAt = start.AddDate(0, 0, 2).Add(10 * time.Hour)
+	addItem("Billing", 5)
+	// Added on day 3
+	store.SetClock(func() time.Time { return start.AddDate(0, 0, 2).Add(12 * time.Hour) })
+	addItem("Hotfix", 1)
+	// Another iteration
+	other := store.AddItem(project, store.AddIssue(repo, "Later"))
+	store.SetValue(other, sprint, fake.Value{IterationID: sprint.Iterations[1].ID})
+
+	ctx := context.Background()
+	client := api.NewClient("ghp_fake")
+	p, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+	require.NoError(t, err)
+	now := start.AddDate(0, 0, 3).Add(8 * time.Hour)
+	analytics := NewAnalyticsService(client)
+
+	values := func(burndown *Burndown, get func(*BurndownDay) *float64) []interface{} {
+		result := make([]interface{}, len(burndown.Days))
+		for i := range burndown.Days {
+			if v := get(&burndown.Days[i]); v != nil {
+				result[i] = *v
+			}
+		}
+		return result
+	}
+
+	t.Run("Counts items per day", func(t *testing.T) {
+		burndown, err := analytics.BuildBurndown(ctx, p, now, BurndownOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "Sprint", burndown.Field)
+		assert.Equal(t, "Sprint 1", burndown.Iteration)
+		assert.Equal(t, 4, burndown.Items)
+		require.Len(t, burndown.Days, 6)
+		assert.Equal(t, []interface{}{3.0, 3.0, 2.0, 2.0, nil, nil}, values(burndown, func(d *BurndownDay) *float64 { return d.Remaining }))
+		assert.Equal(t, []interface{}{3.0, 3.0, 3.0, 4.0, nil, nil}, values(burndown, func(d *BurndownDay) *float64 { return d.Scope }))
+		assert.InDelta(t, 4.0, burndown.Days[0].Ideal, 0)
+		assert.InDelta(t, 0.0, burndown.Days[5].Ideal, 0)
+	})
+
+	t.Run("Sums an estimate field", func(t *testing.T) {
+		burndown, err := analytics.BuildBurndown(ctx, p, now, BurndownOptions{Estimate: "Points", Iteration: "sprint 1"})
+		require.NoError(t, err)
+		assert.InDelta(t, 11.0, burndown.Total, 0)
+		assert.Equal(t, []interface{}{10.0, 10.0, 7.0, 6.0, nil, nil}, values(burndown, func(d *BurndownDay) *float64 { return d.Remaining }))
+		assert.Equal(t, []interface{}{0.0, 0.0, 3.0, 5.0, nil, nil}, values(burndown, func(d *BurndownDay) *float64 { return d.Completed }))
+
+		var csv bytes.Buffer
+		require.NoError(t, WriteBurndownCSV(&csv, burndown))
+		lines := strings.Split(strings.TrimSpace(csv.String()), "\n")
+		require.Len(t, lines, 7)
+		assert.Equal(t, "date,scope,completed,remaining,ideal", lines[0])
+		assert.Equal(t, "2026-03-04,10,3,7,6.6", lines[3])
+		assert.Equal(t, "2026-03-06,,,,2.2", lines[5])
+
+		chart := FormatBurndownChart(burndown, ChartBurndown)
+		assert.Contains(t, chart, "11 │▄ ▄ ")
+		assert.Contains(t, chart, " 0 │█ █ █ █   · ")
+		assert.Contains(t, chart, "03-02")
+		assert.Contains(t, chart, "03-07")
+
+		svg := RenderBurndownSVG(burndown, ChartBurnup)
+		assert.True(t, strings.HasPrefix(svg, `<svg xmlns="http://www.w3.org/2000/svg"`))
+		assert.Contains(t, svg, "Sprint Sprint 1: burnup")
+		assert.Equal(t, 2, strings.Count(svg, "<polyline"))
+	})
+
+	t.Run("Rejects unknown fields and iterations", func(t *testing.T) {
+		_, err := ComputeBurndown(p, nil, now, BurndownOptions{Estimate: "Status"})
+		assert.ErrorContains(t, err, "field Status is not a number field")
+
+		_, err = ComputeBurndown(p, nil, now, BurndownOptions{Field: "Points"})
+		assert.ErrorContains(t, err, "field Points is not an iteration field")
+
+		_, err = ComputeBurndown(p, nil, now, BurndownOptions{Iteration: "Sprint 9"})
+		assert.ErrorContains(t, err, `iteration "Sprint 9" not found`)
+	})
+}
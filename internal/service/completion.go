@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+)
+
+// DefaultCompletionCacheTTL is how long completion candidates are reused before
+// they are looked up again
+const DefaultCompletionCacheTTL = 2 * time.Minute
+
+// maxCompletionProjects is the number of projects offered per owner
+const maxCompletionProjects = 100
+
+// Completion is a shell completion candidate
+type Completion struct {
+	Value       string `json:"value"`
+	Description string `json:"description,omitempty"`
+}
+
+// CompletionCache keeps completion candidates in files so repeated completions
+// do not have to authenticate and query GitHub every time
+type CompletionCache struct {
+	now func() time.Time
+	Dir string
+	TTL time.Duration
+}
+
+// NewCompletionCache creates a cache in dir whose entries expire after ttl
+func NewCompletionCache(dir string, ttl time.Duration) *CompletionCache {
+	return &CompletionCache{Dir: dir, TTL: ttl, now: time.Now}
+}
+
+// DefaultCompletionCacheDir returns the directory completion candidates are
+// cached in unless another cache directory is configured
+func DefaultCompletionCacheDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to find home directory: %w", err)
+	}
+	return filepath.Join(home, ".ghx", "cache", "completion"), nil
+}
+
+// CompletionCacheKey builds a cache key from the GraphQL endpoint, so different
+// hosts do not share candidates, and the given parts
+func CompletionCacheKey(parts ...string) string {
+	return strings.Join(append([]string{api.Endpoint()}, parts...), "\x00")
+}
+
+// path returns the file holding the entry for key
+func (c *CompletionCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.Dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Load returns the candidates cached for key, and false when there are none or
+// they have expired
+func (c *CompletionCache) Load(key string) ([]Completion, bool) {
+	path := c.path(key)
+	info, err := os.Stat(path)
+	if err != nil || c.now().Sub(info.ModTime()) > c.TTL {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var completions []Completion
+	if err := json.Unmarshal(data, &completions); err != nil {
+		return nil, false
+	}
+	return completions, true
+}
+
+// Store caches the candidates for key
+func (c *CompletionCache) Store(key string, completions []Completion) error {
+	if err := os.MkdirAll(c.Dir, 0o700); err != nil {
+		return fmt.Errorf("failed to create completion cache: %w", err)
+	}
+	data, err := json.Marshal(completions)
+	if err != nil {
+		return fmt.Errorf("failed to marshal completions: %w", err)
+	}
+	path := c.path(key)
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write completion cache: %w", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("failed to write completion cache: %w", err)
+	}
+	return nil
+}
+
+// CompletionService looks up shell completion candidates
+type CompletionService struct {
+	client *api.Client
+}
+
+// NewCompletionService creates a new completion service
+func NewCompletionService(client *api.Client) *CompletionService {
+	return &CompletionService{
+		client: client,
+	}
+}
+
+// Projects returns the open projects of owner as owner/number references,
+// trying the user before the organization unless isOrg is set
+func (s *CompletionService) Projects(ctx context.Context, owner string, isOrg bool) ([]Completion, error) {
+	projects, err := s.listProjects(ctx, owner, isOrg)
+	if err != nil {
+		return nil, err
+	}
+
+	var completions []Completion
+	for i := range projects {
+		if projects[i].Closed {
+			continue
+		}
+		completions = append(completions, Completion{
+			Value:       fmt.Sprintf("%s/%d", owner, projects[i].Number),
+			Description: projects[i].Title,
+		})
+	}
+	return completions, nil
+}
+
+func (s *CompletionService) listProjects(ctx context.Context, owner string, isOrg bool) ([]ProjectInfo, error) {
+	projectService := NewProjectService(s.client)
+	listUser := func() ([]ProjectInfo, error) {
+		return projectService.ListUserProjects(ctx, ListUserProjectsOptions{Login: owner, First: maxCompletionProjects})
+	}
+	listOrg := func() ([]ProjectInfo, error) {
+		return projectService.ListOrgProjects(ctx, ListOrgProjectsOptions{Login: owner, First: maxCompletionProjects})
+	}
+	if isOrg {
+		listUser, listOrg = listOrg, listUser
+	}
+
+	projects, err := listUser()
+	if err == nil {
+		return projects, nil
+	}
+	projects, otherErr := listOrg()
+	if otherErr == nil {
+		return projects, nil
+	}
+	return nil, err
+}
+
+// Fields returns the names of the fields of a project, optionally only those of
+// the given data types (as shown by FormatFieldDataType)
+func (s *CompletionService) Fields(ctx context.Context, projectRef string, dataTypes ...string) ([]Completion, error) {
+	fields, err := s.projectFields(ctx, projectRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var completions []Completion
+	for i := range fields {
+		dataType := FormatFieldDataType(fields[i].DataType)
+		if len(dataTypes) > 0 && !containsFold(dataTypes, dataType) {
+			continue
+		}
+		completions = append(completions, Completion{Value: fields[i].Name, Description: dataType})
+	}
+	return completions, nil
+}
+
+// FieldOptions returns the option names of a single select field of a project,
+// found by name or ID
+func (s *CompletionService) FieldOptions(ctx context.Context, projectRef, field string) ([]Completion, error) {
+	fields, err := s.projectFields(ctx, projectRef)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range fields {
+		if fields[i].ID != field && !strings.EqualFold(fields[i].Name, field) {
+			continue
+		}
+		completions := make([]Completion, len(fields[i].Options))
+		for j, option := range fields[i].Options {
+			completions[j] = Completion{Value: option.Name}
+			if option.Description != nil {
+				completions[j].Description = *option.Description
+			}
+		}
+		return completions, nil
+	}
+	return nil, fmt.Errorf("field not found: %s", field)
+}
+
+func (s *CompletionService) projectFields(ctx context.Context, projectRef string) ([]FieldInfo, error) {
+	owner, number, err := ParseProjectReference(projectRef)
+	if err != nil {
+		return nil, err
+	}
+
+	fieldService := NewFieldService(s.client)
+	fields, err := fieldService.GetProjectFields(ctx, owner, number, false)
+	if err == nil {
+		return fields, nil
+	}
+	fields, orgErr := fieldService.GetProjectFields(ctx, owner, number, true)
+	if orgErr == nil {
+		return fields, nil
+	}
+	return nil, err
+}
+
+// Views returns the names of the views of a project, described by their layouts
+func (s *CompletionService) Views(ctx context.Context, projectRef string) ([]Completion, error) {
+	owner, number, err := ParseProjectReference(projectRef)
+	if err != nil {
+		return nil, err
+	}
+	project, err := NewProjectService(s.client).GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return nil, err
+	}
+	views, err := NewViewService(s.client).GetProjectViews(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	completions := make([]Completion, len(views))
+	for i := range views {
+		completions[i] = Completion{Value: views[i].Name, Description: FormatViewLayout(views[i].Layout)}
+	}
+	return completions, nil
+}
+
+// OwnerViews returns the IDs of the views of all open projects of owner, for
+// commands that take a view ID without a project
+func (s *CompletionService) OwnerViews(ctx context.Context, owner string, isOrg bool) ([]Completion, error) {
+	projects, err := s.listProjects(ctx, owner, isOrg)
+	if err != nil {
+		return nil, err
+	}
+
+	viewService := NewViewService(s.client)
+	var completions []Completion
+	for i := range projects {
+		if projects[i].Closed {
+			continue
+		}
+		views, err := viewService.GetProjectViews(ctx, projects[i].ID)
+		if err != nil {
+			return nil, err
+		}
+		for j := range views {
+			completions = append(completions, Completion{
+				Value:       views[j].ID,
+				Description: fmt.Sprintf("%s: %s", projects[i].Title, views[j].Name),
+			})
+		}
+	}
+	return completions, nil
+}
+
+// Categories returns the discussion category slugs of a repository
+func (s *CompletionService) Categories(ctx context.Context, repoRef string) ([]Completion, error) {
+	owner, repo, err := ParseRepositoryReference(repoRef)
+	if err != nil {
+		return nil, err
+	}
+	categories, err := NewDiscussionService(s.client).ListCategories(ctx, owner, repo)
+	if err != nil {
+		return nil, err
+	}
+
+	completions := make([]Completion, len(categories))
+	for i := range categories {
+		completions[i] = Completion{Value: categories[i].Slug, Description: categories[i].Name}
+	}
+	return completions, nil
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestCompletionCache(t *testing.T) {
+	cache := NewCompletionCache(t.TempDir(), time.Minute)
+	now := time.Now()
+	cache.now = func() time.Time { return now }
+
+	_, ok := cache.Load("projects")
+	assert.False(t, ok)
+
+	completions := []Completion{{Value: "octocat/1", Description: "Roadmap"}, {Value: "octocat/2"}}
+	require.NoError(t, cache.Store("projects", completions))
+	loaded, ok := cache.Load("projects")
+	require.True(t, ok)
+	assert.Equal(t, completions, loaded)
+
+	_, ok = cache.Load("fields")
+	assert.False(t, ok)
+
+	now = now.Add(2 * time.Minute)
+	_, ok = cache.Load("projects")
+	assert.False(t, ok)
+
+	require.NoError(t, os.WriteFile(cache.path("broken"), []byte("{"), 0o600))
+	now = time.Now()
+	_, ok = cache.Load("broken")
+	assert.False(t, ok)
+}
+
+func TestCompletionServiceAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+	store.AddField(project, "Points", "NUMBER")
+	store.AddField(project, "Sprint", "ITERATION")
+	store.AddView(project, "Board", "BOARD_LAYOUT")
+	store.AddProject(fake.DefaultViewer, "Archive").Closed = true
+	store.AddOrganization("acme")
+	store.AddProject("acme", "Platform")
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	store.AddDiscussionCategory(repo, "Q&A", true)
+
+	ctx := context.Background()
+	s := NewCompletionService(api.NewClient("ghp_fake"))
+	ref := fake.DefaultViewer + "/1"
+
+	t.Run("Completes open projects of users and organizations", func(t *testing.T) {
+		completions, err := s.Projects(ctx, fake.DefaultViewer, false)
+		require.NoError(t, err)
+		assert.Equal(t, []Completion{{Value: ref, Description: "Roadmap"}}, completions)
+
+		completions, err = s.Projects(ctx, "acme", false)
+		require.NoError(t, err)
+		assert.Equal(t, []Completion{{Value: "acme/1", Description: "Platform"}}, completions)
+	})
+
+	t.Run("Completes fields and options", func(t *testing.T) {
+		completions, err := s.Fields(ctx, ref, "Number", "Iteration")
+		require.NoError(t, err)
+		assert.Equal(t, []Completion{{Value: "Points", Description: "Number"}, {Value: "Sprint", Description: "Iteration"}}, completions)
+
+		completions, err = s.FieldOptions(ctx, ref, "status")
+		require.NoError(t, err)
+		assert.Equal(t, []Completion{{Value: "Todo"}, {Value: "In Progress"}, {Value: "Done"}}, completions)
+
+		_, err = s.FieldOptions(ctx, ref, "Priority")
+		assert.ErrorContains(t, err, "field not found: Priority")
+	})
+
+	t.Run("Completes views by name and ID", func(t *testing.T) {
+		completions, err := s.Views(ctx, ref)
+		require.NoError(t, err)
+		require.Len(t, completions, len(project.Views))
+		assert.Equal(t, "Board", completions[len(completions)-1].Value)
+
+		byID, err := s.OwnerViews(ctx, fake.DefaultViewer, false)
+		require.NoError(t, err)
+		require.Len(t, byID, len(project.Views))
+		assert.Equal(t, project.Views[len(project.Views)-1].ID, byID[len(byID)-1].Value)
+		assert.Equal(t, "Roadmap: Board", byID[len(byID)-1].Description)
+	})
+
+	t.Run("Completes discussion categories", func(t *testing.T) {
+		completions, err := s.Categories(ctx, fake.DefaultViewer+"/app")
+		require.NoError(t, err)
+		assert.Equal(t, []Completion{{Value: "q&a", Description: "Q&A"}}, completions)
+	})
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gql "github.com/shurcooL/graphql"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// ConversionTarget is the repository, labels and assignees of issues created from
+// draft issues
+type ConversionTarget struct {
+	RepositoryID string
+	Repository   string
+	LabelIDs     []string
+	AssigneeIDs  []string
+}
+
+// ResolveConversionTarget looks up the repository given as owner/repo and the IDs of
+// the labels and assignees to apply, so mistakes are reported before any draft is
+// converted
+func (s *ItemService) ResolveConversionTarget(ctx context.Context, repository string, labels, assignees []string) (*ConversionTarget, error) {
+	owner, name, ok := strings.Cut(repository, "/")
+	if !ok || owner == "" || name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("invalid repository format: %s (expected owner/repo)", repository)
+	}
+
+	var query graphql.RepositoryIDQuery
+	if err := s.client.Query(ctx, &query, graphql.BuildRepositoryIDVariables(owner, name)); err != nil {
+		return nil, fmt.Errorf("failed to get repository %s: %w", repository, err)
+	}
+	if query.Repository.ID == "" {
+		return nil, fmt.Errorf("repository %s not found", repository)
+	}
+
+	// Labels are looked up one by one, since repositories can have any number of them
+	target := &ConversionTarget{RepositoryID: query.Repository.ID, Repository: repository}
+	for _, label := range labels {
+		var labelQuery graphql.RepositoryLabelQuery
+		if err := s.client.Query(ctx, &labelQuery, graphql.BuildRepositoryLabelVariables(owner, name, label)); err != nil {
+			return nil, fmt.Errorf("failed to get label %s: %w", label, err)
+		}
+		if labelQuery.Repository.Label == nil {
+			return nil, fmt.Errorf("label %s not found in %s", label, repository)
+		}
+		target.LabelIDs = append(target.LabelIDs, labelQuery.Repository.Label.ID)
+	}
+
+	projectService := NewProjectService(s.client)
+	for _, assignee := range assignees {
+		id, err := projectService.GetOwnerID(ctx, strings.TrimPrefix(assignee, "@"))
+		if err != nil {
+			return nil, err
+		}
+		target.AssigneeIDs = append(target.AssigneeIDs, id)
+	}
+
+	return target, nil
+}
+
+// ConvertDraftIssue converts a draft issue item into an issue in the target
+// repository and applies the target's labels and assignees. The project item is
+// kept, so its field values stay as they are. When the issue was created but
+// labels or assignees could not be added, the converted item is returned with
+// the error.
+func (s *ItemService) ConvertDraftIssue(ctx context.Context, itemID string, target *ConversionTarget) (*graphql.ProjectV2Item, error) {
+	variables := graphql.BuildConvertDraftIssueVariables(&graphql.ConvertDraftIssueInput{
+		ItemID:       gql.ID(itemID),
+		RepositoryID: gql.ID(target.RepositoryID),
+	})
+
+	var mutation graphql.ConvertDraftIssueMutation
+	if err := s.client.Mutate(ctx, &mutation, variables); err != nil {
+		return nil, fmt.Errorf("failed to convert draft issue: %w", err)
+	}
+	item := &mutation.ConvertProjectV2DraftIssueItemToIssue.Item
+	issueID := item.Content.Issue.ID
+
+	if len(target.LabelIDs) > 0 {
+		var addLabels graphql.AddLabelsMutation
+		err := s.client.Mutate(ctx, &addLabels, graphql.BuildAddLabelsVariables(&graphql.AddLabelsInput{
+			LabelableID: gql.ID(issueID),
+			LabelIDs:    toIDs(target.LabelIDs),
+		}))
+		if err != nil {
+			return item, fmt.Errorf("converted, but failed to add labels: %w", err)
+		}
+	}
+
+	if len(target.AssigneeIDs) > 0 {
+		var addAssignees graphql.AddAssigneesMutation
+		err := s.client.Mutate(ctx, &addAssignees, graphql.BuildAddAssigneesVariables(&graphql.AddAssigneesInput{
+			AssignableID: gql.ID(issueID),
+			AssigneeIDs:  toIDs(target.AssigneeIDs),
+		}))
+		if err != nil {
+			return item, fmt.Errorf("converted, but failed to add assignees: %w", err)
+		}
+	}
+
+	return item, nil
+}
+
+// ConvertedDraftIssue is a draft issue that was, or with a dry run would be,
+// converted into an issue
+type ConvertedDraftIssue struct {
+	ItemID      string `json:"itemId"`
+	Title       string `json:"title"`
+	IssueURL    string `json:"issueUrl,omitempty"`
+	IssueNumber int    `json:"issueNumber,omitempty"`
+}
+
+// ConvertDraftIssuesResult represents the result of converting the draft issues
+// of a project
+type ConvertDraftIssuesResult struct {
+	Converted []ConvertedDraftIssue `json:"converted"`
+	Warnings  []string              `json:"warnings"`
+	// Failed is the number of drafts that could not be converted
+	Failed int `json:"failed"`
+}
+
+// ConvertDraftIssues converts every draft issue of a project that matches the
+// filter. A failed conversion is reported as a warning and does not stop the
+// others.
+func (s *ItemService) ConvertDraftIssues(ctx context.Context, projectID string, filter *ItemFilter, target *ConversionTarget, dryRun bool) (*ConvertDraftIssuesResult, error) {
+	items, err := s.ListProjectItems(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &ConvertDraftIssuesResult{}
+	for _, item := range FilterProjectItems(items, filter) {
+		if item.Content.TypeName != "DraftIssue" {
+			continue
+		}
+
+		converted := ConvertedDraftIssue{ItemID: item.ID, Title: item.Content.DraftIssue.Title}
+		if dryRun {
+			result.Converted = append(result.Converted, converted)
+			continue
+		}
+
+		issue, err := s.ConvertDraftIssue(ctx, item.ID, target)
+		if issue == nil {
+			result.Failed++
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %v", converted.Title, err))
+			continue
+		}
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %v", converted.Title, err))
+		}
+		converted.IssueNumber = issue.Content.Issue.Number
+		converted.IssueURL = issue.Content.Issue.URL
+		result.Converted = append(result.Converted, converted)
+	}
+
+	return result, nil
+}
+
+func toIDs(ids []string) []gql.ID {
+	result := make([]gql.ID, len(ids))
+	for i, id := range ids {
+		result[i] = gql.ID(id)
+	}
+	return result
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestConvertDraftIssuesAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	bug := store.AddLabel(repo, "bug", "d73a4a")
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+	status := project.Field("Status")
+	todo := store.AddDraftIssue(project, "Fix crash", "Steps to reproduce")
+	store.SetValue(todo, status, fake.Value{OptionID: status.Option("Todo").ID})
+	done := store.AddDraftIssue(project, "Write docs", "")
+	store.SetValue(done, status, fake.Value{OptionID: status.Option("Done").ID})
+
+	ctx := context.Background()
+	itemService := NewItemService(api.NewClient("ghp_fake"))
+
+	t.Run("Finds labels in repositories with many labels", func(t *testing.T) {
+		for i := 0; i < 150; i++ {
+			store.AddLabel(repo, fmt.Sprintf("area-%03d", i), "ededed")
+		}
+		target, err := itemService.ResolveConversionTarget(ctx, "octocat/app", []string{"area-140", "BUG"}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []string{repo.Labels[141].ID, bug.ID}, target.LabelIDs)
+	})
+
+	t.Run("Rejects unknown labels before converting", func(t *testing.T) {
+		_, err := itemService.ResolveConversionTarget(ctx, "octocat/app", []string{"feature"}, nil)
+		assert.ErrorContains(t, err, "label feature not found")
+		_, err = itemService.ResolveConversionTarget(ctx, "app", nil, nil)
+		assert.ErrorContains(t, err, "expected owner/repo")
+	})
+
+	target, err := itemService.ResolveConversionTarget(ctx, "octocat/app", []string{"Bug"}, []string{"@octocat"})
+	require.NoError(t, err)
+	filter, err := ParseItemFilter("status:todo")
+	require.NoError(t, err)
+
+	t.Run("Dry run converts nothing", func(t *testing.T) {
+		result, err := itemService.ConvertDraftIssues(ctx, project.ID, filter, target, true)
+		require.NoError(t, err)
+		require.Len(t, result.Converted, 1)
+		assert.Equal(t, "Fix crash", result.Converted[0].Title)
+		assert.NotNil(t, todo.Draft)
+	})
+
+	t.Run("Converts matching drafts and keeps their values", func(t *testing.T) {
+		result, err := itemService.ConvertDraftIssues(ctx, project.ID, filter, target, false)
+		require.NoError(t, err)
+		assert.Empty(t, result.Warnings)
+		require.Len(t, result.Converted, 1)
+		assert.Equal(t, todo.ID, result.Converted[0].ItemID)
+
+		issue := todo.Issue
+		require.NotNil(t, issue)
+		assert.Equal(t, result.Converted[0].IssueNumber, issue.Number)
+		assert.Equal(t, "Fix crash", issue.Title)
+		assert.Equal(t, "Steps to reproduce", issue.Body)
+		assert.Equal(t, []*fake.Label{bug}, issue.Labels)
+		require.Len(t, issue.Assignees, 1)
+		assert.Equal(t, fake.DefaultViewer, issue.Assignees[0].Login)
+		assert.Equal(t, status.Option("Todo").ID, todo.Values[status.ID].OptionID)
+		assert.NotNil(t, done.Draft)
+	})
+
+	t.Run("Reports items that are not drafts", func(t *testing.T) {
+		_, err := itemService.ConvertDraftIssue(ctx, todo.ID, target)
+		assert.Error(t, err)
+	})
+}
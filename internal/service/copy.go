@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gql "github.com/shurcooL/graphql"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// CopyProjectOptions represents options for copying a project
+type CopyProjectOptions struct {
+	SourceOwner string
+	// TargetOwner is the login of the user or organization owning the copy;
+	// defaults to the owner of the source project
+	TargetOwner string
+	// Title of the copy; defaults to the title of the source project
+	Title        string
+	SourceNumber int
+	// IncludeDraftIssues lets GitHub copy the draft issues of the project
+	IncludeDraftIssues bool
+	// IncludeItems adds the issues and pull requests of the source project to the copy
+	IncludeItems bool
+	// CopyFieldValues sets the field values of added items, mapping fields and
+	// options by name
+	CopyFieldValues bool
+	// LinkRepositories links the copy to the repositories of the source project
+	LinkRepositories bool
+}
+
+// CopyProjectResult represents the result of copying a project
+type CopyProjectResult struct {
+	Project            *graphql.ProjectV2
+	Warnings           []string
+	ItemsAdded         int
+	ValuesCopied       int
+	RepositoriesLinked int
+}
+
+// CopyProject copies a project with copyProjectV2, which copies fields, views,
+// workflows and optionally draft issues. Items, field values and repository links
+// are added afterwards when requested. Once the copy exists, failures to add an
+// item, value or link are reported as warnings so the new project is not lost.
+func (s *ProjectService) CopyProject(ctx context.Context, opts CopyProjectOptions) (*CopyProjectResult, error) {
+	source, err := s.GetProjectWithOwnerDetection(ctx, opts.SourceOwner, opts.SourceNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	ownerID := source.Owner.ID
+	if opts.TargetOwner != "" && !strings.EqualFold(opts.TargetOwner, source.Owner.Login) {
+		ownerID, err = s.GetOwnerID(ctx, opts.TargetOwner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	title := opts.Title
+	if title == "" {
+		title = source.Title
+	}
+
+	variables := graphql.BuildCopyProjectVariables(&graphql.CopyProjectInput{
+		ProjectID:          gql.ID(source.ID),
+		OwnerID:            gql.ID(ownerID),
+		Title:              gql.String(title),
+		IncludeDraftIssues: gql.Boolean(opts.IncludeDraftIssues),
+	})
+
+	var mutation graphql.CopyProjectMutation
+	if err := s.client.Mutate(ctx, &mutation, variables); err != nil {
+		return nil, fmt.Errorf("failed to copy project: %w", err)
+	}
+
+	result := &CopyProjectResult{Project: &mutation.CopyProjectV2.ProjectV2}
+
+	if opts.LinkRepositories {
+		if err := s.copyRepositoryLinks(ctx, source.ID, result); err != nil {
+			return result, err
+		}
+	}
+
+	if opts.IncludeItems {
+		if err := s.copyProjectItems(ctx, source.ID, opts.CopyFieldValues, result); err != nil {
+			return result, err
+		}
+	}
+
+	return result, nil
+}
+
+func (s *ProjectService) copyRepositoryLinks(ctx context.Context, sourceID string, result *CopyProjectResult) error {
+	settings, err := s.GetProjectSettings(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	for _, repo := range settings.Repositories.Nodes {
+		if err := s.LinkProjectToRepository(ctx, result.Project.ID, repo.NameWithOwner); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("repository %s: %v", repo.NameWithOwner, err))
+			continue
+		}
+		result.RepositoriesLinked++
+	}
+	return nil
+}
+
+func (s *ProjectService) copyProjectItems(ctx context.Context, sourceID string, copyValues bool, result *CopyProjectResult) error {
+	items, err := NewItemService(s.client).ListProjectItems(ctx, sourceID)
+	if err != nil {
+		return err
+	}
+
+	target := result.Project
+	now := time.Now()
+
+	for i := range items {
+		item := &items[i]
+
+		var contentID, title string
+		switch item.Content.TypeName {
+		case "Issue":
+			contentID, title = item.Content.Issue.ID, item.Content.Issue.Title
+		case "PullRequest":
+			contentID, title = item.Content.PullRequest.ID, item.Content.PullRequest.Title
+		default:
+			// Draft issues are copied by GitHub with includeDraftIssues
+			continue
+		}
+
+		added, err := s.AddItem(ctx, AddItemInput{ProjectID: target.ID, ContentID: contentID})
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("item %s: %v", title, err))
+			continue
+		}
+		result.ItemsAdded++
+
+		if !copyValues {
+			continue
+		}
+		for j := range item.FieldValues.Nodes {
+			value := &item.FieldValues.Nodes[j]
+			field := settableField(target, value.Field.Name)
+			raw := exportFieldValue(value)
+			if field == nil || raw == nil {
+				continue
+			}
+
+			fieldValue, err := BuildFieldValue(field, formatExportedValue(raw), now)
+			if err == nil {
+				_, err = s.UpdateItemField(ctx, UpdateItemFieldInput{
+					ProjectID: target.ID,
+					ItemID:    added.ID,
+					FieldID:   field.ID,
+					Value:     fieldValue,
+				})
+			}
+			if err != nil {
+				result.Warnings = append(result.Warnings, fmt.Sprintf("item %s, field %s: %v", title, field.Name, err))
+				continue
+			}
+			result.ValuesCopied++
+		}
+	}
+	return nil
+}
+
+// settableField returns the field of the project with the given name when its
+// values can be set, or nil
+func settableField(project *graphql.ProjectV2, name string) *graphql.ProjectV2Field {
+	for i := range project.Fields.Nodes {
+		field := &project.Fields.Nodes[i]
+		if !strings.EqualFold(field.Name, name) {
+			continue
+		}
+		switch field.DataType {
+		case graphql.ProjectV2FieldDataTypeText,
+			graphql.ProjectV2FieldDataTypeNumber,
+			graphql.ProjectV2FieldDataTypeDate,
+			graphql.ProjectV2FieldDataTypeSingleSelect,
+			graphql.ProjectV2FieldDataTypeIteration:
+			return field
+		}
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestCopyProjectAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	org := store.AddOrganization("acme")
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	issue := store.AddIssue(repo, "Fix login")
+
+	source := store.AddProject(fake.DefaultViewer, "Roadmap")
+	source.Repositories = append(source.Repositories, repo)
+	status := source.Field("Status")
+	sprint := store.AddField(source, "Sprint", "ITERATION")
+	notes := store.AddField(source, "Notes", "TEXT")
+	view := source.Views[0]
+	view.GroupBy = []fake.ViewSetting{{Field: status, Direction: "ASC"}}
+
+	item := store.AddItem(source, issue)
+	store.SetValue(item, status, fake.Value{OptionID: status.Option("Done").ID})
+	store.SetValue(item, sprint, fake.Value{IterationID: sprint.Iterations[1].ID})
+	store.SetValue(item, notes, fake.Value{Text: "check SSO"})
+	draft := store.AddDraftIssue(source, "Write docs", "")
+	store.SetValue(draft, status, fake.Value{OptionID: status.Option("Todo").ID})
+
+	ctx := context.Background()
+	projectService := NewProjectService(api.NewClient("ghp_fake"))
+
+	t.Run("Copies structure only by default", func(t *testing.T) {
+		result, err := projectService.CopyProject(ctx, CopyProjectOptions{
+			SourceOwner:  fake.DefaultViewer,
+			SourceNumber: source.Number,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Roadmap", result.Project.Title)
+		assert.Equal(t, fake.DefaultViewer, result.Project.Owner.Login)
+
+		copied := store.Project(fake.DefaultViewer, result.Project.Number)
+		require.NotNil(t, copied)
+		assert.Empty(t, copied.Items)
+		assert.Empty(t, copied.Repositories)
+		require.NotNil(t, copied.Field("Sprint"))
+		assert.Len(t, copied.Field("Sprint").Iterations, 3)
+		require.Len(t, copied.Views, 1)
+		assert.Equal(t, copied.Field("Status"), copied.Views[0].GroupBy[0].Field)
+	})
+
+	t.Run("Copies items, values and links to another owner", func(t *testing.T) {
+		result, err := projectService.CopyProject(ctx, CopyProjectOptions{
+			SourceOwner:        fake.DefaultViewer,
+			SourceNumber:       source.Number,
+			TargetOwner:        "acme",
+			Title:              "Acme Roadmap",
+			IncludeDraftIssues: true,
+			IncludeItems:       true,
+			CopyFieldValues:    true,
+			LinkRepositories:   true,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Warnings)
+		assert.Equal(t, 1, result.ItemsAdded)
+		assert.Equal(t, 3, result.ValuesCopied)
+		assert.Equal(t, 1, result.RepositoriesLinked)
+
+		copied := org.Projects[0]
+		assert.Equal(t, "Acme Roadmap", copied.Title)
+		require.Len(t, copied.Items, 2)
+
+		values := map[string]string{}
+		for _, item := range copied.Items {
+			for _, field := range copied.Fields {
+				v, ok := item.Values[field.ID]
+				if !ok {
+					continue
+				}
+				switch {
+				case v.OptionID != "":
+					values[item.Title()+"/"+field.Name] = field.Options[optionIndex(field, v.OptionID)].Name
+				case v.IterationID != "":
+					values[item.Title()+"/"+field.Name] = iterationTitle(field, v.IterationID)
+				default:
+					values[item.Title()+"/"+field.Name] = v.Text
+				}
+			}
+		}
+		assert.Equal(t, map[string]string{
+			"Fix login/Status":  "Done",
+			"Fix login/Sprint":  "Iteration 2",
+			"Fix login/Notes":   "check SSO",
+			"Write docs/Status": "Todo",
+		}, values)
+	})
+
+	t.Run("Copies items beyond the first page", func(t *testing.T) {
+		large := store.AddProject(fake.DefaultViewer, "Backlog")
+		for i := 0; i < 120; i++ {
+			store.AddItem(large, store.AddIssue(repo, fmt.Sprintf("Issue %03d", i)))
+		}
+
+		result, err := projectService.CopyProject(ctx, CopyProjectOptions{
+			SourceOwner:  fake.DefaultViewer,
+			SourceNumber: large.Number,
+			IncludeItems: true,
+		})
+		require.NoError(t, err)
+		assert.Empty(t, result.Warnings)
+		assert.Equal(t, 120, result.ItemsAdded)
+		assert.Len(t, store.Project(fake.DefaultViewer, result.Project.Number).Items, 120)
+	})
+
+	t.Run("Fails for an unknown owner", func(t *testing.T) {
+		_, err := projectService.CopyProject(ctx, CopyProjectOptions{
+			SourceOwner:  fake.DefaultViewer,
+			SourceNumber: source.Number,
+			TargetOwner:  "nobody",
+		})
+		assert.ErrorContains(t, err, "nobody not found")
+	})
+}
+
+func optionIndex(field *fake.Field, id string) int {
+	for i, option := range field.Options {
+		if option.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+func iterationTitle(field *fake.Field, id string) string {
+	for _, iteration := range field.Iterations {
+		if iteration.ID == id {
+			return iteration.Title
+		}
+	}
+	return ""
+}
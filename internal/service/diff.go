@@ -0,0 +1,349 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// Kinds of project differences
+const (
+	DiffKindField  = "field"
+	DiffKindOption = "option"
+	DiffKindView   = "view"
+	DiffKindItem   = "item"
+)
+
+// Project differences, described from the source project towards the target
+const (
+	DiffOnlyInSource = "only_in_source"
+	DiffOnlyInTarget = "only_in_target"
+	DiffRenamed      = "renamed"
+	DiffChanged      = "changed"
+)
+
+// ProjectDiffOptions represents options for comparing projects
+type ProjectDiffOptions struct {
+	// Items compares item membership and field values
+	Items bool
+}
+
+// ProjectDiff lists the differences between two projects
+type ProjectDiff struct {
+	Source      string      `json:"source"`
+	Target      string      `json:"target"`
+	Differences []DiffEntry `json:"differences"`
+}
+
+// DiffEntry is a single difference between two projects
+type DiffEntry struct {
+	Kind    string   `json:"kind"`
+	Name    string   `json:"name"`
+	Change  string   `json:"change"`
+	Details []string `json:"details,omitempty"`
+}
+
+// DiffProjects compares two projects read from the API or an export file. Fields
+// and views are matched by name; a field missing on one side is reported as
+// renamed when exactly one unmatched field of the same type and options exists on
+// the other side. Items are matched by URL, or by title for draft issues.
+func DiffProjects(source, target *ExportedProject, opts ProjectDiffOptions) *ProjectDiff {
+	diff := &ProjectDiff{
+		Source:      describeExport(source),
+		Target:      describeExport(target),
+		Differences: []DiffEntry{},
+	}
+
+	diff.diffFields(source.Fields, target.Fields)
+	diff.diffViews(source.Views, target.Views)
+	if opts.Items {
+		diff.diffItems(source, target)
+	}
+
+	return diff
+}
+
+func (d *ProjectDiff) add(kind, name, change string, details ...string) {
+	d.Differences = append(d.Differences, DiffEntry{Kind: kind, Name: name, Change: change, Details: details})
+}
+
+func (d *ProjectDiff) diffFields(source, target []ExportedField) {
+	matched, matches := matchByName(exportedFieldNames(target), exportedFieldNames(source), nil)
+
+	// Fields missing on one side may have been renamed on the other
+	for i := range source {
+		if matches[i] >= 0 {
+			continue
+		}
+		candidate := -1
+		for j := range target {
+			if matched[j] || !sameFieldShape(&source[i], &target[j]) {
+				continue
+			}
+			if candidate >= 0 {
+				candidate = -1
+				break
+			}
+			candidate = j
+		}
+		if candidate >= 0 && countShapeMatches(source, matches, &target[candidate]) == 1 {
+			matches[i] = candidate
+			matched[candidate] = true
+			d.add(DiffKindField, source[i].Name, DiffRenamed, describeChange("name", source[i].Name, target[candidate].Name))
+		}
+	}
+
+	for i := range source {
+		if matches[i] < 0 {
+			d.add(DiffKindField, source[i].Name, DiffOnlyInSource, "type: "+formatExportedType(source[i].DataType))
+			continue
+		}
+		d.diffField(&source[i], &target[matches[i]])
+	}
+	for j := range target {
+		if !matched[j] {
+			d.add(DiffKindField, target[j].Name, DiffOnlyInTarget, "type: "+formatExportedType(target[j].DataType))
+		}
+	}
+}
+
+func (d *ProjectDiff) diffField(source, target *ExportedField) {
+	if source.DataType != target.DataType {
+		d.add(DiffKindField, target.Name, DiffChanged, fmt.Sprintf("type: %s → %s",
+			formatExportedType(source.DataType), formatExportedType(target.DataType)))
+		return
+	}
+
+	names := func(options []ExportedOption) []string {
+		result := make([]string, len(options))
+		for i, option := range options {
+			result[i] = option.Name
+		}
+		return result
+	}
+	matched, matches := matchByName(names(target.Options), names(source.Options), nil)
+
+	for i, option := range source.Options {
+		name := target.Name + "/" + option.Name
+		if matches[i] < 0 {
+			d.add(DiffKindOption, name, DiffOnlyInSource)
+			continue
+		}
+		other := target.Options[matches[i]]
+		var details []string
+		if NormalizeColor(option.Color) != NormalizeColor(other.Color) {
+			details = append(details, fmt.Sprintf("color: %s → %s", FormatColor(option.Color), FormatColor(other.Color)))
+		}
+		if option.Description != other.Description {
+			details = append(details, describeChange("description", option.Description, other.Description))
+		}
+		if option.Name != other.Name {
+			details = append(details, describeChange("name", option.Name, other.Name))
+		}
+		if len(details) > 0 {
+			d.add(DiffKindOption, name, DiffChanged, details...)
+		}
+	}
+	for j, option := range target.Options {
+		if !matched[j] {
+			d.add(DiffKindOption, target.Name+"/"+option.Name, DiffOnlyInTarget)
+		}
+	}
+}
+
+func (d *ProjectDiff) diffViews(source, target []ExportedView) {
+	names := func(views []ExportedView) []string {
+		result := make([]string, len(views))
+		for i, view := range views {
+			result[i] = view.Name
+		}
+		return result
+	}
+	matched, matches := matchByName(names(target), names(source), nil)
+
+	for i := range source {
+		view := &source[i]
+		if matches[i] < 0 {
+			d.add(DiffKindView, view.Name, DiffOnlyInSource, "layout: "+formatExportedLayout(view.Layout))
+			continue
+		}
+		other := &target[matches[i]]
+
+		var details []string
+		if formatExportedLayout(view.Layout) != formatExportedLayout(other.Layout) {
+			details = append(details, fmt.Sprintf("layout: %s → %s",
+				formatExportedLayout(view.Layout), formatExportedLayout(other.Layout)))
+		}
+		if view.Filter != other.Filter {
+			details = append(details, describeChange("filter", view.Filter, other.Filter))
+		}
+		if from, to := describeExportedOrder(view.SortBy), describeExportedOrder(other.SortBy); from != to {
+			details = append(details, fmt.Sprintf("sort: %s → %s", from, to))
+		}
+		if from, to := describeExportedOrder(view.GroupBy), describeExportedOrder(other.GroupBy); from != to {
+			details = append(details, fmt.Sprintf("group: %s → %s", from, to))
+		}
+		if len(details) > 0 {
+			d.add(DiffKindView, other.Name, DiffChanged, details...)
+		}
+	}
+	for j := range target {
+		if !matched[j] {
+			d.add(DiffKindView, target[j].Name, DiffOnlyInTarget, "layout: "+formatExportedLayout(target[j].Layout))
+		}
+	}
+}
+
+func (d *ProjectDiff) diffItems(source, target *ExportedProject) {
+	targetItems := make(map[string]*ExportedItem, len(target.Items))
+	for i := range target.Items {
+		targetItems[exportedItemKey(&target.Items[i])] = &target.Items[i]
+	}
+
+	// Only fields present in both projects are compared
+	shared := map[string]bool{}
+	_, matches := matchByName(exportedFieldNames(target.Fields), exportedFieldNames(source.Fields), nil)
+	for i, field := range source.Fields {
+		if matches[i] >= 0 {
+			shared[field.Name] = true
+		}
+	}
+
+	seen := map[string]bool{}
+	for i := range source.Items {
+		item := &source.Items[i]
+		key := exportedItemKey(item)
+		seen[key] = true
+
+		other := targetItems[key]
+		if other == nil {
+			d.add(DiffKindItem, item.Title, DiffOnlyInSource)
+			continue
+		}
+
+		var details []string
+		for _, name := range sortedFieldNames(item.Fields, other.Fields) {
+			if !shared[name] {
+				continue
+			}
+			from, to := formatExportedValue(item.Fields[name]), formatExportedValue(other.Fields[name])
+			if from != to {
+				details = append(details, describeChange(name, from, to))
+			}
+		}
+		if len(details) > 0 {
+			d.add(DiffKindItem, item.Title, DiffChanged, details...)
+		}
+	}
+	for i := range target.Items {
+		item := &target.Items[i]
+		if !seen[exportedItemKey(item)] {
+			d.add(DiffKindItem, item.Title, DiffOnlyInTarget)
+		}
+	}
+}
+
+// sameFieldShape reports whether two fields have the same type and option names
+func sameFieldShape(a, b *ExportedField) bool {
+	if a.DataType != b.DataType || len(a.Options) != len(b.Options) {
+		return false
+	}
+	names := map[string]bool{}
+	for _, option := range a.Options {
+		names[strings.ToLower(option.Name)] = true
+	}
+	for _, option := range b.Options {
+		if !names[strings.ToLower(option.Name)] {
+			return false
+		}
+	}
+	return true
+}
+
+// countShapeMatches counts the unmatched source fields with the same shape as field
+func countShapeMatches(source []ExportedField, matches []int, field *ExportedField) int {
+	count := 0
+	for i := range source {
+		if matches[i] < 0 && sameFieldShape(&source[i], field) {
+			count++
+		}
+	}
+	return count
+}
+
+func exportedFieldNames(fields []ExportedField) []string {
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = field.Name
+	}
+	return names
+}
+
+func exportedItemKey(item *ExportedItem) string {
+	if item.URL != nil && *item.URL != "" {
+		return *item.URL
+	}
+	return "draft:" + strings.ToLower(item.Title)
+}
+
+func sortedFieldNames(a, b map[string]interface{}) []string {
+	names := make([]string, 0, len(a)+len(b))
+	seen := map[string]bool{}
+	for _, values := range []map[string]interface{}{a, b} {
+		for name := range values {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+func formatExportedValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case float64:
+		return fmt.Sprintf("%g", v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func formatExportedType(dataType string) string {
+	return FormatFieldDataType(graphql.ProjectV2FieldDataType(strings.ToUpper(dataType)))
+}
+
+func formatExportedLayout(layout string) string {
+	validated, err := ValidateViewLayout(layout)
+	if err != nil {
+		return layout
+	}
+	return FormatViewLayout(validated)
+}
+
+func describeExportedOrder(orders []ExportedViewOrder) string {
+	if len(orders) == 0 {
+		return "none"
+	}
+	parts := make([]string, len(orders))
+	for i, order := range orders {
+		direction, err := ValidateSortDirection(order.Direction)
+		if err != nil {
+			direction = graphql.ProjectV2ViewSortDirectionASC
+		}
+		parts[i] = describeOrder(order.Field, direction)
+	}
+	return strings.Join(parts, ", ")
+}
+
+func describeExport(export *ExportedProject) string {
+	if export.Project.Owner != "" && export.Project.Number > 0 {
+		return fmt.Sprintf("%s (%s)", FormatProjectReference(export.Project.Owner, export.Project.Number), export.Project.Title)
+	}
+	return export.Project.Title
+}
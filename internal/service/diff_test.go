@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func differences(diff *ProjectDiff) []string {
+	result := make([]string, len(diff.Differences))
+	for i, entry := range diff.Differences {
+		result[i] = entry.Change + " " + entry.Kind + " " + entry.Name
+	}
+	return result
+}
+
+func TestDiffProjects(t *testing.T) {
+	status := func(doneColor string) ExportedField {
+		return ExportedField{Name: "Status", DataType: "SINGLE_SELECT", Options: []ExportedOption{
+			{Name: "Todo", Color: "GRAY"},
+			{Name: "Done", Color: doneColor},
+		}}
+	}
+
+	t.Run("Identical projects have no differences", func(t *testing.T) {
+		project := &ExportedProject{
+			Fields: []ExportedField{status("GREEN")},
+			Views:  []ExportedView{{Name: "Board", Layout: "BOARD_LAYOUT"}},
+		}
+
+		diff := DiffProjects(project, project, ProjectDiffOptions{Items: true})
+		assert.Empty(t, diff.Differences)
+	})
+
+	t.Run("Reports fields, options and views", func(t *testing.T) {
+		source := &ExportedProject{
+			Project: ExportedProjectData{Title: "Team A", Owner: "octocat", Number: 1},
+			Fields: []ExportedField{
+				status("GREEN"),
+				{Name: "Points", DataType: "NUMBER"},
+				{Name: "Notes", DataType: "TEXT"},
+			},
+			Views: []ExportedView{
+				{Name: "Board", Layout: "BOARD_LAYOUT", GroupBy: []ExportedViewOrder{{Field: "Status"}}},
+				{Name: "Backlog", Layout: "TABLE_LAYOUT"},
+			},
+		}
+		target := &ExportedProject{
+			Project: ExportedProjectData{Title: "Team B", Owner: "octocat", Number: 2},
+			Fields: []ExportedField{
+				status("PURPLE"),
+				{Name: "Estimate", DataType: "NUMBER"},
+				{Name: "Due", DataType: "DATE"},
+			},
+			Views: []ExportedView{
+				{Name: "Board", Layout: "TABLE_VIEW", Filter: "is:open"},
+			},
+		}
+
+		diff := DiffProjects(source, target, ProjectDiffOptions{})
+		assert.Equal(t, "octocat/1 (Team A)", diff.Source)
+		assert.Equal(t, []string{
+			"renamed field Points",
+			"changed option Status/Done",
+			"only_in_source field Notes",
+			"only_in_target field Due",
+			"changed view Board",
+			"only_in_source view Backlog",
+		}, differences(diff))
+		assert.Equal(t, []string{`name: "Points" → "Estimate"`}, diff.Differences[0].Details)
+		assert.Equal(t, []string{"color: Green → Purple"}, diff.Differences[1].Details)
+		assert.Equal(t, []string{
+			"layout: Board → Table",
+			`filter: "" → "is:open"`,
+			"group: Status (ascending) → none",
+		}, diff.Differences[4].Details)
+	})
+
+	t.Run("Compares items only when asked", func(t *testing.T) {
+		url := "https://github.com/octocat/app/issues/1"
+		source := &ExportedProject{
+			Fields: []ExportedField{status("GREEN")},
+			Items: []ExportedItem{
+				{Title: "Fix login", URL: &url, Fields: map[string]interface{}{"Status": "Todo"}},
+				{Title: "Draft idea"},
+			},
+		}
+		target := &ExportedProject{
+			Fields: []ExportedField{status("GREEN")},
+			Items: []ExportedItem{
+				{Title: "Fix login", URL: &url, Fields: map[string]interface{}{"Status": "Done", "Size": "L"}},
+			},
+		}
+
+		assert.Empty(t, DiffProjects(source, target, ProjectDiffOptions{}).Differences)
+
+		diff := DiffProjects(source, target, ProjectDiffOptions{Items: true})
+		assert.Equal(t, []string{"changed item Fix login", "only_in_source item Draft idea"}, differences(diff))
+		assert.Equal(t, []string{`Status: "Todo" → "Done"`}, diff.Differences[0].Details)
+	})
+}
+
+func TestDiffProjectsAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	issue := store.AddIssue(repo, "Fix login")
+
+	first := store.AddProject(fake.DefaultViewer, "First")
+	second := store.AddProject(fake.DefaultViewer, "Second")
+	store.AddField(first, "Size", "SINGLE_SELECT", "S", "M", "L")
+	store.AddField(second, "Size", "SINGLE_SELECT", "S", "M")
+	store.AddItem(first, issue)
+	store.AddItem(second, issue)
+	store.AddDraftIssue(second, "Only here", "")
+
+	ctx := context.Background()
+	projectService := NewProjectService(api.NewClient("ghp_fake"))
+
+	export := func(project *fake.Project) *ExportedProject {
+		exported, err := projectService.BuildProjectExport(ctx, &ProjectExportData{
+			ProjectID:     FormatProjectReference(fake.DefaultViewer, project.Number),
+			IncludeFields: true,
+			IncludeViews:  true,
+			IncludeItems:  true,
+		})
+		require.NoError(t, err)
+		return exported
+	}
+
+	source, target := export(first), export(second)
+	require.Len(t, source.Items, 1)
+	assert.NotEmpty(t, source.Views)
+
+	diff := DiffProjects(source, target, ProjectDiffOptions{Items: true})
+	assert.Equal(t, []string{"only_in_source option Size/L", "only_in_target item Only here"}, differences(diff))
+
+	// Items beyond the first page are exported and compared too
+	for i := 0; i < 120; i++ {
+		store.AddDraftIssue(first, fmt.Sprintf("Draft %03d", i), "")
+	}
+	source = export(first)
+	assert.Len(t, source.Items, 121)
+	diff = DiffProjects(source, target, ProjectDiffOptions{Items: true})
+	assert.Contains(t, differences(diff), "only_in_source item Draft 119")
+
+	// So are fields beyond the first page
+	for i := 0; i < 25; i++ {
+		store.AddField(first, fmt.Sprintf("Extra %02d", i), "TEXT")
+		store.AddField(second, fmt.Sprintf("Extra %02d", i), "TEXT")
+	}
+	source, target = export(first), export(second)
+	assert.Len(t, source.Fields, len(first.Fields))
+	diff = DiffProjects(source, target, ProjectDiffOptions{})
+	assert.Equal(t, []string{"only_in_source option Size/L"}, differences(diff))
+}
@@ -3,7 +3,9 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	gql "github.com/shurcooL/graphql"
 
@@ -11,6 +13,9 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 )
 
+// projectFieldsPageSize is the number of project fields fetched per request
+const projectFieldsPageSize = 50
+
 // FieldService handles field-related operations
 type FieldService struct {
 	client *api.Client
@@ -46,13 +51,19 @@ type CreateFieldInput struct {
 	ProjectID           string
 	Name                string
 	DataType            graphql.ProjectV2FieldDataType
+	IterationStart      time.Time
 	SingleSelectOptions []string
+	Options             []FieldOptionInfo
 	Duration            string
+	IterationCount      int
 }
 
 // UpdateFieldInput represents input for updating a field
 type UpdateFieldInput struct {
-	Name    *string
+	Name *string
+	// Options replace the options of a single select field when not nil; options
+	// with an ID keep it and the items that have them
+	Options []FieldOptionInfo
 	FieldID string
 }
 
@@ -102,6 +113,31 @@ func (s *FieldService) CreateField(ctx context.Context, input CreateFieldInput)
 		gqlInput.SingleSelectOptions = options
 	}
 
+	// Options with colors and descriptions take precedence over plain option names
+	if input.DataType == graphql.ProjectV2FieldDataTypeSingleSelect && len(input.Options) > 0 {
+		gqlInput.SingleSelectOptions = singleSelectOptionInputs(input.Options)
+	}
+
+	// Iteration fields start with a few iterations of the requested duration
+	if input.DataType == graphql.ProjectV2FieldDataTypeIteration {
+		duration := 0
+		if input.Duration != "" {
+			var err error
+			if duration, err = ParseIterationDuration(input.Duration); err != nil {
+				return nil, err
+			}
+		}
+		start := input.IterationStart
+		if start.IsZero() {
+			start = time.Now()
+		}
+		config, err := NewIterationConfiguration(start, duration, input.IterationCount)
+		if err != nil {
+			return nil, err
+		}
+		gqlInput.IterationConfiguration = config
+	}
+
 	variables := graphql.BuildCreateFieldVariables(gqlInput)
 
 	var mutation graphql.CreateFieldMutation
@@ -122,6 +158,9 @@ func (s *FieldService) UpdateField(ctx context.Context, input UpdateFieldInput)
 		name := gql.String(*input.Name)
 		gqlInput.Name = &name
 	}
+	if input.Options != nil {
+		gqlInput.SingleSelectOptions = singleSelectOptionInputs(input.Options)
+	}
 
 	variables := graphql.BuildUpdateFieldVariables(gqlInput)
 
@@ -134,6 +173,31 @@ func (s *FieldService) UpdateField(ctx context.Context, input UpdateFieldInput)
 	return &mutation.UpdateProjectV2Field.ProjectV2Field, nil
 }
 
+// singleSelectOptionInputs converts options to option inputs; options without a
+// color are gray and options with an ID update the existing option
+func singleSelectOptionInputs(options []FieldOptionInfo) []graphql.SingleSelectOption {
+	inputs := make([]graphql.SingleSelectOption, len(options))
+	for i, opt := range options {
+		color := "GRAY"
+		if opt.Color != "" {
+			color = NormalizeColor(opt.Color)
+		}
+		inputs[i] = graphql.SingleSelectOption{
+			Name:  gql.String(opt.Name),
+			Color: gql.String(color),
+		}
+		if opt.ID != "" {
+			id := gql.ID(opt.ID)
+			inputs[i].ID = &id
+		}
+		if opt.Description != nil {
+			desc := gql.String(*opt.Description)
+			inputs[i].Description = &desc
+		}
+	}
+	return inputs
+}
+
 // DeleteField deletes a project field
 func (s *FieldService) DeleteField(ctx context.Context, input DeleteFieldInput) error {
 	variables := graphql.BuildDeleteFieldVariables(&graphql.DeleteFieldInput{
@@ -222,6 +286,28 @@ func (s *FieldService) DeleteFieldOption(ctx context.Context, input DeleteFieldO
 	return nil
 }
 
+// ListProjectFields returns every field of a project, fetching as many pages as
+// needed. The fields of a project query stop after the first 20, and built-in
+// fields take about half of those.
+func (s *FieldService) ListProjectFields(ctx context.Context, projectID string) ([]graphql.ProjectV2Field, error) {
+	var fields []graphql.ProjectV2Field
+	var after *string
+
+	for {
+		var query graphql.ListProjectFieldsQuery
+		if err := s.client.Query(ctx, &query, graphql.BuildListProjectFieldsVariables(projectID, projectFieldsPageSize, after)); err != nil {
+			return nil, fmt.Errorf("failed to list project fields: %w", err)
+		}
+
+		page := query.Node.ProjectV2.Fields
+		fields = append(fields, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return fields, nil
+		}
+		after = &page.PageInfo.EndCursor
+	}
+}
+
 // GetProjectFields gets all fields for a project
 func (s *FieldService) GetProjectFields(ctx context.Context, owner string, number int, isOrg bool) ([]FieldInfo, error) {
 	// Get project first to get fields
@@ -233,8 +319,8 @@ func (s *FieldService) GetProjectFields(ctx context.Context, owner string, numbe
 
 	fields := make([]FieldInfo, len(project.Fields.Nodes))
 	for i, field := range project.Fields.Nodes {
-		options := make([]FieldOptionInfo, len(field.Options.Nodes))
-		for j, option := range field.Options.Nodes {
+		options := make([]FieldOptionInfo, len(field.SingleSelect.Options))
+		for j, option := range field.SingleSelect.Options {
 			options[j] = FieldOptionInfo{
 				ID:          option.ID,
 				Name:        option.Name,
@@ -256,6 +342,46 @@ func (s *FieldService) GetProjectFields(ctx context.Context, owner string, numbe
 	return fields, nil
 }
 
+// BuildFieldValue converts a value given on the command line into the field value input
+// for the field's data type. Single select values are option names or IDs; iteration
+// values are iteration titles, IDs or @current, @next and @previous relative to now.
+func BuildFieldValue(field *graphql.ProjectV2Field, value string, now time.Time) (map[string]interface{}, error) {
+	switch field.DataType {
+	case graphql.ProjectV2FieldDataTypeText:
+		return map[string]interface{}{"text": value}, nil
+	case graphql.ProjectV2FieldDataTypeNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("field %s requires a number, got %q", field.Name, value)
+		}
+		return map[string]interface{}{"number": number}, nil
+	case graphql.ProjectV2FieldDataTypeDate:
+		if _, err := ParseDate(value); err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		return map[string]interface{}{"date": value}, nil
+	case graphql.ProjectV2FieldDataTypeSingleSelect:
+		for _, option := range field.SingleSelect.Options {
+			if option.ID == value || strings.EqualFold(option.Name, value) {
+				return map[string]interface{}{"singleSelectOptionId": option.ID}, nil
+			}
+		}
+		return nil, fmt.Errorf("option %q not found in field %s", value, field.Name)
+	case graphql.ProjectV2FieldDataTypeIteration:
+		iterations, err := IterationsFromField(field)
+		if err != nil {
+			return nil, err
+		}
+		iteration, err := ResolveIteration(iterations, value, now)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %w", field.Name, err)
+		}
+		return map[string]interface{}{"iterationId": iteration.ID}, nil
+	default:
+		return nil, fmt.Errorf("field %s of type %s cannot be set directly", field.Name, FormatFieldDataType(field.DataType))
+	}
+}
+
 // ValidateFieldName validates a field name
 func ValidateFieldName(name string) error {
 	if strings.TrimSpace(name) == "" {
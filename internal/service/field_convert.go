@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// FieldConversionInput represents input for converting a field to another data type
+type FieldConversionInput struct {
+	Project *graphql.ProjectV2
+	Field   *graphql.ProjectV2Field
+	// Mapping replaces old values, compared case-insensitively, before they are
+	// converted; mapping a value to "" leaves the items with it empty
+	Mapping  map[string]string
+	DataType graphql.ProjectV2FieldDataType
+	// Name is the name of the new field; it defaults to the old name followed by
+	// the new type
+	Name string
+	// Duration is the iteration length of a new iteration field, e.g. 2w
+	Duration string
+	// Replace deletes the old field and gives its name to the new one, unless
+	// some values could not be converted
+	Replace bool
+	DryRun  bool
+}
+
+// FieldConversionFailure is an item value that could not be converted
+type FieldConversionFailure struct {
+	ItemID string `json:"itemId"`
+	Title  string `json:"title"`
+	Value  string `json:"value"`
+	Reason string `json:"reason"`
+}
+
+// FieldConversionResult represents the result of converting a field
+type FieldConversionResult struct {
+	FieldID  string                   `json:"fieldId,omitempty"`
+	Name     string                   `json:"name"`
+	DataType string                   `json:"dataType"`
+	Options  []string                 `json:"options,omitempty"`
+	Failures []FieldConversionFailure `json:"failures"`
+	// Converted is the number of items whose value was, or with a dry run would
+	// be, copied to the new field
+	Converted int  `json:"converted"`
+	Replaced  bool `json:"replaced"`
+}
+
+// convertedValue is an item's value converted to the text form of a value of the
+// new field
+type convertedValue struct {
+	item  *graphql.ProjectV2Item
+	old   string
+	value string
+}
+
+// ConvertField converts a field to another data type. Field types cannot be
+// changed on GitHub, so a new field is created, with the distinct values as
+// options when it is a single select field, and every item's value is copied
+// over. Values that cannot be converted are reported and left out.
+func (s *FieldService) ConvertField(ctx context.Context, input FieldConversionInput) (*FieldConversionResult, error) {
+	source := input.Field
+	if !convertibleFieldTypes[source.DataType] || !convertibleFieldTypes[input.DataType] {
+		return nil, fmt.Errorf("cannot convert field %s from %s to %s", source.Name,
+			FormatFieldDataType(source.DataType), FormatFieldDataType(input.DataType))
+	}
+	if source.DataType == input.DataType {
+		return nil, fmt.Errorf("field %s is already a %s field", source.Name, FormatFieldDataType(source.DataType))
+	}
+
+	name := input.Name
+	switch {
+	case input.Replace:
+		name = source.Name + " (converted)"
+	case name == "":
+		name = fmt.Sprintf("%s (%s)", source.Name, FormatFieldDataType(input.DataType))
+	}
+	if projectFieldByName(input.Project, name) != nil {
+		return nil, fmt.Errorf("field %s already exists in project", name)
+	}
+
+	items, err := NewItemService(s.client).ListProjectItems(ctx, input.Project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &FieldConversionResult{Name: name, DataType: string(input.DataType), Failures: []FieldConversionFailure{}}
+	var values []convertedValue
+	for i := range items {
+		old := fieldValueText(&items[i], source.ID)
+		if old == "" {
+			continue
+		}
+		raw := old
+		if mapped, ok := lookupMapping(input.Mapping, old); ok {
+			if raw = mapped; raw == "" {
+				continue
+			}
+		}
+		value, err := ConvertFieldValue(raw, input.DataType)
+		if err != nil {
+			result.Failures = append(result.Failures, conversionFailure(&items[i], old, err))
+			continue
+		}
+		values = append(values, convertedValue{item: &items[i], old: old, value: value})
+	}
+
+	create := CreateFieldInput{
+		ProjectID: input.Project.ID,
+		Name:      name,
+		DataType:  input.DataType,
+		Duration:  input.Duration,
+	}
+	switch input.DataType {
+	case graphql.ProjectV2FieldDataTypeSingleSelect:
+		create.Options = conversionOptions(source, values)
+		if len(create.Options) == 0 {
+			return nil, fmt.Errorf("field %s has no values to turn into options", source.Name)
+		}
+		for _, option := range create.Options {
+			result.Options = append(result.Options, option.Name)
+		}
+	case graphql.ProjectV2FieldDataTypeIteration:
+		if create.IterationStart, create.IterationCount, err = conversionIterations(values, input.Duration); err != nil {
+			return nil, err
+		}
+	}
+
+	if input.DryRun {
+		// Iteration values are only resolved against the iterations of the new field
+		result.Converted = len(values)
+		return result, nil
+	}
+
+	field, err := s.CreateField(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	result.FieldID = field.ID
+
+	projectService := NewProjectService(s.client)
+	iterations, _ := IterationsFromField(field)
+	for _, v := range values {
+		fieldValue, err := conversionFieldValue(field, iterations, v.value)
+		if err == nil {
+			_, err = projectService.UpdateItemField(ctx, UpdateItemFieldInput{
+				ProjectID: input.Project.ID,
+				ItemID:    v.item.ID,
+				FieldID:   field.ID,
+				Value:     fieldValue,
+			})
+		}
+		if err != nil {
+			result.Failures = append(result.Failures, conversionFailure(v.item, v.old, err))
+			continue
+		}
+		result.Converted++
+	}
+
+	if !input.Replace || len(result.Failures) > 0 {
+		return result, nil
+	}
+	if err := s.DeleteField(ctx, DeleteFieldInput{FieldID: source.ID}); err != nil {
+		return result, err
+	}
+	if _, err := s.UpdateField(ctx, UpdateFieldInput{FieldID: field.ID, Name: &source.Name}); err != nil {
+		return result, err
+	}
+	result.Name = source.Name
+	result.Replaced = true
+	return result, nil
+}
+
+// convertibleFieldTypes are the data types a field can be converted from and to
+var convertibleFieldTypes = map[graphql.ProjectV2FieldDataType]bool{
+	graphql.ProjectV2FieldDataTypeText:         true,
+	graphql.ProjectV2FieldDataTypeNumber:       true,
+	graphql.ProjectV2FieldDataTypeDate:         true,
+	graphql.ProjectV2FieldDataTypeSingleSelect: true,
+	graphql.ProjectV2FieldDataTypeIteration:    true,
+}
+
+// ConvertFieldValue converts a value to the text form of a value of the given
+// data type. Numbers may use thousands separators, dates may be timestamps and
+// iteration values are iteration titles or dates within an iteration.
+func ConvertFieldValue(value string, dataType graphql.ProjectV2FieldDataType) (string, error) {
+	value = strings.TrimSpace(value)
+	switch dataType {
+	case graphql.ProjectV2FieldDataTypeNumber:
+		number, err := strconv.ParseFloat(strings.ReplaceAll(value, ",", ""), 64)
+		if err != nil {
+			return "", fmt.Errorf("%q is not a number", value)
+		}
+		return strconv.FormatFloat(number, 'f', -1, 64), nil
+	case graphql.ProjectV2FieldDataTypeDate:
+		for _, layout := range []string{DateLayout, time.RFC3339, "2006/01/02"} {
+			if date, err := time.Parse(layout, value); err == nil {
+				return date.Format(DateLayout), nil
+			}
+		}
+		return "", fmt.Errorf("%q is not a date (expected YYYY-MM-DD)", value)
+	default:
+		return value, nil
+	}
+}
+
+// ParseFieldValueMapping parses a value mapping given as Old=New
+func ParseFieldValueMapping(s string) (old, value string, err error) {
+	old, value, ok := strings.Cut(s, "=")
+	old = strings.TrimSpace(old)
+	if !ok || old == "" {
+		return "", "", fmt.Errorf("invalid mapping %q, expected Old=New", s)
+	}
+	return old, strings.TrimSpace(value), nil
+}
+
+func lookupMapping(mapping map[string]string, value string) (string, bool) {
+	for old, mapped := range mapping {
+		if strings.EqualFold(old, value) {
+			return mapped, true
+		}
+	}
+	return "", false
+}
+
+// fieldValueText returns the value of an item's field as text, or ""
+func fieldValueText(item *graphql.ProjectV2Item, fieldID string) string {
+	for i := range item.FieldValues.Nodes {
+		if item.FieldValues.Nodes[i].Field.ID == fieldID {
+			return formatExportedValue(exportFieldValue(&item.FieldValues.Nodes[i]))
+		}
+	}
+	return ""
+}
+
+func conversionFailure(item *graphql.ProjectV2Item, value string, err error) FieldConversionFailure {
+	return FieldConversionFailure{ItemID: item.ID, Title: projectItemTitle(item), Value: value, Reason: err.Error()}
+}
+
+// conversionOptions returns the options of a single select field made from the
+// distinct values, compared case-insensitively. Values that were options of a
+// single select source keep its order, color and description; other values
+// follow in the order items have them.
+func conversionOptions(source *graphql.ProjectV2Field, values []convertedValue) []FieldOptionInfo {
+	used := make(map[string]bool, len(values))
+	for _, v := range values {
+		used[strings.ToLower(v.value)] = true
+	}
+
+	var options []FieldOptionInfo
+	added := make(map[string]bool)
+	for _, option := range source.SingleSelect.Options {
+		key := strings.ToLower(option.Name)
+		if used[key] && !added[key] {
+			added[key] = true
+			options = append(options, FieldOptionInfo{Name: option.Name, Color: option.Color, Description: option.Description})
+		}
+	}
+	for _, v := range values {
+		key := strings.ToLower(v.value)
+		if !added[key] {
+			added[key] = true
+			options = append(options, FieldOptionInfo{Name: v.value})
+		}
+	}
+	return options
+}
+
+// conversionIterations returns the start and number of iterations of a new
+// iteration field so that every date among the values falls in an iteration
+func conversionIterations(values []convertedValue, duration string) (time.Time, int, error) {
+	days := defaultIterationDuration
+	if duration != "" {
+		var err error
+		if days, err = ParseIterationDuration(duration); err != nil {
+			return time.Time{}, 0, err
+		}
+	}
+
+	var first, last time.Time
+	for _, v := range values {
+		date, err := time.Parse(DateLayout, v.value)
+		if err != nil {
+			continue
+		}
+		if first.IsZero() || date.Before(first) {
+			first = date
+		}
+		if last.IsZero() || date.After(last) {
+			last = date
+		}
+	}
+	if first.IsZero() {
+		return time.Time{}, 0, nil
+	}
+
+	count := int(last.Sub(first).Hours()/24)/days + 1
+	return first, max(count, defaultIterationCount), nil
+}
+
+// conversionFieldValue builds the value input of a converted value; values of
+// iteration fields that are dates select the iteration containing the date
+func conversionFieldValue(field *graphql.ProjectV2Field, iterations []IterationInfo, value string) (map[string]interface{}, error) {
+	if field.DataType == graphql.ProjectV2FieldDataTypeIteration {
+		if date, err := time.Parse(DateLayout, value); err == nil {
+			for _, iteration := range iterations {
+				if !date.Before(iteration.StartDate) && date.Before(iteration.EndDate()) {
+					return map[string]interface{}{"iterationId": iteration.ID}, nil
+				}
+			}
+			return nil, fmt.Errorf("no iteration of field %s contains %s", field.Name, value)
+		}
+	}
+	return BuildFieldValue(field, value, time.Now())
+}
@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+func TestConvertFieldValue(t *testing.T) {
+	tests := []struct {
+		value    string
+		dataType graphql.ProjectV2FieldDataType
+		want     string
+		wantErr  string
+	}{
+		{value: " 1,250.5 ", dataType: graphql.ProjectV2FieldDataTypeNumber, want: "1250.5"},
+		{value: "3", dataType: graphql.ProjectV2FieldDataTypeNumber, want: "3"},
+		{value: "high", dataType: graphql.ProjectV2FieldDataTypeNumber, wantErr: `"high" is not a number`},
+		{value: "2026-03-01T10:00:00Z", dataType: graphql.ProjectV2FieldDataTypeDate, want: "2026-03-01"},
+		{value: "2026/03/01", dataType: graphql.ProjectV2FieldDataTypeDate, want: "2026-03-01"},
+		{value: "next week", dataType: graphql.ProjectV2FieldDataTypeDate, wantErr: "is not a date"},
+		{value: " High ", dataType: graphql.ProjectV2FieldDataTypeSingleSelect, want: "High"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := ConvertFieldValue(tt.value, tt.dataType)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseFieldValueMapping(t *testing.T) {
+	old, value, err := ParseFieldValueMapping("P1 = High")
+	require.NoError(t, err)
+	assert.Equal(t, "P1", old)
+	assert.Equal(t, "High", value)
+
+	_, value, err = ParseFieldValueMapping("n/a=")
+	require.NoError(t, err)
+	assert.Empty(t, value)
+
+	_, _, err = ParseFieldValueMapping("High")
+	assert.ErrorContains(t, err, "expected Old=New")
+}
+
+func TestConvertFieldAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	ctx := context.Background()
+	client := api.NewClient("ghp_fake")
+	fieldService := NewFieldService(client)
+
+	setup := func(t *testing.T, dataType string, values ...string) (*fake.Project, *fake.Field, *graphql.ProjectV2) {
+		t.Helper()
+		project := store.AddProject(fake.DefaultViewer, "Backlog")
+		field := store.AddField(project, "Priority", dataType)
+		for i, value := range values {
+			item := store.AddDraftIssue(project, "Item "+string(rune('A'+i)), "")
+			if value != "" {
+				store.SetValue(item, field, fake.Value{Text: value, Date: value})
+			}
+		}
+		graphqlProject, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+		require.NoError(t, err)
+		return project, field, graphqlProject
+	}
+	sourceField := func(project *graphql.ProjectV2, name string) *graphql.ProjectV2Field {
+		return projectFieldByName(project, name)
+	}
+
+	t.Run("Turns distinct text values into options and takes over the name", func(t *testing.T) {
+		project, old, graphqlProject := setup(t, "TEXT", "High", "low", "", "high", "P1")
+
+		result, err := fieldService.ConvertField(ctx, FieldConversionInput{
+			Project:  graphqlProject,
+			Field:    sourceField(graphqlProject, "Priority"),
+			DataType: graphql.ProjectV2FieldDataTypeSingleSelect,
+			Mapping:  map[string]string{"p1": "High"},
+			Replace:  true,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"High", "low"}, result.Options)
+		assert.Equal(t, 4, result.Converted)
+		assert.Empty(t, result.Failures)
+		assert.True(t, result.Replaced)
+		assert.Nil(t, project.Field(old.Name+" (converted)"))
+
+		field := project.Field("Priority")
+		require.NotNil(t, field)
+		assert.Equal(t, "SINGLE_SELECT", field.DataType)
+		assert.Equal(t, field.Option("High").ID, project.Items[4].Values[field.ID].OptionID)
+		assert.Equal(t, field.Option("low").ID, project.Items[1].Values[field.ID].OptionID)
+		assert.NotContains(t, project.Items[2].Values, field.ID)
+	})
+
+	t.Run("Reports values that fail to convert and keeps the old field", func(t *testing.T) {
+		project, old, graphqlProject := setup(t, "TEXT", "3", "five", "8")
+
+		result, err := fieldService.ConvertField(ctx, FieldConversionInput{
+			Project:  graphqlProject,
+			Field:    sourceField(graphqlProject, "Priority"),
+			DataType: graphql.ProjectV2FieldDataTypeNumber,
+			Replace:  true,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, result.Converted)
+		require.Len(t, result.Failures, 1)
+		assert.Equal(t, "Item B", result.Failures[0].Title)
+		assert.Equal(t, "five", result.Failures[0].Value)
+		assert.False(t, result.Replaced)
+		assert.NotNil(t, project.Field(old.Name))
+
+		field := project.Field("Priority (converted)")
+		require.NotNil(t, field)
+		assert.InDelta(t, 8, project.Items[2].Values[field.ID].Number, 0)
+	})
+
+	t.Run("Places dates in the iterations of a new iteration field", func(t *testing.T) {
+		project, _, graphqlProject := setup(t, "DATE", "2026-01-05", "2026-03-02")
+
+		result, err := fieldService.ConvertField(ctx, FieldConversionInput{
+			Project:  graphqlProject,
+			Field:    sourceField(graphqlProject, "Priority"),
+			DataType: graphql.ProjectV2FieldDataTypeIteration,
+			Name:     "Sprint",
+			Duration: "2w",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, result.Converted)
+
+		field := project.Field("Sprint")
+		require.NotNil(t, field)
+		assert.Len(t, field.Iterations, 5)
+		assert.Equal(t, field.Iterations[0].ID, project.Items[0].Values[field.ID].IterationID)
+		assert.Equal(t, field.Iterations[4].ID, project.Items[1].Values[field.ID].IterationID)
+	})
+
+	t.Run("Dry run changes nothing", func(t *testing.T) {
+		project, _, graphqlProject := setup(t, "TEXT", "High")
+		fields := len(project.Fields)
+
+		result, err := fieldService.ConvertField(ctx, FieldConversionInput{
+			Project:  graphqlProject,
+			Field:    sourceField(graphqlProject, "Priority"),
+			DataType: graphql.ProjectV2FieldDataTypeSingleSelect,
+			DryRun:   true,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Priority (Single Select)", result.Name)
+		assert.Equal(t, 1, result.Converted)
+		assert.Len(t, project.Fields, fields)
+	})
+
+	t.Run("Rejects conversions to the same or a built-in type", func(t *testing.T) {
+		_, _, graphqlProject := setup(t, "TEXT")
+
+		_, err := fieldService.ConvertField(ctx, FieldConversionInput{
+			Project:  graphqlProject,
+			Field:    sourceField(graphqlProject, "Priority"),
+			DataType: graphql.ProjectV2FieldDataTypeText,
+		})
+		assert.ErrorContains(t, err, "already a Text field")
+
+		_, err = fieldService.ConvertField(ctx, FieldConversionInput{
+			Project:  graphqlProject,
+			Field:    sourceField(graphqlProject, "Assignees"),
+			DataType: graphql.ProjectV2FieldDataTypeText,
+		})
+		assert.ErrorContains(t, err, "cannot convert field Assignees")
+	})
+}
@@ -0,0 +1,298 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// MergeFieldOptionInput represents input for merging one option of a single
+// select field into another
+type MergeFieldOptionInput struct {
+	Project *graphql.ProjectV2
+	Field   *graphql.ProjectV2Field
+	// From and Into are option names or IDs
+	From string
+	Into string
+}
+
+// FieldOptionMergeResult represents the result of merging a field option
+type FieldOptionMergeResult struct {
+	From  string `json:"from"`
+	Into  string `json:"into"`
+	Moved int    `json:"moved"`
+}
+
+// FieldOptionsPlan describes the options a field gets from option specs
+type FieldOptionsPlan struct {
+	// Options are the options of the field in order; options that already exist
+	// keep their ID
+	Options []FieldOptionInfo `json:"-"`
+	Created []string          `json:"created"`
+	Updated []string          `json:"updated"`
+	// Removed are the options that are left out; items that have them lose
+	// their value
+	Removed []string `json:"removed"`
+}
+
+// optionsFile is the layout of a file given to field set-options
+type optionsFile struct {
+	Options []OptionSpec `yaml:"options"`
+}
+
+// FindFieldOption finds an option of a single select field by ID or name
+func FindFieldOption(field *graphql.ProjectV2Field, ref string) (*graphql.ProjectV2SingleSelectFieldOption, error) {
+	options := field.SingleSelect.Options
+	candidates := make([]referenceCandidate, len(options))
+	for i := range options {
+		candidates[i] = referenceCandidate{ID: options[i].ID, Name: options[i].Name}
+	}
+	index, err := matchReference("option", ref, candidates)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("option '%s' not found in field %s", ref, field.Name)
+	}
+	return &options[index], nil
+}
+
+// MergeFieldOption moves every item with one option of a single select field to
+// another option and then deletes the first one. The option is kept if any item
+// could not be moved.
+func (s *FieldService) MergeFieldOption(ctx context.Context, input MergeFieldOptionInput) (*FieldOptionMergeResult, error) {
+	if input.Field.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return nil, fmt.Errorf("field %s is not a single select field", input.Field.Name)
+	}
+	from, err := FindFieldOption(input.Field, input.From)
+	if err != nil {
+		return nil, err
+	}
+	into, err := FindFieldOption(input.Field, input.Into)
+	if err != nil {
+		return nil, err
+	}
+	if from.ID == into.ID {
+		return nil, fmt.Errorf("cannot merge option %s into itself", from.Name)
+	}
+
+	// Every item's value of this field is read before anything changes, so an
+	// item that cannot be checked never loses its value when the option is deleted
+	items, err := NewItemService(s.client).ListProjectItemFieldValues(ctx, input.Project.ID, input.Field.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s of project items, option %s was kept: %w", input.Field.Name, from.Name, err)
+	}
+	var toMove []*graphql.ProjectItemFieldValue
+	var unreadable []string
+	for i := range items {
+		optionID, ok := itemOptionID(&items[i], input.Field.ID)
+		switch {
+		case !ok:
+			unreadable = append(unreadable, itemFieldValueTitle(&items[i]))
+		case optionID == from.ID:
+			toMove = append(toMove, &items[i])
+		}
+	}
+	if len(unreadable) > 0 {
+		return nil, fmt.Errorf("cannot read %s of %s, option %s was kept",
+			input.Field.Name, strings.Join(unreadable, ", "), from.Name)
+	}
+
+	result := &FieldOptionMergeResult{From: from.Name, Into: into.Name}
+	projectService := NewProjectService(s.client)
+	for _, item := range toMove {
+		_, err := projectService.UpdateItemField(ctx, UpdateItemFieldInput{
+			ProjectID: input.Project.ID,
+			ItemID:    item.ID,
+			FieldID:   input.Field.ID,
+			Value:     map[string]interface{}{"singleSelectOptionId": into.ID},
+		})
+		if err != nil {
+			return result, fmt.Errorf("failed to move %s to %s, option %s was kept: %w",
+				itemFieldValueTitle(item), into.Name, from.Name, err)
+		}
+		result.Moved++
+	}
+
+	if err := s.DeleteFieldOption(ctx, DeleteFieldOptionInput{OptionID: from.ID}); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// itemOptionID returns the ID of the option an item has in a single select field,
+// or "" when it has none. ok is false when the value read is not a value of the
+// field, so the item's option is unknown.
+func itemOptionID(item *graphql.ProjectItemFieldValue, fieldID string) (optionID string, ok bool) {
+	value := item.Value
+	if value == nil {
+		return "", true
+	}
+	if value.Field.ID != fieldID || value.SingleSelectValue.OptionID == nil {
+		return "", false
+	}
+	return *value.SingleSelectValue.OptionID, true
+}
+
+// itemFieldValueTitle returns the title of the item's issue, pull request or draft
+func itemFieldValueTitle(item *graphql.ProjectItemFieldValue) string {
+	switch item.Content.TypeName {
+	case "Issue":
+		return item.Content.Issue.Title
+	case "PullRequest":
+		return item.Content.PullRequest.Title
+	case "DraftIssue":
+		return item.Content.DraftIssue.Title
+	default:
+		return item.ID
+	}
+}
+
+// OrderFieldOptions returns the options of a single select field with the named
+// options first, in the given order, followed by the others in their current
+// order
+func OrderFieldOptions(field *graphql.ProjectV2Field, names []string) ([]FieldOptionInfo, error) {
+	if field.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return nil, fmt.Errorf("field %s is not a single select field", field.Name)
+	}
+
+	listed := make(map[string]bool, len(names))
+	options := make([]FieldOptionInfo, 0, len(field.SingleSelect.Options))
+	for _, name := range names {
+		option, err := FindFieldOption(field, name)
+		if err != nil {
+			return nil, err
+		}
+		if listed[option.ID] {
+			return nil, fmt.Errorf("option %s is listed twice", option.Name)
+		}
+		listed[option.ID] = true
+		options = append(options, fieldOptionInfo(option))
+	}
+	for i := range field.SingleSelect.Options {
+		if option := &field.SingleSelect.Options[i]; !listed[option.ID] {
+			options = append(options, fieldOptionInfo(option))
+		}
+	}
+	return options, nil
+}
+
+// ReorderFieldOptions puts the named options of a single select field first, in
+// the given order
+func (s *FieldService) ReorderFieldOptions(ctx context.Context, field *graphql.ProjectV2Field, names []string) (*graphql.ProjectV2Field, error) {
+	options, err := OrderFieldOptions(field, names)
+	if err != nil {
+		return nil, err
+	}
+	return s.UpdateField(ctx, UpdateFieldInput{FieldID: field.ID, Options: options})
+}
+
+// PlanFieldOptions works out the options a single select field gets from option
+// specs. Options are matched by name, or by renamedFrom, and keep their ID, color
+// and description unless the spec changes them; new options are gray.
+func PlanFieldOptions(field *graphql.ProjectV2Field, specs []OptionSpec) (*FieldOptionsPlan, error) {
+	if field.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return nil, fmt.Errorf("field %s is not a single select field", field.Name)
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("a single select field needs at least one option")
+	}
+	if err := validateOptionSpecs(specs); err != nil {
+		return nil, err
+	}
+
+	live := field.SingleSelect.Options
+	names := make([]string, len(live))
+	for i, option := range live {
+		names[i] = option.Name
+	}
+	wanted := make([]string, len(specs))
+	renamedFrom := make([]string, len(specs))
+	for i, spec := range specs {
+		wanted[i] = spec.Name
+		renamedFrom[i] = spec.RenamedFrom
+	}
+	matched, matches := matchByName(names, wanted, renamedFrom)
+
+	plan := &FieldOptionsPlan{Created: []string{}, Updated: []string{}, Removed: []string{}}
+	for i, spec := range specs {
+		option := FieldOptionInfo{Name: spec.Name, Color: "GRAY", Description: spec.Description}
+		if spec.Color != "" {
+			option.Color = NormalizeColor(spec.Color)
+		}
+		if matches[i] < 0 {
+			plan.Created = append(plan.Created, spec.Name)
+			plan.Options = append(plan.Options, option)
+			continue
+		}
+
+		current := live[matches[i]]
+		option.ID = current.ID
+		if spec.Color == "" {
+			option.Color = current.Color
+		}
+		if spec.Description == nil {
+			option.Description = current.Description
+		}
+		if option.Name != current.Name || option.Color != NormalizeColor(current.Color) ||
+			derefString(option.Description) != derefString(current.Description) {
+			plan.Updated = append(plan.Updated, spec.Name)
+		}
+		plan.Options = append(plan.Options, option)
+	}
+	for i, option := range live {
+		if !matched[i] {
+			plan.Removed = append(plan.Removed, option.Name)
+		}
+	}
+	return plan, nil
+}
+
+// SetFieldOptions replaces the options of a single select field with the options
+// of a plan in one update
+func (s *FieldService) SetFieldOptions(ctx context.Context, field *graphql.ProjectV2Field, plan *FieldOptionsPlan) (*graphql.ProjectV2Field, error) {
+	return s.UpdateField(ctx, UpdateFieldInput{FieldID: field.ID, Options: plan.Options})
+}
+
+// LoadOptionSpecs reads and validates a file listing the options of a single
+// select field under a top-level options key
+func LoadOptionSpecs(path string) ([]OptionSpec, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // reading the user-provided options file is intended
+	if err != nil {
+		return nil, fmt.Errorf("failed to read options: %w", err)
+	}
+
+	specs, err := ParseOptionSpecs(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return specs, nil
+}
+
+// ParseOptionSpecs parses and validates a YAML list of options
+func ParseOptionSpecs(data []byte) ([]OptionSpec, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	file := &optionsFile{}
+	if err := decoder.Decode(file); err != nil {
+		return nil, fmt.Errorf("invalid options: %w", err)
+	}
+	if len(file.Options) == 0 {
+		return nil, fmt.Errorf("no options listed")
+	}
+	if err := validateOptionSpecs(file.Options); err != nil {
+		return nil, err
+	}
+	return file.Options, nil
+}
+
+func fieldOptionInfo(option *graphql.ProjectV2SingleSelectFieldOption) FieldOptionInfo {
+	return FieldOptionInfo{ID: option.ID, Name: option.Name, Color: option.Color, Description: option.Description}
+}
@@ -0,0 +1,217 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+func TestParseOptionSpecs(t *testing.T) {
+	specs, err := ParseOptionSpecs([]byte(`
+options:
+  - name: Todo
+    color: blue
+  - name: Review
+    renamedFrom: In Review
+    description: Waiting for a reviewer
+`))
+	require.NoError(t, err)
+	require.Len(t, specs, 2)
+	assert.Equal(t, "In Review", specs[1].RenamedFrom)
+	assert.Equal(t, "Waiting for a reviewer", *specs[1].Description)
+
+	_, err = ParseOptionSpecs([]byte("options:\n  - name: Todo\n  - name: todo\n"))
+	assert.ErrorContains(t, err, "option todo is listed twice")
+
+	_, err = ParseOptionSpecs([]byte("options:\n  - name: Todo\n    colour: blue\n"))
+	assert.ErrorContains(t, err, "field colour not found")
+
+	_, err = ParseOptionSpecs([]byte("options: []\n"))
+	assert.ErrorContains(t, err, "no options listed")
+}
+
+func TestOrderFieldOptions(t *testing.T) {
+	field := &graphql.ProjectV2Field{Name: "Status", DataType: graphql.ProjectV2FieldDataTypeSingleSelect}
+	for _, name := range []string{"Todo", "In Progress", "Review", "Done"} {
+		field.SingleSelect.Options = append(field.SingleSelect.Options,
+			graphql.ProjectV2SingleSelectFieldOption{ID: "id-" + name, Name: name, Color: "GRAY"})
+	}
+	names := func(options []FieldOptionInfo) []string {
+		result := make([]string, len(options))
+		for i, option := range options {
+			result[i] = option.Name
+		}
+		return result
+	}
+
+	options, err := OrderFieldOptions(field, []string{"done", "Review"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Done", "Review", "Todo", "In Progress"}, names(options))
+	assert.Equal(t, "id-Done", options[0].ID)
+
+	_, err = OrderFieldOptions(field, []string{"Done", "done"})
+	assert.ErrorContains(t, err, "option Done is listed twice")
+
+	_, err = OrderFieldOptions(field, []string{"Blocked"})
+	assert.ErrorContains(t, err, "option 'Blocked' not found")
+}
+
+func TestItemOptionID(t *testing.T) {
+	optionID := "OPT_1"
+	item := &graphql.ProjectItemFieldValue{}
+
+	id, ok := itemOptionID(item, "FIELD_1")
+	assert.True(t, ok, "an item without a value has no option")
+	assert.Empty(t, id)
+
+	item.Value = &graphql.ProjectV2ItemFieldValue{}
+	item.Value.Field.ID = "FIELD_1"
+	item.Value.SingleSelectValue.OptionID = &optionID
+	id, ok = itemOptionID(item, "FIELD_1")
+	assert.True(t, ok)
+	assert.Equal(t, optionID, id)
+
+	_, ok = itemOptionID(item, "FIELD_2")
+	assert.False(t, ok, "a value of another field is not read as the option")
+}
+
+func TestFieldOptionsAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	ctx := context.Background()
+	client := api.NewClient("ghp_fake")
+	fieldService := NewFieldService(client)
+
+	setup := func(t *testing.T) (*fake.Project, *fake.Field, *graphql.ProjectV2) {
+		t.Helper()
+		project := store.AddProject(fake.DefaultViewer, "Backlog")
+		status := project.Field("Status")
+		store.AddOption(status, "In Review")
+		store.AddOption(status, "Review")
+		for i, option := range []string{"In Review", "Review", "Todo", "In Review"} {
+			item := store.AddDraftIssue(project, "Item "+string(rune('A'+i)), "")
+			store.SetValue(item, status, fake.Value{OptionID: status.Option(option).ID})
+		}
+		graphqlProject, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+		require.NoError(t, err)
+		return project, status, graphqlProject
+	}
+
+	t.Run("Merges an option by moving its items before deleting it", func(t *testing.T) {
+		project, status, graphqlProject := setup(t)
+		review := status.Option("Review").ID
+
+		result, err := fieldService.MergeFieldOption(ctx, MergeFieldOptionInput{
+			Project: graphqlProject,
+			Field:   projectFieldByName(graphqlProject, "Status"),
+			From:    "in review",
+			Into:    "Review",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &FieldOptionMergeResult{From: "In Review", Into: "Review", Moved: 2}, result)
+
+		assert.Nil(t, status.Option("In Review"))
+		assert.Equal(t, review, project.Items[0].Values[status.ID].OptionID)
+		assert.Equal(t, review, project.Items[3].Values[status.ID].OptionID)
+		assert.Equal(t, status.Option("Todo").ID, project.Items[2].Values[status.ID].OptionID)
+	})
+
+	t.Run("Moves items whose value is past their first 20 field values", func(t *testing.T) {
+		project := store.AddProject(fake.DefaultViewer, "Wide")
+		item := store.AddDraftIssue(project, "Late value", "")
+		for i := 0; i < 25; i++ {
+			store.SetValue(item, store.AddField(project, fmt.Sprintf("Note %02d", i), "TEXT"), fake.Value{Text: "x"})
+		}
+		stage := store.AddField(project, "Stage", "SINGLE_SELECT", "Old", "New")
+		store.SetValue(item, stage, fake.Value{OptionID: stage.Option("Old").ID})
+		graphqlProject, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+		require.NoError(t, err)
+		fields, err := fieldService.ListProjectFields(ctx, graphqlProject.ID)
+		require.NoError(t, err)
+
+		var field *graphql.ProjectV2Field
+		for i := range fields {
+			if fields[i].Name == "Stage" {
+				field = &fields[i]
+			}
+		}
+		require.NotNil(t, field)
+		result, err := fieldService.MergeFieldOption(ctx, MergeFieldOptionInput{
+			Project: graphqlProject,
+			Field:   field,
+			From:    "Old",
+			Into:    "New",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.Moved)
+		assert.Equal(t, stage.Option("New").ID, item.Values[stage.ID].OptionID)
+	})
+
+	t.Run("Rejects merging an option into itself", func(t *testing.T) {
+		_, _, graphqlProject := setup(t)
+
+		_, err := fieldService.MergeFieldOption(ctx, MergeFieldOptionInput{
+			Project: graphqlProject,
+			Field:   projectFieldByName(graphqlProject, "Status"),
+			From:    "Review",
+			Into:    "review",
+		})
+		assert.ErrorContains(t, err, "cannot merge option Review into itself")
+	})
+
+	t.Run("Reorders options and keeps item values", func(t *testing.T) {
+		project, status, graphqlProject := setup(t)
+		review := status.Option("Review").ID
+
+		_, err := fieldService.ReorderFieldOptions(ctx, projectFieldByName(graphqlProject, "Status"), []string{"Review", "Done"})
+		require.NoError(t, err)
+
+		var names []string
+		for _, option := range status.Options {
+			names = append(names, option.Name)
+		}
+		assert.Equal(t, []string{"Review", "Done", "Todo", "In Progress", "In Review"}, names)
+		assert.Equal(t, review, project.Items[1].Values[status.ID].OptionID)
+	})
+
+	t.Run("Syncs options from specs in one update", func(t *testing.T) {
+		project, status, graphqlProject := setup(t)
+		todo := status.Option("Todo")
+		description := "Waiting for a reviewer"
+
+		field := projectFieldByName(graphqlProject, "Status")
+		plan, err := PlanFieldOptions(field, []OptionSpec{
+			{Name: "Backlog"},
+			{Name: "To Do", RenamedFrom: "Todo", Color: "blue"},
+			{Name: "Review", Description: &description},
+			{Name: "Done"},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Backlog"}, plan.Created)
+		assert.Equal(t, []string{"To Do", "Review"}, plan.Updated)
+		assert.Equal(t, []string{"In Progress", "In Review"}, plan.Removed)
+
+		_, err = fieldService.SetFieldOptions(ctx, field, plan)
+		require.NoError(t, err)
+
+		require.Len(t, status.Options, 4)
+		assert.Equal(t, "Backlog", status.Options[0].Name)
+		assert.Equal(t, "GRAY", status.Options[0].Color)
+		assert.Same(t, todo, status.Options[1])
+		assert.Equal(t, "To Do", todo.Name)
+		assert.Equal(t, "BLUE", todo.Color)
+		assert.Equal(t, description, status.Option("Review").Description)
+		assert.Equal(t, todo.ID, project.Items[2].Values[status.ID].OptionID)
+		assert.NotContains(t, project.Items[0].Values, status.ID)
+	})
+}
@@ -10,6 +10,9 @@ import (
 	"github.com/roboco-io/ghx-cli/internal/api/graphql"
 )
 
+// projectItemsPageSize is the number of project items fetched per request
+const projectItemsPageSize = 100
+
 // ItemService handles item-related operations
 type ItemService struct {
 	client *api.Client
@@ -286,6 +289,60 @@ func (s *ItemService) ListRepositoryPullRequests(ctx context.Context, owner, rep
 	return items, nil
 }
 
+// ListProjectItems returns all items of a project with their field values
+func (s *ItemService) ListProjectItems(ctx context.Context, projectID string) ([]graphql.ProjectV2Item, error) {
+	var items []graphql.ProjectV2Item
+	var after *string
+
+	for {
+		var query graphql.ListProjectItemsQuery
+		if err := s.client.Query(ctx, &query, graphql.BuildListProjectItemsVariables(projectID, projectItemsPageSize, after)); err != nil {
+			return nil, fmt.Errorf("failed to list project items: %w", err)
+		}
+
+		page := query.Node.ProjectV2.Items
+		items = append(items, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return items, nil
+		}
+		after = &page.PageInfo.EndCursor
+	}
+}
+
+// ListProjectItemFieldValues returns all items of a project with their value of the
+// named field. Unlike the field values of ListProjectItems, which stop after the
+// first 20 fields, the value is read for every item.
+func (s *ItemService) ListProjectItemFieldValues(ctx context.Context, projectID, fieldName string) ([]graphql.ProjectItemFieldValue, error) {
+	var items []graphql.ProjectItemFieldValue
+	var after *string
+
+	for {
+		var query graphql.ListProjectItemFieldValuesQuery
+		variables := graphql.BuildListProjectItemFieldValuesVariables(projectID, fieldName, projectItemsPageSize, after)
+		if err := s.client.Query(ctx, &query, variables); err != nil {
+			return nil, fmt.Errorf("failed to list %s values of project items: %w", fieldName, err)
+		}
+
+		page := query.Node.ProjectV2.Items
+		items = append(items, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return items, nil
+		}
+		after = &page.PageInfo.EndCursor
+	}
+}
+
+// FilterProjectItems returns the items matching a filter (see ItemFilter)
+func FilterProjectItems(items []graphql.ProjectV2Item, filter *ItemFilter) []graphql.ProjectV2Item {
+	var matched []graphql.ProjectV2Item
+	for i := range items {
+		if filter.Matches(&items[i]) {
+			matched = append(matched, items[i])
+		}
+	}
+	return matched
+}
+
 // AddItemToProject adds an existing issue or PR to a project
 func (s *ItemService) AddItemToProject(ctx context.Context, projectID, contentID string) (*graphql.ProjectV2Item, error) {
 	input := AddItemInput{
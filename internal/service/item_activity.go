@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// ItemProjectMembership is the item of an issue or pull request in one project,
+// with the values of its fields
+type ItemProjectMembership struct {
+	ItemID    string           `json:"itemId"`
+	ProjectID string           `json:"projectId"`
+	Project   string           `json:"project"`
+	Title     string           `json:"title"`
+	URL       string           `json:"url"`
+	Fields    []ItemFieldValue `json:"fields"`
+}
+
+// ItemFieldValue is the value of a project field for an item
+type ItemFieldValue struct {
+	Field string `json:"field"`
+	Value string `json:"value"`
+}
+
+// ItemCommentInfo is a comment on an issue or pull request
+type ItemCommentInfo struct {
+	CreatedAt time.Time `json:"createdAt"`
+	Author    string    `json:"author"`
+	Body      string    `json:"body"`
+	URL       string    `json:"url"`
+}
+
+// ItemTimelineEvent is a timeline entry of an issue or pull request described
+// in words
+type ItemTimelineEvent struct {
+	CreatedAt   time.Time `json:"createdAt"`
+	Type        string    `json:"type"`
+	Actor       string    `json:"actor"`
+	Description string    `json:"description"`
+}
+
+// LinkedItemInfo is a pull request closing an issue, or an issue closed by a
+// pull request
+type LinkedItemInfo struct {
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+	State     string `json:"state"`
+}
+
+// GetProjectMemberships returns the projects an issue or pull request is in,
+// with its field values in each. The built-in Title field is left out.
+func (s *ItemService) GetProjectMemberships(ctx context.Context, owner, repo string, number int) ([]ItemProjectMembership, error) {
+	var query graphql.GetContentProjectItemValuesQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetContentProjectItemsVariables(owner, repo, number))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project items: %w", err)
+	}
+
+	content := query.Repository.IssueOrPullRequest
+	items := content.Issue.ProjectItems.Nodes
+	if content.TypeName == "PullRequest" {
+		items = content.PullRequest.ProjectItems.Nodes
+	}
+
+	memberships := make([]ItemProjectMembership, len(items))
+	for i := range items {
+		project := items[i].Project
+		memberships[i] = ItemProjectMembership{
+			ItemID:    items[i].ID,
+			ProjectID: project.ID,
+			Project:   fmt.Sprintf("%s/%d", project.Owner.Login, project.Number),
+			Title:     project.Title,
+			URL:       project.URL,
+			Fields:    []ItemFieldValue{},
+		}
+		for j := range items[i].FieldValues.Nodes {
+			fieldValue := &items[i].FieldValues.Nodes[j]
+			text := formatExportedValue(exportFieldValue(fieldValue))
+			if fieldValue.Field.Name == titleFieldName || text == "" {
+				continue
+			}
+			memberships[i].Fields = append(memberships[i].Fields, ItemFieldValue{Field: fieldValue.Field.Name, Value: text})
+		}
+	}
+	return memberships, nil
+}
+
+// GetComments returns the latest comments of an issue or pull request, oldest
+// first, and the total number of comments
+func (s *ItemService) GetComments(ctx context.Context, owner, repo string, number, limit int) ([]ItemCommentInfo, int, error) {
+	var query graphql.GetItemCommentsQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetItemActivityVariables(owner, repo, number, limit))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	content := query.Repository.IssueOrPullRequest
+	comments := content.Issue.Comments
+	if content.TypeName == "PullRequest" {
+		comments = content.PullRequest.Comments
+	}
+
+	infos := make([]ItemCommentInfo, len(comments.Nodes))
+	for i, comment := range comments.Nodes {
+		infos[i] = ItemCommentInfo{
+			CreatedAt: comment.CreatedAt,
+			Author:    actorLogin(comment.Author),
+			Body:      comment.Body,
+			URL:       comment.URL,
+		}
+	}
+	return infos, comments.TotalCount, nil
+}
+
+// GetTimeline returns the latest timeline entries of an issue or pull request,
+// oldest first, and the total number of entries. Entry types ghx does not
+// describe are left out.
+func (s *ItemService) GetTimeline(ctx context.Context, owner, repo string, number, limit int) ([]ItemTimelineEvent, int, error) {
+	var query graphql.GetItemTimelineQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetItemActivityVariables(owner, repo, number, limit))
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get timeline: %w", err)
+	}
+
+	content := query.Repository.IssueOrPullRequest
+	timeline := content.Issue.TimelineItems
+	if content.TypeName == "PullRequest" {
+		timeline = content.PullRequest.TimelineItems
+	}
+
+	var events []ItemTimelineEvent
+	for i := range timeline.Nodes {
+		if event, ok := describeTimelineItem(&timeline.Nodes[i]); ok {
+			events = append(events, event)
+		}
+	}
+	return events, timeline.TotalCount, nil
+}
+
+// GetLinkedItems returns the pull requests that close an issue, or the issues a
+// pull request closes
+func (s *ItemService) GetLinkedItems(ctx context.Context, owner, repo string, number int) ([]LinkedItemInfo, error) {
+	var query graphql.GetLinkedItemsQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetContentProjectItemsVariables(owner, repo, number))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get linked items: %w", err)
+	}
+
+	content := query.Repository.IssueOrPullRequest
+	linked := content.Issue.ClosedByPullRequestsReferences.Nodes
+	if content.TypeName == "PullRequest" {
+		linked = content.PullRequest.ClosingIssuesReferences.Nodes
+	}
+
+	infos := make([]LinkedItemInfo, len(linked))
+	for i := range linked {
+		infos[i] = LinkedItemInfo{
+			Reference: linkedItemReference(&linked[i]),
+			Title:     linked[i].Title,
+			URL:       linked[i].URL,
+			State:     linked[i].State,
+		}
+	}
+	return infos, nil
+}
+
+// describeTimelineItem turns a timeline entry into an event, and reports false
+// for entry types that are not described
+func describeTimelineItem(item *graphql.TimelineItem) (ItemTimelineEvent, bool) {
+	var event *graphql.TimelineEvent
+	var description string
+	switch item.TypeName {
+	case "IssueComment":
+		return ItemTimelineEvent{
+			CreatedAt:   item.IssueComment.CreatedAt,
+			Type:        item.TypeName,
+			Actor:       actorLogin(item.IssueComment.Author),
+			Description: "commented",
+		}, true
+	case "ClosedEvent":
+		event, description = &item.ClosedEvent, "closed this"
+	case "ReopenedEvent":
+		event, description = &item.ReopenedEvent, "reopened this"
+	case "MergedEvent":
+		event, description = &item.MergedEvent, "merged this"
+	case "LabeledEvent":
+		event, description = &item.LabeledEvent.TimelineEvent, fmt.Sprintf("added the %s label", item.LabeledEvent.Label.Name)
+	case "UnlabeledEvent":
+		event, description = &item.UnlabeledEvent.TimelineEvent, fmt.Sprintf("removed the %s label", item.UnlabeledEvent.Label.Name)
+	case "AssignedEvent":
+		event, description = &item.AssignedEvent.TimelineEvent, "assigned "+item.AssignedEvent.Assignee.User.Login
+	case "UnassignedEvent":
+		event, description = &item.UnassignedEvent.TimelineEvent, "unassigned "+item.UnassignedEvent.Assignee.User.Login
+	case "RenamedTitleEvent":
+		renamed := &item.RenamedTitleEvent
+		event, description = &renamed.TimelineEvent, fmt.Sprintf("changed the title from %q to %q", renamed.PreviousTitle, renamed.CurrentTitle)
+	case "CrossReferencedEvent":
+		source := &item.CrossReferencedEvent.Source
+		linked := &source.Issue
+		if source.TypeName == "PullRequest" {
+			linked = &source.PullRequest
+		}
+		event, description = &item.CrossReferencedEvent.TimelineEvent, fmt.Sprintf("mentioned this in %s %s", linkedItemReference(linked), linked.Title)
+	default:
+		return ItemTimelineEvent{}, false
+	}
+
+	return ItemTimelineEvent{
+		CreatedAt:   event.CreatedAt,
+		Type:        item.TypeName,
+		Actor:       actorLogin(event.Actor),
+		Description: description,
+	}, true
+}
+
+// linkedItemReference returns the owner/repo#number reference of a linked item
+func linkedItemReference(item *graphql.LinkedItem) string {
+	owner, repo, _ := strings.Cut(item.Repository.NameWithOwner, "/")
+	return FormatItemReference(owner, repo, item.Number)
+}
+
+// actorLogin returns the login of an actor, or "ghost" for deleted accounts
+func actorLogin(actor *graphql.Actor) string {
+	if actor == nil {
+		return "ghost"
+	}
+	return actor.Login
+}
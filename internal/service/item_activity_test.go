@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestItemActivityAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	roadmap := store.AddProject(fake.DefaultViewer, "Roadmap")
+	backlog := store.AddProject(fake.DefaultViewer, "Backlog")
+	priority := store.AddField(roadmap, "Priority", "SINGLE_SELECT", "High", "Low")
+	points := store.AddField(roadmap, "Points", "NUMBER")
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	issue := store.AddIssue(repo, "Login fails")
+	pr := store.AddPullRequest(repo, "Fix login")
+	bug := store.AddLabel(repo, "bug", "d73a4a")
+
+	item := store.AddItem(roadmap, issue)
+	store.SetValue(item, roadmap.Field("Status"), fake.Value{OptionID: roadmap.Field("Status").Option("In Progress").ID})
+	store.SetValue(item, priority, fake.Value{OptionID: priority.Option("High").ID})
+	store.SetValue(item, points, fake.Value{Number: 3})
+	store.AddItem(backlog, issue)
+
+	store.AddTimelineEvent(issue, &fake.TimelineEvent{Type: "LabeledEvent", Label: bug})
+	store.AddIssueComment(issue, "Reproduced on Safari")
+	store.AddTimelineEvent(issue, &fake.TimelineEvent{Type: "RenamedTitleEvent", PreviousTitle: "Login", CurrentTitle: "Login fails"})
+	store.AddTimelineEvent(issue, &fake.TimelineEvent{Type: "CrossReferencedEvent", Source: pr})
+	store.AddTimelineEvent(issue, &fake.TimelineEvent{Type: "SubscribedEvent"})
+	store.AddIssueComment(issue, "Fixed by the new session handling")
+	store.LinkClosingPullRequest(pr, issue)
+
+	ctx := context.Background()
+	s := NewItemService(api.NewClient("ghp_fake"))
+
+	t.Run("Lists project memberships with field values", func(t *testing.T) {
+		memberships, err := s.GetProjectMemberships(ctx, fake.DefaultViewer, "app", issue.Number)
+		require.NoError(t, err)
+		require.Len(t, memberships, 2)
+
+		assert.Equal(t, item.ID, memberships[0].ItemID)
+		assert.Equal(t, "octocat/1", memberships[0].Project)
+		assert.Equal(t, "Roadmap", memberships[0].Title)
+		assert.Equal(t, []ItemFieldValue{
+			{Field: "Status", Value: "In Progress"},
+			{Field: "Priority", Value: "High"},
+			{Field: "Points", Value: "3"},
+		}, memberships[0].Fields)
+
+		assert.Equal(t, "octocat/2", memberships[1].Project)
+		assert.Empty(t, memberships[1].Fields)
+
+		memberships, err = s.GetProjectMemberships(ctx, fake.DefaultViewer, "app", pr.Number)
+		require.NoError(t, err)
+		assert.Empty(t, memberships)
+	})
+
+	t.Run("Gets the latest comments", func(t *testing.T) {
+		comments, total, err := s.GetComments(ctx, fake.DefaultViewer, "app", issue.Number, 1)
+		require.NoError(t, err)
+		assert.Equal(t, 2, total)
+		require.Len(t, comments, 1)
+		assert.Equal(t, "Fixed by the new session handling", comments[0].Body)
+		assert.Equal(t, fake.DefaultViewer, comments[0].Author)
+		assert.Contains(t, comments[0].URL, "/issues/1#issuecomment-")
+	})
+
+	t.Run("Describes the timeline", func(t *testing.T) {
+		events, total, err := s.GetTimeline(ctx, fake.DefaultViewer, "app", issue.Number, 10)
+		require.NoError(t, err)
+		assert.Equal(t, 6, total)
+
+		descriptions := make([]string, len(events))
+		for i, event := range events {
+			descriptions[i] = event.Actor + " " + event.Description
+		}
+		assert.Equal(t, []string{
+			"octocat added the bug label",
+			"octocat commented",
+			`octocat changed the title from "Login" to "Login fails"`,
+			"octocat mentioned this in octocat/app#2 Fix login",
+			"octocat commented",
+		}, descriptions)
+	})
+
+	t.Run("Links closing pull requests and closed issues", func(t *testing.T) {
+		linked, err := s.GetLinkedItems(ctx, fake.DefaultViewer, "app", issue.Number)
+		require.NoError(t, err)
+		assert.Equal(t, []LinkedItemInfo{{Reference: "octocat/app#2", Title: "Fix login", URL: pr.URL(), State: "OPEN"}}, linked)
+
+		linked, err = s.GetLinkedItems(ctx, fake.DefaultViewer, "app", pr.Number)
+		require.NoError(t, err)
+		require.Len(t, linked, 1)
+		assert.Equal(t, "octocat/app#1", linked[0].Reference)
+	})
+}
@@ -0,0 +1,472 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// ItemFilter matches project items against a filter written like the filters of
+// project views, e.g. `status:Todo,"In Progress" -priority:Low is:draft no:sprint`.
+//
+// Terms are separated by spaces and all have to match:
+//   - field:value matches items whose field has one of the comma separated values;
+//     hyphens in the field name stand for spaces, values are compared case-insensitively,
+//     and number and date values can be compared with >, >=, < and <=
+//   - assignee:, label:, milestone: and repo: match the built-in fields of issues and
+//     pull requests
+//   - is:draft, is:issue, is:pr, is:open, is:closed and is:merged match the item type
+//     and state
+//   - has:field and no:field match items with and without a value for the field
+//   - a leading - negates a term, and any other word has to appear in the title
+//
+// Values can be relative: @me is the viewer, @today (optionally followed by an offset
+// such as -7d or +2w) is a date, and @current, @next and @previous are iterations.
+type ItemFilter struct {
+	terms []filterTerm
+}
+
+// ItemFilterContext is what relative filter values are resolved against
+type ItemFilterContext struct {
+	// Now is the time @today, @current, @next and @previous are relative to
+	Now time.Time
+	// Viewer is the login @me stands for
+	Viewer string
+	// Fields are the fields of the project. When set, iteration references are
+	// resolved and terms naming a field the project does not have are rejected
+	// instead of matching nothing.
+	Fields []graphql.ProjectV2Field
+}
+
+type filterTerm struct {
+	key    string
+	values []string
+	negate bool
+}
+
+// Built-in fields of issues and pull requests that filters and views can use
+const (
+	builtinAssignees  = "assignees"
+	builtinLabels     = "labels"
+	builtinMilestone  = "milestone"
+	builtinRepository = "repository"
+)
+
+// relativeDatePattern matches @today with an optional offset in days or weeks
+var relativeDatePattern = regexp.MustCompile(`^@today(?:([+-]\d+)([dw]))?$`)
+
+// ParseItemFilter parses a project item filter relative to the current time; an
+// empty filter matches every item
+func ParseItemFilter(filter string) (*ItemFilter, error) {
+	return NewItemFilter(filter, ItemFilterContext{Now: time.Now()})
+}
+
+// NewItemFilter parses a project item filter and resolves its relative values
+func NewItemFilter(filter string, fc ItemFilterContext) (*ItemFilter, error) {
+	tokens, err := splitFilter(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	f := &ItemFilter{}
+	for _, token := range tokens {
+		term := filterTerm{}
+		if strings.HasPrefix(token, "-") && len(token) > 1 {
+			term.negate = true
+			token = token[1:]
+		}
+
+		key, value, qualified := cutQualifier(token)
+		if !qualified {
+			term.values = []string{unquote(token)}
+			f.terms = append(f.terms, term)
+			continue
+		}
+
+		term.key = strings.ToLower(key)
+		for _, v := range splitOutsideQuotes(value, ',') {
+			if v = unquote(v); v != "" {
+				term.values = append(term.values, v)
+			}
+		}
+		if len(term.values) == 0 {
+			return nil, fmt.Errorf("filter %q has no value", token)
+		}
+		if term.key == "is" {
+			for _, v := range term.values {
+				switch strings.ToLower(v) {
+				case "draft", "issue", "pr", "open", "closed", "merged":
+				default:
+					return nil, fmt.Errorf("unsupported filter is:%s, expected draft, issue, pr, open, closed or merged", v)
+				}
+			}
+		}
+		if err := term.resolve(token, &fc); err != nil {
+			return nil, err
+		}
+		f.terms = append(f.terms, term)
+	}
+
+	return f, nil
+}
+
+// Matches reports whether an item matches every term of the filter
+func (f *ItemFilter) Matches(item *graphql.ProjectV2Item) bool {
+	for _, term := range f.terms {
+		if term.matches(item) == term.negate {
+			return false
+		}
+	}
+	return true
+}
+
+// resolve checks that the term names a field the filter can evaluate and replaces
+// relative values with concrete ones
+func (t *filterTerm) resolve(token string, fc *ItemFilterContext) error {
+	switch t.key {
+	case "", "title", "is":
+		return nil
+	case "has", "no":
+		for _, name := range t.values {
+			if err := checkFilterField(token, name, fc); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if builtin, ok := builtinFieldName(t.key); ok {
+		t.key = builtin
+	} else if err := checkFilterField(token, t.key, fc); err != nil {
+		return err
+	}
+
+	for i, v := range t.values {
+		op := ""
+		for _, candidate := range []string{">=", "<=", ">", "<"} {
+			if strings.HasPrefix(v, candidate) {
+				op = candidate
+				break
+			}
+		}
+		ref := strings.ToLower(strings.TrimPrefix(v, op))
+		if !strings.HasPrefix(ref, "@") {
+			continue
+		}
+
+		resolved, err := resolveFilterValue(t.key, ref, fc)
+		if err != nil {
+			return fmt.Errorf("cannot evaluate filter %s: %w", token, err)
+		}
+		t.values[i] = op + resolved
+	}
+	return nil
+}
+
+// resolveFilterValue resolves @me, @today and iteration references. An iteration
+// reference that matches no iteration, such as @current between iterations,
+// resolves to "" so the term matches no item.
+func resolveFilterValue(key, ref string, fc *ItemFilterContext) (string, error) {
+	if ref == "@me" {
+		if fc.Viewer == "" {
+			return "", fmt.Errorf("@me needs the login of the viewer")
+		}
+		return fc.Viewer, nil
+	}
+
+	if match := relativeDatePattern.FindStringSubmatch(ref); match != nil {
+		date := truncateDay(fc.Now)
+		if match[1] != "" {
+			n, _ := strconv.Atoi(match[1])
+			if match[2] == "w" {
+				n *= daysPerWeek
+			}
+			date = date.AddDate(0, 0, n)
+		}
+		return date.Format(DateLayout), nil
+	}
+
+	switch ref {
+	case IterationRefCurrent, IterationRefNext, IterationRefPrevious:
+		field := filterField(fc.Fields, key)
+		if field == nil || field.DataType != graphql.ProjectV2FieldDataTypeIteration {
+			return "", fmt.Errorf("%s needs an iteration field", ref)
+		}
+		iterations, err := IterationsFromField(field)
+		if err != nil {
+			return "", err
+		}
+		iteration, err := ResolveIteration(iterations, ref, fc.Now)
+		if err != nil {
+			return "", nil //nolint:nilerr // no such iteration, so no item matches
+		}
+		return iteration.Title, nil
+	}
+
+	return "", fmt.Errorf("unsupported value %s", ref)
+}
+
+// checkFilterField returns an error when the project fields are known and none of
+// them, nor a built-in field, has the given name
+func checkFilterField(token, name string, fc *ItemFilterContext) error {
+	if fc.Fields == nil {
+		return nil
+	}
+	if _, ok := builtinFieldName(name); ok || strings.EqualFold(name, titleFieldName) {
+		return nil
+	}
+	if filterField(fc.Fields, name) == nil {
+		return fmt.Errorf("cannot evaluate filter %s: the project has no field %s", token, name)
+	}
+	return nil
+}
+
+// filterField finds a field by name; hyphens in the name match spaces
+func filterField(fields []graphql.ProjectV2Field, name string) *graphql.ProjectV2Field {
+	name = strings.ReplaceAll(name, "-", " ")
+	for i := range fields {
+		if strings.EqualFold(strings.ReplaceAll(fields[i].Name, "-", " "), name) {
+			return &fields[i]
+		}
+	}
+	return nil
+}
+
+// builtinFieldName returns the built-in field a filter qualifier or column name
+// stands for
+func builtinFieldName(name string) (string, bool) {
+	switch strings.ToLower(name) {
+	case "assignee", builtinAssignees:
+		return builtinAssignees, true
+	case "label", builtinLabels:
+		return builtinLabels, true
+	case builtinMilestone:
+		return builtinMilestone, true
+	case "repo", builtinRepository:
+		return builtinRepository, true
+	default:
+		return "", false
+	}
+}
+
+// itemBuiltinValues returns the values of a built-in field of the item's issue or
+// pull request; draft issues have none
+func itemBuiltinValues(item *graphql.ProjectV2Item, builtin string) []string {
+	var assignees graphql.ItemAssignees
+	var labels graphql.ItemLabels
+	var milestone *graphql.ItemMilestone
+	var repository string
+	switch item.Content.TypeName {
+	case "Issue":
+		issue := &item.Content.Issue
+		assignees, labels, milestone, repository = issue.Assignees, issue.Labels, issue.Milestone, issue.Repository.NameWithOwner
+	case "PullRequest":
+		pr := &item.Content.PullRequest
+		assignees, labels, milestone, repository = pr.Assignees, pr.Labels, pr.Milestone, pr.Repository.NameWithOwner
+	default:
+		return nil
+	}
+
+	var values []string
+	switch builtin {
+	case builtinAssignees:
+		for _, assignee := range assignees.Nodes {
+			values = append(values, assignee.Login)
+		}
+	case builtinLabels:
+		for _, label := range labels.Nodes {
+			values = append(values, label.Name)
+		}
+	case builtinMilestone:
+		if milestone != nil {
+			values = append(values, milestone.Title)
+		}
+	case builtinRepository:
+		if repository != "" {
+			values = append(values, repository)
+		}
+	}
+	return values
+}
+
+// matchesBuiltinValue compares a value of a built-in field with a filter value;
+// repositories match by owner/name or by name alone
+func matchesBuiltinValue(builtin, value, want string) bool {
+	if strings.EqualFold(value, want) {
+		return true
+	}
+	if builtin == builtinRepository {
+		_, name, _ := strings.Cut(value, "/")
+		return strings.EqualFold(name, want)
+	}
+	return false
+}
+
+// itemHasValue reports whether the item has a value for a field or built-in field
+func itemHasValue(item *graphql.ProjectV2Item, name string) bool {
+	if builtin, ok := builtinFieldName(name); ok {
+		return len(itemBuiltinValues(item, builtin)) > 0
+	}
+	return itemFieldValue(item, name) != ""
+}
+
+func (t *filterTerm) matches(item *graphql.ProjectV2Item) bool {
+	switch t.key {
+	case "":
+		return strings.Contains(strings.ToLower(projectItemTitle(item)), strings.ToLower(t.values[0]))
+	case "title":
+		return anyValue(t.values, func(v string) bool {
+			return strings.Contains(strings.ToLower(projectItemTitle(item)), strings.ToLower(v))
+		})
+	case "is":
+		return anyValue(t.values, func(v string) bool { return matchesItemKind(item, strings.ToLower(v)) })
+	case "has":
+		return anyValue(t.values, func(v string) bool { return itemHasValue(item, v) })
+	case "no":
+		return anyValue(t.values, func(v string) bool { return !itemHasValue(item, v) })
+	case builtinAssignees, builtinLabels, builtinMilestone, builtinRepository:
+		values := itemBuiltinValues(item, t.key)
+		return anyValue(t.values, func(v string) bool {
+			return anyValue(values, func(value string) bool { return matchesBuiltinValue(t.key, value, v) })
+		})
+	default:
+		value := itemFieldValue(item, t.key)
+		if value == "" {
+			return false
+		}
+		return anyValue(t.values, func(v string) bool { return matchesFieldValue(value, v) })
+	}
+}
+
+func matchesItemKind(item *graphql.ProjectV2Item, kind string) bool {
+	switch kind {
+	case "draft":
+		return item.Content.TypeName == "DraftIssue"
+	case "issue":
+		return item.Content.TypeName == "Issue"
+	case "pr":
+		return item.Content.TypeName == "PullRequest"
+	case "open":
+		return item.Content.TypeName != "DraftIssue" && !projectItemClosed(item)
+	case "merged":
+		return item.Content.TypeName == "PullRequest" && item.Content.PullRequest.State == "MERGED"
+	default:
+		return projectItemClosed(item)
+	}
+}
+
+// itemFieldValue returns the value of the item's field with the given name as
+// text, or "" when it has none; hyphens in the name match spaces. The built-in
+// assignees, labels, milestone and repository fields are comma separated lists.
+func itemFieldValue(item *graphql.ProjectV2Item, name string) string {
+	normalized := strings.ReplaceAll(name, "-", " ")
+	for i := range item.FieldValues.Nodes {
+		value := &item.FieldValues.Nodes[i]
+		if strings.EqualFold(strings.ReplaceAll(value.Field.Name, "-", " "), normalized) {
+			return formatExportedValue(exportFieldValue(value))
+		}
+	}
+	if builtin, ok := builtinFieldName(name); ok {
+		return strings.Join(itemBuiltinValues(item, builtin), ", ")
+	}
+	return ""
+}
+
+// matchesFieldValue compares a field value with a filter value, which may start
+// with a comparison operator
+func matchesFieldValue(value, want string) bool {
+	for _, op := range []string{">=", "<=", ">", "<"} {
+		operand, ok := strings.CutPrefix(want, op)
+		if !ok {
+			continue
+		}
+		cmp := compareFilterValues(value, operand)
+		switch op {
+		case ">=":
+			return cmp >= 0
+		case "<=":
+			return cmp <= 0
+		case ">":
+			return cmp > 0
+		default:
+			return cmp < 0
+		}
+	}
+	return strings.EqualFold(value, want)
+}
+
+// compareFilterValues compares numbers numerically and anything else, such as
+// YYYY-MM-DD dates, as text
+func compareFilterValues(a, b string) int {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	if errA == nil && errB == nil {
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		default:
+			return 0
+		}
+	}
+	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+}
+
+func anyValue(values []string, match func(string) bool) bool {
+	for _, v := range values {
+		if match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// cutQualifier splits key:value, ignoring colons inside quotes
+func cutQualifier(token string) (key, value string, ok bool) {
+	if strings.HasPrefix(token, `"`) {
+		return "", "", false
+	}
+	return strings.Cut(token, ":")
+}
+
+// splitFilter splits a filter into terms at spaces outside double quotes
+func splitFilter(filter string) ([]string, error) {
+	if strings.Count(filter, `"`)%2 != 0 {
+		return nil, fmt.Errorf("unterminated quote in filter %q", filter)
+	}
+	var tokens []string
+	for _, token := range splitOutsideQuotes(filter, ' ') {
+		if token = strings.TrimSpace(token); token != "" {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+func splitOutsideQuotes(s string, sep rune) []string {
+	var parts []string
+	var current strings.Builder
+	quoted := false
+	for _, r := range s {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			current.WriteRune(r)
+		case r == sep && !quoted:
+			parts = append(parts, current.String())
+			current.Reset()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	return append(parts, current.String())
+}
+
+func unquote(s string) string {
+	return strings.ReplaceAll(strings.TrimSpace(s), `"`, "")
+}
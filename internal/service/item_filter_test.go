@@ -0,0 +1,134 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+func filterTestItem(typeName, title string, values map[string]interface{}) *graphql.ProjectV2Item {
+	item := &graphql.ProjectV2Item{}
+	item.Content.TypeName = typeName
+	switch typeName {
+	case "Issue":
+		item.Content.Issue.Title = title
+	case "PullRequest":
+		item.Content.PullRequest.Title = title
+	default:
+		item.Content.DraftIssue.Title = title
+	}
+
+	for name, v := range values {
+		var value graphql.ProjectV2ItemFieldValue
+		value.Field.Name = name
+		switch v := v.(type) {
+		case float64:
+			value.NumberValue.Number = &v
+		case string:
+			value.SingleSelectValue.Name = &v
+		}
+		item.FieldValues.Nodes = append(item.FieldValues.Nodes, value)
+	}
+	return item
+}
+
+func TestItemFilter(t *testing.T) {
+	draft := filterTestItem("DraftIssue", "Write release notes", map[string]interface{}{
+		"Status": "In Progress", "Estimate": float64(3),
+	})
+	issue := filterTestItem("Issue", "Fix login", map[string]interface{}{
+		"Status": "Todo", "Due Date": "2026-04-01",
+	})
+
+	tests := []struct {
+		filter string
+		want   []bool
+	}{
+		{"", []bool{true, true}},
+		{"is:draft", []bool{true, false}},
+		{"-is:draft", []bool{false, true}},
+		{`status:Todo,"In progress"`, []bool{true, true}},
+		{"status:todo", []bool{false, true}},
+		{"-status:todo", []bool{true, false}},
+		{"estimate:>2", []bool{true, false}},
+		{"estimate:<=2", []bool{false, false}},
+		{"due-date:<2026-05-01", []bool{false, true}},
+		{"no:estimate", []bool{false, true}},
+		{"has:estimate is:draft", []bool{true, false}},
+		{"release notes", []bool{true, false}},
+		{`title:"fix login"`, []bool{false, true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			filter, err := ParseItemFilter(tt.filter)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, []bool{filter.Matches(draft), filter.Matches(issue)})
+		})
+	}
+
+	t.Run("Rejects invalid filters", func(t *testing.T) {
+		_, err := ParseItemFilter(`status:"Todo`)
+		assert.ErrorContains(t, err, "unterminated quote")
+		_, err = ParseItemFilter("is:epic")
+		assert.ErrorContains(t, err, "unsupported filter is:epic")
+		_, err = ParseItemFilter("status:")
+		assert.ErrorContains(t, err, "has no value")
+	})
+}
+
+func TestItemFilterBuiltinsAndRelativeValues(t *testing.T) {
+	issue := filterTestItem("Issue", "Fix login", map[string]interface{}{"Due": "2026-04-01"})
+	issue.Content.Issue.Assignees.Nodes = append(issue.Content.Issue.Assignees.Nodes, struct {
+		Login string `graphql:"login"`
+	}{Login: "octocat"})
+	issue.Content.Issue.Labels.Nodes = append(issue.Content.Issue.Labels.Nodes, struct {
+		Name string `graphql:"name"`
+	}{Name: "bug"})
+	issue.Content.Issue.Milestone = &graphql.ItemMilestone{Title: "v1"}
+	issue.Content.Issue.Repository.NameWithOwner = "octocat/app"
+
+	pr := filterTestItem("PullRequest", "Add SSO", nil)
+	pr.Content.PullRequest.State = "MERGED"
+	pr.Content.PullRequest.Closed = true
+
+	fc := ItemFilterContext{Now: time.Date(2026, 3, 30, 9, 0, 0, 0, time.UTC), Viewer: "octocat"}
+	tests := []struct {
+		filter string
+		want   []bool
+	}{
+		{"assignee:@me", []bool{true, false}},
+		{"label:bug,docs", []bool{true, false}},
+		{"milestone:v1 repo:app", []bool{true, false}},
+		{"repo:octocat/app", []bool{true, false}},
+		{"no:milestone", []bool{false, true}},
+		{"is:merged", []bool{false, true}},
+		{"due:>@today", []bool{true, false}},
+		{"due:<@today+1w", []bool{true, false}},
+		{"due:<@today", []bool{false, false}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			filter, err := NewItemFilter(tt.filter, fc)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, []bool{filter.Matches(issue), filter.Matches(pr)})
+		})
+	}
+
+	assert.Equal(t, "octocat", itemFieldValue(issue, "Assignees"))
+	assert.Equal(t, "bug", itemFieldValue(issue, "Labels"))
+
+	t.Run("Rejects terms it cannot evaluate", func(t *testing.T) {
+		_, err := NewItemFilter("assignee:@me", ItemFilterContext{Now: fc.Now})
+		assert.ErrorContains(t, err, "@me needs the login of the viewer")
+		_, err = NewItemFilter("reviewers:octocat", ItemFilterContext{Now: fc.Now, Fields: []graphql.ProjectV2Field{{Name: "Status"}}})
+		assert.ErrorContains(t, err, "the project has no field reviewers")
+		_, err = NewItemFilter("status:@current", ItemFilterContext{Now: fc.Now, Fields: []graphql.ProjectV2Field{{Name: "Status"}}})
+		assert.ErrorContains(t, err, "@current needs an iteration field")
+	})
+}
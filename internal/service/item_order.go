@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	gql "github.com/shurcooL/graphql"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// ItemSortKey is a field the items of a project are ordered by
+type ItemSortKey struct {
+	Field      string
+	Descending bool
+}
+
+// ReorderItemsResult represents the result of reordering the items of a project
+type ReorderItemsResult struct {
+	// Order is the titles of the items in their new order
+	Order []string `json:"order"`
+	// Moved is the number of items that were, or with a dry run would be, moved
+	Moved int `json:"moved"`
+}
+
+// PositionedItem is a project item with its position in the manual order
+type PositionedItem struct {
+	SubIssues *graphql.SubIssuesSummary `json:"subIssues,omitempty"`
+	ID        string                    `json:"id"`
+	Type      string                    `json:"type"`
+	Title     string                    `json:"title"`
+	State     string                    `json:"state,omitempty"`
+	URL       string                    `json:"url,omitempty"`
+	ContentID string                    `json:"contentId,omitempty"`
+	// ParentID is the ID of the parent issue of an issue that is a sub-issue
+	ParentID string `json:"parentId,omitempty"`
+	Position int    `json:"position"`
+	Number   int    `json:"number,omitempty"`
+}
+
+// ListPositionedItems returns the items of a project in their manual order,
+// numbered from 1
+func (s *ItemService) ListPositionedItems(ctx context.Context, projectID string) ([]PositionedItem, error) {
+	items, err := s.ListProjectItems(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	positioned := make([]PositionedItem, len(items))
+	for i := range items {
+		positioned[i] = newPositionedItem(&items[i], i+1)
+	}
+	return positioned, nil
+}
+
+func newPositionedItem(item *graphql.ProjectV2Item, position int) PositionedItem {
+	positioned := PositionedItem{
+		ID:       item.ID,
+		Type:     item.Content.TypeName,
+		Title:    projectItemTitle(item),
+		Position: position,
+	}
+	switch item.Content.TypeName {
+	case "Issue":
+		issue := &item.Content.Issue
+		positioned.State = issue.State
+		positioned.URL = issue.URL
+		positioned.Number = issue.Number
+		positioned.ContentID = issue.ID
+		if issue.Parent != nil {
+			positioned.ParentID = issue.Parent.ID
+		}
+		if issue.SubIssuesSummary.Total > 0 {
+			summary := issue.SubIssuesSummary
+			positioned.SubIssues = &summary
+		}
+	case "PullRequest":
+		positioned.State = item.Content.PullRequest.State
+		positioned.URL = item.Content.PullRequest.URL
+		positioned.Number = item.Content.PullRequest.Number
+		positioned.ContentID = item.Content.PullRequest.ID
+	}
+	return positioned
+}
+
+// MoveItem moves an item within the manual order of a project, right after the
+// item afterID or, when afterID is empty, to the top
+func (s *ItemService) MoveItem(ctx context.Context, projectID, itemID, afterID string) error {
+	input := &graphql.UpdateItemPositionInput{
+		ProjectID: gql.ID(projectID),
+		ItemID:    gql.ID(itemID),
+	}
+	if afterID != "" {
+		after := gql.ID(afterID)
+		input.AfterID = &after
+	}
+
+	var mutation graphql.UpdateItemPositionMutation
+	if err := s.client.Mutate(ctx, &mutation, graphql.BuildUpdateItemPositionVariables(input)); err != nil {
+		return fmt.Errorf("failed to move item: %w", err)
+	}
+	return nil
+}
+
+// ParseItemSortKeys parses a comma separated list of field names such as
+// "Priority,-Story Points"; a leading - sorts the field in descending order
+func ParseItemSortKeys(by string) ([]ItemSortKey, error) {
+	var keys []ItemSortKey
+	for _, part := range strings.Split(by, ",") {
+		part = strings.TrimSpace(part)
+		key := ItemSortKey{}
+		if name, ok := strings.CutPrefix(part, "-"); ok {
+			key.Descending = true
+			part = strings.TrimSpace(name)
+		}
+		if part == "" {
+			return nil, fmt.Errorf("invalid sort fields %q: empty field name", by)
+		}
+		key.Field = part
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+// SortProjectItems orders items by the values of the given fields. Single select
+// options sort in the order they are defined in and iterations by start date;
+// items without a value come last, and ties keep their current order.
+func SortProjectItems(project *graphql.ProjectV2, items []graphql.ProjectV2Item, keys []ItemSortKey) ([]graphql.ProjectV2Item, error) {
+	fields := make([]*graphql.ProjectV2Field, len(keys))
+	for i, key := range keys {
+		if fields[i] = projectFieldByName(project, key.Field); fields[i] == nil {
+			return nil, fmt.Errorf("field %s not found in project", key.Field)
+		}
+	}
+
+	sorted := append([]graphql.ProjectV2Item(nil), items...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		for k, field := range fields {
+			a, b := itemSortValue(&sorted[i], field), itemSortValue(&sorted[j], field)
+			switch {
+			case a == nil && b == nil:
+				continue
+			case a == nil:
+				return false
+			case b == nil:
+				return true
+			}
+			cmp := compareSortValues(a, b)
+			if cmp == 0 {
+				continue
+			}
+			if keys[k].Descending {
+				return cmp > 0
+			}
+			return cmp < 0
+		}
+		return false
+	})
+	return sorted, nil
+}
+
+// ReorderItems rewrites the manual order of a project's items from their field
+// values. Items already in a correct relative order stay where they are, so the
+// fewest items are moved and reordering a sorted project changes nothing.
+func (s *ItemService) ReorderItems(ctx context.Context, project *graphql.ProjectV2, keys []ItemSortKey, dryRun bool) (*ReorderItemsResult, error) {
+	items, err := s.ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	sorted, err := SortProjectItems(project, items, keys)
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]int, len(items))
+	for i := range items {
+		current[items[i].ID] = i
+	}
+	positions := make([]int, len(sorted))
+	for i := range sorted {
+		positions[i] = current[sorted[i].ID]
+	}
+	keep := longestIncreasingRun(positions)
+
+	result := &ReorderItemsResult{Order: make([]string, len(sorted))}
+	for i := range sorted {
+		result.Order[i] = projectItemTitle(&sorted[i])
+		if keep[i] {
+			continue
+		}
+		result.Moved++
+		if dryRun {
+			continue
+		}
+		afterID := ""
+		if i > 0 {
+			afterID = sorted[i-1].ID
+		}
+		if err := s.MoveItem(ctx, project.ID, sorted[i].ID, afterID); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// longestIncreasingRun marks the elements of a longest increasing subsequence
+func longestIncreasingRun(values []int) []bool {
+	// tails[k] is the index of the smallest last element of a run of length k+1
+	var tails []int
+	previous := make([]int, len(values))
+	for i, v := range values {
+		k := sort.Search(len(tails), func(k int) bool { return values[tails[k]] >= v })
+		previous[i] = -1
+		if k > 0 {
+			previous[i] = tails[k-1]
+		}
+		if k == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[k] = i
+		}
+	}
+
+	keep := make([]bool, len(values))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = previous[i] {
+			keep[i] = true
+		}
+	}
+	return keep
+}
+
+// itemSortValue returns the value of an item's field as a float64 or a string
+// that sorts in the field's order, or nil when the item has no value
+func itemSortValue(item *graphql.ProjectV2Item, field *graphql.ProjectV2Field) interface{} {
+	for i := range item.FieldValues.Nodes {
+		value := &item.FieldValues.Nodes[i]
+		if value.Field.ID != field.ID {
+			continue
+		}
+		switch {
+		case value.SingleSelectValue.OptionID != nil:
+			for j, option := range field.SingleSelect.Options {
+				if option.ID == *value.SingleSelectValue.OptionID {
+					return float64(j)
+				}
+			}
+			return float64(len(field.SingleSelect.Options))
+		case value.IterationValue.StartDate != nil:
+			return *value.IterationValue.StartDate
+		case value.NumberValue.Number != nil:
+			return *value.NumberValue.Number
+		}
+		if text := formatExportedValue(exportFieldValue(value)); text != "" {
+			return strings.ToLower(text)
+		}
+		return nil
+	}
+	return nil
+}
+
+// compareSortValues compares two values of the same field
+func compareSortValues(a, b interface{}) int {
+	x, okA := a.(float64)
+	y, okB := b.(float64)
+	if !okA || !okB {
+		return strings.Compare(formatExportedValue(a), formatExportedValue(b))
+	}
+	switch {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	default:
+		return 0
+	}
+}
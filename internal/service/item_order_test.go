@@ -0,0 +1,100 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestParseItemSortKeys(t *testing.T) {
+	keys, err := ParseItemSortKeys("Priority, -Story Points")
+	require.NoError(t, err)
+	assert.Equal(t, []ItemSortKey{{Field: "Priority"}, {Field: "Story Points", Descending: true}}, keys)
+
+	_, err = ParseItemSortKeys("Priority,,Status")
+	assert.ErrorContains(t, err, "empty field name")
+}
+
+func TestLongestIncreasingRun(t *testing.T) {
+	assert.Equal(t, []bool{true, true, true}, longestIncreasingRun([]int{0, 1, 2}))
+	assert.Equal(t, []bool{true, true, false}, longestIncreasingRun([]int{1, 2, 0}))
+	assert.Equal(t, []bool{false, true, true, true}, longestIncreasingRun([]int{3, 0, 1, 2}))
+	assert.Empty(t, longestIncreasingRun(nil))
+}
+
+func TestItemOrderAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	project := store.AddProject(fake.DefaultViewer, "Backlog")
+	priority := store.AddField(project, "Priority", "SINGLE_SELECT", "High", "Medium", "Low")
+	points := store.AddField(project, "Story Points", "NUMBER")
+
+	add := func(title, option string, estimate float64) *fake.Item {
+		item := store.AddDraftIssue(project, title, "")
+		if option != "" {
+			store.SetValue(item, priority, fake.Value{OptionID: priority.Option(option).ID})
+		}
+		store.SetValue(item, points, fake.Value{Number: estimate})
+		return item
+	}
+	low := add("Polish", "Low", 1)
+	small := add("Fix typo", "High", 1)
+	none := add("Someday", "", 8)
+	big := add("Rewrite auth", "High", 5)
+	medium := add("Add search", "Medium", 3)
+
+	ctx := context.Background()
+	client := api.NewClient("ghp_fake")
+	itemService := NewItemService(client)
+	order := func() []*fake.Item { return append([]*fake.Item(nil), project.Items...) }
+
+	t.Run("Moves items after another item and to the top", func(t *testing.T) {
+		require.NoError(t, itemService.MoveItem(ctx, project.ID, low.ID, none.ID))
+		assert.Equal(t, []*fake.Item{small, none, low, big, medium}, order())
+		require.NoError(t, itemService.MoveItem(ctx, project.ID, low.ID, ""))
+		assert.Equal(t, []*fake.Item{low, small, none, big, medium}, order())
+	})
+
+	projectService := NewProjectService(client)
+	graphqlProject, err := projectService.GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+	require.NoError(t, err)
+	keys, err := ParseItemSortKeys("Priority,-Story Points")
+	require.NoError(t, err)
+
+	t.Run("Dry run moves nothing", func(t *testing.T) {
+		result, err := itemService.ReorderItems(ctx, graphqlProject, keys, true)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Rewrite auth", "Fix typo", "Add search", "Polish", "Someday"}, result.Order)
+		assert.Equal(t, []*fake.Item{low, small, none, big, medium}, order())
+	})
+
+	t.Run("Reorders by field values with the fewest moves", func(t *testing.T) {
+		result, err := itemService.ReorderItems(ctx, graphqlProject, keys, false)
+		require.NoError(t, err)
+		assert.Equal(t, []*fake.Item{big, small, medium, low, none}, order())
+		assert.Equal(t, 3, result.Moved)
+
+		result, err = itemService.ReorderItems(ctx, graphqlProject, keys, false)
+		require.NoError(t, err)
+		assert.Zero(t, result.Moved)
+
+		positioned, err := itemService.ListPositionedItems(ctx, project.ID)
+		require.NoError(t, err)
+		require.Len(t, positioned, 5)
+		assert.Equal(t, PositionedItem{ID: big.ID, Type: "DraftIssue", Title: "Rewrite auth", Position: 1}, positioned[0])
+		assert.Equal(t, 5, positioned[4].Position)
+	})
+
+	t.Run("Rejects unknown fields", func(t *testing.T) {
+		_, err := itemService.ReorderItems(ctx, graphqlProject, []ItemSortKey{{Field: "Effort"}}, true)
+		assert.ErrorContains(t, err, "field Effort not found")
+	})
+}
@@ -0,0 +1,386 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	gql "github.com/shurcooL/graphql"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// DateLayout is the date format used by GitHub Projects
+const DateLayout = "2006-01-02"
+
+// Iteration references resolved relative to today
+const (
+	IterationRefCurrent  = "@current"
+	IterationRefNext     = "@next"
+	IterationRefPrevious = "@previous"
+)
+
+// Iteration states
+const (
+	IterationStateCompleted = "completed"
+	IterationStateCurrent   = "current"
+	IterationStateUpcoming  = "upcoming"
+)
+
+const (
+	defaultIterationDuration = 14
+	defaultIterationCount    = 3
+	maxIterationDuration     = 99
+	daysPerWeek              = 7
+)
+
+// IterationInfo represents a single iteration of an iteration field
+type IterationInfo struct {
+	StartDate time.Time
+	ID        string
+	Title     string
+	Duration  int
+}
+
+// EndDate returns the first day after the iteration
+func (i IterationInfo) EndDate() time.Time {
+	return i.StartDate.AddDate(0, 0, i.Duration)
+}
+
+// State returns whether the iteration is completed, current or upcoming on the given day
+func (i IterationInfo) State(now time.Time) string {
+	today := truncateDay(now)
+	switch {
+	case !i.EndDate().After(today):
+		return IterationStateCompleted
+	case i.StartDate.After(today):
+		return IterationStateUpcoming
+	default:
+		return IterationStateCurrent
+	}
+}
+
+// IterationFieldInfo represents an iteration field with its iterations sorted by start date
+type IterationFieldInfo struct {
+	ID         string
+	Name       string
+	Iterations []IterationInfo
+	Duration   int
+}
+
+// IterationsFromField returns the completed and active iterations of a field sorted by start date
+func IterationsFromField(field *graphql.ProjectV2Field) ([]IterationInfo, error) {
+	config := field.Iteration.Configuration
+	all := append(append([]graphql.ProjectV2IterationFieldIteration{}, config.CompletedIterations...), config.Iterations...)
+
+	iterations := make([]IterationInfo, 0, len(all))
+	for _, iteration := range all {
+		start, err := time.Parse(DateLayout, iteration.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start date %q for iteration %s: %w", iteration.StartDate, iteration.Title, err)
+		}
+		iterations = append(iterations, IterationInfo{
+			ID:        iteration.ID,
+			Title:     iteration.Title,
+			StartDate: start,
+			Duration:  iteration.Duration,
+		})
+	}
+	sortIterations(iterations)
+	return iterations, nil
+}
+
+// GetField gets a project field by ID
+func (s *FieldService) GetField(ctx context.Context, fieldID string) (*graphql.ProjectV2Field, error) {
+	var query graphql.GetFieldQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetFieldVariables(fieldID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get field: %w", err)
+	}
+	if query.Node.Field.ID == "" {
+		return nil, fmt.Errorf("field %s not found", fieldID)
+	}
+
+	return &query.Node.Field, nil
+}
+
+// GetIterationField gets an iteration field and its iterations
+func (s *FieldService) GetIterationField(ctx context.Context, fieldID string) (*IterationFieldInfo, error) {
+	field, err := s.GetField(ctx, fieldID)
+	if err != nil {
+		return nil, err
+	}
+	return NewIterationFieldInfo(field)
+}
+
+// SetIterations replaces the iterations of an iteration field. Iteration inputs carry
+// no ID, so GitHub creates every iteration anew: all iterations get new IDs and every
+// item of the project loses its value for the field, even for unchanged iterations.
+func (s *FieldService) SetIterations(
+	ctx context.Context,
+	fieldID string,
+	duration int,
+	iterations []IterationInfo,
+) (*IterationFieldInfo, error) {
+	config, err := BuildIterationConfiguration(duration, iterations)
+	if err != nil {
+		return nil, err
+	}
+
+	variables := graphql.BuildUpdateFieldVariables(&graphql.UpdateFieldInput{
+		FieldID:                gql.ID(fieldID),
+		IterationConfiguration: config,
+	})
+
+	var mutation graphql.UpdateFieldMutation
+	err = s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update iterations: %w", err)
+	}
+
+	return NewIterationFieldInfo(&mutation.UpdateProjectV2Field.ProjectV2Field)
+}
+
+// CountIterationItems returns how many items of the field's project have a value for
+// the iteration field, i.e. how many items lose their value when its iterations change
+func (s *FieldService) CountIterationItems(ctx context.Context, fieldID string) (int, error) {
+	var query graphql.GetFieldProjectQuery
+	if err := s.client.Query(ctx, &query, graphql.BuildGetFieldVariables(fieldID)); err != nil {
+		return 0, fmt.Errorf("failed to get field project: %w", err)
+	}
+	if query.Node.Field.Project.ID == "" {
+		return 0, fmt.Errorf("field %s not found", fieldID)
+	}
+
+	items, err := NewItemService(s.client).ListProjectItems(ctx, query.Node.Field.Project.ID)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for i := range items {
+		for _, value := range items[i].FieldValues.Nodes {
+			if value.Field.ID == fieldID && value.IterationValue.IterationID != nil {
+				count++
+				break
+			}
+		}
+	}
+	return count, nil
+}
+
+// BuildIterationConfiguration builds the iteration configuration input for a field
+func BuildIterationConfiguration(duration int, iterations []IterationInfo) (*graphql.IterationConfigurationInput, error) {
+	if duration <= 0 || duration > maxIterationDuration {
+		return nil, fmt.Errorf("iteration duration must be between 1 and %d days", maxIterationDuration)
+	}
+	if len(iterations) == 0 {
+		return nil, fmt.Errorf("an iteration field needs at least one iteration")
+	}
+
+	sorted := append([]IterationInfo{}, iterations...)
+	sortIterations(sorted)
+	if err := validateIterations(sorted); err != nil {
+		return nil, err
+	}
+
+	inputs := make([]graphql.IterationInput, len(sorted))
+	for i, iteration := range sorted {
+		inputs[i] = graphql.IterationInput{
+			Title:     gql.String(iteration.Title),
+			StartDate: gql.String(iteration.StartDate.Format(DateLayout)),
+			Duration:  gql.Int(iteration.Duration), //nolint:gosec // duration is validated above
+		}
+	}
+
+	return &graphql.IterationConfigurationInput{
+		StartDate:  gql.String(sorted[0].StartDate.Format(DateLayout)),
+		Duration:   gql.Int(duration), //nolint:gosec // duration is validated above
+		Iterations: inputs,
+	}, nil
+}
+
+// NewIterationConfiguration builds the configuration of a new iteration field with
+// count iterations of the given duration starting at start
+func NewIterationConfiguration(start time.Time, duration, count int) (*graphql.IterationConfigurationInput, error) {
+	if duration == 0 {
+		duration = defaultIterationDuration
+	}
+	if count == 0 {
+		count = defaultIterationCount
+	}
+	return BuildIterationConfiguration(duration, AppendIterations(nil, IterationInfo{
+		StartDate: truncateDay(start),
+		Duration:  duration,
+	}, count, 0))
+}
+
+// AppendIterations appends count iterations after the last one, separated by breakDays.
+// The template provides the duration, an optional start date for the first new
+// iteration and an optional title; untitled iterations are numbered "Iteration N".
+func AppendIterations(iterations []IterationInfo, template IterationInfo, count, breakDays int) []IterationInfo {
+	start := template.StartDate
+	if start.IsZero() && len(iterations) > 0 {
+		start = iterations[len(iterations)-1].EndDate().AddDate(0, 0, breakDays)
+	}
+
+	next := nextIterationNumber(iterations)
+	result := append([]IterationInfo{}, iterations...)
+	for i := 0; i < count; i++ {
+		title := template.Title
+		switch {
+		case title == "":
+			title = fmt.Sprintf("Iteration %d", next+i)
+		case count > 1:
+			title = fmt.Sprintf("%s %d", title, i+1)
+		}
+		result = append(result, IterationInfo{
+			Title:     title,
+			StartDate: start,
+			Duration:  template.Duration,
+		})
+		start = start.AddDate(0, 0, template.Duration)
+	}
+	return result
+}
+
+// ShiftIterations moves every iteration starting on or after from by days
+func ShiftIterations(iterations []IterationInfo, from time.Time, days int) []IterationInfo {
+	result := append([]IterationInfo{}, iterations...)
+	for i := range result {
+		if !result[i].StartDate.Before(from) {
+			result[i].StartDate = result[i].StartDate.AddDate(0, 0, days)
+		}
+	}
+	return result
+}
+
+// ResolveIteration finds an iteration by ID, title (case-insensitive) or one of the
+// relative references @current, @next and @previous
+func ResolveIteration(iterations []IterationInfo, ref string, now time.Time) (*IterationInfo, error) {
+	sorted := append([]IterationInfo{}, iterations...)
+	sortIterations(sorted)
+
+	switch strings.ToLower(ref) {
+	case IterationRefCurrent:
+		for i := range sorted {
+			if sorted[i].State(now) == IterationStateCurrent {
+				return &sorted[i], nil
+			}
+		}
+		return nil, fmt.Errorf("no iteration is in progress")
+	case IterationRefNext:
+		for i := range sorted {
+			if sorted[i].State(now) == IterationStateUpcoming {
+				return &sorted[i], nil
+			}
+		}
+		return nil, fmt.Errorf("no upcoming iteration")
+	case IterationRefPrevious:
+		for i := len(sorted) - 1; i >= 0; i-- {
+			if sorted[i].State(now) == IterationStateCompleted {
+				return &sorted[i], nil
+			}
+		}
+		return nil, fmt.Errorf("no completed iteration")
+	}
+
+	for i := range sorted {
+		if sorted[i].ID == ref || strings.EqualFold(sorted[i].Title, ref) {
+			return &sorted[i], nil
+		}
+	}
+	return nil, fmt.Errorf("iteration %q not found", ref)
+}
+
+// ParseIterationDuration parses an iteration duration in days ("14", "10d") or weeks ("2w")
+func ParseIterationDuration(duration string) (int, error) {
+	duration = strings.ToLower(strings.TrimSpace(duration))
+	multiplier := 1
+	switch {
+	case strings.HasSuffix(duration, "w"):
+		multiplier = daysPerWeek
+		duration = strings.TrimSuffix(duration, "w")
+	case strings.HasSuffix(duration, "d"):
+		duration = strings.TrimSuffix(duration, "d")
+	}
+
+	n, err := strconv.Atoi(duration)
+	if err != nil || n <= 0 || n*multiplier > maxIterationDuration {
+		return 0, fmt.Errorf("invalid iteration duration: %s (use days or weeks like 10d or 2w, up to %d days)",
+			duration, maxIterationDuration)
+	}
+	return n * multiplier, nil
+}
+
+// Today returns the current date at midnight UTC, the way iteration dates are compared
+func Today() time.Time {
+	return truncateDay(time.Now())
+}
+
+// ParseDate parses a date in YYYY-MM-DD format
+func ParseDate(date string) (time.Time, error) {
+	parsed, err := time.Parse(DateLayout, date)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date: %s (expected YYYY-MM-DD)", date)
+	}
+	return parsed, nil
+}
+
+// NewIterationFieldInfo returns the iterations of an iteration field
+func NewIterationFieldInfo(field *graphql.ProjectV2Field) (*IterationFieldInfo, error) {
+	if field.DataType != graphql.ProjectV2FieldDataTypeIteration {
+		return nil, fmt.Errorf("field %s is not an iteration field", field.Name)
+	}
+
+	iterations, err := IterationsFromField(field)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IterationFieldInfo{
+		ID:         field.ID,
+		Name:       field.Name,
+		Duration:   field.Iteration.Configuration.Duration,
+		Iterations: iterations,
+	}, nil
+}
+
+// nextIterationNumber returns the number following the highest "Iteration N" title
+func nextIterationNumber(iterations []IterationInfo) int {
+	highest := 0
+	for _, iteration := range iterations {
+		if n, err := strconv.Atoi(strings.TrimPrefix(iteration.Title, "Iteration ")); err == nil && n > highest {
+			highest = n
+		}
+	}
+	return highest + 1
+}
+
+func validateIterations(sorted []IterationInfo) error {
+	for i, iteration := range sorted {
+		if strings.TrimSpace(iteration.Title) == "" {
+			return fmt.Errorf("iteration titles cannot be empty")
+		}
+		if iteration.Duration <= 0 || iteration.Duration > maxIterationDuration {
+			return fmt.Errorf("iteration %s: duration must be between 1 and %d days", iteration.Title, maxIterationDuration)
+		}
+		if i > 0 && sorted[i-1].EndDate().After(iteration.StartDate) {
+			return fmt.Errorf("iteration %s overlaps %s", iteration.Title, sorted[i-1].Title)
+		}
+	}
+	return nil
+}
+
+func sortIterations(iterations []IterationInfo) {
+	sort.SliceStable(iterations, func(i, j int) bool {
+		return iterations[i].StartDate.Before(iterations[j].StartDate)
+	})
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,181 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+func date(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := ParseDate(value)
+	require.NoError(t, err)
+	return parsed
+}
+
+func sprints(t *testing.T) []IterationInfo {
+	return []IterationInfo{
+		{ID: "a", Title: "Iteration 1", StartDate: date(t, "2025-01-06"), Duration: 14},
+		{ID: "b", Title: "Iteration 2", StartDate: date(t, "2025-01-20"), Duration: 14},
+		{ID: "c", Title: "Iteration 3", StartDate: date(t, "2025-02-10"), Duration: 14},
+	}
+}
+
+func TestIterationState(t *testing.T) {
+	iteration := sprints(t)[1]
+
+	assert.Equal(t, IterationStateUpcoming, iteration.State(date(t, "2025-01-19")))
+	assert.Equal(t, IterationStateCurrent, iteration.State(date(t, "2025-01-20")))
+	assert.Equal(t, IterationStateCurrent, iteration.State(date(t, "2025-02-02").Add(23*time.Hour)))
+	assert.Equal(t, IterationStateCompleted, iteration.State(date(t, "2025-02-03")))
+}
+
+func TestResolveIteration(t *testing.T) {
+	iterations := sprints(t)
+	now := date(t, "2025-01-25")
+
+	t.Run("Relative references", func(t *testing.T) {
+		current, err := ResolveIteration(iterations, "@current", now)
+		require.NoError(t, err)
+		assert.Equal(t, "b", current.ID)
+
+		next, err := ResolveIteration(iterations, "@next", now)
+		require.NoError(t, err)
+		assert.Equal(t, "c", next.ID)
+
+		previous, err := ResolveIteration(iterations, "@previous", now)
+		require.NoError(t, err)
+		assert.Equal(t, "a", previous.ID)
+	})
+
+	t.Run("Title and ID", func(t *testing.T) {
+		byTitle, err := ResolveIteration(iterations, "iteration 3", now)
+		require.NoError(t, err)
+		assert.Equal(t, "c", byTitle.ID)
+
+		byID, err := ResolveIteration(iterations, "a", now)
+		require.NoError(t, err)
+		assert.Equal(t, "Iteration 1", byID.Title)
+	})
+
+	t.Run("No current iteration during a break", func(t *testing.T) {
+		_, err := ResolveIteration(iterations, "@current", date(t, "2025-02-05"))
+		assert.ErrorContains(t, err, "no iteration is in progress")
+
+		_, err = ResolveIteration(iterations, "Sprint 9", now)
+		assert.ErrorContains(t, err, "not found")
+	})
+}
+
+func TestAppendIterations(t *testing.T) {
+	t.Run("Continues numbering after the last iteration", func(t *testing.T) {
+		result := AppendIterations(sprints(t), IterationInfo{Duration: 7}, 2, 0)
+
+		require.Len(t, result, 5)
+		assert.Equal(t, "Iteration 4", result[3].Title)
+		assert.Equal(t, date(t, "2025-02-24"), result[3].StartDate)
+		assert.Equal(t, "Iteration 5", result[4].Title)
+		assert.Equal(t, date(t, "2025-03-03"), result[4].StartDate)
+	})
+
+	t.Run("Leaves a break before the new iterations", func(t *testing.T) {
+		result := AppendIterations(sprints(t), IterationInfo{Title: "Hardening", Duration: 14}, 1, 7)
+
+		assert.Equal(t, "Hardening", result[3].Title)
+		assert.Equal(t, date(t, "2025-03-03"), result[3].StartDate)
+	})
+}
+
+func TestShiftIterations(t *testing.T) {
+	iterations := sprints(t)
+	shifted := ShiftIterations(iterations, iterations[1].StartDate, 7)
+
+	assert.Equal(t, date(t, "2025-01-06"), shifted[0].StartDate)
+	assert.Equal(t, date(t, "2025-01-27"), shifted[1].StartDate)
+	assert.Equal(t, date(t, "2025-02-17"), shifted[2].StartDate)
+	assert.Equal(t, date(t, "2025-01-20"), iterations[1].StartDate, "input is not modified")
+}
+
+func TestBuildIterationConfiguration(t *testing.T) {
+	t.Run("Starts at the first iteration", func(t *testing.T) {
+		config, err := BuildIterationConfiguration(14, sprints(t))
+		require.NoError(t, err)
+
+		assert.EqualValues(t, "2025-01-06", config.StartDate)
+		assert.EqualValues(t, 14, config.Duration)
+		require.Len(t, config.Iterations, 3)
+		assert.EqualValues(t, "Iteration 3", config.Iterations[2].Title)
+	})
+
+	t.Run("Rejects overlapping iterations", func(t *testing.T) {
+		iterations := sprints(t)
+		iterations[0].Duration = 21
+
+		_, err := BuildIterationConfiguration(14, iterations)
+		assert.ErrorContains(t, err, "overlaps")
+	})
+}
+
+func TestParseIterationDuration(t *testing.T) {
+	for input, expected := range map[string]int{"14": 14, "10d": 10, "2w": 14, "3W": 21} {
+		days, err := ParseIterationDuration(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, days, input)
+	}
+
+	for _, input := range []string{"", "0", "1m", "15w", "abc"} {
+		_, err := ParseIterationDuration(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestBuildFieldValue(t *testing.T) {
+	now := date(t, "2025-01-25")
+
+	t.Run("Values match the field type", func(t *testing.T) {
+		text := &graphql.ProjectV2Field{Name: "Notes", DataType: graphql.ProjectV2FieldDataTypeText}
+		value, err := BuildFieldValue(text, "hello", now)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"text": "hello"}, value)
+
+		number := &graphql.ProjectV2Field{Name: "Points", DataType: graphql.ProjectV2FieldDataTypeNumber}
+		value, err = BuildFieldValue(number, "3.5", now)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"number": 3.5}, value)
+
+		_, err = BuildFieldValue(number, "many", now)
+		assert.Error(t, err)
+	})
+
+	t.Run("Single select options are matched by name", func(t *testing.T) {
+		field := &graphql.ProjectV2Field{Name: "Status", DataType: graphql.ProjectV2FieldDataTypeSingleSelect}
+		field.SingleSelect.Options = []graphql.ProjectV2SingleSelectFieldOption{{ID: "opt1", Name: "In Progress"}}
+
+		value, err := BuildFieldValue(field, "in progress", now)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"singleSelectOptionId": "opt1"}, value)
+	})
+
+	t.Run("Iterations resolve relative references", func(t *testing.T) {
+		field := &graphql.ProjectV2Field{Name: "Sprint", DataType: graphql.ProjectV2FieldDataTypeIteration}
+		field.Iteration.Configuration.CompletedIterations = []graphql.ProjectV2IterationFieldIteration{
+			{ID: "a", Title: "Iteration 1", StartDate: "2025-01-06", Duration: 14},
+		}
+		field.Iteration.Configuration.Iterations = []graphql.ProjectV2IterationFieldIteration{
+			{ID: "b", Title: "Iteration 2", StartDate: "2025-01-20", Duration: 14},
+			{ID: "c", Title: "Iteration 3", StartDate: "2025-02-03", Duration: 14},
+		}
+
+		value, err := BuildFieldValue(field, "@next", now)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"iterationId": "c"}, value)
+
+		value, err = BuildFieldValue(field, "Iteration 1", now)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"iterationId": "a"}, value)
+	})
+}
@@ -0,0 +1,347 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+)
+
+// MCPProtocolVersion is the latest Model Context Protocol version the server speaks
+const MCPProtocolVersion = "2025-06-18"
+
+const (
+	mcpJSONRPCVersion = "2.0"
+	mcpServerName     = "ghx"
+	// maxMCPMessage is the size of the largest message read from the client
+	maxMCPMessage = 10 << 20
+)
+
+// JSON-RPC error codes
+const (
+	mcpParseError     = -32700
+	mcpInvalidRequest = -32600
+	mcpMethodNotFound = -32601
+	mcpInvalidParams  = -32602
+)
+
+// mcpProtocolVersions lists the protocol versions the server accepts, newest first
+var mcpProtocolVersions = []string{MCPProtocolVersion, "2025-03-26", "2024-11-05"}
+
+// MCPTool is a tool exposed by the MCP server
+type MCPTool struct {
+	InputSchema map[string]interface{}
+	call        func(ctx context.Context, args json.RawMessage) (interface{}, error)
+	Name        string
+	Description string
+	// ReadOnly tools do not change anything on GitHub
+	ReadOnly bool
+	// Destructive tools overwrite or undo existing data rather than only adding to it
+	Destructive bool
+}
+
+// MCPServerOptions represents options for creating an MCP server
+type MCPServerOptions struct {
+	Version string
+	// Tools allows only the named tools; all tools are allowed when empty
+	Tools []string
+	// ReadOnly leaves out tools that change anything, even when they are allowed
+	ReadOnly bool
+}
+
+// MCPServer serves ghx operations as Model Context Protocol tools over a stream
+// of newline-delimited JSON-RPC messages
+type MCPServer struct {
+	tools   map[string]*MCPTool
+	names   []string
+	version string
+}
+
+type mcpRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	Method  string          `json:"method"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type mcpResponse struct {
+	Result  interface{}     `json:"result,omitempty"`
+	Error   *mcpError       `json:"error,omitempty"`
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+}
+
+type mcpError struct {
+	Message string `json:"message"`
+	Code    int    `json:"code"`
+}
+
+type mcpToolResult struct {
+	Content []mcpContent `json:"content"`
+	IsError bool         `json:"isError,omitempty"`
+}
+
+type mcpContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type mcpToolInfo struct {
+	InputSchema map[string]interface{} `json:"inputSchema"`
+	Annotations map[string]interface{} `json:"annotations"`
+	Name        string                 `json:"name"`
+	Description string                 `json:"description"`
+}
+
+// NewMCPServer creates an MCP server with the tools allowed by opts
+func NewMCPServer(client *api.Client, opts MCPServerOptions) (*MCPServer, error) {
+	tools, err := SelectMCPTools(MCPTools(client), opts.Tools, opts.ReadOnly)
+	if err != nil {
+		return nil, err
+	}
+	if len(tools) == 0 {
+		return nil, fmt.Errorf("no tools enabled")
+	}
+
+	s := &MCPServer{tools: map[string]*MCPTool{}, version: opts.Version}
+	for i := range tools {
+		s.tools[tools[i].Name] = &tools[i]
+		s.names = append(s.names, tools[i].Name)
+	}
+	sort.Strings(s.names)
+	return s, nil
+}
+
+// SelectMCPTools returns the tools named in the allowlist, or all tools when it
+// is empty, leaving out tools that change anything in read-only mode
+func SelectMCPTools(tools []MCPTool, allowlist []string, readOnly bool) ([]MCPTool, error) {
+	known := make(map[string]bool, len(tools))
+	for i := range tools {
+		known[tools[i].Name] = true
+	}
+	allowed := make(map[string]bool, len(allowlist))
+	for _, name := range allowlist {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown tool: %s", name)
+		}
+		allowed[name] = true
+	}
+
+	var selected []MCPTool
+	for i := range tools {
+		if (len(allowed) > 0 && !allowed[tools[i].Name]) || (readOnly && !tools[i].ReadOnly) {
+			continue
+		}
+		selected = append(selected, tools[i])
+	}
+	return selected, nil
+}
+
+// ToolNames returns the names of the enabled tools
+func (s *MCPServer) ToolNames() []string {
+	return s.names
+}
+
+// Serve reads requests from r and writes responses to w until r is exhausted or
+// ctx is done. Requests are handled one at a time; notifications get no response.
+// r is read in a separate goroutine so that Serve returns as soon as ctx is done,
+// even while a read is blocked; that goroutine ends with the next read.
+func (s *MCPServer) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	lines := make(chan []byte)
+	readErr := make(chan error, 1)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxMCPMessage)
+		for scanner.Scan() {
+			line := append([]byte(nil), scanner.Bytes()...)
+			select {
+			case lines <- line:
+			case <-ctx.Done():
+				return
+			}
+		}
+		readErr <- scanner.Err()
+	}()
+
+	encoder := json.NewEncoder(w)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case line, ok := <-lines:
+			if !ok {
+				if err := <-readErr; err != nil {
+					return fmt.Errorf("failed to read request: %w", err)
+				}
+				return nil
+			}
+			line = bytes.TrimSpace(line)
+			if len(line) == 0 {
+				continue
+			}
+			if resp := s.handle(ctx, line); resp != nil {
+				if err := encoder.Encode(resp); err != nil {
+					return fmt.Errorf("failed to write response: %w", err)
+				}
+			}
+		}
+	}
+}
+
+// handle handles one message, returning nil for notifications
+func (s *MCPServer) handle(ctx context.Context, message []byte) *mcpResponse {
+	var req mcpRequest
+	if err := json.Unmarshal(message, &req); err != nil {
+		return mcpErrorResponse(nil, mcpParseError, "parse error: "+err.Error())
+	}
+	notification := len(req.ID) == 0 || string(req.ID) == "null"
+	if req.JSONRPC != mcpJSONRPCVersion || req.Method == "" {
+		if notification {
+			return nil
+		}
+		return mcpErrorResponse(req.ID, mcpInvalidRequest, "invalid request")
+	}
+
+	var result interface{}
+	var err *mcpError
+	switch req.Method {
+	case "initialize":
+		result, err = s.initialize(req.Params)
+	case "ping":
+		result = map[string]interface{}{}
+	case "tools/list":
+		result = s.listTools()
+	case "tools/call":
+		result, err = s.callTool(ctx, req.Params)
+	default:
+		if strings.HasPrefix(req.Method, "notifications/") {
+			return nil
+		}
+		err = &mcpError{Code: mcpMethodNotFound, Message: "method not found: " + req.Method}
+	}
+
+	if notification {
+		return nil
+	}
+	if err != nil {
+		return mcpErrorResponse(req.ID, err.Code, err.Message)
+	}
+	return &mcpResponse{JSONRPC: mcpJSONRPCVersion, ID: req.ID, Result: result}
+}
+
+func mcpErrorResponse(id json.RawMessage, code int, message string) *mcpResponse {
+	if len(id) == 0 {
+		id = json.RawMessage("null")
+	}
+	return &mcpResponse{JSONRPC: mcpJSONRPCVersion, ID: id, Error: &mcpError{Code: code, Message: message}}
+}
+
+// initialize answers with the client's protocol version when it is supported and
+// the latest version otherwise
+func (s *MCPServer) initialize(params json.RawMessage) (interface{}, *mcpError) {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	if len(params) > 0 {
+		if err := json.Unmarshal(params, &p); err != nil {
+			return nil, &mcpError{Code: mcpInvalidParams, Message: "invalid params: " + err.Error()}
+		}
+	}
+	version := MCPProtocolVersion
+	if containsFold(mcpProtocolVersions, p.ProtocolVersion) {
+		version = p.ProtocolVersion
+	}
+
+	return map[string]interface{}{
+		"protocolVersion": version,
+		"capabilities": map[string]interface{}{
+			"tools": map[string]interface{}{"listChanged": false},
+		},
+		"serverInfo": map[string]interface{}{
+			"name":    mcpServerName,
+			"version": s.version,
+		},
+		"instructions": "Projects are referenced as owner/number, repositories as owner/repo and " +
+			"issues or pull requests as owner/repo#number.",
+	}, nil
+}
+
+func (s *MCPServer) listTools() interface{} {
+	tools := make([]mcpToolInfo, 0, len(s.names))
+	for _, name := range s.names {
+		tool := s.tools[name]
+		tools = append(tools, mcpToolInfo{
+			Name:        tool.Name,
+			Description: tool.Description,
+			InputSchema: tool.InputSchema,
+			Annotations: map[string]interface{}{
+				"readOnlyHint":    tool.ReadOnly,
+				"destructiveHint": tool.Destructive,
+				"openWorldHint":   true,
+			},
+		})
+	}
+	return map[string]interface{}{"tools": tools}
+}
+
+// callTool runs a tool. Failures of the tool itself are reported in the result so
+// the model can see them; unknown tools are protocol errors.
+func (s *MCPServer) callTool(ctx context.Context, params json.RawMessage) (interface{}, *mcpError) {
+	var p struct {
+		Arguments json.RawMessage `json:"arguments"`
+		Name      string          `json:"name"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil {
+		return nil, &mcpError{Code: mcpInvalidParams, Message: "invalid params: " + err.Error()}
+	}
+	tool, ok := s.tools[p.Name]
+	if !ok {
+		return nil, &mcpError{Code: mcpInvalidParams, Message: "unknown tool: " + p.Name}
+	}
+	if len(p.Arguments) == 0 || string(p.Arguments) == "null" {
+		p.Arguments = json.RawMessage("{}")
+	}
+
+	output, err := tool.call(ctx, p.Arguments)
+	if err != nil {
+		return mcpToolResult{IsError: true, Content: []mcpContent{{Type: "text", Text: err.Error()}}}, nil
+	}
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return mcpToolResult{IsError: true, Content: []mcpContent{{Type: "text", Text: "failed to marshal result: " + err.Error()}}}, nil
+	}
+	return mcpToolResult{Content: []mcpContent{{Type: "text", Text: string(data)}}}, nil
+}
+
+// decodeMCPArguments decodes tool arguments, rejecting unknown arguments and
+// checking that the required ones are set
+func decodeMCPArguments(args json.RawMessage, v interface{}, required ...string) error {
+	decoder := json.NewDecoder(bytes.NewReader(args))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+
+	var present map[string]json.RawMessage
+	if err := json.Unmarshal(args, &present); err != nil {
+		return fmt.Errorf("invalid arguments: %w", err)
+	}
+	var missing []string
+	for _, name := range required {
+		if value, ok := present[name]; !ok || string(value) == "null" || string(value) == `""` {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		return errors.New("missing required arguments: " + strings.Join(missing, ", "))
+	}
+	return nil
+}
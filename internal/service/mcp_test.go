@@ -0,0 +1,254 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+type mcpTestResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *mcpError       `json:"error"`
+	ID     json.RawMessage `json:"id"`
+}
+
+// mcpSession sends messages to a server and returns its responses
+func mcpSession(t *testing.T, server *MCPServer, messages ...string) []mcpTestResponse {
+	t.Helper()
+	var out bytes.Buffer
+	require.NoError(t, server.Serve(context.Background(), strings.NewReader(strings.Join(messages, "\n")+"\n"), &out))
+
+	var responses []mcpTestResponse
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var resp mcpTestResponse
+		require.NoError(t, json.Unmarshal([]byte(line), &resp), line)
+		responses = append(responses, resp)
+	}
+	return responses
+}
+
+// mcpCall calls a tool and returns the text of its result and whether it failed
+func mcpCall(t *testing.T, server *MCPServer, tool string, args interface{}) (string, bool) {
+	t.Helper()
+	params, err := json.Marshal(map[string]interface{}{"name": tool, "arguments": args})
+	require.NoError(t, err)
+	responses := mcpSession(t, server, `{"jsonrpc":"2.0","id":1,"method":"tools/call","params":`+string(params)+`}`)
+	require.Len(t, responses, 1)
+	require.Nil(t, responses[0].Error)
+
+	var result mcpToolResult
+	require.NoError(t, json.Unmarshal(responses[0].Result, &result))
+	require.Len(t, result.Content, 1)
+	return result.Content[0].Text, result.IsError
+}
+
+func TestMCPServerProtocol(t *testing.T) {
+	server, err := NewMCPServer(api.NewClient("ghp_fake"), MCPServerOptions{Version: "1.2.3"})
+	require.NoError(t, err)
+
+	responses := mcpSession(t, server,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05","capabilities":{},"clientInfo":{"name":"test"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":"two","method":"ping"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":4,"method":"resources/list"}`,
+		`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"delete_everything"}}`,
+		`not json`,
+	)
+	require.Len(t, responses, 6)
+
+	var initialized struct {
+		ServerInfo struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"serverInfo"`
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	require.NoError(t, json.Unmarshal(responses[0].Result, &initialized))
+	assert.Equal(t, "2024-11-05", initialized.ProtocolVersion)
+	assert.Equal(t, "ghx", initialized.ServerInfo.Name)
+	assert.Equal(t, "1.2.3", initialized.ServerInfo.Version)
+
+	assert.JSONEq(t, `"two"`, string(responses[1].ID))
+	assert.JSONEq(t, `{}`, string(responses[1].Result))
+
+	var listed struct {
+		Tools []mcpToolInfo `json:"tools"`
+	}
+	require.NoError(t, json.Unmarshal(responses[2].Result, &listed))
+	require.Len(t, listed.Tools, 11)
+	assert.Equal(t, "add_item", listed.Tools[0].Name)
+	assert.Equal(t, []interface{}{"project", "item"}, listed.Tools[0].InputSchema["required"])
+	destructive := map[string]bool{}
+	for _, tool := range listed.Tools {
+		if tool.Annotations["destructiveHint"] == true {
+			destructive[tool.Name] = true
+		}
+	}
+	assert.Equal(t, false, listed.Tools[0].Annotations["readOnlyHint"])
+	assert.Equal(t, map[string]bool{"answer_discussion": true, "update_item_field": true}, destructive)
+
+	assert.Equal(t, mcpMethodNotFound, responses[3].Error.Code)
+	assert.Equal(t, mcpInvalidParams, responses[4].Error.Code)
+	assert.Equal(t, "unknown tool: delete_everything", responses[4].Error.Message)
+	assert.Equal(t, mcpParseError, responses[5].Error.Code)
+	assert.JSONEq(t, `null`, string(responses[5].ID))
+}
+
+func TestMCPServerStopsWhenContextIsDone(t *testing.T) {
+	server, err := NewMCPServer(api.NewClient("ghp_fake"), MCPServerOptions{})
+	require.NoError(t, err)
+
+	// The reader never returns, like stdin of an idle client
+	r, w := io.Pipe()
+	defer w.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- server.Serve(ctx, r, io.Discard) }()
+	cancel()
+
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return after the context was canceled")
+	}
+}
+
+func TestMCPServerToolSelection(t *testing.T) {
+	client := api.NewClient("ghp_fake")
+
+	server, err := NewMCPServer(client, MCPServerOptions{ReadOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"list_discussions", "list_project_items", "list_projects", "view_discussion", "view_project"}, server.ToolNames())
+
+	server, err = NewMCPServer(client, MCPServerOptions{Tools: []string{"view_project", "add_item"}})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"add_item", "view_project"}, server.ToolNames())
+
+	server, err = NewMCPServer(client, MCPServerOptions{Tools: []string{"view_project", "add_item"}, ReadOnly: true})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"view_project"}, server.ToolNames())
+
+	_, err = NewMCPServer(client, MCPServerOptions{Tools: []string{"add_item"}, ReadOnly: true})
+	assert.ErrorContains(t, err, "no tools enabled")
+
+	_, err = NewMCPServer(client, MCPServerOptions{Tools: []string{"drop_project"}})
+	assert.ErrorContains(t, err, "unknown tool: drop_project")
+}
+
+func TestMCPToolsAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	category := store.AddDiscussionCategory(repo, "Q&A", true)
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+	status := project.Field("Status")
+	issue := store.AddIssue(repo, "Fix login")
+	todo := store.AddDraftIssue(project, "Write docs", "")
+	store.SetValue(todo, status, fake.Value{OptionID: status.Option("Todo").ID})
+	store.AddDraftIssue(project, "Plan launch", "")
+	ref := fake.DefaultViewer + "/1"
+
+	mcp, err := NewMCPServer(api.NewClient("ghp_fake"), MCPServerOptions{})
+	require.NoError(t, err)
+
+	t.Run("Views projects and filters items", func(t *testing.T) {
+		text, failed := mcpCall(t, mcp, "view_project", map[string]interface{}{"project": ref})
+		require.False(t, failed, text)
+		var viewed MCPProject
+		require.NoError(t, json.Unmarshal([]byte(text), &viewed))
+		assert.Equal(t, "Roadmap", viewed.Title)
+		assert.Contains(t, viewed.Fields, MCPField{Name: "Status", Type: "Single Select", Options: []string{"Todo", "In Progress", "Done"}})
+
+		text, failed = mcpCall(t, mcp, "list_project_items", map[string]interface{}{"project": ref, "filter": "status:todo"})
+		require.False(t, failed, text)
+		var listed struct {
+			Items []MCPItem `json:"items"`
+			Total int       `json:"total"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(text), &listed))
+		assert.Equal(t, 1, listed.Total)
+		assert.Equal(t, "Write docs", listed.Items[0].Title)
+		assert.Equal(t, "Todo", listed.Items[0].Fields["Status"])
+
+		text, failed = mcpCall(t, mcp, "list_projects", map[string]interface{}{"owner": fake.DefaultViewer})
+		require.False(t, failed, text)
+		assert.Contains(t, text, `"Title": "Roadmap"`)
+	})
+
+	t.Run("Changes items", func(t *testing.T) {
+		text, failed := mcpCall(t, mcp, "update_item_field", map[string]interface{}{
+			"project": ref, "item_id": todo.ID, "field": "status", "value": "Done",
+		})
+		require.False(t, failed, text)
+		assert.Equal(t, status.Option("Done").ID, todo.Values[status.ID].OptionID)
+
+		text, failed = mcpCall(t, mcp, "add_item", map[string]interface{}{"project": ref, "item": fake.DefaultViewer + "/app#1"})
+		require.False(t, failed, text)
+		require.Len(t, project.Items, 3)
+		assert.Same(t, issue, project.Items[2].Issue)
+
+		text, failed = mcpCall(t, mcp, "create_draft_issue", map[string]interface{}{"project": ref, "title": "Triage"})
+		require.False(t, failed, text)
+		require.Len(t, project.Items, 4)
+		assert.Equal(t, "Triage", project.Items[3].Draft.Title)
+	})
+
+	t.Run("Creates, comments on and answers discussions", func(t *testing.T) {
+		repoRef := fake.DefaultViewer + "/app"
+		text, failed := mcpCall(t, mcp, "create_discussion", map[string]interface{}{
+			"repo": repoRef, "category": category.Slug, "title": "How do I log in?", "body": "It fails.",
+		})
+		require.False(t, failed, text)
+		require.Len(t, repo.Discussions, 1)
+		discussion := repo.Discussions[0]
+
+		text, failed = mcpCall(t, mcp, "comment_on_discussion", map[string]interface{}{
+			"repo": repoRef, "number": discussion.Number, "body": "Reset your password.",
+		})
+		require.False(t, failed, text)
+		require.Len(t, discussion.Comments, 1)
+
+		text, failed = mcpCall(t, mcp, "answer_discussion", map[string]interface{}{"comment_id": discussion.Comments[0].ID})
+		require.False(t, failed, text)
+		assert.Same(t, discussion.Comments[0], discussion.Answer)
+
+		text, failed = mcpCall(t, mcp, "list_discussions", map[string]interface{}{"repo": repoRef, "answered": true})
+		require.False(t, failed, text)
+		assert.Contains(t, text, `"Title": "How do I log in?"`)
+	})
+
+	t.Run("Reports tool errors in the result", func(t *testing.T) {
+		text, failed := mcpCall(t, mcp, "update_item_field", map[string]interface{}{"project": ref, "item_id": todo.ID, "field": "Status"})
+		assert.True(t, failed)
+		assert.Equal(t, "missing required arguments: value", text)
+
+		text, failed = mcpCall(t, mcp, "view_project", map[string]interface{}{"project": ref, "verbose": true})
+		assert.True(t, failed)
+		assert.Contains(t, text, `unknown field "verbose"`)
+
+		text, failed = mcpCall(t, mcp, "update_item_field", map[string]interface{}{
+			"project": ref, "item_id": todo.ID, "field": "Status", "value": "Blocked",
+		})
+		assert.True(t, failed)
+		assert.Contains(t, text, "Blocked")
+	})
+}
@@ -0,0 +1,542 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+const (
+	defaultMCPProjectLimit = 20
+	defaultMCPItemLimit    = 50
+)
+
+// MCPItem represents a project item returned by MCP tools
+type MCPItem struct {
+	Fields map[string]interface{} `json:"fields,omitempty"`
+	ID     string                 `json:"id"`
+	Type   string                 `json:"type"`
+	Title  string                 `json:"title"`
+	URL    string                 `json:"url,omitempty"`
+	Number int                    `json:"number,omitempty"`
+	Closed bool                   `json:"closed"`
+}
+
+// MCPProject represents a project and its fields returned by MCP tools
+type MCPProject struct {
+	Description *string    `json:"description,omitempty"`
+	ID          string     `json:"id"`
+	Title       string     `json:"title"`
+	URL         string     `json:"url"`
+	Owner       string     `json:"owner"`
+	Fields      []MCPField `json:"fields"`
+	Number      int        `json:"number"`
+	Closed      bool       `json:"closed"`
+}
+
+// MCPField represents a project field returned by MCP tools
+type MCPField struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	Options    []string `json:"options,omitempty"`
+	Iterations []string `json:"iterations,omitempty"`
+}
+
+// MCPTools returns every tool the MCP server can expose
+func MCPTools(client *api.Client) []MCPTool {
+	t := &mcpTools{
+		projects:    NewProjectService(client),
+		items:       NewItemService(client),
+		discussions: NewDiscussionService(client),
+	}
+
+	return []MCPTool{
+		{
+			Name:        "list_projects",
+			Description: "List the projects of a user or organization",
+			ReadOnly:    true,
+			InputSchema: mcpObject(map[string]interface{}{
+				"owner": mcpString("User or organization login"),
+				"limit": mcpInteger("Maximum number of projects (default 20)"),
+			}, "owner"),
+			call: t.listProjects,
+		},
+		{
+			Name:        "view_project",
+			Description: "Show a project with its fields, single select options and iterations",
+			ReadOnly:    true,
+			InputSchema: mcpObject(map[string]interface{}{
+				"project": mcpString("Project as owner/number"),
+			}, "project"),
+			call: t.viewProject,
+		},
+		{
+			Name: "list_project_items",
+			Description: "List the items of a project with their field values. The filter uses the syntax of " +
+				`project views, e.g. status:Todo,"In Progress" -priority:Low is:open no:sprint`,
+			ReadOnly: true,
+			InputSchema: mcpObject(map[string]interface{}{
+				"project": mcpString("Project as owner/number"),
+				"filter":  mcpString("Item filter"),
+				"limit":   mcpInteger("Maximum number of items (default 50)"),
+			}, "project"),
+			call: t.listProjectItems,
+		},
+		{
+			Name: "update_item_field",
+			Description: "Set a field of a project item. Single select values are option names, iteration " +
+				"values are titles or @current, @next and @previous, dates are YYYY-MM-DD",
+			Destructive: true,
+			InputSchema: mcpObject(map[string]interface{}{
+				"project": mcpString("Project as owner/number"),
+				"item_id": mcpString("Project item ID"),
+				"field":   mcpString("Field name"),
+				"value":   mcpString("New value"),
+			}, "project", "item_id", "field", "value"),
+			call: t.updateItemField,
+		},
+		{
+			Name:        "add_item",
+			Description: "Add an issue or pull request to a project",
+			InputSchema: mcpObject(map[string]interface{}{
+				"project": mcpString("Project as owner/number"),
+				"item":    mcpString("Issue or pull request as owner/repo#number or URL"),
+			}, "project", "item"),
+			call: t.addItem,
+		},
+		{
+			Name:        "create_draft_issue",
+			Description: "Create a draft issue in a project",
+			InputSchema: mcpObject(map[string]interface{}{
+				"project": mcpString("Project as owner/number"),
+				"title":   mcpString("Title"),
+				"body":    mcpString("Body in Markdown"),
+			}, "project", "title"),
+			call: t.createDraftIssue,
+		},
+		{
+			Name:        "list_discussions",
+			Description: "List the discussions of a repository",
+			ReadOnly:    true,
+			InputSchema: mcpObject(map[string]interface{}{
+				"repo":     mcpString("Repository as owner/repo"),
+				"category": mcpString("Category slug"),
+				"state":    mcpEnum("State", "open", "closed", "all"),
+				"answered": mcpBoolean("Only answered (true) or unanswered (false) discussions"),
+				"limit":    mcpInteger("Maximum number of discussions (default 20)"),
+			}, "repo"),
+			call: t.listDiscussions,
+		},
+		{
+			Name:        "view_discussion",
+			Description: "Show a discussion with its comments and their IDs",
+			ReadOnly:    true,
+			InputSchema: mcpObject(map[string]interface{}{
+				"repo":     mcpString("Repository as owner/repo"),
+				"number":   mcpInteger("Discussion number"),
+				"comments": mcpInteger("Maximum number of comments (default 20)"),
+			}, "repo", "number"),
+			call: t.viewDiscussion,
+		},
+		{
+			Name:        "create_discussion",
+			Description: "Create a discussion in a repository",
+			InputSchema: mcpObject(map[string]interface{}{
+				"repo":     mcpString("Repository as owner/repo"),
+				"category": mcpString("Category slug"),
+				"title":    mcpString("Title"),
+				"body":     mcpString("Body in Markdown"),
+			}, "repo", "category", "title", "body"),
+			call: t.createDiscussion,
+		},
+		{
+			Name:        "comment_on_discussion",
+			Description: "Comment on a discussion, or reply to one of its comments",
+			InputSchema: mcpObject(map[string]interface{}{
+				"repo":     mcpString("Repository as owner/repo"),
+				"number":   mcpInteger("Discussion number"),
+				"body":     mcpString("Comment in Markdown"),
+				"reply_to": mcpString("ID of the comment to reply to"),
+			}, "repo", "number", "body"),
+			call: t.commentOnDiscussion,
+		},
+		{
+			Name:        "answer_discussion",
+			Description: "Mark a comment as the answer of its discussion, or unmark it",
+			Destructive: true,
+			InputSchema: mcpObject(map[string]interface{}{
+				"comment_id": mcpString("Comment ID"),
+				"unmark":     mcpBoolean("Unmark the comment instead"),
+			}, "comment_id"),
+			call: t.answerDiscussion,
+		},
+	}
+}
+
+func mcpObject(properties map[string]interface{}, required ...string) map[string]interface{} {
+	schema := map[string]interface{}{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+func mcpString(description string) map[string]interface{} {
+	return map[string]interface{}{"type": "string", "description": description}
+}
+
+func mcpInteger(description string) map[string]interface{} {
+	return map[string]interface{}{"type": "integer", "description": description}
+}
+
+func mcpBoolean(description string) map[string]interface{} {
+	return map[string]interface{}{"type": "boolean", "description": description}
+}
+
+func mcpEnum(description string, values ...string) map[string]interface{} {
+	return map[string]interface{}{"type": "string", "description": description, "enum": values}
+}
+
+type mcpTools struct {
+	projects    *ProjectService
+	items       *ItemService
+	discussions *DiscussionService
+}
+
+func (t *mcpTools) project(ctx context.Context, ref string) (*graphql.ProjectV2, error) {
+	owner, number, err := ParseProjectReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project reference: %w", err)
+	}
+	project, err := t.projects.GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	return project, nil
+}
+
+func (t *mcpTools) listProjects(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		Owner string `json:"owner"`
+		Limit int    `json:"limit"`
+	}
+	if err := decodeMCPArguments(args, &in, "owner"); err != nil {
+		return nil, err
+	}
+	if in.Limit <= 0 {
+		in.Limit = defaultMCPProjectLimit
+	}
+
+	// The owner may be a user or an organization
+	projects, err := t.projects.ListUserProjects(ctx, ListUserProjectsOptions{Login: in.Owner, First: in.Limit})
+	if err != nil {
+		projects, err = t.projects.ListOrgProjects(ctx, ListOrgProjectsOptions{Login: in.Owner, First: in.Limit})
+	}
+	if err != nil {
+		return nil, err
+	}
+	return projects, nil
+}
+
+func (t *mcpTools) viewProject(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		Project string `json:"project"`
+	}
+	if err := decodeMCPArguments(args, &in, "project"); err != nil {
+		return nil, err
+	}
+	project, err := t.project(ctx, in.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	result := MCPProject{
+		ID:          project.ID,
+		Title:       project.Title,
+		URL:         project.URL,
+		Owner:       project.Owner.Login,
+		Number:      project.Number,
+		Closed:      project.Closed,
+		Description: project.Description,
+		Fields:      make([]MCPField, 0, len(project.Fields.Nodes)),
+	}
+	for i := range project.Fields.Nodes {
+		field := &project.Fields.Nodes[i]
+		info := MCPField{Name: field.Name, Type: FormatFieldDataType(field.DataType)}
+		for _, option := range field.SingleSelect.Options {
+			info.Options = append(info.Options, option.Name)
+		}
+		if field.DataType == graphql.ProjectV2FieldDataTypeIteration {
+			iterations, err := IterationsFromField(field)
+			if err != nil {
+				return nil, err
+			}
+			for _, iteration := range iterations {
+				info.Iterations = append(info.Iterations, iteration.Title)
+			}
+		}
+		result.Fields = append(result.Fields, info)
+	}
+	return result, nil
+}
+
+func (t *mcpTools) listProjectItems(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		Project string `json:"project"`
+		Filter  string `json:"filter"`
+		Limit   int    `json:"limit"`
+	}
+	if err := decodeMCPArguments(args, &in, "project"); err != nil {
+		return nil, err
+	}
+	if in.Limit <= 0 {
+		in.Limit = defaultMCPItemLimit
+	}
+	filter, err := ParseItemFilter(in.Filter)
+	if err != nil {
+		return nil, err
+	}
+	project, err := t.project(ctx, in.Project)
+	if err != nil {
+		return nil, err
+	}
+	items, err := t.items.ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	matched := []MCPItem{}
+	total := 0
+	for i := range items {
+		if !filter.Matches(&items[i]) {
+			continue
+		}
+		total++
+		if len(matched) < in.Limit {
+			matched = append(matched, newMCPItem(&items[i]))
+		}
+	}
+	return map[string]interface{}{"total": total, "items": matched}, nil
+}
+
+func newMCPItem(item *graphql.ProjectV2Item) MCPItem {
+	result := MCPItem{
+		ID:     item.ID,
+		Type:   item.Content.TypeName,
+		Title:  projectItemTitle(item),
+		Closed: projectItemClosed(item),
+		Fields: map[string]interface{}{},
+	}
+	switch item.Content.TypeName {
+	case "Issue":
+		result.URL, result.Number = item.Content.Issue.URL, item.Content.Issue.Number
+	case "PullRequest":
+		result.URL, result.Number = item.Content.PullRequest.URL, item.Content.PullRequest.Number
+	}
+	for i := range item.FieldValues.Nodes {
+		value := &item.FieldValues.Nodes[i]
+		if v := exportFieldValue(value); v != nil && value.Field.Name != "" {
+			result.Fields[value.Field.Name] = v
+		}
+	}
+	return result
+}
+
+func (t *mcpTools) updateItemField(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		Project string `json:"project"`
+		ItemID  string `json:"item_id"`
+		Field   string `json:"field"`
+		Value   string `json:"value"`
+	}
+	if err := decodeMCPArguments(args, &in, "project", "item_id", "field", "value"); err != nil {
+		return nil, err
+	}
+	project, err := t.project(ctx, in.Project)
+	if err != nil {
+		return nil, err
+	}
+	values, err := resolveSyncValues(project, []SyncFieldValue{{Field: in.Field, Value: in.Value}}, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = t.projects.UpdateItemField(ctx, UpdateItemFieldInput{
+		ProjectID: project.ID,
+		ItemID:    in.ItemID,
+		FieldID:   values[0].field.ID,
+		Value:     values[0].value,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"itemId": in.ItemID, "field": values[0].field.Name, "value": in.Value}, nil
+}
+
+func (t *mcpTools) addItem(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		Project string `json:"project"`
+		Item    string `json:"item"`
+	}
+	if err := decodeMCPArguments(args, &in, "project", "item"); err != nil {
+		return nil, err
+	}
+	owner, repo, number, err := ParseItemReference(in.Item)
+	if err != nil {
+		return nil, fmt.Errorf("invalid item reference: %w", err)
+	}
+	project, err := t.project(ctx, in.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	var contentID, itemType, title string
+	if issue, err := t.items.GetIssue(ctx, owner, repo, number); err == nil {
+		contentID, itemType, title = issue.ID, "Issue", issue.Title
+	} else {
+		pr, err := t.items.GetPullRequest(ctx, owner, repo, number)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find issue or pull request: %w", err)
+		}
+		contentID, itemType, title = pr.ID, "PullRequest", pr.Title
+	}
+
+	item, err := t.items.AddItemToProject(ctx, project.ID, contentID)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"itemId": item.ID, "type": itemType, "title": title}, nil
+}
+
+func (t *mcpTools) createDraftIssue(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		Body    *string `json:"body"`
+		Project string  `json:"project"`
+		Title   string  `json:"title"`
+	}
+	if err := decodeMCPArguments(args, &in, "project", "title"); err != nil {
+		return nil, err
+	}
+	project, err := t.project(ctx, in.Project)
+	if err != nil {
+		return nil, err
+	}
+	item, err := t.items.CreateDraftIssue(ctx, project.ID, in.Title, in.Body)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"itemId": item.ID, "type": "DraftIssue", "title": in.Title}, nil
+}
+
+func (t *mcpTools) listDiscussions(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		Answered *bool  `json:"answered"`
+		Repo     string `json:"repo"`
+		Category string `json:"category"`
+		State    string `json:"state"`
+		Limit    int    `json:"limit"`
+	}
+	if err := decodeMCPArguments(args, &in, "repo"); err != nil {
+		return nil, err
+	}
+	owner, repo, err := ParseRepositoryReference(in.Repo)
+	if err != nil {
+		return nil, err
+	}
+	return t.discussions.ListDiscussions(ctx, ListDiscussionsOptions{
+		Owner:    owner,
+		Repo:     repo,
+		Category: in.Category,
+		State:    in.State,
+		Answered: in.Answered,
+		First:    in.Limit,
+	})
+}
+
+func (t *mcpTools) viewDiscussion(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		Repo     string `json:"repo"`
+		Number   int    `json:"number"`
+		Comments int    `json:"comments"`
+	}
+	if err := decodeMCPArguments(args, &in, "repo", "number"); err != nil {
+		return nil, err
+	}
+	owner, repo, err := ParseRepositoryReference(in.Repo)
+	if err != nil {
+		return nil, err
+	}
+	return t.discussions.GetDiscussion(ctx, owner, repo, in.Number, in.Comments)
+}
+
+func (t *mcpTools) createDiscussion(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		Repo     string `json:"repo"`
+		Category string `json:"category"`
+		Title    string `json:"title"`
+		Body     string `json:"body"`
+	}
+	if err := decodeMCPArguments(args, &in, "repo", "category", "title", "body"); err != nil {
+		return nil, err
+	}
+	owner, repo, err := ParseRepositoryReference(in.Repo)
+	if err != nil {
+		return nil, err
+	}
+	return t.discussions.CreateDiscussion(ctx, CreateDiscussionOptions{
+		Owner:    owner,
+		Repo:     repo,
+		Category: in.Category,
+		Title:    in.Title,
+		Body:     in.Body,
+	})
+}
+
+func (t *mcpTools) commentOnDiscussion(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		ReplyTo *string `json:"reply_to"`
+		Repo    string  `json:"repo"`
+		Body    string  `json:"body"`
+		Number  int     `json:"number"`
+	}
+	if err := decodeMCPArguments(args, &in, "repo", "number", "body"); err != nil {
+		return nil, err
+	}
+	owner, repo, err := ParseRepositoryReference(in.Repo)
+	if err != nil {
+		return nil, err
+	}
+	return t.discussions.AddComment(ctx, AddCommentOptions{
+		Owner:     owner,
+		Repo:      repo,
+		Number:    in.Number,
+		Body:      in.Body,
+		ReplyToID: in.ReplyTo,
+	})
+}
+
+func (t *mcpTools) answerDiscussion(ctx context.Context, args json.RawMessage) (interface{}, error) {
+	var in struct {
+		CommentID string `json:"comment_id"`
+		Unmark    bool   `json:"unmark"`
+	}
+	if err := decodeMCPArguments(args, &in, "comment_id"); err != nil {
+		return nil, err
+	}
+	if in.Unmark {
+		if err := t.discussions.UnmarkAnswer(ctx, in.CommentID); err != nil {
+			return nil, err
+		}
+	} else if err := t.discussions.MarkAnswer(ctx, in.CommentID); err != nil {
+		return nil, err
+	}
+	return map[string]interface{}{"commentId": in.CommentID, "answer": !in.Unmark}, nil
+}
@@ -0,0 +1,806 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// Plan actions
+const (
+	PlanActionCreate = "create"
+	PlanActionUpdate = "update"
+	PlanActionDelete = "delete"
+)
+
+// Plan change kinds
+const (
+	PlanKindProject    = "project"
+	PlanKindRepository = "repository"
+	PlanKindField      = "field"
+	PlanKindOption     = "option"
+	PlanKindView       = "view"
+)
+
+// statusFieldName is the name of GitHub's default status field. Board views and
+// built-in workflows depend on it, so it is never pruned.
+const statusFieldName = "Status"
+
+// ProjectSpecService compares projects with a ProjectSpec and applies the differences
+type ProjectSpecService struct {
+	projects *ProjectService
+	fields   *FieldService
+	views    *ViewService
+}
+
+// NewProjectSpecService creates a new project spec service
+func NewProjectSpecService(client *api.Client) *ProjectSpecService {
+	return &ProjectSpecService{
+		projects: NewProjectService(client),
+		fields:   NewFieldService(client),
+		views:    NewViewService(client),
+	}
+}
+
+// ProjectState is the live configuration of a project that a spec is compared with
+type ProjectState struct {
+	ID               string
+	Title            string
+	ShortDescription string
+	Readme           string
+	Repositories     []string
+	Fields           []graphql.ProjectV2Field
+	Views            []ViewInfo
+	Public           bool
+}
+
+// PlanChange is a single change that brings a project closer to its spec
+type PlanChange struct {
+	apply   func(ctx context.Context, run *planRun) error
+	Action  string   `json:"action"`
+	Kind    string   `json:"kind"`
+	Target  string   `json:"target"`
+	Details []string `json:"details,omitempty"`
+}
+
+// ProjectPlan lists the changes that make a project match its spec. Deletions are
+// only planned when pruning; otherwise they are listed as skipped.
+type ProjectPlan struct {
+	fieldIDs  map[string]string
+	ProjectID string       `json:"projectId"`
+	Changes   []PlanChange `json:"changes"`
+	Skipped   []PlanChange `json:"skipped,omitempty"`
+}
+
+// planRun holds the state shared by the changes of a plan while it is applied
+type planRun struct {
+	*ProjectSpecService
+	fieldIDs map[string]string
+}
+
+// GetProjectState gets the live configuration of a project
+func (s *ProjectSpecService) GetProjectState(ctx context.Context, owner string, number int) (*ProjectState, error) {
+	project, err := s.projects.GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := s.projects.GetProjectSettings(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	fields, err := s.fields.ListProjectFields(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	views, err := s.views.GetProjectViews(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &ProjectState{
+		ID:     project.ID,
+		Title:  project.Title,
+		Fields: fields,
+		Views:  views,
+		Public: settings.Public,
+	}
+	if settings.ShortDescription != nil {
+		state.ShortDescription = *settings.ShortDescription
+	}
+	if settings.Readme != nil {
+		state.Readme = *settings.Readme
+	}
+	for _, repository := range settings.Repositories.Nodes {
+		state.Repositories = append(state.Repositories, repository.NameWithOwner)
+	}
+
+	return state, nil
+}
+
+// Plan compares a project with a spec and returns the changes needed to converge
+func (s *ProjectSpecService) Plan(
+	ctx context.Context,
+	owner string,
+	number int,
+	spec *ProjectSpec,
+	prune bool,
+) (*ProjectPlan, error) {
+	state, err := s.GetProjectState(ctx, owner, number)
+	if err != nil {
+		return nil, err
+	}
+	return BuildProjectPlan(state, spec, prune)
+}
+
+// Apply applies the changes of a plan in order, calling onChange after each one.
+// It stops at the first failure and returns the number of changes applied.
+func (s *ProjectSpecService) Apply(ctx context.Context, plan *ProjectPlan, onChange func(PlanChange)) (int, error) {
+	run := &planRun{ProjectSpecService: s, fieldIDs: map[string]string{}}
+	for name, id := range plan.fieldIDs {
+		run.fieldIDs[name] = id
+	}
+
+	for i, change := range plan.Changes {
+		if err := change.apply(ctx, run); err != nil {
+			return i, fmt.Errorf("failed to %s %s %s: %w", change.Action, change.Kind, change.Target, err)
+		}
+		if onChange != nil {
+			onChange(change)
+		}
+	}
+	return len(plan.Changes), nil
+}
+
+// BuildProjectPlan compares a project with a spec. Fields, options and views are
+// matched by name, or by the name they were renamed from.
+func BuildProjectPlan(state *ProjectState, spec *ProjectSpec, prune bool) (*ProjectPlan, error) {
+	b := &planBuilder{
+		plan:  &ProjectPlan{ProjectID: state.ID, Changes: []PlanChange{}, fieldIDs: map[string]string{}},
+		prune: prune,
+	}
+	for _, field := range state.Fields {
+		b.plan.fieldIDs[strings.ToLower(field.Name)] = field.ID
+	}
+
+	b.planSettings(state, spec)
+	if spec.Repositories != nil {
+		b.planRepositories(state, spec.Repositories)
+	}
+	if spec.Fields != nil {
+		if err := b.planFields(state, spec.Fields); err != nil {
+			return nil, err
+		}
+	}
+	if spec.Views != nil {
+		if err := b.planViews(state, spec.Views); err != nil {
+			return nil, err
+		}
+	}
+
+	return b.plan, nil
+}
+
+type planBuilder struct {
+	plan  *ProjectPlan
+	prune bool
+}
+
+func (b *planBuilder) add(change PlanChange) {
+	if change.Action == PlanActionDelete && !b.prune {
+		b.plan.Skipped = append(b.plan.Skipped, change)
+		return
+	}
+	b.plan.Changes = append(b.plan.Changes, change)
+}
+
+func (b *planBuilder) planSettings(state *ProjectState, spec *ProjectSpec) {
+	input := UpdateProjectInput{ProjectID: state.ID}
+	var details []string
+
+	if spec.Title != "" && spec.Title != state.Title {
+		input.Title = &spec.Title
+		details = append(details, describeChange("title", state.Title, spec.Title))
+	}
+	if spec.ShortDescription != nil && *spec.ShortDescription != state.ShortDescription {
+		input.ShortDescription = spec.ShortDescription
+		details = append(details, describeChange("short description", state.ShortDescription, *spec.ShortDescription))
+	}
+	if spec.Readme != nil && *spec.Readme != state.Readme {
+		input.Readme = spec.Readme
+		details = append(details, fmt.Sprintf("readme: %d → %d lines", countLines(state.Readme), countLines(*spec.Readme)))
+	}
+	if spec.Visibility != "" {
+		public := strings.EqualFold(spec.Visibility, VisibilityPublic)
+		if public != state.Public {
+			input.Public = &public
+			details = append(details, fmt.Sprintf("visibility: %s → %s", FormatVisibility(state.Public), FormatVisibility(public)))
+		}
+	}
+
+	if len(details) == 0 {
+		return
+	}
+	b.add(PlanChange{
+		Action:  PlanActionUpdate,
+		Kind:    PlanKindProject,
+		Target:  state.Title,
+		Details: details,
+		apply: func(ctx context.Context, run *planRun) error {
+			_, err := run.projects.UpdateProject(ctx, input)
+			return err
+		},
+	})
+}
+
+func (b *planBuilder) planRepositories(state *ProjectState, repositories []string) {
+	matched, matches := matchByName(state.Repositories, repositories, nil)
+
+	for i, repository := range repositories {
+		if matches[i] >= 0 {
+			continue
+		}
+		b.add(PlanChange{
+			Action: PlanActionCreate,
+			Kind:   PlanKindRepository,
+			Target: repository,
+			apply: func(ctx context.Context, run *planRun) error {
+				return run.projects.LinkProjectToRepository(ctx, b.plan.ProjectID, repository)
+			},
+		})
+	}
+
+	for i, repository := range state.Repositories {
+		if matched[i] {
+			continue
+		}
+		b.add(PlanChange{
+			Action: PlanActionDelete,
+			Kind:   PlanKindRepository,
+			Target: repository,
+			apply: func(ctx context.Context, run *planRun) error {
+				return run.projects.UnlinkProjectFromRepository(ctx, b.plan.ProjectID, repository)
+			},
+		})
+	}
+}
+
+func (b *planBuilder) planFields(state *ProjectState, specs []FieldSpec) error {
+	names := make([]string, len(state.Fields))
+	for i, field := range state.Fields {
+		names[i] = field.Name
+	}
+	wanted := make([]string, len(specs))
+	renamedFrom := make([]string, len(specs))
+	for i, spec := range specs {
+		wanted[i] = spec.Name
+		renamedFrom[i] = spec.RenamedFrom
+	}
+	matched, matches := matchByName(names, wanted, renamedFrom)
+
+	for i := range specs {
+		spec := &specs[i]
+		if matches[i] < 0 {
+			if err := b.planFieldCreate(spec); err != nil {
+				return err
+			}
+			continue
+		}
+		field := &state.Fields[matches[i]]
+		b.plan.fieldIDs[strings.ToLower(spec.Name)] = field.ID
+		if err := b.planFieldUpdate(field, spec); err != nil {
+			return err
+		}
+	}
+
+	for i := range state.Fields {
+		field := &state.Fields[i]
+		if matched[i] || !isPrunableField(field) {
+			continue
+		}
+		b.add(PlanChange{
+			Action: PlanActionDelete,
+			Kind:   PlanKindField,
+			Target: field.Name,
+			apply: func(ctx context.Context, run *planRun) error {
+				return run.fields.DeleteField(ctx, DeleteFieldInput{FieldID: field.ID})
+			},
+		})
+	}
+
+	return nil
+}
+
+func (b *planBuilder) planFieldCreate(spec *FieldSpec) error {
+	if spec.Type == "" {
+		return fmt.Errorf("field %s does not exist; set its type to create it", spec.Name)
+	}
+	dataType, err := ValidateFieldType(spec.Type)
+	if err != nil {
+		return err
+	}
+
+	input := CreateFieldInput{
+		ProjectID: b.plan.ProjectID,
+		Name:      spec.Name,
+		DataType:  dataType,
+	}
+	details := []string{"type: " + FormatFieldDataType(dataType)}
+
+	switch dataType {
+	case graphql.ProjectV2FieldDataTypeSingleSelect:
+		if len(spec.Options) == 0 {
+			return fmt.Errorf("field %s needs at least one option", spec.Name)
+		}
+		names := make([]string, len(spec.Options))
+		for i, option := range spec.Options {
+			names[i] = option.Name
+			input.Options = append(input.Options, FieldOptionInfo{
+				Name:        option.Name,
+				Color:       option.Color,
+				Description: option.Description,
+			})
+		}
+		details = append(details, "options: "+strings.Join(names, ", "))
+	case graphql.ProjectV2FieldDataTypeIteration:
+		iterations := IterationSpec{}
+		if spec.Iterations != nil {
+			iterations = *spec.Iterations
+		}
+		input.Duration = iterations.Duration
+		input.IterationCount = iterations.Count
+		if iterations.Start != "" {
+			if input.IterationStart, err = ParseDate(iterations.Start); err != nil {
+				return err
+			}
+		}
+		details = append(details, describeIterationSpec(iterations))
+	}
+
+	b.plan.fieldIDs[strings.ToLower(spec.Name)] = ""
+	b.add(PlanChange{
+		Action:  PlanActionCreate,
+		Kind:    PlanKindField,
+		Target:  spec.Name,
+		Details: details,
+		apply: func(ctx context.Context, run *planRun) error {
+			field, err := run.fields.CreateField(ctx, input)
+			if err != nil {
+				return err
+			}
+			run.fieldIDs[strings.ToLower(input.Name)] = field.ID
+			return nil
+		},
+	})
+	return nil
+}
+
+func (b *planBuilder) planFieldUpdate(field *graphql.ProjectV2Field, spec *FieldSpec) error {
+	if spec.Type != "" {
+		dataType, err := ValidateFieldType(spec.Type)
+		if err != nil {
+			return err
+		}
+		if dataType != field.DataType {
+			return fmt.Errorf("field %s is a %s field, not %s; changing the type of a field is not supported",
+				field.Name, FormatFieldDataType(field.DataType), FormatFieldDataType(dataType))
+		}
+	}
+
+	input := UpdateFieldInput{FieldID: field.ID}
+	var details []string
+	if field.Name != spec.Name {
+		input.Name = &spec.Name
+		details = append(details, describeChange("name", field.Name, spec.Name))
+	}
+
+	duration := 0
+	if spec.Iterations != nil && spec.Iterations.Duration != "" {
+		days, err := ParseIterationDuration(spec.Iterations.Duration)
+		if err != nil {
+			return err
+		}
+		if current := field.Iteration.Configuration.Duration; days != current {
+			duration = days
+			details = append(details, fmt.Sprintf("iteration duration: %d → %d days (items lose their iteration value)", current, days))
+		}
+	}
+
+	if len(details) > 0 {
+		b.add(PlanChange{
+			Action:  PlanActionUpdate,
+			Kind:    PlanKindField,
+			Target:  spec.Name,
+			Details: details,
+			apply: func(ctx context.Context, run *planRun) error {
+				if input.Name != nil {
+					if _, err := run.fields.UpdateField(ctx, input); err != nil {
+						return err
+					}
+				}
+				if duration == 0 {
+					return nil
+				}
+				iterations, err := IterationsFromField(field)
+				if err != nil {
+					return err
+				}
+				_, err = run.fields.SetIterations(ctx, field.ID, duration, iterations)
+				return err
+			},
+		})
+	}
+
+	if field.DataType == graphql.ProjectV2FieldDataTypeSingleSelect && spec.Options != nil {
+		b.planOptions(field, spec)
+	}
+	return nil
+}
+
+func (b *planBuilder) planOptions(field *graphql.ProjectV2Field, spec *FieldSpec) {
+	options := field.SingleSelect.Options
+	names := make([]string, len(options))
+	for i, option := range options {
+		names[i] = option.Name
+	}
+	wanted := make([]string, len(spec.Options))
+	renamedFrom := make([]string, len(spec.Options))
+	for i, option := range spec.Options {
+		wanted[i] = option.Name
+		renamedFrom[i] = option.RenamedFrom
+	}
+	matched, matches := matchByName(names, wanted, renamedFrom)
+
+	for i, option := range spec.Options {
+		target := spec.Name + "/" + option.Name
+		if matches[i] < 0 {
+			input := CreateFieldOptionInput{
+				FieldID:     field.ID,
+				Name:        option.Name,
+				Color:       "GRAY",
+				Description: option.Description,
+			}
+			if option.Color != "" {
+				input.Color = NormalizeColor(option.Color)
+			}
+			b.add(PlanChange{
+				Action:  PlanActionCreate,
+				Kind:    PlanKindOption,
+				Target:  target,
+				Details: []string{"color: " + FormatColor(input.Color)},
+				apply: func(ctx context.Context, run *planRun) error {
+					_, err := run.fields.CreateFieldOption(ctx, input)
+					return err
+				},
+			})
+			continue
+		}
+
+		live := options[matches[i]]
+		input := UpdateFieldOptionInput{OptionID: live.ID}
+		var details []string
+		if live.Name != option.Name {
+			input.Name = &option.Name
+			details = append(details, describeChange("name", live.Name, option.Name))
+		}
+		if option.Color != "" && NormalizeColor(option.Color) != NormalizeColor(live.Color) {
+			color := NormalizeColor(option.Color)
+			input.Color = &color
+			details = append(details, fmt.Sprintf("color: %s → %s", FormatColor(live.Color), FormatColor(color)))
+		}
+		if option.Description != nil && *option.Description != derefString(live.Description) {
+			input.Description = option.Description
+			details = append(details, describeChange("description", derefString(live.Description), *option.Description))
+		}
+		if len(details) == 0 {
+			continue
+		}
+		b.add(PlanChange{
+			Action:  PlanActionUpdate,
+			Kind:    PlanKindOption,
+			Target:  target,
+			Details: details,
+			apply: func(ctx context.Context, run *planRun) error {
+				_, err := run.fields.UpdateFieldOption(ctx, input)
+				return err
+			},
+		})
+	}
+
+	for i, option := range options {
+		if matched[i] {
+			continue
+		}
+		b.add(PlanChange{
+			Action: PlanActionDelete,
+			Kind:   PlanKindOption,
+			Target: spec.Name + "/" + option.Name,
+			apply: func(ctx context.Context, run *planRun) error {
+				return run.fields.DeleteFieldOption(ctx, DeleteFieldOptionInput{OptionID: option.ID})
+			},
+		})
+	}
+}
+
+func (b *planBuilder) planViews(state *ProjectState, specs []ViewSpec) error {
+	names := make([]string, len(state.Views))
+	for i, view := range state.Views {
+		names[i] = view.Name
+	}
+	wanted := make([]string, len(specs))
+	renamedFrom := make([]string, len(specs))
+	for i, spec := range specs {
+		wanted[i] = spec.Name
+		renamedFrom[i] = spec.RenamedFrom
+	}
+	matched, matches := matchByName(names, wanted, renamedFrom)
+
+	for i := range specs {
+		spec := &specs[i]
+		for _, order := range []*ViewOrderSpec{spec.SortBy, spec.GroupBy} {
+			if order == nil {
+				continue
+			}
+			if _, ok := b.plan.fieldIDs[strings.ToLower(order.Field)]; !ok {
+				return fmt.Errorf("view %s refers to unknown field %s", spec.Name, order.Field)
+			}
+		}
+
+		if matches[i] < 0 {
+			b.planViewCreate(spec)
+		} else {
+			b.planViewUpdate(&state.Views[matches[i]], spec)
+		}
+	}
+
+	for i := range state.Views {
+		if matched[i] {
+			continue
+		}
+		view := &state.Views[i]
+		b.add(PlanChange{
+			Action: PlanActionDelete,
+			Kind:   PlanKindView,
+			Target: view.Name,
+			apply: func(ctx context.Context, run *planRun) error {
+				return run.views.DeleteView(ctx, DeleteViewInput{ViewID: view.ID})
+			},
+		})
+	}
+
+	return nil
+}
+
+func (b *planBuilder) planViewCreate(spec *ViewSpec) {
+	layout := graphql.ProjectV2ViewLayoutTable
+	if spec.Layout != "" {
+		layout, _ = ValidateViewLayout(spec.Layout)
+	}
+
+	details := []string{"layout: " + FormatViewLayout(layout)}
+	update := UpdateViewInput{Filter: spec.Filter}
+	if spec.Filter != nil {
+		details = append(details, fmt.Sprintf("filter: %q", *spec.Filter))
+	}
+	if spec.SortBy != nil {
+		details = append(details, "sort: "+describeOrderSpec(spec.SortBy))
+	}
+	if spec.GroupBy != nil {
+		details = append(details, "group: "+describeOrderSpec(spec.GroupBy))
+	}
+
+	b.add(PlanChange{
+		Action:  PlanActionCreate,
+		Kind:    PlanKindView,
+		Target:  spec.Name,
+		Details: details,
+		apply: func(ctx context.Context, run *planRun) error {
+			view, err := run.views.CreateView(ctx, CreateViewInput{
+				ProjectID: b.plan.ProjectID,
+				Name:      spec.Name,
+				Layout:    layout,
+			})
+			if err != nil {
+				return err
+			}
+			return run.configureView(ctx, view.ID, update, spec.SortBy, spec.GroupBy)
+		},
+	})
+}
+
+func (b *planBuilder) planViewUpdate(view *ViewInfo, spec *ViewSpec) {
+	update := UpdateViewInput{}
+	var details []string
+
+	if view.Name != spec.Name {
+		update.Name = &spec.Name
+		details = append(details, describeChange("name", view.Name, spec.Name))
+	}
+	if spec.Layout != "" {
+		layout, _ := ValidateViewLayout(spec.Layout)
+		current, err := ValidateViewLayout(string(view.Layout))
+		if err != nil {
+			current = view.Layout
+		}
+		if current != layout {
+			update.Layout = &layout
+			details = append(details, fmt.Sprintf("layout: %s → %s", FormatViewLayout(current), FormatViewLayout(layout)))
+		}
+	}
+	if spec.Filter != nil && *spec.Filter != derefString(view.Filter) {
+		update.Filter = spec.Filter
+		details = append(details, describeChange("filter", derefString(view.Filter), *spec.Filter))
+	}
+
+	var sortBy, groupBy *ViewOrderSpec
+	var currentField string
+	var currentDirection graphql.ProjectV2ViewSortDirection
+	if len(view.SortBy) > 0 {
+		currentField, currentDirection = view.SortBy[0].FieldName, view.SortBy[0].Direction
+	}
+	if spec.SortBy != nil && !orderMatches(spec.SortBy, currentField, currentDirection) {
+		sortBy = spec.SortBy
+		details = append(details, fmt.Sprintf("sort: %s → %s",
+			describeOrder(currentField, currentDirection), describeOrderSpec(spec.SortBy)))
+	}
+
+	currentField, currentDirection = "", ""
+	if len(view.GroupBy) > 0 {
+		currentField, currentDirection = view.GroupBy[0].FieldName, view.GroupBy[0].Direction
+	}
+	if spec.GroupBy != nil && !orderMatches(spec.GroupBy, currentField, currentDirection) {
+		groupBy = spec.GroupBy
+		details = append(details, fmt.Sprintf("group: %s → %s",
+			describeOrder(currentField, currentDirection), describeOrderSpec(spec.GroupBy)))
+	}
+
+	if len(details) == 0 {
+		return
+	}
+	b.add(PlanChange{
+		Action:  PlanActionUpdate,
+		Kind:    PlanKindView,
+		Target:  spec.Name,
+		Details: details,
+		apply: func(ctx context.Context, run *planRun) error {
+			return run.configureView(ctx, view.ID, update, sortBy, groupBy)
+		},
+	})
+}
+
+// configureView applies the settings, sort and grouping of a view
+func (r *planRun) configureView(
+	ctx context.Context,
+	viewID string,
+	update UpdateViewInput,
+	sortBy, groupBy *ViewOrderSpec,
+) error {
+	if update.Name != nil || update.Filter != nil || update.Layout != nil {
+		update.ViewID = viewID
+		if _, err := r.views.UpdateView(ctx, update); err != nil {
+			return err
+		}
+	}
+	if sortBy != nil {
+		fieldID := r.fieldIDs[strings.ToLower(sortBy.Field)]
+		err := r.views.UpdateViewSort(ctx, UpdateViewSortInput{
+			ViewID:    viewID,
+			SortByID:  &fieldID,
+			Direction: orderDirection(sortBy),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if groupBy != nil {
+		fieldID := r.fieldIDs[strings.ToLower(groupBy.Field)]
+		err := r.views.UpdateViewGroup(ctx, UpdateViewGroupInput{
+			ViewID:    viewID,
+			GroupByID: &fieldID,
+			Direction: orderDirection(groupBy),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchByName pairs each wanted name with a live name, first by name and then by
+// the name it was renamed from. It returns which live names were matched and, for
+// each wanted name, the index of its live name or -1 when there is none.
+func matchByName(live, wanted, renamedFrom []string) (matched []bool, matches []int) {
+	matched = make([]bool, len(live))
+	matches = make([]int, len(wanted))
+
+	find := func(name string) int {
+		for i, candidate := range live {
+			if !matched[i] && strings.EqualFold(candidate, name) {
+				matched[i] = true
+				return i
+			}
+		}
+		return -1
+	}
+
+	for i, name := range wanted {
+		matches[i] = find(name)
+	}
+	for i := range wanted {
+		if matches[i] < 0 && i < len(renamedFrom) && renamedFrom[i] != "" {
+			matches[i] = find(renamedFrom[i])
+		}
+	}
+	return matched, matches
+}
+
+// isPrunableField reports whether a field is a custom field that pruning may delete
+func isPrunableField(field *graphql.ProjectV2Field) bool {
+	if strings.EqualFold(field.Name, statusFieldName) {
+		return false
+	}
+	_, err := ValidateFieldType(string(field.DataType))
+	return err == nil
+}
+
+func orderMatches(order *ViewOrderSpec, field string, direction graphql.ProjectV2ViewSortDirection) bool {
+	return strings.EqualFold(order.Field, field) && orderDirection(order) == direction
+}
+
+func orderDirection(order *ViewOrderSpec) graphql.ProjectV2ViewSortDirection {
+	if order.Direction == "" {
+		return graphql.ProjectV2ViewSortDirectionASC
+	}
+	direction, _ := ValidateSortDirection(order.Direction)
+	return direction
+}
+
+func describeOrder(field string, direction graphql.ProjectV2ViewSortDirection) string {
+	if field == "" {
+		return "none"
+	}
+	return fmt.Sprintf("%s (%s)", field, strings.ToLower(FormatSortDirection(direction)))
+}
+
+func describeOrderSpec(order *ViewOrderSpec) string {
+	return describeOrder(order.Field, orderDirection(order))
+}
+
+func describeIterationSpec(spec IterationSpec) string {
+	duration := defaultIterationDuration
+	if spec.Duration != "" {
+		duration, _ = ParseIterationDuration(spec.Duration)
+	}
+	count := spec.Count
+	if count == 0 {
+		count = defaultIterationCount
+	}
+	start := spec.Start
+	if start == "" {
+		start = "today"
+	}
+	return fmt.Sprintf("iterations: %d × %d days from %s", count, duration, start)
+}
+
+func describeChange(name, from, to string) string {
+	return fmt.Sprintf("%s: %q → %q", name, from, to)
+}
+
+func countLines(text string) int {
+	if text == "" {
+		return 0
+	}
+	return strings.Count(strings.TrimSuffix(text, "\n"), "\n") + 1
+}
+
+func derefString(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
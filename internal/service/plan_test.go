@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+const roadmapSpec = `
+title: Roadmap
+shortDescription: What we ship next
+visibility: public
+repositories:
+  - octocat/app
+fields:
+  - name: Status
+    type: single_select
+    options:
+      - name: Todo
+        color: gray
+      - name: Doing
+        renamedFrom: In Progress
+      - name: Done
+  - name: Priority
+    type: single_select
+    options:
+      - name: High
+        color: red
+      - name: Low
+  - name: Sprint
+    type: iteration
+    iterations:
+      duration: 1w
+      start: 2025-01-06
+views:
+  - name: Board
+    renamedFrom: View 1
+    layout: board
+    groupBy:
+      field: Status
+    sortBy:
+      field: Priority
+      direction: desc
+`
+
+func actions(changes []PlanChange) []string {
+	result := make([]string, len(changes))
+	for i, change := range changes {
+		result[i] = change.Action + " " + change.Kind + " " + change.Target
+	}
+	return result
+}
+
+func TestParseProjectSpec(t *testing.T) {
+	t.Run("Parses a complete spec", func(t *testing.T) {
+		spec, err := ParseProjectSpec([]byte(roadmapSpec))
+		require.NoError(t, err)
+
+		assert.Equal(t, "Roadmap", spec.Title)
+		require.Len(t, spec.Fields, 3)
+		assert.Equal(t, "In Progress", spec.Fields[0].Options[1].RenamedFrom)
+		assert.Equal(t, "1w", spec.Fields[2].Iterations.Duration)
+		require.Len(t, spec.Views, 1)
+		assert.Equal(t, "desc", spec.Views[0].SortBy.Direction)
+	})
+
+	t.Run("Rejects invalid specs", func(t *testing.T) {
+		for name, spec := range map[string]string{
+			"unknown key":        "title: x\ncolour: red\n",
+			"bad visibility":     "visibility: secret\n",
+			"bad repository":     "repositories: [app]\n",
+			"duplicate field":    "fields:\n  - {name: A, type: text}\n  - {name: a, type: text}\n",
+			"options on text":    "fields:\n  - name: A\n    type: text\n    options: [{name: x}]\n",
+			"bad color":          "fields:\n  - name: A\n    type: single_select\n    options: [{name: x, color: teal}]\n",
+			"bad duration":       "fields:\n  - name: A\n    type: iteration\n    iterations: {duration: 3m}\n",
+			"bad layout":         "views:\n  - {name: A, layout: gantt}\n",
+			"sort without field": "views:\n  - name: A\n    sortBy: {direction: asc}\n",
+		} {
+			_, err := ParseProjectSpec([]byte(spec))
+			assert.Error(t, err, name)
+		}
+	})
+}
+
+func TestBuildProjectPlan(t *testing.T) {
+	state := &ProjectState{
+		ID:           "PVT_1",
+		Title:        "Old",
+		Repositories: []string{"octocat/legacy"},
+		Fields: []graphql.ProjectV2Field{
+			{ID: "F1", Name: "Title", DataType: graphql.ProjectV2FieldDataType("TITLE")},
+			{ID: "F2", Name: "Notes", DataType: graphql.ProjectV2FieldDataTypeText},
+		},
+		Views: []ViewInfo{{ID: "V1", Name: "Table", Layout: "TABLE_LAYOUT"}},
+	}
+
+	t.Run("Deletions require prune", func(t *testing.T) {
+		spec := &ProjectSpec{
+			Title:        "New",
+			Repositories: []string{},
+			Fields:       []FieldSpec{{Name: "Estimate", Type: "number"}},
+			Views:        []ViewSpec{{Name: "Table", Layout: "table"}},
+		}
+
+		plan, err := BuildProjectPlan(state, spec, false)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"update project Old", "create field Estimate"}, actions(plan.Changes))
+		assert.Equal(t, []string{"delete repository octocat/legacy", "delete field Notes"}, actions(plan.Skipped))
+		assert.Equal(t, []string{`title: "Old" → "New"`}, plan.Changes[0].Details)
+
+		plan, err = BuildProjectPlan(state, spec, true)
+		require.NoError(t, err)
+		assert.Len(t, plan.Changes, 4)
+		assert.Empty(t, plan.Skipped)
+	})
+
+	t.Run("Renames keep the existing field", func(t *testing.T) {
+		spec := &ProjectSpec{Fields: []FieldSpec{{Name: "Comments", RenamedFrom: "Notes", Type: "text"}}}
+
+		plan, err := BuildProjectPlan(state, spec, true)
+		require.NoError(t, err)
+		require.Equal(t, []string{"update field Comments"}, actions(plan.Changes))
+		assert.Equal(t, []string{`name: "Notes" → "Comments"`}, plan.Changes[0].Details)
+	})
+
+	t.Run("Rejects type changes and unknown view fields", func(t *testing.T) {
+		_, err := BuildProjectPlan(state, &ProjectSpec{Fields: []FieldSpec{{Name: "Notes", Type: "number"}}}, false)
+		assert.ErrorContains(t, err, "changing the type")
+
+		_, err = BuildProjectPlan(state, &ProjectSpec{Views: []ViewSpec{{Name: "Table", SortBy: &ViewOrderSpec{Field: "Size"}}}}, false)
+		assert.ErrorContains(t, err, "unknown field Size")
+	})
+}
+
+func TestProjectSpecServiceAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	store.AddRepository(fake.DefaultViewer, "app")
+	project := store.AddProject(fake.DefaultViewer, "Draft")
+	store.AddField(project, "Obsolete", "TEXT")
+
+	spec, err := ParseProjectSpec([]byte(roadmapSpec))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	specService := NewProjectSpecService(api.NewClient("ghp_fake"))
+
+	plan, err := specService.Plan(ctx, fake.DefaultViewer, project.Number, spec, true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"update project Draft",
+		"create repository octocat/app",
+		"update option Status/Todo",
+		"update option Status/Doing",
+		"create field Priority",
+		"create field Sprint",
+		"delete field Obsolete",
+		"update view Board",
+	}, actions(plan.Changes))
+
+	applied, err := specService.Apply(ctx, plan, nil)
+	require.NoError(t, err)
+	assert.Equal(t, len(plan.Changes), applied)
+
+	assert.Equal(t, "Roadmap", project.Title)
+	assert.True(t, project.Public)
+	require.Len(t, project.Repositories, 1)
+	assert.Nil(t, project.Field("Obsolete"))
+	assert.Equal(t, "RED", project.Field("Priority").Options[0].Color)
+	assert.Equal(t, "Doing", project.Field("Status").Options[1].Name)
+	sprint := project.Field("Sprint")
+	require.NotNil(t, sprint)
+	assert.Equal(t, "2025-01-06", sprint.Iterations[0].StartDate.Format(DateLayout))
+	assert.Equal(t, 7, sprint.Iterations[0].Duration)
+
+	view := project.Views[0]
+	assert.Equal(t, "Board", view.Name)
+	require.Len(t, view.SortBy, 1)
+	assert.Equal(t, project.Field("Priority"), view.SortBy[0].Field)
+	assert.Equal(t, "DESC", view.SortBy[0].Direction)
+	require.Len(t, view.GroupBy, 1)
+	assert.Equal(t, project.Field("Status"), view.GroupBy[0].Field)
+
+	t.Run("Applying again makes no changes", func(t *testing.T) {
+		plan, err := specService.Plan(ctx, fake.DefaultViewer, project.Number, spec, true)
+		require.NoError(t, err)
+		assert.Empty(t, plan.Changes)
+	})
+
+	t.Run("Reads fields beyond the first page of a project", func(t *testing.T) {
+		for i := 0; i < 60; i++ {
+			store.AddField(project, fmt.Sprintf("Extra %02d", i), "TEXT")
+		}
+		state, err := specService.GetProjectState(ctx, fake.DefaultViewer, project.Number)
+		require.NoError(t, err)
+		require.Len(t, state.Fields, len(project.Fields))
+		assert.Equal(t, "Extra 59", state.Fields[len(state.Fields)-1].Name)
+
+		plan, err := specService.Plan(ctx, fake.DefaultViewer, project.Number, spec, false)
+		require.NoError(t, err)
+		assert.Empty(t, plan.Changes)
+	})
+}
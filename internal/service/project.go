@@ -137,6 +137,20 @@ func (s *ProjectService) GetProject(ctx context.Context, owner string, number in
 	return &query.User.ProjectV2, nil
 }
 
+// GetOwnerID returns the node ID of the user or organization with the given login
+func (s *ProjectService) GetOwnerID(ctx context.Context, login string) (string, error) {
+	var query graphql.GetOwnerQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetOwnerVariables(login))
+	if err != nil {
+		return "", fmt.Errorf("failed to get owner %s: %w", login, err)
+	}
+	if query.RepositoryOwner.ID == "" {
+		return "", fmt.Errorf("user or organization %s not found", login)
+	}
+
+	return query.RepositoryOwner.ID, nil
+}
+
 // CreateProjectInput represents input for creating a project
 type CreateProjectInput struct {
 	OwnerID     string
@@ -183,38 +197,155 @@ func (s *ProjectService) CreateProject(ctx context.Context, input *CreateProject
 
 // LinkProjectToRepository links a project to a GitHub repository
 func (s *ProjectService) LinkProjectToRepository(ctx context.Context, projectID, repository string) error {
-	// Parse repository string to get owner and name
-	repoParts := parseRepositoryString(repository)
-	if len(repoParts) != 2 {
-		return fmt.Errorf("invalid repository format: %s (expected owner/repo)", repository)
+	repositoryID, err := s.resolveRepository(ctx, repository)
+	if err != nil {
+		return err
 	}
 
-	repoOwner := repoParts[0]
-	repoName := repoParts[1]
+	variables := graphql.BuildProjectRepositoryVariables(&graphql.ProjectRepositoryInput{
+		ProjectID:    gql.ID(projectID),
+		RepositoryID: gql.ID(repositoryID),
+	})
 
-	// Validate that the repository exists
-	if err := s.validateRepository(ctx, repoOwner, repoName); err != nil {
-		return fmt.Errorf("repository validation failed for %s: %w", repository, err)
+	var mutation graphql.LinkProjectToRepositoryMutation
+	err = s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to link repository %s: %w", repository, err)
 	}
 
-	// For now, this is a placeholder implementation as the exact GraphQL mutation
-	// for linking projects to repositories may vary based on GitHub's API implementation
-	// The actual implementation would involve executing a GraphQL mutation like:
-	//
-	// mutation LinkProjectToRepository($projectId: ID!, $repositoryId: ID!) {
-	//   updateProjectV2(input: { projectId: $projectId, repositoryId: $repositoryId }) {
-	//     projectV2 { id title url }
-	//   }
-	// }
-	//
-	// This would require finding the exact mutation signature in GitHub's GraphQL API
+	return nil
+}
+
+// UnlinkProjectFromRepository unlinks a project from a GitHub repository
+func (s *ProjectService) UnlinkProjectFromRepository(ctx context.Context, projectID, repository string) error {
+	repositoryID, err := s.resolveRepository(ctx, repository)
+	if err != nil {
+		return err
+	}
 
-	// Log success for now
-	fmt.Printf("Successfully linked project %s to repository %s\n", projectID, repository)
+	variables := graphql.BuildProjectRepositoryVariables(&graphql.ProjectRepositoryInput{
+		ProjectID:    gql.ID(projectID),
+		RepositoryID: gql.ID(repositoryID),
+	})
+
+	var mutation graphql.UnlinkProjectFromRepositoryMutation
+	err = s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to unlink repository %s: %w", repository, err)
+	}
 
 	return nil
 }
 
+// LinkProjectToTeam links a project to an organization team given as org/team
+func (s *ProjectService) LinkProjectToTeam(ctx context.Context, projectID, team string) error {
+	teamID, err := s.resolveTeam(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	variables := graphql.BuildProjectTeamVariables(&graphql.ProjectTeamInput{
+		ProjectID: gql.ID(projectID),
+		TeamID:    gql.ID(teamID),
+	})
+
+	var mutation graphql.LinkProjectToTeamMutation
+	err = s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to link team %s: %w", team, err)
+	}
+
+	return nil
+}
+
+// UnlinkProjectFromTeam unlinks a project from an organization team given as org/team
+func (s *ProjectService) UnlinkProjectFromTeam(ctx context.Context, projectID, team string) error {
+	teamID, err := s.resolveTeam(ctx, team)
+	if err != nil {
+		return err
+	}
+
+	variables := graphql.BuildProjectTeamVariables(&graphql.ProjectTeamInput{
+		ProjectID: gql.ID(projectID),
+		TeamID:    gql.ID(teamID),
+	})
+
+	var mutation graphql.UnlinkProjectFromTeamMutation
+	err = s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to unlink team %s: %w", team, err)
+	}
+
+	return nil
+}
+
+// GetProjectLinks gets the repositories and teams a project is linked to
+func (s *ProjectService) GetProjectLinks(ctx context.Context, projectID string) (*graphql.ProjectV2Links, error) {
+	var query graphql.GetProjectLinksQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetProjectLinksVariables(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project links: %w", err)
+	}
+	if query.Node.ProjectV2.ID == "" {
+		return nil, fmt.Errorf("project %s not found", projectID)
+	}
+
+	return &query.Node.ProjectV2, nil
+}
+
+// ResolveProjectID returns the node ID of a project given as owner/number or as a node ID
+func (s *ProjectService) ResolveProjectID(ctx context.Context, ref string) (string, error) {
+	return NewResolver(s.client).ProjectID(ctx, ref)
+}
+
+// GetProjectSettings gets the README, short description, visibility and linked
+// repositories of a project
+func (s *ProjectService) GetProjectSettings(ctx context.Context, projectID string) (*graphql.ProjectV2Settings, error) {
+	var query graphql.GetProjectSettingsQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetProjectSettingsVariables(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project settings: %w", err)
+	}
+	if query.Node.ProjectV2.ID == "" {
+		return nil, fmt.Errorf("project %s not found", projectID)
+	}
+
+	return &query.Node.ProjectV2, nil
+}
+
+// resolveTeam returns the node ID of a team given as org/team
+func (s *ProjectService) resolveTeam(ctx context.Context, team string) (string, error) {
+	org, slug, ok := strings.Cut(team, "/")
+	if !ok || org == "" || slug == "" || strings.Contains(slug, "/") {
+		return "", fmt.Errorf("invalid team format: %s (expected org/team)", team)
+	}
+
+	var query graphql.GetTeamQuery
+	err := s.client.Query(ctx, &query, graphql.BuildGetTeamVariables(org, slug))
+	if err != nil {
+		return "", fmt.Errorf("failed to get team %s: %w", team, err)
+	}
+	if query.Organization.Team.ID == "" {
+		return "", fmt.Errorf("team %s not found", team)
+	}
+
+	return query.Organization.Team.ID, nil
+}
+
+// resolveRepository returns the node ID of a repository given as owner/repo
+func (s *ProjectService) resolveRepository(ctx context.Context, repository string) (string, error) {
+	repoParts := parseRepositoryString(repository)
+	if len(repoParts) != 2 {
+		return "", fmt.Errorf("invalid repository format: %s (expected owner/repo)", repository)
+	}
+
+	repositoryID, err := s.validateRepository(ctx, repoParts[0], repoParts[1])
+	if err != nil {
+		return "", fmt.Errorf("repository validation failed for %s: %w", repository, err)
+	}
+	return repositoryID, nil
+}
+
 // ProjectExportData represents data for project export
 type ProjectExportData struct {
 	ProjectID        string
@@ -262,13 +393,16 @@ type ExportMetadata struct {
 
 // ExportedProjectData represents project configuration data
 type ExportedProjectData struct {
-	ID          string  `json:"id" yaml:"id"`
-	Title       string  `json:"title" yaml:"title"`
-	Description *string `json:"description,omitempty" yaml:"description,omitempty"`
-	URL         string  `json:"url" yaml:"url"`
-	Owner       string  `json:"owner" yaml:"owner"`
-	Number      int     `json:"number" yaml:"number"`
-	Closed      bool    `json:"closed" yaml:"closed"`
+	ID               string  `json:"id" yaml:"id"`
+	Title            string  `json:"title" yaml:"title"`
+	Description      *string `json:"description,omitempty" yaml:"description,omitempty"`
+	ShortDescription *string `json:"short_description,omitempty" yaml:"short_description,omitempty"`
+	Readme           *string `json:"readme,omitempty" yaml:"readme,omitempty"`
+	URL              string  `json:"url" yaml:"url"`
+	Owner            string  `json:"owner" yaml:"owner"`
+	Visibility       string  `json:"visibility,omitempty" yaml:"visibility,omitempty"`
+	Number           int     `json:"number" yaml:"number"`
+	Closed           bool    `json:"closed" yaml:"closed"`
 }
 
 // ExportedItem represents a project item
@@ -283,31 +417,77 @@ type ExportedItem struct {
 
 // ExportedField represents a custom field
 type ExportedField struct {
-	ID       string      `json:"id" yaml:"id"`
-	Name     string      `json:"name" yaml:"name"`
-	DataType string      `json:"data_type" yaml:"data_type"`
-	Options  interface{} `json:"options,omitempty" yaml:"options,omitempty"`
+	ID       string           `json:"id" yaml:"id"`
+	Name     string           `json:"name" yaml:"name"`
+	DataType string           `json:"data_type" yaml:"data_type"`
+	Options  []ExportedOption `json:"options,omitempty" yaml:"options,omitempty"`
+}
+
+// ExportedOption represents a single select field option
+type ExportedOption struct {
+	Name        string `json:"name" yaml:"name"`
+	Color       string `json:"color" yaml:"color"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
 }
 
 // ExportedView represents a project view
 type ExportedView struct {
-	ID     string `json:"id" yaml:"id"`
-	Name   string `json:"name" yaml:"name"`
-	Layout string `json:"layout" yaml:"layout"`
+	ID      string              `json:"id" yaml:"id"`
+	Name    string              `json:"name" yaml:"name"`
+	Layout  string              `json:"layout" yaml:"layout"`
+	Filter  string              `json:"filter,omitempty" yaml:"filter,omitempty"`
+	SortBy  []ExportedViewOrder `json:"sort_by,omitempty" yaml:"sort_by,omitempty"`
+	GroupBy []ExportedViewOrder `json:"group_by,omitempty" yaml:"group_by,omitempty"`
+}
+
+// ExportedViewOrder represents the field a view is sorted or grouped by
+type ExportedViewOrder struct {
+	Field     string `json:"field" yaml:"field"`
+	Direction string `json:"direction" yaml:"direction"`
 }
 
 // ExportProject exports project data to a file
 func (s *ProjectService) ExportProject(ctx context.Context, exportData *ProjectExportData, outputFile, format string) error {
+	export, err := s.BuildProjectExport(ctx, exportData)
+	if err != nil {
+		return err
+	}
+
+	// Serialize data to requested format
+	var data []byte
+	switch format {
+	case "json":
+		data, err = json.MarshalIndent(export, "", "  ")
+	case "yaml":
+		data, err = yaml.Marshal(export)
+	default:
+		return fmt.Errorf("unsupported format: %s", format)
+	}
+
+	if err != nil {
+		return fmt.Errorf("failed to serialize export data: %w", err)
+	}
+
+	// Write to output file
+	if err := os.WriteFile(outputFile, data, 0600); err != nil {
+		return fmt.Errorf("failed to write export file: %w", err)
+	}
+
+	return nil
+}
+
+// BuildProjectExport reads the project data selected by exportData
+func (s *ProjectService) BuildProjectExport(ctx context.Context, exportData *ProjectExportData) (*ExportedProject, error) {
 	// Parse project ID to get owner and number
 	owner, number, err := parseProjectID(exportData.ProjectID)
 	if err != nil {
-		return fmt.Errorf("invalid project ID format: %w", err)
+		return nil, fmt.Errorf("invalid project ID format: %w", err)
 	}
 
 	// Fetch project details
-	project, err := s.GetProject(ctx, owner, number, false)
+	project, err := s.GetProjectWithOwnerDetection(ctx, owner, number)
 	if err != nil {
-		return fmt.Errorf("failed to fetch project: %w", err)
+		return nil, fmt.Errorf("failed to fetch project: %w", err)
 	}
 
 	// Create export structure
@@ -329,54 +509,59 @@ func (s *ProjectService) ExportProject(ctx context.Context, exportData *ProjectE
 		},
 	}
 
-	// Fetch and include items if requested
+	settings, err := s.GetProjectSettings(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	export.Project.ShortDescription = settings.ShortDescription
+	export.Project.Readme = settings.Readme
+	export.Project.Visibility = FormatVisibility(settings.Public)
+
 	if exportData.IncludeItems {
-		items, itemsErr := s.fetchProjectItems(ctx, project.ID)
+		items, itemsErr := NewItemService(s.client).ListProjectItems(ctx, project.ID)
 		if itemsErr != nil {
-			return fmt.Errorf("failed to fetch project items: %w", itemsErr)
+			return nil, itemsErr
 		}
-		export.Items = items
+		export.Items = exportProjectItems(items)
 	}
-
-	// Fetch and include fields if requested
 	if exportData.IncludeFields {
-		fields, fieldsErr := s.fetchProjectFields(ctx, project.ID)
+		fields, fieldsErr := NewFieldService(s.client).ListProjectFields(ctx, project.ID)
 		if fieldsErr != nil {
-			return fmt.Errorf("failed to fetch project fields: %w", fieldsErr)
+			return nil, fieldsErr
 		}
-		export.Fields = fields
+		export.Fields = exportProjectFields(fields)
 	}
 
 	// Fetch and include views if requested
 	if exportData.IncludeViews {
 		views, viewsErr := s.fetchProjectViews(ctx, project.ID)
 		if viewsErr != nil {
-			return fmt.Errorf("failed to fetch project views: %w", viewsErr)
+			return nil, fmt.Errorf("failed to fetch project views: %w", viewsErr)
 		}
 		export.Views = views
 	}
 
-	// Serialize data to requested format
-	var data []byte
-	switch format {
-	case "json":
-		data, err = json.MarshalIndent(export, "", "  ")
-	case "yaml":
-		data, err = yaml.Marshal(export)
-	default:
-		return fmt.Errorf("unsupported format: %s", format)
-	}
+	return export, nil
+}
 
+// LoadProjectExport reads a file written by ExportProject (JSON or YAML)
+func LoadProjectExport(filename string) (*ExportedProject, error) {
+	data, err := os.ReadFile(filename) //nolint:gosec // reading the user-provided export file is intended
 	if err != nil {
-		return fmt.Errorf("failed to serialize export data: %w", err)
+		return nil, fmt.Errorf("failed to read export file: %w", err)
 	}
 
-	// Write to output file
-	if err := os.WriteFile(outputFile, data, 0600); err != nil {
-		return fmt.Errorf("failed to write export file: %w", err)
+	var exported ExportedProject
+
+	// Try JSON first, then YAML
+	if err := json.Unmarshal(data, &exported); err != nil {
+		// If JSON fails, try YAML
+		if err := yaml.Unmarshal(data, &exported); err != nil {
+			return nil, fmt.Errorf("failed to parse export file as JSON or YAML: %w", err)
+		}
 	}
 
-	return nil
+	return &exported, nil
 }
 
 // ImportProject imports project data from a file
@@ -387,18 +572,27 @@ func (s *ProjectService) ImportProject(ctx context.Context, opts *ProjectImportO
 		return nil, fmt.Errorf("failed to parse import file: %w", err)
 	}
 
-	// Create new project with imported configuration
-	description := ""
-	if exportData.Project.Description != nil {
-		description = *exportData.Project.Description
+	// Create new project with imported configuration; projects are private
+	// unless the export says otherwise
+	description := derefString(exportData.Project.ShortDescription)
+	if description == "" {
+		description = derefString(exportData.Project.Description)
+	}
+	visibility := VisibilityPrivate
+	if exportData.Project.Visibility != "" {
+		public, err := ParseVisibility(exportData.Project.Visibility)
+		if err != nil {
+			return nil, err
+		}
+		visibility = FormatVisibility(public)
 	}
 
 	createInput := &CreateProjectInput{
 		OwnerID:     opts.Owner, // Note: This should be owner ID, not login
 		Title:       exportData.Project.Title,
 		Description: description,
-		Readme:      "",
-		Visibility:  "public", // Default to public, could be made configurable
+		Readme:      derefString(exportData.Project.Readme),
+		Visibility:  visibility,
 		Repository:  "",
 	}
 
@@ -448,9 +642,12 @@ func (s *ProjectService) ImportProject(ctx context.Context, opts *ProjectImportO
 
 // UpdateProjectInput represents input for updating a project
 type UpdateProjectInput struct {
-	Title     *string
-	Closed    *bool
-	ProjectID string
+	Title            *string
+	ShortDescription *string
+	Readme           *string
+	Public           *bool
+	Closed           *bool
+	ProjectID        string
 }
 
 // UpdateProject updates an existing project
@@ -464,6 +661,18 @@ func (s *ProjectService) UpdateProject(ctx context.Context, input UpdateProjectI
 		title := gql.String(*input.Title)
 		gqlInput.Title = &title
 	}
+	if input.ShortDescription != nil {
+		description := gql.String(*input.ShortDescription)
+		gqlInput.ShortDescription = &description
+	}
+	if input.Readme != nil {
+		readme := gql.String(*input.Readme)
+		gqlInput.Readme = &readme
+	}
+	if input.Public != nil {
+		public := gql.Boolean(*input.Public)
+		gqlInput.Public = &public
+	}
 	if input.Closed != nil {
 		closed := gql.Boolean(*input.Closed)
 		gqlInput.Closed = &closed
@@ -594,50 +803,138 @@ func FormatProjectReference(owner string, number int) string {
 	return fmt.Sprintf("%s/%d", owner, number)
 }
 
+// ParseVisibility parses a project visibility of public or private
+func ParseVisibility(visibility string) (public bool, err error) {
+	switch strings.ToLower(visibility) {
+	case VisibilityPublic:
+		return true, nil
+	case VisibilityPrivate:
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid visibility: %s (valid: public, private)", visibility)
+	}
+}
+
+// FormatVisibility formats the visibility of a project as public or private
+func FormatVisibility(public bool) string {
+	if public {
+		return VisibilityPublic
+	}
+	return VisibilityPrivate
+}
+
 // parseProjectID parses project ID in format "owner/number"
 func parseProjectID(projectID string) (owner string, number int, err error) {
 	return ParseProjectReference(projectID)
 }
 
-// fetchProjectItems fetches all items for a project
-func (s *ProjectService) fetchProjectItems(_ context.Context, _ string) ([]ExportedItem, error) {
-	// For now, return empty slice as item fetching requires more complex GraphQL queries
-	// This would be implemented with proper GraphQL queries to fetch project items
-	return []ExportedItem{}, nil
+// exportProjectItems converts project items and their field values
+func exportProjectItems(nodes []graphql.ProjectV2Item) []ExportedItem {
+	items := make([]ExportedItem, 0, len(nodes))
+	for i := range nodes {
+		node := &nodes[i]
+		item := ExportedItem{
+			ID:     node.ID,
+			Type:   node.Content.TypeName,
+			Fields: map[string]interface{}{},
+		}
+		switch node.Content.TypeName {
+		case "Issue":
+			item.Title = node.Content.Issue.Title
+			url := node.Content.Issue.URL
+			item.URL = &url
+		case "PullRequest":
+			item.Title = node.Content.PullRequest.Title
+			url := node.Content.PullRequest.URL
+			item.URL = &url
+		default:
+			item.Title = node.Content.DraftIssue.Title
+			item.Body = node.Content.DraftIssue.Body
+		}
+
+		for _, value := range node.FieldValues.Nodes {
+			if v := exportFieldValue(&value); v != nil && value.Field.Name != "" {
+				item.Fields[value.Field.Name] = v
+			}
+		}
+		items = append(items, item)
+	}
+	return items
 }
 
-// fetchProjectFields fetches all custom fields for a project
-func (s *ProjectService) fetchProjectFields(_ context.Context, _ string) ([]ExportedField, error) {
-	// For now, return empty slice as field fetching requires more complex GraphQL queries
-	// This would be implemented with proper GraphQL queries to fetch project fields
-	return []ExportedField{}, nil
+// exportFieldValue returns the plain value of an item field value, or nil
+func exportFieldValue(value *graphql.ProjectV2ItemFieldValue) interface{} {
+	switch {
+	case value.TextValue.Text != nil:
+		return *value.TextValue.Text
+	case value.NumberValue.Number != nil:
+		return *value.NumberValue.Number
+	case value.DateValue.Date != nil:
+		return *value.DateValue.Date
+	case value.SingleSelectValue.Name != nil:
+		return *value.SingleSelectValue.Name
+	case value.IterationValue.Title != nil:
+		return *value.IterationValue.Title
+	default:
+		return nil
+	}
 }
 
-// fetchProjectViews fetches all views for a project
-func (s *ProjectService) fetchProjectViews(_ context.Context, _ string) ([]ExportedView, error) {
-	// For now, return empty slice as view fetching requires more complex GraphQL queries
-	// This would be implemented with proper GraphQL queries to fetch project views
-	return []ExportedView{}, nil
+// exportProjectFields converts the fields of a project and their options
+func exportProjectFields(nodes []graphql.ProjectV2Field) []ExportedField {
+	fields := make([]ExportedField, len(nodes))
+	for i, field := range nodes {
+		fields[i] = ExportedField{
+			ID:       field.ID,
+			Name:     field.Name,
+			DataType: string(field.DataType),
+		}
+		for _, option := range field.SingleSelect.Options {
+			fields[i].Options = append(fields[i].Options, ExportedOption{
+				Name:        option.Name,
+				Color:       option.Color,
+				Description: derefString(option.Description),
+			})
+		}
+	}
+	return fields
 }
 
-// parseImportFile reads and parses the import file (JSON or YAML)
-func (s *ProjectService) parseImportFile(filename string) (*ExportedProject, error) {
-	data, err := os.ReadFile(filename)
+// fetchProjectViews fetches all views for a project
+func (s *ProjectService) fetchProjectViews(ctx context.Context, projectID string) ([]ExportedView, error) {
+	views, err := NewViewService(s.client).GetProjectViews(ctx, projectID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read import file: %w", err)
+		return nil, err
 	}
 
-	var exported ExportedProject
-
-	// Try JSON first, then YAML
-	if err := json.Unmarshal(data, &exported); err != nil {
-		// If JSON fails, try YAML
-		if err := yaml.Unmarshal(data, &exported); err != nil {
-			return nil, fmt.Errorf("failed to parse import file as JSON or YAML: %w", err)
+	exported := make([]ExportedView, len(views))
+	for i := range views {
+		view := &views[i]
+		exported[i] = ExportedView{
+			ID:     view.ID,
+			Name:   view.Name,
+			Layout: string(view.Layout),
+			Filter: derefString(view.Filter),
+		}
+		for _, order := range view.SortBy {
+			exported[i].SortBy = append(exported[i].SortBy, ExportedViewOrder{
+				Field:     order.FieldName,
+				Direction: string(order.Direction),
+			})
+		}
+		for _, order := range view.GroupBy {
+			exported[i].GroupBy = append(exported[i].GroupBy, ExportedViewOrder{
+				Field:     order.FieldName,
+				Direction: string(order.Direction),
+			})
 		}
 	}
+	return exported, nil
+}
 
-	return &exported, nil
+// parseImportFile reads and parses the import file (JSON or YAML)
+func (s *ProjectService) parseImportFile(filename string) (*ExportedProject, error) {
+	return LoadProjectExport(filename)
 }
 
 // importProjectFields imports custom fields into the project
@@ -678,10 +975,10 @@ func parseRepositoryString(repository string) []string {
 	return strings.Split(repository, "/")
 }
 
-// validateRepository validates that a repository exists
-func (s *ProjectService) validateRepository(ctx context.Context, owner, name string) error {
+// validateRepository validates that a repository exists and returns its node ID
+func (s *ProjectService) validateRepository(ctx context.Context, owner, name string) (string, error) {
 	if owner == "" || name == "" {
-		return fmt.Errorf("invalid repository owner or name")
+		return "", fmt.Errorf("invalid repository owner or name")
 	}
 
 	// Query the repository to verify it exists and is accessible
@@ -690,26 +987,26 @@ func (s *ProjectService) validateRepository(ctx context.Context, owner, name str
 
 	err := s.client.Query(ctx, &query, variables)
 	if err != nil {
-		return fmt.Errorf("failed to query repository: %w", err)
+		return "", fmt.Errorf("failed to query repository: %w", err)
 	}
 
 	// Parse the response
 	repoInfo, err := graphql.ParseRepositoryResponse(&query)
 	if err != nil {
-		return fmt.Errorf("failed to parse repository response: %w", err)
+		return "", fmt.Errorf("failed to parse repository response: %w", err)
 	}
 
 	if repoInfo == nil {
-		return fmt.Errorf("repository %s/%s not found or not accessible", owner, name)
+		return "", fmt.Errorf("repository %s/%s not found or not accessible", owner, name)
 	}
 
 	// Verify the repository details match
-	if repoInfo.Owner != owner || repoInfo.Name != name {
-		return fmt.Errorf("repository details mismatch: expected %s/%s, got %s/%s",
+	if !strings.EqualFold(repoInfo.Owner, owner) || !strings.EqualFold(repoInfo.Name, name) {
+		return "", fmt.Errorf("repository details mismatch: expected %s/%s, got %s/%s",
 			owner, name, repoInfo.Owner, repoInfo.Name)
 	}
 
-	return nil
+	return repoInfo.ID, nil
 }
 
 const (
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
 )
 
 func TestProjectService(t *testing.T) {
@@ -125,6 +128,89 @@ func TestFormatProjectReference(t *testing.T) {
 	})
 }
 
+func TestParseVisibility(t *testing.T) {
+	public, err := ParseVisibility("Public")
+	require.NoError(t, err)
+	assert.True(t, public)
+
+	public, err = ParseVisibility("private")
+	require.NoError(t, err)
+	assert.False(t, public)
+
+	_, err = ParseVisibility("internal")
+	assert.ErrorContains(t, err, "invalid visibility")
+}
+
+func TestProjectSettingsRoundTrip(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	source := store.AddProject(fake.DefaultViewer, "Roadmap")
+	source.ShortDescription = "What we ship"
+	source.Readme = "# Roadmap\n"
+
+	ctx := context.Background()
+	projectService := NewProjectService(api.NewClient("ghp_fake"))
+	file := filepath.Join(t.TempDir(), "roadmap.json")
+
+	require.NoError(t, projectService.ExportProject(ctx, &ProjectExportData{ProjectID: "octocat/1"}, file, "json"))
+	exported, err := LoadProjectExport(file)
+	require.NoError(t, err)
+	assert.Equal(t, VisibilityPrivate, exported.Project.Visibility)
+	assert.Equal(t, "# Roadmap\n", *exported.Project.Readme)
+
+	_, err = projectService.ImportProject(ctx, &ProjectImportOptions{File: file, Owner: store.Account(fake.DefaultViewer).ID})
+	require.NoError(t, err)
+
+	imported := store.Project(fake.DefaultViewer, 2)
+	require.NotNil(t, imported)
+	assert.Equal(t, "What we ship", imported.ShortDescription)
+	assert.Equal(t, "# Roadmap\n", imported.Readme)
+	assert.False(t, imported.Public)
+}
+
+func TestProjectLinksAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	org := store.AddOrganization("acme")
+	store.AddRepository("acme", "app")
+	team := store.AddTeam(org, "Platform Team")
+	project := store.AddProject("acme", "Roadmap")
+
+	ctx := context.Background()
+	projectService := NewProjectService(api.NewClient("ghp_fake"))
+
+	projectID, err := projectService.ResolveProjectID(ctx, "acme/1")
+	require.NoError(t, err)
+	assert.Equal(t, project.ID, projectID)
+
+	require.NoError(t, projectService.LinkProjectToRepository(ctx, projectID, "acme/app"))
+	require.NoError(t, projectService.LinkProjectToTeam(ctx, projectID, "acme/platform-team"))
+
+	links, err := projectService.GetProjectLinks(ctx, projectID)
+	require.NoError(t, err)
+	require.Len(t, links.Repositories.Nodes, 1)
+	assert.Equal(t, "acme/app", links.Repositories.Nodes[0].NameWithOwner)
+	require.Len(t, links.Teams.Nodes, 1)
+	assert.Equal(t, "acme/platform-team", links.Teams.Nodes[0].CombinedSlug)
+	assert.Equal(t, team.ID, links.Teams.Nodes[0].ID)
+
+	require.NoError(t, projectService.UnlinkProjectFromTeam(ctx, projectID, "acme/platform-team"))
+	require.NoError(t, projectService.UnlinkProjectFromRepository(ctx, projectID, "acme/app"))
+	assert.Empty(t, project.Teams)
+	assert.Empty(t, project.Repositories)
+
+	err = projectService.LinkProjectToTeam(ctx, projectID, "acme/missing")
+	assert.ErrorContains(t, err, "team acme/missing not found")
+	err = projectService.LinkProjectToTeam(ctx, projectID, "platform")
+	assert.ErrorContains(t, err, "invalid team format")
+}
+
 func TestProjectServiceMethods(t *testing.T) {
 	client := api.NewClient("test-token")
 	service := NewProjectService(client)
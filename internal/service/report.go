@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+const (
+	defaultStaleAfter       = 14 * 24 * time.Hour
+	defaultBlockedLabel     = "blocked"
+	reportPercentMultiplier = 100
+)
+
+// DefaultReportTemplate is the Markdown template used for project reports when
+// no template file is given
+const DefaultReportTemplate = `# {{.Project.Title}} report
+
+{{date .Since}} to {{date .Until}} · {{.Done}} of {{.Total}} items done ({{percent .Done .Total}})
+
+## Status
+
+| Status | Items |
+|--------|-------|
+{{- range .ByStatus}}
+| {{.Status}} | {{.Count}} |
+{{- end}}
+{{- with .Iteration}}
+
+## {{.Field}}: {{.Title}}
+
+{{date .Start}} to {{date .End}} · {{.Done}} of {{.Total}} items done ({{percent .Done .Total}})
+{{- end}}
+
+## Completed ({{len .Completed}})
+{{range .Completed}}
+- {{.Title}}{{with .Assignees}} (@{{join . ", @"}}){{end}}
+{{- else}}
+Nothing was completed.
+{{- end}}
+
+## Added ({{len .Added}})
+{{range .Added}}
+- {{.Title}}{{with .Status}} · {{.}}{{end}}
+{{- else}}
+Nothing was added.
+{{- end}}
+{{- if .Assignees}}
+
+## Load
+
+| Assignee | Open | Done |
+|----------|------|------|
+{{- range .Assignees}}
+| @{{.Assignee}} | {{.Open}} | {{.Done}} |
+{{- end}}
+{{- end}}
+{{- if .Blocked}}
+
+## Blocked ({{len .Blocked}})
+{{range .Blocked}}
+- {{.Title}}{{with .Status}} · {{.}}{{end}}
+{{- end}}
+{{- end}}
+{{- if .Stale}}
+
+## Stale ({{len .Stale}})
+{{range .Stale}}
+- {{.Title}} · last updated {{date .UpdatedAt}}
+{{- end}}
+{{- end}}
+`
+
+// ReportOptions represents options for computing a project report
+type ReportOptions struct {
+	StatusField string
+	DoneOption  string
+	// BlockedLabel marks open items as blocked, as does a status containing
+	// "blocked"
+	BlockedLabel string
+	// Window is how far back completed and added items are counted
+	Window time.Duration
+	// StaleAfter is how long an open item can go without updates before it is
+	// stale
+	StaleAfter time.Duration
+}
+
+// ProjectReport represents the data a report template is executed with
+type ProjectReport struct {
+	GeneratedAt time.Time
+	Since       time.Time
+	Until       time.Time
+	Iteration   *ReportIteration
+	Project     ReportProject
+	ByStatus    []StatusStat
+	Completed   []ReportItem
+	Added       []ReportItem
+	Assignees   []AssigneeLoad
+	Stale       []ReportItem
+	Blocked     []ReportItem
+	Total       int
+	Open        int
+	Done        int
+}
+
+// ReportProject represents the project a report is about
+type ReportProject struct {
+	Title  string
+	URL    string
+	Owner  string
+	Number int
+}
+
+// ReportItem represents a project item in a report
+type ReportItem struct {
+	UpdatedAt time.Time
+	Title     string
+	URL       string
+	Status    string
+	Assignees []string
+	Labels    []string
+	Number    int
+	Done      bool
+}
+
+// AssigneeLoad represents the open and done items of an assignee
+type AssigneeLoad struct {
+	Assignee string
+	Open     int
+	Done     int
+}
+
+// ReportIteration represents the current iteration of the first iteration field
+type ReportIteration struct {
+	Start time.Time
+	End   time.Time
+	ID    string
+	Field string
+	Title string
+	Items []ReportItem
+	Total int
+	Done  int
+}
+
+// BuildProjectReport fetches every item of a project and computes a report
+func (s *AnalyticsService) BuildProjectReport(ctx context.Context, project *graphql.ProjectV2, now time.Time, opts ReportOptions) (*ProjectReport, error) {
+	items, err := NewItemService(s.client).ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	return ComputeProjectReport(project, items, now, opts)
+}
+
+// ComputeProjectReport computes a report from the items of a project. Items are
+// done when their status is the done option or their issue or pull request is
+// closed; they count as completed when they were closed, or otherwise last
+// updated, within the window. Items without assignees are left out of the load.
+func ComputeProjectReport(project *graphql.ProjectV2, items []graphql.ProjectV2Item, now time.Time, opts ReportOptions) (*ProjectReport, error) {
+	statusName := opts.StatusField
+	if statusName == "" {
+		statusName = defaultStatusFieldName
+	}
+	doneOption := opts.DoneOption
+	if doneOption == "" {
+		doneOption = defaultDoneOptionName
+	}
+	blockedLabel := opts.BlockedLabel
+	if blockedLabel == "" {
+		blockedLabel = defaultBlockedLabel
+	}
+	window := opts.Window
+	if window <= 0 {
+		window = defaultMetricsWindow
+	}
+	staleAfter := opts.StaleAfter
+	if staleAfter <= 0 {
+		staleAfter = defaultStaleAfter
+	}
+
+	statusField := projectFieldByName(project, statusName)
+	if statusField == nil && opts.StatusField != "" {
+		return nil, fmt.Errorf("field %s not found in project", opts.StatusField)
+	}
+	if statusField != nil && statusField.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return nil, fmt.Errorf("field %s is not a single select field", statusField.Name)
+	}
+
+	report := &ProjectReport{
+		GeneratedAt: now,
+		Since:       now.Add(-window),
+		Until:       now,
+		Project: ReportProject{
+			Title:  project.Title,
+			URL:    project.URL,
+			Owner:  project.Owner.Login,
+			Number: project.Number,
+		},
+		Total: len(items),
+	}
+	report.Iteration = currentReportIteration(project, now)
+
+	staleBefore := now.Add(-staleAfter)
+	counts := map[string]int{}
+	loads := map[string]*AssigneeLoad{}
+	var order []string
+
+	for i := range items {
+		item := newReportItem(&items[i], statusField)
+		item.Done = projectItemDone(&items[i], item.Status, doneOption)
+
+		if statusField != nil {
+			label := item.Status
+			if label == "" {
+				label = "No " + statusField.Name
+			}
+			if _, seen := counts[label]; !seen {
+				order = append(order, label)
+			}
+			counts[label]++
+		}
+
+		if !items[i].CreatedAt.Before(report.Since) {
+			report.Added = append(report.Added, item)
+		}
+		for _, assignee := range item.Assignees {
+			load := loads[assignee]
+			if load == nil {
+				load = &AssigneeLoad{Assignee: assignee}
+				loads[assignee] = load
+			}
+			if item.Done {
+				load.Done++
+			} else {
+				load.Open++
+			}
+		}
+		if report.Iteration != nil && itemInIteration(&items[i], report.Iteration) {
+			report.Iteration.Items = append(report.Iteration.Items, item)
+			report.Iteration.Total++
+			if item.Done {
+				report.Iteration.Done++
+			}
+		}
+
+		if item.Done {
+			report.Done++
+			if !projectItemCompletedAt(&items[i]).Before(report.Since) {
+				report.Completed = append(report.Completed, item)
+			}
+			continue
+		}
+
+		report.Open++
+		if strings.Contains(strings.ToLower(item.Status), "blocked") || containsFold(item.Labels, blockedLabel) {
+			report.Blocked = append(report.Blocked, item)
+		}
+		if item.UpdatedAt.Before(staleBefore) {
+			report.Stale = append(report.Stale, item)
+		}
+	}
+
+	for _, label := range statusOptionOrder(statusField, order) {
+		report.ByStatus = append(report.ByStatus, StatusStat{Status: label, Count: counts[label]})
+	}
+	for _, load := range loads {
+		report.Assignees = append(report.Assignees, *load)
+	}
+	sort.Slice(report.Assignees, func(i, j int) bool {
+		a, b := report.Assignees[i], report.Assignees[j]
+		if a.Open != b.Open {
+			return a.Open > b.Open
+		}
+		return a.Assignee < b.Assignee
+	})
+	sort.SliceStable(report.Stale, func(i, j int) bool {
+		return report.Stale[i].UpdatedAt.Before(report.Stale[j].UpdatedAt)
+	})
+
+	return report, nil
+}
+
+// RenderProjectReport executes a report template, which may use the date, join
+// and percent functions
+func RenderProjectReport(text string, report *ProjectReport) (string, error) {
+	tmpl, err := template.New("report").Funcs(template.FuncMap{
+		"date": func(t time.Time) string {
+			return t.Format(DateLayout)
+		},
+		"join": strings.Join,
+		"percent": func(part, total int) string {
+			if total == 0 {
+				return "0%"
+			}
+			return fmt.Sprintf("%d%%", part*reportPercentMultiplier/total)
+		},
+	}).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid report template: %w", err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, report); err != nil {
+		return "", fmt.Errorf("failed to render report: %w", err)
+	}
+	return b.String(), nil
+}
+
+// LoadReportTemplate reads a report template file, or returns the default
+// template when path is empty
+func LoadReportTemplate(path string) (string, error) {
+	if path == "" {
+		return DefaultReportTemplate, nil
+	}
+	data, err := os.ReadFile(path) //nolint:gosec // reading the user-provided template is intended
+	if err != nil {
+		return "", fmt.Errorf("failed to read template: %w", err)
+	}
+	return string(data), nil
+}
+
+func newReportItem(item *graphql.ProjectV2Item, statusField *graphql.ProjectV2Field) ReportItem {
+	result := ReportItem{Title: projectItemTitle(item), UpdatedAt: item.UpdatedAt}
+	var assignees graphql.ItemAssignees
+	var labels graphql.ItemLabels
+	switch item.Content.TypeName {
+	case "Issue":
+		result.URL, result.Number = item.Content.Issue.URL, item.Content.Issue.Number
+		assignees, labels = item.Content.Issue.Assignees, item.Content.Issue.Labels
+	case "PullRequest":
+		result.URL, result.Number = item.Content.PullRequest.URL, item.Content.PullRequest.Number
+		assignees, labels = item.Content.PullRequest.Assignees, item.Content.PullRequest.Labels
+	}
+	for _, assignee := range assignees.Nodes {
+		result.Assignees = append(result.Assignees, assignee.Login)
+	}
+	for _, label := range labels.Nodes {
+		result.Labels = append(result.Labels, label.Name)
+	}
+	if statusField != nil {
+		for _, value := range item.FieldValues.Nodes {
+			if value.Field.ID == statusField.ID && value.SingleSelectValue.Name != nil {
+				result.Status = *value.SingleSelectValue.Name
+			}
+		}
+	}
+	return result
+}
+
+func projectItemClosedAt(item *graphql.ProjectV2Item) *time.Time {
+	switch item.Content.TypeName {
+	case "Issue":
+		return item.Content.Issue.ClosedAt
+	case "PullRequest":
+		return item.Content.PullRequest.ClosedAt
+	default:
+		return nil
+	}
+}
+
+// currentReportIteration returns the iteration in progress of the first iteration
+// field, or nil
+func currentReportIteration(project *graphql.ProjectV2, now time.Time) *ReportIteration {
+	for i := range project.Fields.Nodes {
+		field := &project.Fields.Nodes[i]
+		if field.DataType != graphql.ProjectV2FieldDataTypeIteration {
+			continue
+		}
+		iterations, err := IterationsFromField(field)
+		if err != nil {
+			return nil
+		}
+		current, err := ResolveIteration(iterations, IterationRefCurrent, now)
+		if err != nil {
+			return nil
+		}
+		return &ReportIteration{
+			ID:    current.ID,
+			Field: field.Name,
+			Title: current.Title,
+			Start: current.StartDate,
+			End:   current.EndDate(),
+		}
+	}
+	return nil
+}
+
+func itemInIteration(item *graphql.ProjectV2Item, iteration *ReportIteration) bool {
+	for _, value := range item.FieldValues.Nodes {
+		if value.IterationValue.IterationID != nil && *value.IterationValue.IterationID == iteration.ID {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(values []string, want string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, want) {
+			return true
+		}
+	}
+	return false
+}
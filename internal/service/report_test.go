@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestProjectReportAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	now := time.Date(2026, 3, 12, 9, 0, 0, 0, time.UTC)
+	store := server.Store
+	store.SetClock(func() time.Time { return now.AddDate(0, 0, -30) })
+
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	blockedLabel := store.AddLabel(repo, "Blocked", "d73a4a")
+	alice, bob := store.AddUser("alice"), store.AddUser("bob")
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+	status := project.Field("Status")
+	// Iterations of the field start a month ago, so the third one is current
+	sprint := store.AddField(project, "Sprint", "ITERATION")
+	store.AddOption(status, "Blocked")
+
+	// Items added a month ago
+	old := store.AddIssue(repo, "Old work")
+	old.Assignees = []*fake.Account{alice}
+	oldItem := store.AddItem(project, old)
+	store.SetValue(oldItem, status, fake.Value{OptionID: status.Option("In Progress").ID})
+	waiting := store.AddIssue(repo, "Waiting on vendor")
+	waiting.Labels = []*fake.Label{blockedLabel}
+	store.AddItem(project, waiting)
+
+	// Items added this week
+	store.SetClock(func() time.Time { return now })
+	closed := store.AddIssue(repo, "Fix login")
+	closed.Assignees = []*fake.Account{alice, bob}
+	closed.Closed = true
+	closedAt := now.AddDate(0, 0, -1)
+	closed.ClosedAt = &closedAt
+	closedItem := store.AddItem(project, closed)
+	store.SetValue(closedItem, sprint, fake.Value{IterationID: sprint.Iterations[2].ID})
+	stuck := store.AddDraftIssue(project, "Migrate billing", "")
+	store.SetValue(stuck, status, fake.Value{OptionID: status.Option("Blocked").ID})
+	store.SetValue(stuck, sprint, fake.Value{IterationID: sprint.Iterations[2].ID})
+
+	ctx := context.Background()
+	client := api.NewClient("ghp_fake")
+	p, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+	require.NoError(t, err)
+
+	report, err := NewAnalyticsService(client).BuildProjectReport(ctx, p, now.Add(time.Hour), ReportOptions{})
+	require.NoError(t, err)
+
+	titles := func(items []ReportItem) []string {
+		result := make([]string, len(items))
+		for i, item := range items {
+			result[i] = item.Title
+		}
+		return result
+	}
+
+	t.Run("Computes counts, windows and load", func(t *testing.T) {
+		assert.Equal(t, 4, report.Total)
+		assert.Equal(t, 1, report.Done)
+		assert.Equal(t, 3, report.Open)
+		assert.Equal(t, []string{"Fix login"}, titles(report.Completed))
+		assert.Equal(t, []string{"Fix login", "Migrate billing"}, titles(report.Added))
+		assert.Equal(t, []string{"Old work", "Waiting on vendor"}, titles(report.Stale))
+		assert.Equal(t, []string{"Waiting on vendor", "Migrate billing"}, titles(report.Blocked))
+		assert.Equal(t, []AssigneeLoad{
+			{Assignee: "alice", Open: 1, Done: 1},
+			{Assignee: "bob", Done: 1},
+		}, report.Assignees)
+		assert.Equal(t, []StatusStat{
+			{Status: "In Progress", Count: 1},
+			{Status: "Blocked", Count: 1},
+			{Status: "No Status", Count: 2},
+		}, report.ByStatus)
+
+		require.NotNil(t, report.Iteration)
+		assert.Equal(t, "Sprint", report.Iteration.Field)
+		assert.Equal(t, sprint.Iterations[2].Title, report.Iteration.Title)
+		assert.Equal(t, 2, report.Iteration.Total)
+		assert.Equal(t, 1, report.Iteration.Done)
+	})
+
+	t.Run("Renders the default template", func(t *testing.T) {
+		text, err := RenderProjectReport(DefaultReportTemplate, report)
+		require.NoError(t, err)
+		assert.Contains(t, text, "# Roadmap report\n")
+		assert.Contains(t, text, "1 of 4 items done (25%)")
+		assert.Contains(t, text, "| Blocked | 1 |\n")
+		assert.Contains(t, text, "## Completed (1)\n\n- Fix login (@alice, @bob)\n")
+		assert.Contains(t, text, "| @alice | 1 | 1 |\n")
+		assert.Contains(t, text, "## Stale (2)\n\n- Old work · last updated 2026-02-10\n")
+	})
+
+	t.Run("Renders custom templates and reports template errors", func(t *testing.T) {
+		text, err := RenderProjectReport("{{.Project.Title}}: {{len .Blocked}} blocked", report)
+		require.NoError(t, err)
+		assert.Equal(t, "Roadmap: 2 blocked", text)
+
+		_, err = RenderProjectReport("{{.Nope}}", report)
+		assert.ErrorContains(t, err, "failed to render report")
+
+		_, err = RenderProjectReport("{{if}}", report)
+		assert.ErrorContains(t, err, "invalid report template")
+	})
+
+	t.Run("Rejects a missing status field", func(t *testing.T) {
+		_, err := ComputeProjectReport(p, nil, now, ReportOptions{StatusField: "Stage"})
+		assert.ErrorContains(t, err, "field Stage not found")
+	})
+}
@@ -0,0 +1,356 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// nodeIDPattern matches GraphQL node IDs such as PVTV_lADOB... or PVTI_lADOB...
+var nodeIDPattern = regexp.MustCompile(`^[A-Z][A-Za-z]*_[A-Za-z0-9_-]+$`)
+
+// IsNodeID reports whether ref looks like a GraphQL node ID
+func IsNodeID(ref string) bool {
+	return nodeIDPattern.MatchString(ref)
+}
+
+// AmbiguousReferenceError reports a reference that matches more than one node
+type AmbiguousReferenceError struct {
+	Kind string
+	Ref  string
+	// Matches describes each node the reference matches
+	Matches []string
+}
+
+func (e *AmbiguousReferenceError) Error() string {
+	return fmt.Sprintf("%s %q is ambiguous, it matches %s; use the ID instead",
+		e.Kind, e.Ref, strings.Join(e.Matches, ", "))
+}
+
+// referenceCandidate is a node a reference can match
+type referenceCandidate struct {
+	ID   string
+	Name string
+}
+
+// matchReference returns the index of the candidate with the ID ref, or else of
+// the one named ref. Exact names win over names that only match ignoring case.
+// It returns -1 when nothing matches and an AmbiguousReferenceError when
+// several candidates do.
+func matchReference(kind, ref string, candidates []referenceCandidate) (int, error) {
+	for i := range candidates {
+		if candidates[i].ID == ref {
+			return i, nil
+		}
+	}
+
+	var exact, folded []int
+	for i := range candidates {
+		switch {
+		case candidates[i].Name == ref:
+			exact = append(exact, i)
+		case strings.EqualFold(candidates[i].Name, ref):
+			folded = append(folded, i)
+		}
+	}
+	matches := exact
+	if len(matches) == 0 {
+		matches = folded
+	}
+
+	switch len(matches) {
+	case 0:
+		return -1, nil
+	case 1:
+		return matches[0], nil
+	default:
+		described := make([]string, len(matches))
+		for i, match := range matches {
+			described[i] = fmt.Sprintf("%s (%s)", candidates[match].Name, candidates[match].ID)
+		}
+		return -1, &AmbiguousReferenceError{Kind: kind, Ref: ref, Matches: described}
+	}
+}
+
+// Resolver translates human-friendly references into GraphQL node IDs: projects
+// as owner/number, views by number or name, fields by name, options as
+// Field:Option and items as owner/repo#number or issue URLs. Node IDs are
+// accepted everywhere and passed through. Projects are fetched once per
+// reference and reused.
+type Resolver struct {
+	client   *api.Client
+	projects map[string]*graphql.ProjectV2
+	views    map[string][]ViewInfo
+}
+
+// NewResolver creates a new resolver
+func NewResolver(client *api.Client) *Resolver {
+	return &Resolver{
+		client:   client,
+		projects: map[string]*graphql.ProjectV2{},
+		views:    map[string][]ViewInfo{},
+	}
+}
+
+// Project returns the project given as owner/number
+func (r *Resolver) Project(ctx context.Context, ref string) (*graphql.ProjectV2, error) {
+	if project, ok := r.projects[ref]; ok {
+		return project, nil
+	}
+
+	owner, number, err := ParseProjectReference(ref)
+	if err != nil {
+		return nil, fmt.Errorf("invalid project reference: %w", err)
+	}
+	project, err := NewProjectService(r.client).GetProjectWithOwnerDetection(ctx, owner, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+	r.projects[ref] = project
+	return project, nil
+}
+
+// ProjectID returns the node ID of a project given as owner/number or as a node ID
+func (r *Resolver) ProjectID(ctx context.Context, ref string) (string, error) {
+	if !strings.Contains(ref, "/") {
+		if !IsNodeID(ref) {
+			return "", fmt.Errorf("invalid project reference: %s (expected owner/number or a project node ID)", ref)
+		}
+		return ref, nil
+	}
+	project, err := r.Project(ctx, ref)
+	if err != nil {
+		return "", err
+	}
+	return project.ID, nil
+}
+
+// projectViews returns the views of a project, fetching them once
+func (r *Resolver) projectViews(ctx context.Context, project *graphql.ProjectV2) ([]ViewInfo, error) {
+	if views, ok := r.views[project.ID]; ok {
+		return views, nil
+	}
+	views, err := NewViewService(r.client).GetProjectViews(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	r.views[project.ID] = views
+	return views, nil
+}
+
+// View returns the view of a project given by number, ID or name
+func (r *Resolver) View(ctx context.Context, projectRef, ref string) (*ViewInfo, error) {
+	project, err := r.Project(ctx, projectRef)
+	if err != nil {
+		return nil, err
+	}
+	views, err := r.projectViews(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+	view, err := FindProjectView(views, ref)
+	if err != nil {
+		return nil, withProject(err, projectRef)
+	}
+	found := *view
+	found.ProjectID, found.ProjectName = project.ID, project.Title
+	return &found, nil
+}
+
+// ViewID returns the node ID of a view given by number, ID or name within a
+// project, or as a node ID when no project is given
+func (r *Resolver) ViewID(ctx context.Context, projectRef, ref string) (string, error) {
+	if projectRef == "" {
+		if !IsNodeID(ref) {
+			return "", fmt.Errorf("view %s is not a view ID; give the project with --project owner/number to find it by number or name", ref)
+		}
+		return ref, nil
+	}
+	view, err := r.View(ctx, projectRef, ref)
+	if err != nil {
+		return "", err
+	}
+	return view.ID, nil
+}
+
+// Field returns the field of a project given by ID or name
+func (r *Resolver) Field(ctx context.Context, projectRef, ref string) (*graphql.ProjectV2Field, error) {
+	project, err := r.Project(ctx, projectRef)
+	if err != nil {
+		return nil, err
+	}
+	field, err := FindProjectField(project, ref)
+	if err != nil {
+		return nil, withProject(err, projectRef)
+	}
+	return field, nil
+}
+
+// FieldID returns the node ID of a field given by name within a project, or as
+// a node ID when no project is given
+func (r *Resolver) FieldID(ctx context.Context, projectRef, ref string) (string, error) {
+	if projectRef == "" {
+		if !IsNodeID(ref) {
+			return "", fmt.Errorf("field %s is not a field ID; give the project with --project owner/number to find it by name", ref)
+		}
+		return ref, nil
+	}
+	field, err := r.Field(ctx, projectRef, ref)
+	if err != nil {
+		return "", err
+	}
+	return field.ID, nil
+}
+
+// Option returns a single select option given as Field:Option within a project,
+// together with its field. The field and option are matched by ID or name.
+func (r *Resolver) Option(ctx context.Context, projectRef, ref string) (*graphql.ProjectV2Field, *graphql.ProjectV2SingleSelectFieldOption, error) {
+	project, err := r.Project(ctx, projectRef)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Field and option names may contain colons, so every split that names a
+	// field of the project is considered
+	var field *graphql.ProjectV2Field
+	var optionRef string
+	var fields []string
+	for i := range ref {
+		if ref[i] != ':' {
+			continue
+		}
+		candidate, findErr := FindProjectField(project, strings.TrimSpace(ref[:i]))
+		if findErr != nil {
+			continue
+		}
+		if field == nil || candidate.ID != field.ID {
+			fields = append(fields, fmt.Sprintf("%s (%s)", candidate.Name, candidate.ID))
+		}
+		field, optionRef = candidate, strings.TrimSpace(ref[i+1:])
+	}
+	switch {
+	case len(fields) == 0 && !strings.Contains(ref, ":"):
+		return nil, nil, fmt.Errorf("option %s must be given as Field:Option", ref)
+	case len(fields) == 0:
+		return nil, nil, fmt.Errorf("no field of option %s found in project %s", ref, projectRef)
+	case len(fields) > 1:
+		return nil, nil, &AmbiguousReferenceError{Kind: "option", Ref: ref, Matches: fields}
+	}
+	if field.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return nil, nil, fmt.Errorf("field %s is not a single select field", field.Name)
+	}
+
+	option, err := FindFieldOption(field, optionRef)
+	if err != nil {
+		return nil, nil, err
+	}
+	return field, option, nil
+}
+
+// OptionID returns the ID of a single select option given as Field:Option within
+// a project, or as an option ID when no project is given
+func (r *Resolver) OptionID(ctx context.Context, projectRef, ref string) (string, error) {
+	if projectRef == "" {
+		if strings.Contains(ref, ":") {
+			return "", fmt.Errorf("option %s needs the project with --project owner/number", ref)
+		}
+		return ref, nil
+	}
+	_, option, err := r.Option(ctx, projectRef, ref)
+	if err != nil {
+		return "", err
+	}
+	return option.ID, nil
+}
+
+// ItemID returns the node ID of the item of a project holding the issue or pull
+// request given as owner/repo#number or as a URL. Project item IDs are passed
+// through.
+func (r *Resolver) ItemID(ctx context.Context, projectRef, ref string) (string, error) {
+	if IsNodeID(ref) {
+		return ref, nil
+	}
+
+	owner, repo, number, err := ParseItemReference(ref)
+	if err != nil {
+		return "", fmt.Errorf("item %s is neither a project item ID nor an issue or pull request reference: %w", ref, err)
+	}
+	project, err := r.Project(ctx, projectRef)
+	if err != nil {
+		return "", err
+	}
+
+	items, err := r.contentProjectItems(ctx, owner, repo, number)
+	if err != nil {
+		return "", err
+	}
+	for i := range items {
+		if items[i].Project.ID == project.ID {
+			return items[i].ID, nil
+		}
+	}
+	return "", fmt.Errorf("%s is not in project %s", FormatItemReference(owner, repo, number), projectRef)
+}
+
+// contentProjectItems returns the project items of an issue or pull request
+func (r *Resolver) contentProjectItems(ctx context.Context, owner, repo string, number int) ([]graphql.ContentProjectItem, error) {
+	var query graphql.GetContentProjectItemsQuery
+	err := r.client.Query(ctx, &query, graphql.BuildGetContentProjectItemsVariables(owner, repo, number))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get %s: %w", FormatItemReference(owner, repo, number), err)
+	}
+
+	content := query.Repository.IssueOrPullRequest
+	if content.TypeName == "PullRequest" {
+		return content.PullRequest.ProjectItems.Nodes, nil
+	}
+	return content.Issue.ProjectItems.Nodes, nil
+}
+
+// FindProjectField returns the field of a project with the given ID or name
+func FindProjectField(project *graphql.ProjectV2, ref string) (*graphql.ProjectV2Field, error) {
+	fields := project.Fields.Nodes
+	candidates := make([]referenceCandidate, len(fields))
+	for i := range fields {
+		candidates[i] = referenceCandidate{ID: fields[i].ID, Name: fields[i].Name}
+	}
+	index, err := matchReference("field", ref, candidates)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("field '%s' not found in project", ref)
+	}
+	return &fields[index], nil
+}
+
+// withProject names the project in the not-found errors of FindProjectView and
+// FindProjectField
+func withProject(err error, projectRef string) error {
+	var ambiguous *AmbiguousReferenceError
+	if errors.As(err, &ambiguous) {
+		return err
+	}
+	return fmt.Errorf("%w %s", err, projectRef)
+}
+
+// viewCandidates returns the views as reference candidates
+func viewCandidates(views []ViewInfo) []referenceCandidate {
+	candidates := make([]referenceCandidate, len(views))
+	for i := range views {
+		candidates[i] = referenceCandidate{ID: views[i].ID, Name: views[i].Name}
+	}
+	return candidates
+}
+
+// parseViewNumber returns the number of a view reference such as 2 or #2
+func parseViewNumber(ref string) (int, bool) {
+	number, err := strconv.Atoi(strings.TrimPrefix(ref, "#"))
+	return number, err == nil
+}
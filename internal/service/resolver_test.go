@@ -0,0 +1,195 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestIsNodeID(t *testing.T) {
+	assert.True(t, IsNodeID("PVTV_lADOB8Xb3s4A"))
+	assert.True(t, IsNodeID("PVTI_12"))
+	assert.False(t, IsNodeID("Board"))
+	assert.False(t, IsNodeID("2"))
+	assert.False(t, IsNodeID("octocat/app#1"))
+	assert.False(t, IsNodeID("In_Progress now"))
+}
+
+func TestMatchReference(t *testing.T) {
+	candidates := []referenceCandidate{
+		{ID: "PVTF_1", Name: "Status"},
+		{ID: "PVTF_2", Name: "status"},
+		{ID: "PVTF_3", Name: "Priority"},
+		{ID: "PVTF_4", Name: "Size"},
+		{ID: "PVTF_5", Name: "size"},
+	}
+
+	tests := []struct {
+		name      string
+		ref       string
+		expected  int
+		ambiguous bool
+	}{
+		{name: "ID", ref: "PVTF_2", expected: 1},
+		{name: "exact name wins over folded name", ref: "Status", expected: 0},
+		{name: "folded name", ref: "priority", expected: 2},
+		{name: "no match", ref: "Estimate", expected: -1},
+		{name: "ambiguous folded names", ref: "SIZE", expected: -1, ambiguous: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index, err := matchReference("field", tt.ref, candidates)
+			if tt.ambiguous {
+				var ambiguous *AmbiguousReferenceError
+				require.ErrorAs(t, err, &ambiguous)
+				assert.Equal(t, []string{"Size (PVTF_4)", "size (PVTF_5)"}, ambiguous.Matches)
+				assert.EqualError(t, err, `field "SIZE" is ambiguous, it matches Size (PVTF_4), size (PVTF_5); use the ID instead`)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, index)
+		})
+	}
+}
+
+func TestResolverAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+	other := store.AddProject(fake.DefaultViewer, "Archive")
+	board := store.AddView(project, "Board", "BOARD_LAYOUT")
+	store.AddView(project, "Triage", "TABLE_LAYOUT")
+	store.AddView(project, "triage", "BOARD_LAYOUT")
+	priority := store.AddField(project, "Priority", "SINGLE_SELECT", "High", "Low")
+	ratio := store.AddField(project, "Ratio: Done", "SINGLE_SELECT", "Half")
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	issue := store.AddIssue(repo, "Login fails")
+	pr := store.AddPullRequest(repo, "Fix login")
+	outside := store.AddIssue(repo, "Unplanned")
+	item := store.AddItem(project, issue)
+	prItem := store.AddItem(project, pr)
+	store.AddItem(other, outside)
+
+	ctx := context.Background()
+	resolver := NewResolver(api.NewClient("ghp_fake"))
+	ref := fake.DefaultViewer + "/1"
+
+	t.Run("Resolves projects and passes project IDs through", func(t *testing.T) {
+		id, err := resolver.ProjectID(ctx, ref)
+		require.NoError(t, err)
+		assert.Equal(t, project.ID, id)
+
+		id, err = resolver.ProjectID(ctx, project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, project.ID, id)
+
+		for _, bad := range []string{"123", "roadmap"} {
+			_, err = resolver.ProjectID(ctx, bad)
+			assert.ErrorContains(t, err, "expected owner/number or a project node ID", bad)
+		}
+	})
+
+	t.Run("Resolves views by number, name and ID", func(t *testing.T) {
+		for _, viewRef := range []string{fmt.Sprint(board.Number), "#" + fmt.Sprint(board.Number), "Board", "board", board.ID} {
+			view, err := resolver.View(ctx, ref, viewRef)
+			require.NoError(t, err, viewRef)
+			assert.Equal(t, board.ID, view.ID)
+			assert.Equal(t, project.ID, view.ProjectID)
+			assert.Equal(t, "Roadmap", view.ProjectName)
+		}
+
+		id, err := resolver.ViewID(ctx, "", board.ID)
+		require.NoError(t, err)
+		assert.Equal(t, board.ID, id)
+	})
+
+	t.Run("Reports view names that are ambiguous or unknown", func(t *testing.T) {
+		view, err := resolver.View(ctx, ref, "Triage")
+		require.NoError(t, err)
+		assert.Equal(t, "Triage", view.Name)
+
+		_, err = resolver.View(ctx, ref, "TRIAGE")
+		var ambiguous *AmbiguousReferenceError
+		require.ErrorAs(t, err, &ambiguous)
+		assert.Len(t, ambiguous.Matches, 2)
+
+		_, err = resolver.View(ctx, ref, "Backlog")
+		assert.EqualError(t, err, "view Backlog not found in project "+ref)
+
+		_, err = resolver.ViewID(ctx, "", "Board")
+		assert.ErrorContains(t, err, "--project owner/number")
+	})
+
+	t.Run("Resolves fields by name", func(t *testing.T) {
+		id, err := resolver.FieldID(ctx, ref, "priority")
+		require.NoError(t, err)
+		assert.Equal(t, priority.ID, id)
+
+		id, err = resolver.FieldID(ctx, "", priority.ID)
+		require.NoError(t, err)
+		assert.Equal(t, priority.ID, id)
+
+		_, err = resolver.FieldID(ctx, ref, "Estimate")
+		assert.EqualError(t, err, "field 'Estimate' not found in project "+ref)
+
+		_, err = resolver.FieldID(ctx, "", "Priority")
+		assert.ErrorContains(t, err, "--project owner/number")
+	})
+
+	t.Run("Resolves options as Field:Option", func(t *testing.T) {
+		id, err := resolver.OptionID(ctx, ref, "Priority:low")
+		require.NoError(t, err)
+		assert.Equal(t, priority.Option("Low").ID, id)
+
+		id, err = resolver.OptionID(ctx, ref, "Ratio: Done:Half")
+		require.NoError(t, err)
+		assert.Equal(t, ratio.Option("Half").ID, id)
+
+		id, err = resolver.OptionID(ctx, "", "PVTSSF_option")
+		require.NoError(t, err)
+		assert.Equal(t, "PVTSSF_option", id)
+
+		_, err = resolver.OptionID(ctx, ref, "Priority")
+		assert.EqualError(t, err, "option Priority must be given as Field:Option")
+
+		_, err = resolver.OptionID(ctx, ref, "Status:Blocked")
+		assert.ErrorContains(t, err, "option 'Blocked' not found in field Status")
+
+		_, err = resolver.OptionID(ctx, ref, "Title:Login")
+		assert.EqualError(t, err, "field Title is not a single select field")
+
+		_, err = resolver.OptionID(ctx, "", "Priority:Low")
+		assert.ErrorContains(t, err, "--project owner/number")
+	})
+
+	t.Run("Resolves items by issue and pull request reference", func(t *testing.T) {
+		id, err := resolver.ItemID(ctx, ref, fmt.Sprintf("%s/app#%d", fake.DefaultViewer, issue.Number))
+		require.NoError(t, err)
+		assert.Equal(t, item.ID, id)
+
+		id, err = resolver.ItemID(ctx, ref, fmt.Sprintf("https://github.com/%s/app/pull/%d", fake.DefaultViewer, pr.Number))
+		require.NoError(t, err)
+		assert.Equal(t, prItem.ID, id)
+
+		id, err = resolver.ItemID(ctx, ref, item.ID)
+		require.NoError(t, err)
+		assert.Equal(t, item.ID, id)
+
+		outsideRef := fmt.Sprintf("%s/app#%d", fake.DefaultViewer, outside.Number)
+		_, err = resolver.ItemID(ctx, ref, outsideRef)
+		assert.EqualError(t, err, outsideRef+" is not in project "+ref)
+
+		_, err = resolver.ItemID(ctx, ref, "login-bug")
+		assert.ErrorContains(t, err, "neither a project item ID nor an issue or pull request reference")
+	})
+}
@@ -0,0 +1,254 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// Project visibilities
+const (
+	VisibilityPublic  = "public"
+	VisibilityPrivate = "private"
+)
+
+// ProjectSpec describes the desired configuration of a project. Settings and
+// sections left out of the spec are not managed.
+type ProjectSpec struct {
+	ShortDescription *string     `yaml:"shortDescription,omitempty"`
+	Readme           *string     `yaml:"readme,omitempty"`
+	Title            string      `yaml:"title,omitempty"`
+	ReadmeFile       string      `yaml:"readmeFile,omitempty"`
+	Visibility       string      `yaml:"visibility,omitempty"`
+	Repositories     []string    `yaml:"repositories,omitempty"`
+	Fields           []FieldSpec `yaml:"fields,omitempty"`
+	Views            []ViewSpec  `yaml:"views,omitempty"`
+}
+
+// FieldSpec describes a project field. RenamedFrom names the field to rename
+// when no field with Name exists yet.
+type FieldSpec struct {
+	Iterations  *IterationSpec `yaml:"iterations,omitempty"`
+	Name        string         `yaml:"name"`
+	RenamedFrom string         `yaml:"renamedFrom,omitempty"`
+	Type        string         `yaml:"type,omitempty"`
+	Options     []OptionSpec   `yaml:"options,omitempty"`
+}
+
+// OptionSpec describes a single select option
+type OptionSpec struct {
+	Description *string `yaml:"description,omitempty"`
+	Name        string  `yaml:"name"`
+	RenamedFrom string  `yaml:"renamedFrom,omitempty"`
+	Color       string  `yaml:"color,omitempty"`
+}
+
+// IterationSpec describes the iteration settings of an iteration field. Start and
+// count only apply when the field is created.
+type IterationSpec struct {
+	Duration string `yaml:"duration,omitempty"`
+	Start    string `yaml:"start,omitempty"`
+	Count    int    `yaml:"count,omitempty"`
+}
+
+// ViewSpec describes a project view
+type ViewSpec struct {
+	Filter      *string        `yaml:"filter,omitempty"`
+	SortBy      *ViewOrderSpec `yaml:"sortBy,omitempty"`
+	GroupBy     *ViewOrderSpec `yaml:"groupBy,omitempty"`
+	Name        string         `yaml:"name"`
+	RenamedFrom string         `yaml:"renamedFrom,omitempty"`
+	Layout      string         `yaml:"layout,omitempty"`
+}
+
+// ViewOrderSpec describes the field a view is sorted or grouped by
+type ViewOrderSpec struct {
+	Field     string `yaml:"field"`
+	Direction string `yaml:"direction,omitempty"`
+}
+
+// LoadProjectSpec reads and validates a project spec file. A readmeFile is read
+// relative to the spec file.
+func LoadProjectSpec(path string) (*ProjectSpec, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // reading the user-provided spec file is intended
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec: %w", err)
+	}
+
+	spec, err := ParseProjectSpec(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	if spec.ReadmeFile != "" {
+		readmePath := spec.ReadmeFile
+		if !filepath.IsAbs(readmePath) {
+			readmePath = filepath.Join(filepath.Dir(path), readmePath)
+		}
+		readme, err := os.ReadFile(readmePath) //nolint:gosec // the README path comes from the spec
+		if err != nil {
+			return nil, fmt.Errorf("failed to read README: %w", err)
+		}
+		content := string(readme)
+		spec.Readme = &content
+	}
+
+	return spec, nil
+}
+
+// ParseProjectSpec parses and validates a YAML project spec
+func ParseProjectSpec(data []byte) (*ProjectSpec, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	spec := &ProjectSpec{}
+	if err := decoder.Decode(spec); err != nil {
+		return nil, fmt.Errorf("invalid spec: %w", err)
+	}
+	if err := spec.Validate(); err != nil {
+		return nil, err
+	}
+	return spec, nil
+}
+
+// Validate checks the spec for invalid values and duplicate names
+func (s *ProjectSpec) Validate() error {
+	if s.Readme != nil && s.ReadmeFile != "" {
+		return fmt.Errorf("readme and readmeFile cannot both be set")
+	}
+	if s.Visibility != "" {
+		if _, err := ParseVisibility(s.Visibility); err != nil {
+			return err
+		}
+	}
+
+	repositories := map[string]bool{}
+	for _, repository := range s.Repositories {
+		if len(parseRepositoryString(repository)) != 2 {
+			return fmt.Errorf("invalid repository format: %s (expected owner/repo)", repository)
+		}
+		if repositories[strings.ToLower(repository)] {
+			return fmt.Errorf("repository %s is listed twice", repository)
+		}
+		repositories[strings.ToLower(repository)] = true
+	}
+
+	fields := map[string]bool{}
+	for i := range s.Fields {
+		field := &s.Fields[i]
+		if err := field.validate(); err != nil {
+			return err
+		}
+		if fields[strings.ToLower(field.Name)] {
+			return fmt.Errorf("field %s is listed twice", field.Name)
+		}
+		fields[strings.ToLower(field.Name)] = true
+	}
+
+	views := map[string]bool{}
+	for i := range s.Views {
+		view := &s.Views[i]
+		if err := view.validate(); err != nil {
+			return err
+		}
+		if views[strings.ToLower(view.Name)] {
+			return fmt.Errorf("view %s is listed twice", view.Name)
+		}
+		views[strings.ToLower(view.Name)] = true
+	}
+
+	return nil
+}
+
+func (f *FieldSpec) validate() error {
+	if err := ValidateFieldName(f.Name); err != nil {
+		return err
+	}
+
+	var dataType graphql.ProjectV2FieldDataType
+	if f.Type != "" {
+		var err error
+		if dataType, err = ValidateFieldType(f.Type); err != nil {
+			return fmt.Errorf("field %s: %w", f.Name, err)
+		}
+	}
+	if len(f.Options) > 0 && dataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return fmt.Errorf("field %s: only single_select fields have options", f.Name)
+	}
+	if f.Iterations != nil && dataType != graphql.ProjectV2FieldDataTypeIteration {
+		return fmt.Errorf("field %s: only iteration fields have iteration settings", f.Name)
+	}
+
+	if err := validateOptionSpecs(f.Options); err != nil {
+		return fmt.Errorf("field %s: %w", f.Name, err)
+	}
+
+	if f.Iterations != nil {
+		if f.Iterations.Duration != "" {
+			if _, err := ParseIterationDuration(f.Iterations.Duration); err != nil {
+				return fmt.Errorf("field %s: %w", f.Name, err)
+			}
+		}
+		if f.Iterations.Start != "" {
+			if _, err := ParseDate(f.Iterations.Start); err != nil {
+				return fmt.Errorf("field %s: %w", f.Name, err)
+			}
+		}
+		if f.Iterations.Count < 0 {
+			return fmt.Errorf("field %s: iteration count cannot be negative", f.Name)
+		}
+	}
+
+	return nil
+}
+
+// validateOptionSpecs checks options for empty or duplicate names and invalid colors
+func validateOptionSpecs(options []OptionSpec) error {
+	names := map[string]bool{}
+	for _, option := range options {
+		if strings.TrimSpace(option.Name) == "" {
+			return fmt.Errorf("option names cannot be empty")
+		}
+		if names[strings.ToLower(option.Name)] {
+			return fmt.Errorf("option %s is listed twice", option.Name)
+		}
+		names[strings.ToLower(option.Name)] = true
+		if option.Color != "" {
+			if err := ValidateColor(option.Color); err != nil {
+				return fmt.Errorf("option %s: %w", option.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (v *ViewSpec) validate() error {
+	if err := ValidateViewName(v.Name); err != nil {
+		return err
+	}
+	if v.Layout != "" {
+		if _, err := ValidateViewLayout(v.Layout); err != nil {
+			return fmt.Errorf("view %s: %w", v.Name, err)
+		}
+	}
+	for _, order := range []*ViewOrderSpec{v.SortBy, v.GroupBy} {
+		if order == nil {
+			continue
+		}
+		if strings.TrimSpace(order.Field) == "" {
+			return fmt.Errorf("view %s: sortBy and groupBy need a field", v.Name)
+		}
+		if order.Direction != "" {
+			if _, err := ValidateSortDirection(order.Direction); err != nil {
+				return fmt.Errorf("view %s: %w", v.Name, err)
+			}
+		}
+	}
+	return nil
+}
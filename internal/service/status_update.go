@@ -0,0 +1,473 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	gql "github.com/shurcooL/graphql"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+const (
+	defaultStatusFieldName  = "Status"
+	defaultDoneOptionName   = "Done"
+	defaultMetricsWindow    = 7 * 24 * time.Hour
+	statusUpdateDateLayout  = "2006-01-02"
+	maxListedMetricsEntries = 10
+)
+
+// StatusUpdateService handles project status update operations
+type StatusUpdateService struct {
+	client *api.Client
+}
+
+// NewStatusUpdateService creates a new status update service
+func NewStatusUpdateService(client *api.Client) *StatusUpdateService {
+	return &StatusUpdateService{
+		client: client,
+	}
+}
+
+// CreateStatusUpdateInput represents input for creating a status update
+type CreateStatusUpdateInput struct {
+	Body       *string
+	StartDate  *string
+	TargetDate *string
+	Status     *graphql.ProjectV2StatusUpdateStatus
+	ProjectID  string
+}
+
+// UpdateStatusUpdateInput represents input for updating a status update
+type UpdateStatusUpdateInput struct {
+	Body           *string
+	StartDate      *string
+	TargetDate     *string
+	Status         *graphql.ProjectV2StatusUpdateStatus
+	StatusUpdateID string
+}
+
+// CreateStatusUpdate creates a status update on a project
+func (s *StatusUpdateService) CreateStatusUpdate(ctx context.Context, input CreateStatusUpdateInput) (*graphql.ProjectV2StatusUpdate, error) {
+	variables := graphql.BuildCreateStatusUpdateVariables(&graphql.CreateStatusUpdateInput{
+		ProjectID:  gql.ID(input.ProjectID),
+		Body:       optionalString(input.Body),
+		StartDate:  optionalString(input.StartDate),
+		TargetDate: optionalString(input.TargetDate),
+		Status:     input.Status,
+	})
+
+	var mutation graphql.CreateStatusUpdateMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create status update: %w", err)
+	}
+
+	return &mutation.CreateProjectV2StatusUpdate.StatusUpdate, nil
+}
+
+// ListStatusUpdates lists the status updates of a project, newest first
+func (s *StatusUpdateService) ListStatusUpdates(ctx context.Context, projectID string, first int) ([]graphql.ProjectV2StatusUpdate, error) {
+	variables := graphql.BuildListStatusUpdatesVariables(projectID, first)
+
+	var query graphql.ListStatusUpdatesQuery
+	err := s.client.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list status updates: %w", err)
+	}
+
+	return query.Node.ProjectV2.StatusUpdates.Nodes, nil
+}
+
+// GetLatestStatusUpdate returns the most recent status update of a project, or
+// nil when the project has none
+func (s *StatusUpdateService) GetLatestStatusUpdate(ctx context.Context, projectID string) (*graphql.ProjectV2StatusUpdate, error) {
+	updates, err := s.ListStatusUpdates(ctx, projectID, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(updates) == 0 {
+		return nil, nil
+	}
+	return &updates[0], nil
+}
+
+// GetStatusUpdate gets a status update by ID
+func (s *StatusUpdateService) GetStatusUpdate(ctx context.Context, statusUpdateID string) (*graphql.ProjectV2StatusUpdate, error) {
+	variables := graphql.BuildGetStatusUpdateVariables(statusUpdateID)
+
+	var query graphql.GetStatusUpdateQuery
+	err := s.client.Query(ctx, &query, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get status update: %w", err)
+	}
+	if query.Node.StatusUpdate.ID == "" {
+		return nil, fmt.Errorf("status update %s not found", statusUpdateID)
+	}
+
+	return &query.Node.StatusUpdate, nil
+}
+
+// UpdateStatusUpdate updates a status update
+func (s *StatusUpdateService) UpdateStatusUpdate(ctx context.Context, input UpdateStatusUpdateInput) (*graphql.ProjectV2StatusUpdate, error) {
+	variables := graphql.BuildUpdateStatusUpdateVariables(&graphql.UpdateStatusUpdateInput{
+		StatusUpdateID: gql.ID(input.StatusUpdateID),
+		Body:           optionalString(input.Body),
+		StartDate:      optionalString(input.StartDate),
+		TargetDate:     optionalString(input.TargetDate),
+		Status:         input.Status,
+	})
+
+	var mutation graphql.UpdateStatusUpdateMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update status update: %w", err)
+	}
+
+	return &mutation.UpdateProjectV2StatusUpdate.StatusUpdate, nil
+}
+
+// DeleteStatusUpdate deletes a status update
+func (s *StatusUpdateService) DeleteStatusUpdate(ctx context.Context, statusUpdateID string) error {
+	variables := graphql.BuildDeleteStatusUpdateVariables(&graphql.DeleteStatusUpdateInput{
+		StatusUpdateID: gql.ID(statusUpdateID),
+	})
+
+	var mutation graphql.DeleteStatusUpdateMutation
+	err := s.client.Mutate(ctx, &mutation, variables)
+	if err != nil {
+		return fmt.Errorf("failed to delete status update: %w", err)
+	}
+
+	return nil
+}
+
+// ValidateStatusUpdateStatus validates a status update status such as "on-track"
+func ValidateStatusUpdateStatus(status string) (graphql.ProjectV2StatusUpdateStatus, error) {
+	normalizedStatus := strings.ToUpper(strings.TrimSpace(status))
+	normalizedStatus = strings.NewReplacer("-", "_", " ", "_").Replace(normalizedStatus)
+
+	for _, valid := range graphql.ValidStatusUpdateStatuses() {
+		if normalizedStatus == valid {
+			return graphql.ProjectV2StatusUpdateStatus(valid), nil
+		}
+	}
+
+	return "", fmt.Errorf("invalid status '%s', must be one of: %v", status, graphql.ValidStatusUpdateStatuses())
+}
+
+// ValidateStatusUpdateDate validates a status update date in YYYY-MM-DD format
+func ValidateStatusUpdateDate(date string) error {
+	if _, err := time.Parse(statusUpdateDateLayout, date); err != nil {
+		return fmt.Errorf("invalid date %q, expected YYYY-MM-DD", date)
+	}
+	return nil
+}
+
+// FormatStatusUpdateStatus formats a status update status for display
+func FormatStatusUpdateStatus(status *graphql.ProjectV2StatusUpdateStatus) string {
+	if status == nil {
+		return "No status"
+	}
+	return graphql.FormatStatusUpdateStatus(*status)
+}
+
+func optionalString(value *string) *gql.String {
+	if value == nil {
+		return nil
+	}
+	s := gql.String(*value)
+	return &s
+}
+
+// Status Metrics
+
+// StatusMetricsOptions configures how project metrics are computed
+type StatusMetricsOptions struct {
+	// StatusField is the single select field holding item status (default "Status")
+	StatusField string
+	// DoneOption is the status option marking items as done (default "Done")
+	DoneOption string
+	// DueField is the date field holding due dates (default: the first date field)
+	DueField string
+	// Window is the period counted as "this week" (default 7 days)
+	Window time.Duration
+}
+
+// StatusMetrics represents metrics computed from the items of a project
+type StatusMetrics struct {
+	Since        time.Time
+	Until        time.Time
+	DoneThisWeek []string
+	AtRisk       []AtRiskItem
+	ByStatus     []StatusStat
+	Total        int
+	Done         int
+}
+
+// AtRiskItem represents an open item that is overdue or due soon
+type AtRiskItem struct {
+	Title   string
+	Due     string
+	Overdue bool
+}
+
+// BuildStatusMetrics fetches every item of a project and computes status update metrics
+func (s *StatusUpdateService) BuildStatusMetrics(
+	ctx context.Context,
+	project *graphql.ProjectV2,
+	now time.Time,
+	opts StatusMetricsOptions,
+) (*StatusMetrics, error) {
+	items, err := NewItemService(s.client).ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	return ComputeStatusMetrics(project, items, now, opts)
+}
+
+// ComputeStatusMetrics computes metrics for a status update from the items of a
+// project. Items are done when their status is the done option or their issue or
+// pull request is closed. Done items completed within the window count as done this
+// week, the same way reports count completed items; open items with a due date
+// before the end of the window are at risk.
+func ComputeStatusMetrics(
+	project *graphql.ProjectV2,
+	items []graphql.ProjectV2Item,
+	now time.Time,
+	opts StatusMetricsOptions,
+) (*StatusMetrics, error) {
+	statusName := opts.StatusField
+	if statusName == "" {
+		statusName = defaultStatusFieldName
+	}
+	doneOption := opts.DoneOption
+	if doneOption == "" {
+		doneOption = defaultDoneOptionName
+	}
+	window := opts.Window
+	if window <= 0 {
+		window = defaultMetricsWindow
+	}
+
+	statusField := projectFieldByName(project, statusName)
+	if statusField == nil && opts.StatusField != "" {
+		return nil, fmt.Errorf("field %s not found in project", opts.StatusField)
+	}
+	if statusField != nil && statusField.DataType != graphql.ProjectV2FieldDataTypeSingleSelect {
+		return nil, fmt.Errorf("field %s is not a single select field", statusField.Name)
+	}
+
+	var dueField *graphql.ProjectV2Field
+	if opts.DueField != "" {
+		dueField = projectFieldByName(project, opts.DueField)
+		if dueField == nil {
+			return nil, fmt.Errorf("field %s not found in project", opts.DueField)
+		}
+		if dueField.DataType != graphql.ProjectV2FieldDataTypeDate {
+			return nil, fmt.Errorf("field %s is not a date field", dueField.Name)
+		}
+	} else {
+		for i := range project.Fields.Nodes {
+			if project.Fields.Nodes[i].DataType == graphql.ProjectV2FieldDataTypeDate {
+				dueField = &project.Fields.Nodes[i]
+				break
+			}
+		}
+	}
+
+	metrics := &StatusMetrics{
+		Since: now.Add(-window),
+		Until: now,
+		Total: len(items),
+	}
+	today := now.Format(statusUpdateDateLayout)
+	horizon := now.Add(window).Format(statusUpdateDateLayout)
+	counts := map[string]int{}
+	var order []string
+
+	for i := range items {
+		item := &items[i]
+		title := projectItemTitle(item)
+
+		status, due := "", ""
+		for _, value := range item.FieldValues.Nodes {
+			switch {
+			case statusField != nil && value.Field.ID == statusField.ID && value.SingleSelectValue.Name != nil:
+				status = *value.SingleSelectValue.Name
+			case dueField != nil && value.Field.ID == dueField.ID && value.DateValue.Date != nil:
+				due = *value.DateValue.Date
+			}
+		}
+
+		if statusField != nil {
+			label := status
+			if label == "" {
+				label = "No " + statusField.Name
+			}
+			if _, seen := counts[label]; !seen {
+				order = append(order, label)
+			}
+			counts[label]++
+		}
+
+		switch {
+		case projectItemDone(item, status, doneOption):
+			metrics.Done++
+			if !projectItemCompletedAt(item).Before(metrics.Since) {
+				metrics.DoneThisWeek = append(metrics.DoneThisWeek, title)
+			}
+		case due != "" && due <= horizon:
+			metrics.AtRisk = append(metrics.AtRisk, AtRiskItem{Title: title, Due: due, Overdue: due < today})
+		}
+	}
+
+	sort.SliceStable(metrics.AtRisk, func(i, j int) bool {
+		return metrics.AtRisk[i].Due < metrics.AtRisk[j].Due
+	})
+	for _, label := range statusOptionOrder(statusField, order) {
+		metrics.ByStatus = append(metrics.ByStatus, StatusStat{Status: label, Count: counts[label]})
+	}
+
+	return metrics, nil
+}
+
+// SuggestStatus suggests a status update status from computed metrics
+func (m *StatusMetrics) SuggestStatus() graphql.ProjectV2StatusUpdateStatus {
+	switch {
+	case m.Total > 0 && m.Done == m.Total:
+		return graphql.ProjectV2StatusUpdateStatusComplete
+	case m.overdueCount() > 0:
+		return graphql.ProjectV2StatusUpdateStatusOffTrack
+	case len(m.AtRisk) > 0:
+		return graphql.ProjectV2StatusUpdateStatusAtRisk
+	default:
+		return graphql.ProjectV2StatusUpdateStatusOnTrack
+	}
+}
+
+func (m *StatusMetrics) overdueCount() int {
+	count := 0
+	for _, item := range m.AtRisk {
+		if item.Overdue {
+			count++
+		}
+	}
+	return count
+}
+
+// BuildStatusUpdateBody renders computed metrics as a Markdown status update body
+func BuildStatusUpdateBody(metrics *StatusMetrics) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "## Summary\n\n")
+	fmt.Fprintf(&b, "- %d of %d items done\n", metrics.Done, metrics.Total)
+	fmt.Fprintf(&b, "- %d done since %s\n", len(metrics.DoneThisWeek), metrics.Since.Format(statusUpdateDateLayout))
+	fmt.Fprintf(&b, "- %d at risk (%d overdue)\n", len(metrics.AtRisk), metrics.overdueCount())
+	for _, stat := range metrics.ByStatus {
+		fmt.Fprintf(&b, "- %s: %d\n", stat.Status, stat.Count)
+	}
+
+	if len(metrics.DoneThisWeek) > 0 {
+		fmt.Fprintf(&b, "\n## Done this week\n\n")
+		for i, title := range metrics.DoneThisWeek {
+			if i == maxListedMetricsEntries {
+				fmt.Fprintf(&b, "- …and %d more\n", len(metrics.DoneThisWeek)-i)
+				break
+			}
+			fmt.Fprintf(&b, "- %s\n", title)
+		}
+	}
+
+	if len(metrics.AtRisk) > 0 {
+		fmt.Fprintf(&b, "\n## At risk\n\n")
+		for i, item := range metrics.AtRisk {
+			if i == maxListedMetricsEntries {
+				fmt.Fprintf(&b, "- …and %d more\n", len(metrics.AtRisk)-i)
+				break
+			}
+			note := "due"
+			if item.Overdue {
+				note = "overdue since"
+			}
+			fmt.Fprintf(&b, "- %s (%s %s)\n", item.Title, note, item.Due)
+		}
+	}
+
+	return b.String()
+}
+
+func projectFieldByName(project *graphql.ProjectV2, name string) *graphql.ProjectV2Field {
+	for i := range project.Fields.Nodes {
+		if strings.EqualFold(project.Fields.Nodes[i].Name, name) {
+			return &project.Fields.Nodes[i]
+		}
+	}
+	return nil
+}
+
+func projectItemTitle(item *graphql.ProjectV2Item) string {
+	switch item.Content.TypeName {
+	case "Issue":
+		return item.Content.Issue.Title
+	case "PullRequest":
+		return item.Content.PullRequest.Title
+	default:
+		return item.Content.DraftIssue.Title
+	}
+}
+
+func projectItemClosed(item *graphql.ProjectV2Item) bool {
+	switch item.Content.TypeName {
+	case "Issue":
+		return item.Content.Issue.Closed
+	case "PullRequest":
+		return item.Content.PullRequest.Closed
+	default:
+		return false
+	}
+}
+
+// projectItemDone reports whether an item is done: its issue or pull request is
+// closed or its status is the done option
+func projectItemDone(item *graphql.ProjectV2Item, status, doneOption string) bool {
+	return projectItemClosed(item) || (status != "" && strings.EqualFold(status, doneOption))
+}
+
+// projectItemCompletedAt returns when a done item was completed: when its issue or
+// pull request was closed, or for items that are done by status alone when the item
+// was last updated
+func projectItemCompletedAt(item *graphql.ProjectV2Item) time.Time {
+	if closedAt := projectItemClosedAt(item); closedAt != nil {
+		return *closedAt
+	}
+	return item.UpdatedAt
+}
+
+// statusOptionOrder orders status labels like the options of the status field,
+// followed by labels that are not options such as "No Status"
+func statusOptionOrder(field *graphql.ProjectV2Field, seen []string) []string {
+	if field == nil {
+		return seen
+	}
+	ordered := make([]string, 0, len(seen))
+	used := map[string]bool{}
+	for _, option := range field.SingleSelect.Options {
+		for _, label := range seen {
+			if label == option.Name && !used[label] {
+				ordered = append(ordered, label)
+				used[label] = true
+			}
+		}
+	}
+	for _, label := range seen {
+		if !used[label] {
+			ordered = append(ordered, label)
+		}
+	}
+	return ordered
+}
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+func TestValidateStatusUpdateStatus(t *testing.T) {
+	for input, expected := range map[string]graphql.ProjectV2StatusUpdateStatus{
+		"on-track":  graphql.ProjectV2StatusUpdateStatusOnTrack,
+		"AT_RISK":   graphql.ProjectV2StatusUpdateStatusAtRisk,
+		"off track": graphql.ProjectV2StatusUpdateStatusOffTrack,
+		"complete":  graphql.ProjectV2StatusUpdateStatusComplete,
+	} {
+		status, err := ValidateStatusUpdateStatus(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expected, status)
+	}
+
+	_, err := ValidateStatusUpdateStatus("green")
+	assert.ErrorContains(t, err, "invalid status 'green'")
+}
+
+func TestStatusUpdatesAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	now := time.Date(2026, 3, 12, 9, 0, 0, 0, time.UTC)
+	store := server.Store
+	store.SetClock(func() time.Time { return now })
+
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+	status := project.Field("Status")
+	due := store.AddField(project, "Due", "DATE")
+
+	shipped := store.AddItem(project, store.AddIssue(repo, "Ship login"))
+	store.SetValue(shipped, status, fake.Value{OptionID: status.Option("Done").ID})
+	old := store.AddItem(project, store.AddIssue(repo, "Old work"))
+	store.SetValue(old, status, fake.Value{OptionID: status.Option("Done").ID})
+	old.UpdatedAt = now.AddDate(0, 0, -30)
+	closed := store.AddIssue(repo, "Closed bug")
+	closed.Closed = true
+	store.AddItem(project, closed)
+	// Edited today, but closed a month ago
+	closedLongAgo := now.AddDate(0, 0, -30)
+	edited := store.AddIssue(repo, "Edited old bug")
+	edited.Closed, edited.ClosedAt = true, &closedLongAgo
+	store.AddItem(project, edited)
+	late := store.AddItem(project, store.AddIssue(repo, "Migrate billing"))
+	store.SetValue(late, status, fake.Value{OptionID: status.Option("In Progress").ID})
+	store.SetValue(late, due, fake.Value{Date: "2026-03-10"})
+	soon := store.AddDraftIssue(project, "Write docs", "")
+	store.SetValue(soon, due, fake.Value{Date: "2026-03-15"})
+	later := store.AddDraftIssue(project, "Plan Q3", "")
+	store.SetValue(later, due, fake.Value{Date: "2026-05-01"})
+
+	ctx := context.Background()
+	client := api.NewClient("ghp_fake")
+	statusUpdateService := NewStatusUpdateService(client)
+
+	t.Run("Computes metrics from project items", func(t *testing.T) {
+		p, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+		require.NoError(t, err)
+
+		metrics, err := statusUpdateService.BuildStatusMetrics(ctx, p, now, StatusMetricsOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, 7, metrics.Total)
+		assert.Equal(t, 4, metrics.Done)
+		assert.Equal(t, []string{"Ship login", "Closed bug"}, metrics.DoneThisWeek)
+		assert.Equal(t, []AtRiskItem{
+			{Title: "Migrate billing", Due: "2026-03-10", Overdue: true},
+			{Title: "Write docs", Due: "2026-03-15"},
+		}, metrics.AtRisk)
+		assert.Equal(t, []StatusStat{
+			{Status: "In Progress", Count: 1},
+			{Status: "Done", Count: 2},
+			{Status: "No Status", Count: 4},
+		}, metrics.ByStatus)
+		assert.Equal(t, graphql.ProjectV2StatusUpdateStatusOffTrack, metrics.SuggestStatus())
+
+		body := BuildStatusUpdateBody(metrics)
+		assert.Contains(t, body, "- 4 of 7 items done")
+		assert.Contains(t, body, "## Done this week\n\n- Ship login\n- Closed bug\n")
+		assert.Contains(t, body, "- Migrate billing (overdue since 2026-03-10)")
+
+		_, err = statusUpdateService.BuildStatusMetrics(ctx, p, now, StatusMetricsOptions{DueField: "Status"})
+		assert.ErrorContains(t, err, "not a date field")
+	})
+
+	t.Run("Counts items beyond the first page", func(t *testing.T) {
+		large := store.AddProject(fake.DefaultViewer, "Backlog")
+		for i := 0; i < 120; i++ {
+			item := store.AddDraftIssue(large, fmt.Sprintf("Draft %03d", i), "")
+			if i%2 == 0 {
+				store.SetValue(item, large.Field("Status"), fake.Value{OptionID: large.Field("Status").Option("Done").ID})
+			}
+		}
+
+		p, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, large.Number)
+		require.NoError(t, err)
+		metrics, err := statusUpdateService.BuildStatusMetrics(ctx, p, now, StatusMetricsOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, 120, metrics.Total)
+		assert.Equal(t, 60, metrics.Done)
+	})
+
+	t.Run("Creates, lists, updates and deletes status updates", func(t *testing.T) {
+		body, start, target := "Kickoff", "2026-03-01", "2026-04-01"
+		onTrack := graphql.ProjectV2StatusUpdateStatusOnTrack
+		created, err := statusUpdateService.CreateStatusUpdate(ctx, CreateStatusUpdateInput{
+			ProjectID:  project.ID,
+			Body:       &body,
+			StartDate:  &start,
+			TargetDate: &target,
+			Status:     &onTrack,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Kickoff", created.Body)
+		assert.Equal(t, fake.DefaultViewer, created.Creator.Login)
+
+		now = now.Add(time.Hour)
+		atRisk := graphql.ProjectV2StatusUpdateStatusAtRisk
+		second, err := statusUpdateService.CreateStatusUpdate(ctx, CreateStatusUpdateInput{ProjectID: project.ID, Status: &atRisk})
+		require.NoError(t, err)
+
+		latest, err := statusUpdateService.GetLatestStatusUpdate(ctx, project.ID)
+		require.NoError(t, err)
+		assert.Equal(t, second.ID, latest.ID)
+
+		updatedBody := "Back on track"
+		updated, err := statusUpdateService.UpdateStatusUpdate(ctx, UpdateStatusUpdateInput{
+			StatusUpdateID: second.ID,
+			Body:           &updatedBody,
+			Status:         &onTrack,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "Back on track", updated.Body)
+		assert.Equal(t, "On track", FormatStatusUpdateStatus(updated.Status))
+
+		fetched, err := statusUpdateService.GetStatusUpdate(ctx, created.ID)
+		require.NoError(t, err)
+		require.NotNil(t, fetched.TargetDate)
+		assert.Equal(t, "2026-04-01", *fetched.TargetDate)
+
+		require.NoError(t, statusUpdateService.DeleteStatusUpdate(ctx, second.ID))
+		updates, err := statusUpdateService.ListStatusUpdates(ctx, project.ID, 10)
+		require.NoError(t, err)
+		require.Len(t, updates, 1)
+		assert.Equal(t, created.ID, updates[0].ID)
+	})
+
+	t.Run("Rejects a target date before the start date", func(t *testing.T) {
+		start, target := "2026-04-01", "2026-03-01"
+		_, err := statusUpdateService.CreateStatusUpdate(ctx, CreateStatusUpdateInput{
+			ProjectID:  project.ID,
+			StartDate:  &start,
+			TargetDate: &target,
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,166 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	gql "github.com/shurcooL/graphql"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+const (
+	// maxSubIssueDepth is the number of sub-issue levels GitHub allows
+	maxSubIssueDepth = 8
+	subIssuePageSize = 50
+)
+
+// SubIssueNode is an issue with its sub-issues
+type SubIssueNode struct {
+	Issue     graphql.SubIssue
+	SubIssues []SubIssueNode
+}
+
+// GetSubIssueTree returns the sub-issues of an issue and, recursively, theirs
+func (s *ItemService) GetSubIssueTree(ctx context.Context, issueID string) ([]SubIssueNode, error) {
+	return s.subIssueTree(ctx, issueID, 1)
+}
+
+func (s *ItemService) subIssueTree(ctx context.Context, issueID string, depth int) ([]SubIssueNode, error) {
+	subIssues, err := s.ListSubIssues(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]SubIssueNode, len(subIssues))
+	for i, sub := range subIssues {
+		nodes[i].Issue = sub
+		if sub.SubIssuesSummary.Total == 0 || depth >= maxSubIssueDepth {
+			continue
+		}
+		if nodes[i].SubIssues, err = s.subIssueTree(ctx, sub.ID, depth+1); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// ListSubIssues returns the direct sub-issues of an issue
+func (s *ItemService) ListSubIssues(ctx context.Context, issueID string) ([]graphql.SubIssue, error) {
+	var subIssues []graphql.SubIssue
+	var after *string
+
+	for {
+		var query graphql.ListSubIssuesQuery
+		if err := s.client.Query(ctx, &query, graphql.BuildListSubIssuesVariables(issueID, subIssuePageSize, after)); err != nil {
+			return nil, fmt.Errorf("failed to list sub-issues: %w", err)
+		}
+
+		page := query.Node.Issue.SubIssues
+		subIssues = append(subIssues, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return subIssues, nil
+		}
+		after = &page.PageInfo.EndCursor
+	}
+}
+
+// FlattenSubIssueTree returns the issues of a sub-issue tree, parents before
+// their sub-issues
+func FlattenSubIssueTree(nodes []SubIssueNode) []graphql.SubIssue {
+	var issues []graphql.SubIssue
+	for _, node := range nodes {
+		issues = append(issues, node.Issue)
+		issues = append(issues, FlattenSubIssueTree(node.SubIssues)...)
+	}
+	return issues
+}
+
+// AddSubIssuesToProject adds the sub-issues of an issue and, recursively, theirs
+// to a project; sub-issues already in the project are left as they are
+func (s *ItemService) AddSubIssuesToProject(ctx context.Context, projectID, issueID string) ([]graphql.SubIssue, error) {
+	tree, err := s.GetSubIssueTree(ctx, issueID)
+	if err != nil {
+		return nil, err
+	}
+
+	issues := FlattenSubIssueTree(tree)
+	for _, issue := range issues {
+		if _, err := s.AddItemToProject(ctx, projectID, issue.ID); err != nil {
+			return nil, fmt.Errorf("failed to add %s#%d: %w", issue.Repository.NameWithOwner, issue.Number, err)
+		}
+	}
+	return issues, nil
+}
+
+// AddSubIssue makes an issue a sub-issue of another issue. An issue has at most
+// one parent; with replaceParent an issue that already has one is moved.
+func (s *ItemService) AddSubIssue(ctx context.Context, parentID, subIssueID string, replaceParent bool) error {
+	input := &graphql.AddSubIssueInput{
+		IssueID:    gql.ID(parentID),
+		SubIssueID: gql.ID(subIssueID),
+	}
+	if replaceParent {
+		replace := gql.Boolean(true)
+		input.ReplaceParent = &replace
+	}
+
+	var mutation graphql.AddSubIssueMutation
+	if err := s.client.Mutate(ctx, &mutation, graphql.BuildAddSubIssueVariables(input)); err != nil {
+		return fmt.Errorf("failed to add sub-issue: %w", err)
+	}
+	return nil
+}
+
+// RemoveSubIssue removes a sub-issue from its parent issue
+func (s *ItemService) RemoveSubIssue(ctx context.Context, parentID, subIssueID string) error {
+	variables := graphql.BuildRemoveSubIssueVariables(&graphql.RemoveSubIssueInput{
+		IssueID:    gql.ID(parentID),
+		SubIssueID: gql.ID(subIssueID),
+	})
+
+	var mutation graphql.RemoveSubIssueMutation
+	if err := s.client.Mutate(ctx, &mutation, variables); err != nil {
+		return fmt.Errorf("failed to remove sub-issue: %w", err)
+	}
+	return nil
+}
+
+// ProjectItemNode is a project item with the items of its sub-issues
+type ProjectItemNode struct {
+	Item      PositionedItem    `json:"item"`
+	SubIssues []ProjectItemNode `json:"subIssues"`
+}
+
+// BuildProjectItemTree nests the items of a project under the items of their
+// parent issues. Items whose parent is not in the project are roots, and items
+// keep their order within each level.
+func BuildProjectItemTree(items []PositionedItem) []ProjectItemNode {
+	byContent := make(map[string]int, len(items))
+	for i := range items {
+		if items[i].ContentID != "" {
+			byContent[items[i].ContentID] = i
+		}
+	}
+
+	children := make(map[int][]int)
+	var roots []int
+	for i := range items {
+		parent, ok := byContent[items[i].ParentID]
+		if items[i].ParentID == "" || !ok {
+			roots = append(roots, i)
+			continue
+		}
+		children[parent] = append(children[parent], i)
+	}
+
+	var build func(indexes []int) []ProjectItemNode
+	build = func(indexes []int) []ProjectItemNode {
+		nodes := make([]ProjectItemNode, len(indexes))
+		for n, i := range indexes {
+			nodes[n] = ProjectItemNode{Item: items[i], SubIssues: build(children[i])}
+		}
+		return nodes
+	}
+	return build(roots)
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+func TestBuildProjectItemTree(t *testing.T) {
+	items := []PositionedItem{
+		{ID: "child", ContentID: "I_2", ParentID: "I_1", Position: 1},
+		{ID: "epic", ContentID: "I_1", Position: 2},
+		{ID: "orphan", ContentID: "I_3", ParentID: "I_9", Position: 3},
+		{ID: "grandchild", ContentID: "I_4", ParentID: "I_2", Position: 4},
+	}
+
+	tree := BuildProjectItemTree(items)
+	require.Len(t, tree, 2)
+	assert.Equal(t, "epic", tree[0].Item.ID)
+	require.Len(t, tree[0].SubIssues, 1)
+	assert.Equal(t, "child", tree[0].SubIssues[0].Item.ID)
+	assert.Equal(t, "grandchild", tree[0].SubIssues[0].SubIssues[0].Item.ID)
+	assert.Equal(t, "orphan", tree[1].Item.ID)
+}
+
+func TestSubIssuesAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	epic := store.AddIssue(repo, "Epic")
+	login := store.AddIssue(repo, "Login")
+	oauth := store.AddIssue(repo, "OAuth")
+	signup := store.AddIssue(repo, "Signup")
+	signup.Closed = true
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+
+	ctx := context.Background()
+	itemService := NewItemService(api.NewClient("ghp_fake"))
+
+	t.Run("Links and unlinks sub-issues", func(t *testing.T) {
+		require.NoError(t, itemService.AddSubIssue(ctx, epic.ID, login.ID, false))
+		require.NoError(t, itemService.AddSubIssue(ctx, epic.ID, signup.ID, false))
+		require.NoError(t, itemService.AddSubIssue(ctx, login.ID, oauth.ID, false))
+		assert.Equal(t, []*fake.Issue{login, signup}, epic.SubIssues)
+
+		err := itemService.AddSubIssue(ctx, login.ID, signup.ID, false)
+		assert.ErrorContains(t, err, "only have one parent")
+		require.NoError(t, itemService.AddSubIssue(ctx, login.ID, signup.ID, true))
+		assert.Equal(t, login, signup.Parent)
+		require.NoError(t, itemService.RemoveSubIssue(ctx, login.ID, signup.ID))
+		require.NoError(t, itemService.AddSubIssue(ctx, epic.ID, signup.ID, false))
+
+		err = itemService.AddSubIssue(ctx, oauth.ID, epic.ID, false)
+		assert.ErrorContains(t, err, "cannot be a sub-issue")
+	})
+
+	t.Run("Fetches parent, sub-issues and progress", func(t *testing.T) {
+		issue, err := itemService.GetIssue(ctx, fake.DefaultViewer, "app", epic.Number)
+		require.NoError(t, err)
+		assert.Nil(t, issue.Parent)
+		require.Len(t, issue.SubIssues.Nodes, 2)
+		assert.Equal(t, 2, issue.SubIssuesSummary.Total)
+		assert.Equal(t, 1, issue.SubIssuesSummary.Completed)
+		assert.Equal(t, 50, issue.SubIssuesSummary.PercentCompleted)
+
+		issue, err = itemService.GetIssue(ctx, fake.DefaultViewer, "app", oauth.Number)
+		require.NoError(t, err)
+		require.NotNil(t, issue.Parent)
+		assert.Equal(t, login.ID, issue.Parent.ID)
+
+		tree, err := itemService.GetSubIssueTree(ctx, epic.ID)
+		require.NoError(t, err)
+		require.Len(t, tree, 2)
+		assert.Equal(t, "Login", tree[0].Issue.Title)
+		require.Len(t, tree[0].SubIssues, 1)
+		assert.Equal(t, "OAuth", tree[0].SubIssues[0].Issue.Title)
+		assert.Equal(t, []string{"Login", "OAuth", "Signup"}, subIssueTitles(FlattenSubIssueTree(tree)))
+	})
+
+	t.Run("Adds sub-issues to a project recursively", func(t *testing.T) {
+		store.AddItem(project, epic)
+		store.AddItem(project, login)
+
+		added, err := itemService.AddSubIssuesToProject(ctx, project.ID, epic.ID)
+		require.NoError(t, err)
+		assert.Len(t, added, 3)
+		assert.Len(t, project.Items, 4)
+
+		items, err := itemService.ListPositionedItems(ctx, project.ID)
+		require.NoError(t, err)
+		tree := BuildProjectItemTree(items)
+		require.Len(t, tree, 1)
+		assert.Equal(t, "Epic", tree[0].Item.Title)
+		assert.Equal(t, 2, tree[0].Item.SubIssues.Total)
+		require.Len(t, tree[0].SubIssues, 2)
+		assert.Equal(t, "OAuth", tree[0].SubIssues[0].SubIssues[0].Item.Title)
+	})
+}
+
+func subIssueTitles(issues []graphql.SubIssue) []string {
+	titles := make([]string, len(issues))
+	for i, issue := range issues {
+		titles[i] = issue.Title
+	}
+	return titles
+}
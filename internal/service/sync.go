@@ -0,0 +1,294 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	gql "github.com/shurcooL/graphql"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// Sync actions
+const (
+	SyncActionAdd     = "add"
+	SyncActionArchive = "archive"
+	SyncActionRestore = "restore"
+)
+
+const (
+	syncPageSize = 100
+	// maxSearchResults is the number of results GitHub search returns at most
+	maxSearchResults = 1000
+)
+
+// SyncFieldValue is a field value set on items added by a sync, e.g. Status=Todo
+type SyncFieldValue struct {
+	Field string
+	Value string
+}
+
+// SyncProjectOptions represents options for syncing a project with a search query
+type SyncProjectOptions struct {
+	Owner string
+	// Query is a GitHub issue search query, e.g. "org:acme is:open label:team-a"
+	Query string
+	// Values are set on items added by the sync
+	Values []SyncFieldValue
+	Number int
+	// Archive archives issues and pull requests that no longer match the query and
+	// restores archived items that match again
+	Archive bool
+	// DryRun computes the changes without making them
+	DryRun bool
+}
+
+// SyncChange represents an item added, archived or restored by a sync
+type SyncChange struct {
+	Action    string `json:"action"`
+	Reference string `json:"reference"`
+	Title     string `json:"title"`
+}
+
+// SyncProjectResult represents the result of syncing a project
+type SyncProjectResult struct {
+	Changes  []SyncChange `json:"changes"`
+	Warnings []string     `json:"warnings"`
+	// Matched is the number of issues and pull requests matching the query
+	Matched int `json:"matched"`
+	// Unchanged is the number of matches that were already in the project
+	Unchanged int `json:"unchanged"`
+	ValuesSet int `json:"valuesSet"`
+	// Truncated is set when the query matched more results than search returns
+	Truncated bool `json:"truncated"`
+}
+
+// syncFieldValue is a field value resolved against the project's fields
+type syncFieldValue struct {
+	field *graphql.ProjectV2Field
+	value map[string]interface{}
+}
+
+// SyncProject makes a project follow a search query: matching issues and pull
+// requests that are not in the project are added and get the initial field values,
+// and with Archive, items that no longer match are archived. Items already in the
+// project are left as they are, so running a sync again changes nothing until the
+// search results change. Failures to change an item are reported as warnings.
+func (s *ProjectService) SyncProject(ctx context.Context, opts SyncProjectOptions) (*SyncProjectResult, error) {
+	if strings.TrimSpace(opts.Query) == "" {
+		return nil, fmt.Errorf("search query is required")
+	}
+
+	project, err := s.GetProjectWithOwnerDetection(ctx, opts.Owner, opts.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := resolveSyncValues(project, opts.Values, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	matches, truncated, err := s.searchContent(ctx, opts.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := s.listProjectItemSummaries(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SyncProjectResult{Matched: len(matches), Truncated: truncated}
+	itemsByContent := make(map[string]*graphql.ProjectV2ItemSummary, len(items))
+	for i := range items {
+		if id := items[i].Content.ContentID(); id != "" {
+			itemsByContent[id] = &items[i]
+		}
+	}
+
+	matched := make(map[string]bool, len(matches))
+	for i := range matches {
+		content := &matches[i]
+		matched[content.ContentID()] = true
+
+		item, ok := itemsByContent[content.ContentID()]
+		switch {
+		case !ok:
+			s.syncAdd(ctx, project.ID, content, values, opts.DryRun, result)
+		case item.IsArchived && opts.Archive:
+			s.syncArchive(ctx, project.ID, item, false, opts.DryRun, result)
+		default:
+			result.Unchanged++
+		}
+	}
+
+	if !opts.Archive {
+		return result, nil
+	}
+	if truncated {
+		result.Warnings = append(result.Warnings, fmt.Sprintf(
+			"the query matches more than %d results; items were not archived", maxSearchResults))
+		return result, nil
+	}
+	for i := range items {
+		item := &items[i]
+		id := item.Content.ContentID()
+		if id == "" || item.IsArchived || matched[id] {
+			continue
+		}
+		s.syncArchive(ctx, project.ID, item, true, opts.DryRun, result)
+	}
+
+	return result, nil
+}
+
+func (s *ProjectService) syncAdd(
+	ctx context.Context, projectID string, content *graphql.ProjectV2ItemContent,
+	values []syncFieldValue, dryRun bool, result *SyncProjectResult,
+) {
+	change := SyncChange{Action: SyncActionAdd, Reference: content.Reference(), Title: content.Title()}
+	if dryRun {
+		result.Changes = append(result.Changes, change)
+		return
+	}
+
+	added, err := s.AddItem(ctx, AddItemInput{ProjectID: projectID, ContentID: content.ContentID()})
+	if err != nil {
+		result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %v", change.Reference, err))
+		return
+	}
+	result.Changes = append(result.Changes, change)
+
+	for _, v := range values {
+		_, err := s.UpdateItemField(ctx, UpdateItemFieldInput{
+			ProjectID: projectID,
+			ItemID:    added.ID,
+			FieldID:   v.field.ID,
+			Value:     v.value,
+		})
+		if err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s, field %s: %v", change.Reference, v.field.Name, err))
+			continue
+		}
+		result.ValuesSet++
+	}
+}
+
+func (s *ProjectService) syncArchive(
+	ctx context.Context, projectID string, item *graphql.ProjectV2ItemSummary,
+	archive, dryRun bool, result *SyncProjectResult,
+) {
+	change := SyncChange{Action: SyncActionRestore, Reference: item.Content.Reference(), Title: item.Content.Title()}
+	if archive {
+		change.Action = SyncActionArchive
+	}
+
+	if !dryRun {
+		if err := s.SetItemArchived(ctx, projectID, item.ID, archive); err != nil {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %v", change.Reference, err))
+			return
+		}
+	}
+	result.Changes = append(result.Changes, change)
+}
+
+// SetItemArchived archives or restores a project item
+func (s *ProjectService) SetItemArchived(ctx context.Context, projectID, itemID string, archived bool) error {
+	variables := graphql.BuildArchiveProjectItemVariables(&graphql.ArchiveProjectItemInput{
+		ProjectID: gql.ID(projectID),
+		ItemID:    gql.ID(itemID),
+	})
+
+	if archived {
+		var mutation graphql.ArchiveProjectItemMutation
+		if err := s.client.Mutate(ctx, &mutation, variables); err != nil {
+			return fmt.Errorf("failed to archive item: %w", err)
+		}
+		return nil
+	}
+
+	var mutation graphql.UnarchiveProjectItemMutation
+	if err := s.client.Mutate(ctx, &mutation, variables); err != nil {
+		return fmt.Errorf("failed to restore item: %w", err)
+	}
+	return nil
+}
+
+// searchContent returns the issues and pull requests matching a search query, and
+// whether there were more results than search returns
+func (s *ProjectService) searchContent(ctx context.Context, query string) ([]graphql.ProjectV2ItemContent, bool, error) {
+	var results []graphql.ProjectV2ItemContent
+	seen := map[string]bool{}
+	var after *string
+
+	for {
+		var search graphql.SearchContentQuery
+		if err := s.client.Query(ctx, &search, graphql.BuildSearchContentVariables(query, syncPageSize, after)); err != nil {
+			return nil, false, fmt.Errorf("failed to search issues: %w", err)
+		}
+
+		for _, node := range search.Search.Nodes {
+			if id := node.ContentID(); id != "" && !seen[id] {
+				seen[id] = true
+				results = append(results, node)
+			}
+		}
+
+		pageInfo := search.Search.PageInfo
+		if !pageInfo.HasNextPage || len(results) >= maxSearchResults {
+			return results, search.Search.IssueCount > maxSearchResults, nil
+		}
+		after = &pageInfo.EndCursor
+	}
+}
+
+// listProjectItemSummaries returns all items of a project, including archived ones
+func (s *ProjectService) listProjectItemSummaries(ctx context.Context, projectID string) ([]graphql.ProjectV2ItemSummary, error) {
+	var items []graphql.ProjectV2ItemSummary
+	var after *string
+
+	for {
+		var query graphql.ListProjectItemSummariesQuery
+		if err := s.client.Query(ctx, &query, graphql.BuildListProjectItemSummariesVariables(projectID, syncPageSize, after)); err != nil {
+			return nil, fmt.Errorf("failed to list project items: %w", err)
+		}
+
+		page := query.Node.ProjectV2.Items
+		items = append(items, page.Nodes...)
+		if !page.PageInfo.HasNextPage {
+			return items, nil
+		}
+		after = &page.PageInfo.EndCursor
+	}
+}
+
+// resolveSyncValues resolves field values against the fields of a project so
+// invalid values are reported before anything is changed
+func resolveSyncValues(project *graphql.ProjectV2, values []SyncFieldValue, now time.Time) ([]syncFieldValue, error) {
+	resolved := make([]syncFieldValue, 0, len(values))
+	for _, v := range values {
+		field := settableField(project, v.Field)
+		if field == nil {
+			return nil, fmt.Errorf("field %s not found or cannot be set", v.Field)
+		}
+		value, err := BuildFieldValue(field, v.Value, now)
+		if err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, syncFieldValue{field: field, value: value})
+	}
+	return resolved, nil
+}
+
+// ParseSyncFieldValue parses a field value given as Field=Value
+func ParseSyncFieldValue(s string) (SyncFieldValue, error) {
+	field, value, ok := strings.Cut(s, "=")
+	field = strings.TrimSpace(field)
+	if !ok || field == "" {
+		return SyncFieldValue{}, fmt.Errorf("invalid field value %q, expected Field=Value", s)
+	}
+	return SyncFieldValue{Field: field, Value: strings.TrimSpace(value)}, nil
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestParseSyncFieldValue(t *testing.T) {
+	value, err := ParseSyncFieldValue("Status = In Progress")
+	require.NoError(t, err)
+	assert.Equal(t, SyncFieldValue{Field: "Status", Value: "In Progress"}, value)
+
+	_, err = ParseSyncFieldValue("Status")
+	assert.ErrorContains(t, err, "expected Field=Value")
+}
+
+func TestSyncProjectAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	store.AddOrganization("acme")
+	api1 := store.AddRepository("acme", "api")
+	web := store.AddRepository("acme", "web")
+	teamA := store.AddLabel(api1, "team-a", "ff0000")
+	webTeamA := store.AddLabel(web, "team-a", "ff0000")
+
+	login := store.AddIssue(api1, "Fix login")
+	login.Labels = append(login.Labels, teamA)
+	search := store.AddPullRequest(web, "Add search")
+	search.Labels = append(search.Labels, webTeamA)
+	store.AddIssue(api1, "Unrelated")
+
+	project := store.AddProject("acme", "Team A")
+	status := project.Field("Status")
+	draft := store.AddDraftIssue(project, "Plan", "")
+
+	ctx := context.Background()
+	projectService := NewProjectService(api.NewClient("ghp_fake"))
+	opts := SyncProjectOptions{
+		Owner:   "acme",
+		Number:  project.Number,
+		Query:   "org:acme is:open label:team-a",
+		Values:  []SyncFieldValue{{Field: "Status", Value: "Todo"}},
+		Archive: true,
+	}
+
+	t.Run("Dry run changes nothing", func(t *testing.T) {
+		dryRun := opts
+		dryRun.DryRun = true
+		result, err := projectService.SyncProject(ctx, dryRun)
+		require.NoError(t, err)
+		assert.Equal(t, []SyncChange{
+			{Action: SyncActionAdd, Reference: "acme/api#1", Title: "Fix login"},
+			{Action: SyncActionAdd, Reference: "acme/web#1", Title: "Add search"},
+		}, result.Changes)
+		assert.Len(t, project.Items, 1)
+	})
+
+	t.Run("Adds matches with initial values", func(t *testing.T) {
+		result, err := projectService.SyncProject(ctx, opts)
+		require.NoError(t, err)
+		assert.Empty(t, result.Warnings)
+		assert.Equal(t, 2, result.Matched)
+		assert.Len(t, result.Changes, 2)
+		assert.Equal(t, 2, result.ValuesSet)
+
+		require.Len(t, project.Items, 3)
+		for _, item := range project.Items[1:] {
+			assert.Equal(t, status.Option("Todo").ID, item.Values[status.ID].OptionID)
+		}
+	})
+
+	t.Run("Is idempotent", func(t *testing.T) {
+		store.SetValue(project.Items[1], status, fake.Value{OptionID: status.Option("Done").ID})
+
+		result, err := projectService.SyncProject(ctx, opts)
+		require.NoError(t, err)
+		assert.Empty(t, result.Changes)
+		assert.Equal(t, 2, result.Unchanged)
+		assert.Equal(t, status.Option("Done").ID, project.Items[1].Values[status.ID].OptionID)
+	})
+
+	t.Run("Archives items that no longer match and restores them", func(t *testing.T) {
+		closedAt := login.CreatedAt
+		login.Closed, login.ClosedAt = true, &closedAt
+
+		result, err := projectService.SyncProject(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []SyncChange{{Action: SyncActionArchive, Reference: "acme/api#1", Title: "Fix login"}}, result.Changes)
+		assert.True(t, project.Items[1].Archived)
+		assert.False(t, draft.Archived)
+
+		login.Closed, login.ClosedAt = false, nil
+		result, err = projectService.SyncProject(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, []SyncChange{{Action: SyncActionRestore, Reference: "acme/api#1", Title: "Fix login"}}, result.Changes)
+		assert.False(t, project.Items[1].Archived)
+	})
+
+	t.Run("Rejects unknown fields before changing anything", func(t *testing.T) {
+		invalid := opts
+		invalid.Values = []SyncFieldValue{{Field: "Team", Value: "A"}}
+		_, err := projectService.SyncProject(ctx, invalid)
+		assert.ErrorContains(t, err, "field Team not found")
+
+		invalid.Values = []SyncFieldValue{{Field: "Status", Value: "Blocked"}}
+		_, err = projectService.SyncProject(ctx, invalid)
+		assert.Error(t, err)
+	})
+}
@@ -33,7 +33,9 @@ type ViewInfo struct {
 	ProjectName string
 	GroupBy     []ViewGroupByInfo
 	SortBy      []ViewSortByInfo
-	Number      int
+	// VerticalGroupBy is the column field of a board view
+	VerticalGroupBy []ViewGroupByInfo
+	Number          int
 }
 
 // ViewGroupByInfo represents group by configuration information
@@ -61,6 +63,7 @@ type CreateViewInput struct {
 type UpdateViewInput struct {
 	Name   *string
 	Filter *string
+	Layout *graphql.ProjectV2ViewLayout
 	ViewID string
 }
 
@@ -122,6 +125,10 @@ func (s *ViewService) UpdateView(ctx context.Context, input UpdateViewInput) (*g
 		filter := gql.String(*input.Filter)
 		gqlInput.Filter = &filter
 	}
+	if input.Layout != nil {
+		layout := *input.Layout
+		gqlInput.Layout = &layout
+	}
 
 	variables := graphql.BuildUpdateViewVariables(gqlInput)
 
@@ -227,15 +234,6 @@ func (s *ViewService) GetProjectViews(ctx context.Context, projectID string) ([]
 	views := make([]ViewInfo, len(query.Node.ProjectV2.Views.Nodes))
 	for i := range query.Node.ProjectV2.Views.Nodes {
 		view := &query.Node.ProjectV2.Views.Nodes[i]
-		groupBy := make([]ViewGroupByInfo, len(view.GroupBy))
-		for j, gb := range view.GroupBy {
-			groupBy[j] = ViewGroupByInfo{
-				FieldID:   gb.Field.ID,
-				FieldName: gb.Field.Name,
-				Direction: gb.Direction,
-			}
-		}
-
 		sortBy := make([]ViewSortByInfo, len(view.SortBy))
 		for j, sb := range view.SortBy {
 			sortBy[j] = ViewSortByInfo{
@@ -246,14 +244,15 @@ func (s *ViewService) GetProjectViews(ctx context.Context, projectID string) ([]
 		}
 
 		views[i] = ViewInfo{
-			ID:        view.ID,
-			Name:      view.Name,
-			Layout:    view.Layout,
-			Number:    view.Number,
-			Filter:    view.Filter,
-			ProjectID: projectID,
-			GroupBy:   groupBy,
-			SortBy:    sortBy,
+			ID:              view.ID,
+			Name:            view.Name,
+			Layout:          view.Layout,
+			Number:          view.Number,
+			Filter:          view.Filter,
+			ProjectID:       projectID,
+			GroupBy:         toViewGroupByInfo(view.GroupBy),
+			SortBy:          sortBy,
+			VerticalGroupBy: toViewGroupByInfo(view.VerticalGroupBy),
 		}
 	}
 
@@ -272,15 +271,6 @@ func (s *ViewService) GetView(ctx context.Context, viewID string) (*ViewInfo, er
 
 	view := query.Node.ProjectV2View
 
-	groupBy := make([]ViewGroupByInfo, len(view.GroupBy))
-	for i, gb := range view.GroupBy {
-		groupBy[i] = ViewGroupByInfo{
-			FieldID:   gb.Field.ID,
-			FieldName: gb.Field.Name,
-			Direction: gb.Direction,
-		}
-	}
-
 	sortBy := make([]ViewSortByInfo, len(view.SortBy))
 	for i, sb := range view.SortBy {
 		sortBy[i] = ViewSortByInfo{
@@ -291,18 +281,31 @@ func (s *ViewService) GetView(ctx context.Context, viewID string) (*ViewInfo, er
 	}
 
 	viewInfo := &ViewInfo{
-		ID:      view.ID,
-		Name:    view.Name,
-		Layout:  view.Layout,
-		Number:  view.Number,
-		Filter:  view.Filter,
-		GroupBy: groupBy,
-		SortBy:  sortBy,
+		ID:              view.ID,
+		Name:            view.Name,
+		Layout:          view.Layout,
+		Number:          view.Number,
+		Filter:          view.Filter,
+		GroupBy:         toViewGroupByInfo(view.GroupBy),
+		SortBy:          sortBy,
+		VerticalGroupBy: toViewGroupByInfo(view.VerticalGroupBy),
 	}
 
 	return viewInfo, nil
 }
 
+func toViewGroupByInfo(groupBy []graphql.ProjectV2ViewGroupBy) []ViewGroupByInfo {
+	result := make([]ViewGroupByInfo, len(groupBy))
+	for i, gb := range groupBy {
+		result[i] = ViewGroupByInfo{
+			FieldID:   gb.Field.ID,
+			FieldName: gb.Field.Name,
+			Direction: gb.Direction,
+		}
+	}
+	return result
+}
+
 // ValidateViewName validates a view name
 func ValidateViewName(name string) error {
 	if strings.TrimSpace(name) == "" {
@@ -317,11 +320,11 @@ func ValidateViewName(name string) error {
 // ValidateViewLayout validates a view layout
 func ValidateViewLayout(layout string) (graphql.ProjectV2ViewLayout, error) {
 	switch strings.ToUpper(layout) {
-	case "TABLE", "TABLE_VIEW":
+	case "TABLE", "TABLE_VIEW", "TABLE_LAYOUT":
 		return graphql.ProjectV2ViewLayoutTable, nil
-	case "BOARD", "BOARD_VIEW":
+	case "BOARD", "BOARD_VIEW", "BOARD_LAYOUT":
 		return graphql.ProjectV2ViewLayoutBoard, nil
-	case "ROADMAP", "ROADMAP_VIEW":
+	case "ROADMAP", "ROADMAP_VIEW", "ROADMAP_LAYOUT":
 		return graphql.ProjectV2ViewLayoutRoadmap, nil
 	default:
 		validLayouts := graphql.ValidViewLayouts()
@@ -0,0 +1,429 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+const (
+	// titleFieldName is the name of the built-in title field, which every
+	// rendered view shows first
+	titleFieldName = "Title"
+
+	// RenderedLayoutBoard is the layout of a rendered board view
+	RenderedLayoutBoard = "board"
+)
+
+// RenderedView is what a project view shows: the project's items after the
+// view's filter, sort and grouping are applied
+type RenderedView struct {
+	Name   string `json:"name"`
+	Layout string `json:"layout"`
+	Filter string `json:"filter,omitempty"`
+	// GroupBy is the field items are grouped by; for board views it is the
+	// column field
+	GroupBy string `json:"groupBy,omitempty"`
+	// DateField is the date or iteration field a roadmap view orders items by
+	DateField string `json:"dateField,omitempty"`
+	// Fields is the names of the visible fields other than the title, in
+	// display order
+	Fields []string        `json:"fields"`
+	Groups []RenderedGroup `json:"groups"`
+}
+
+// RenderedGroup is a group of a table view, a column of a board view or a lane
+// of a roadmap view. Views without grouping have a single group with no name.
+type RenderedGroup struct {
+	Name  string         `json:"name"`
+	Items []RenderedItem `json:"items"`
+}
+
+// RenderedItem is an item of a rendered view with the values of its visible
+// fields
+type RenderedItem struct {
+	Values map[string]string `json:"values"`
+	// Start and End are the dates a roadmap view shows the item between
+	Start string `json:"start,omitempty"`
+	End   string `json:"end,omitempty"`
+	PositionedItem
+}
+
+// RenderViewOptions represents options for rendering a view
+type RenderViewOptions struct {
+	// Now is the time relative filter values such as @today and @current are
+	// resolved against (default: the current time)
+	Now time.Time
+	// DateField overrides the field a roadmap view orders items by; by default
+	// the first iteration field, or else the first date field, is used
+	DateField string
+}
+
+// FindProjectView returns the view with the given number, ID or name
+func FindProjectView(views []ViewInfo, ref string) (*ViewInfo, error) {
+	if number, ok := parseViewNumber(ref); ok {
+		for i := range views {
+			if views[i].Number == number {
+				return &views[i], nil
+			}
+		}
+	}
+
+	index, err := matchReference("view", ref, viewCandidates(views))
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("view %s not found in project", ref)
+	}
+	return &views[index], nil
+}
+
+// GetViewFields returns the names of the fields a view shows, in display order
+func (s *ViewService) GetViewFields(ctx context.Context, viewID string) ([]string, error) {
+	var query graphql.GetViewFieldsQuery
+	if err := s.client.Query(ctx, &query, graphql.BuildGetViewVariables(viewID)); err != nil {
+		return nil, fmt.Errorf("failed to get view fields: %w", err)
+	}
+
+	nodes := query.Node.ProjectV2View.Fields.Nodes
+	names := make([]string, len(nodes))
+	for i, node := range nodes {
+		names[i] = node.Field.Name
+	}
+	return names, nil
+}
+
+// RenderView fetches every item of a project and applies a view's filter, sort
+// and grouping to them the way the web UI does. Table and roadmap views are
+// grouped by their group-by field and board views by their column field, which
+// defaults to Status; roadmap views order the items of each lane by date.
+// Filters are evaluated as described for ItemFilter; a view whose filter uses a
+// qualifier that cannot be evaluated locally is reported as an error rather than
+// rendered without items.
+func (s *ViewService) RenderView(ctx context.Context, project *graphql.ProjectV2, view *ViewInfo, opts RenderViewOptions) (*RenderedView, error) {
+	layout, err := ValidateViewLayout(string(view.Layout))
+	if err != nil {
+		return nil, err
+	}
+
+	filterContext := ItemFilterContext{Now: opts.Now, Fields: project.Fields.Nodes}
+	if filterContext.Now.IsZero() {
+		filterContext.Now = time.Now()
+	}
+	if strings.Contains(strings.ToLower(derefString(view.Filter)), "@me") {
+		if filterContext.Viewer, err = s.viewerLogin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	filter, err := NewItemFilter(derefString(view.Filter), filterContext)
+	if err != nil {
+		return nil, fmt.Errorf("invalid view filter: %w", err)
+	}
+
+	visible, err := s.GetViewFields(ctx, view.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := NewItemService(s.client).ListProjectItems(ctx, project.ID)
+	if err != nil {
+		return nil, err
+	}
+	positions := make(map[string]int, len(items))
+	for i := range items {
+		positions[items[i].ID] = i + 1
+	}
+	items = FilterProjectItems(items, filter)
+
+	keys := make([]ItemSortKey, len(view.SortBy))
+	for i, sb := range view.SortBy {
+		keys[i] = ItemSortKey{Field: sb.FieldName, Descending: sb.Direction == graphql.ProjectV2ViewSortDirectionDESC}
+	}
+	if items, err = SortProjectItems(project, items, keys); err != nil {
+		return nil, err
+	}
+
+	rendered := &RenderedView{
+		Name:   view.Name,
+		Layout: strings.ToLower(FormatViewLayout(layout)),
+		Filter: derefString(view.Filter),
+		Fields: []string{},
+	}
+	for _, name := range visible {
+		if !strings.EqualFold(name, titleFieldName) {
+			rendered.Fields = append(rendered.Fields, name)
+		}
+	}
+
+	var dateField *graphql.ProjectV2Field
+	if layout == graphql.ProjectV2ViewLayoutRoadmap {
+		if dateField, err = roadmapDateField(project, opts.DateField); err != nil {
+			return nil, err
+		}
+		rendered.DateField = dateField.Name
+		// Items without a date come last and otherwise keep the view's order
+		if items, err = SortProjectItems(project, items, []ItemSortKey{{Field: dateField.Name}}); err != nil {
+			return nil, err
+		}
+	}
+
+	groupBy := view.GroupBy
+	if layout == graphql.ProjectV2ViewLayoutBoard {
+		groupBy = view.VerticalGroupBy
+		if len(groupBy) == 0 {
+			if status := projectFieldByName(project, statusFieldName); status != nil {
+				groupBy = []ViewGroupByInfo{{FieldID: status.ID, FieldName: status.Name}}
+			}
+		}
+	}
+
+	groups := []itemGroup{{items: items}}
+	if len(groupBy) > 0 {
+		field := projectFieldByName(project, groupBy[0].FieldName)
+		if field == nil {
+			return nil, fmt.Errorf("field %s not found in project", groupBy[0].FieldName)
+		}
+		rendered.GroupBy = field.Name
+		descending := groupBy[0].Direction == graphql.ProjectV2ViewSortDirectionDESC
+		groups = groupProjectItems(field, items, descending, layout == graphql.ProjectV2ViewLayoutBoard)
+	}
+
+	rendered.Groups = make([]RenderedGroup, len(groups))
+	for i, group := range groups {
+		rendered.Groups[i] = RenderedGroup{Name: group.name, Items: make([]RenderedItem, len(group.items))}
+		for j := range group.items {
+			item := &group.items[j]
+			renderedItem := RenderedItem{
+				PositionedItem: newPositionedItem(item, positions[item.ID]),
+				Values:         make(map[string]string, len(rendered.Fields)),
+			}
+			for _, name := range rendered.Fields {
+				renderedItem.Values[name] = itemFieldValue(item, name)
+			}
+			if dateField != nil {
+				renderedItem.Start, renderedItem.End = itemDateRange(item, dateField)
+			}
+			rendered.Groups[i].Items[j] = renderedItem
+		}
+	}
+
+	return rendered, nil
+}
+
+// viewerLogin returns the login of the authenticated user
+func (s *ViewService) viewerLogin(ctx context.Context) (string, error) {
+	var query graphql.ViewerQuery
+	if err := s.client.Query(ctx, &query, nil); err != nil {
+		return "", fmt.Errorf("failed to get viewer: %w", err)
+	}
+	return query.Viewer.Login, nil
+}
+
+type itemGroup struct {
+	sortValue interface{}
+	name      string
+	items     []graphql.ProjectV2Item
+}
+
+// groupProjectItems groups items by the value of a field. Groups are ordered
+// like the field's values sort, with the group of items without a value last;
+// with allOptions every option of a single select field has a group, even an
+// empty one, the way board columns do.
+func groupProjectItems(field *graphql.ProjectV2Field, items []graphql.ProjectV2Item, descending, allOptions bool) []itemGroup {
+	var groups []itemGroup
+	index := make(map[string]int)
+	if allOptions {
+		for i, option := range field.SingleSelect.Options {
+			index[option.Name] = len(groups)
+			groups = append(groups, itemGroup{name: option.Name, sortValue: float64(i)})
+		}
+	}
+
+	var none []graphql.ProjectV2Item
+	for i := range items {
+		sortValue := itemSortValue(&items[i], field)
+		if sortValue == nil {
+			none = append(none, items[i])
+			continue
+		}
+		name := itemFieldValue(&items[i], field.Name)
+		k, ok := index[name]
+		if !ok {
+			k = len(groups)
+			index[name] = k
+			groups = append(groups, itemGroup{name: name, sortValue: sortValue})
+		}
+		groups[k].items = append(groups[k].items, items[i])
+	}
+
+	sort.SliceStable(groups, func(i, j int) bool {
+		cmp := compareSortValues(groups[i].sortValue, groups[j].sortValue)
+		if descending {
+			return cmp > 0
+		}
+		return cmp < 0
+	})
+	if len(none) > 0 || allOptions {
+		groups = append(groups, itemGroup{name: "No " + field.Name, items: none})
+	}
+	return groups
+}
+
+// roadmapDateField returns the named field, or the first iteration field or
+// else the first date field of a project
+func roadmapDateField(project *graphql.ProjectV2, name string) (*graphql.ProjectV2Field, error) {
+	if name != "" {
+		field := projectFieldByName(project, name)
+		if field == nil {
+			return nil, fmt.Errorf("field %s not found in project", name)
+		}
+		if field.DataType != graphql.ProjectV2FieldDataTypeDate && field.DataType != graphql.ProjectV2FieldDataTypeIteration {
+			return nil, fmt.Errorf("field %s is not a date or iteration field", field.Name)
+		}
+		return field, nil
+	}
+
+	for _, dataType := range []graphql.ProjectV2FieldDataType{graphql.ProjectV2FieldDataTypeIteration, graphql.ProjectV2FieldDataTypeDate} {
+		for i := range project.Fields.Nodes {
+			if project.Fields.Nodes[i].DataType == dataType {
+				return &project.Fields.Nodes[i], nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("roadmap views need a date or iteration field, and the project has none")
+}
+
+// itemDateRange returns the dates an item spans on a roadmap: the day of a date
+// field, or the first and last day of an iteration
+func itemDateRange(item *graphql.ProjectV2Item, field *graphql.ProjectV2Field) (start, end string) {
+	for i := range item.FieldValues.Nodes {
+		value := &item.FieldValues.Nodes[i]
+		if value.Field.ID != field.ID {
+			continue
+		}
+		if value.DateValue.Date != nil {
+			return *value.DateValue.Date, *value.DateValue.Date
+		}
+		iteration := value.IterationValue
+		if iteration.StartDate == nil {
+			return "", ""
+		}
+		first, err := time.Parse(DateLayout, *iteration.StartDate)
+		if err != nil || iteration.Duration == nil || *iteration.Duration < 1 {
+			return *iteration.StartDate, ""
+		}
+		return *iteration.StartDate, first.AddDate(0, 0, *iteration.Duration-1).Format(DateLayout)
+	}
+	return "", ""
+}
+
+// RenderedViewColumns returns the column headings of a rendered view: the title,
+// the visible fields and, for roadmap views, the start and end dates
+func RenderedViewColumns(view *RenderedView) []string {
+	columns := append([]string{titleFieldName}, view.Fields...)
+	if view.DateField != "" {
+		columns = append(columns, "Start", "End")
+	}
+	return columns
+}
+
+// RenderedViewRow returns the cells of an item under RenderedViewColumns
+func RenderedViewRow(view *RenderedView, item *RenderedItem) []string {
+	title := item.Title
+	if item.Number > 0 {
+		title = fmt.Sprintf("%s (#%d)", item.Title, item.Number)
+	}
+	row := []string{title}
+	for _, name := range view.Fields {
+		row = append(row, item.Values[name])
+	}
+	if view.DateField != "" {
+		row = append(row, item.Start, item.End)
+	}
+	return row
+}
+
+// FormatRenderedViewMarkdown renders a view as Markdown: a section per group
+// with a table of its items, or a bullet list per column for board views
+func FormatRenderedViewMarkdown(view *RenderedView) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# %s\n", view.Name)
+	for _, group := range view.Groups {
+		if group.Name != "" {
+			fmt.Fprintf(&b, "\n## %s (%d)\n", group.Name, len(group.Items))
+		}
+		b.WriteString("\n")
+
+		if view.Layout == RenderedLayoutBoard {
+			if len(group.Items) == 0 {
+				b.WriteString("_No items_\n")
+			}
+			for i := range group.Items {
+				fmt.Fprintf(&b, "- %s\n", markdownCell(RenderedViewRow(view, &group.Items[i])[0]))
+			}
+			continue
+		}
+
+		columns := RenderedViewColumns(view)
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(mapStrings(columns, markdownCell), " | "))
+		fmt.Fprintf(&b, "|%s\n", strings.Repeat(" --- |", len(columns)))
+		for i := range group.Items {
+			fmt.Fprintf(&b, "| %s |\n", strings.Join(mapStrings(RenderedViewRow(view, &group.Items[i]), markdownCell), " | "))
+		}
+	}
+
+	return b.String()
+}
+
+// WriteRenderedViewCSV writes the items of a rendered view as CSV, one row per
+// item, with the item's group in the first column when the view is grouped
+func WriteRenderedViewCSV(w io.Writer, view *RenderedView) error {
+	writer := csv.NewWriter(w)
+
+	header := RenderedViewColumns(view)
+	if view.GroupBy != "" {
+		header = append([]string{view.GroupBy}, header...)
+	}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	for _, group := range view.Groups {
+		for i := range group.Items {
+			row := RenderedViewRow(view, &group.Items[i])
+			if view.GroupBy != "" {
+				row = append([]string{group.Name}, row...)
+			}
+			if err := writer.Write(row); err != nil {
+				return fmt.Errorf("failed to write CSV: %w", err)
+			}
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("failed to write CSV: %w", err)
+	}
+	return nil
+}
+
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+func mapStrings(values []string, fn func(string) string) []string {
+	result := make([]string, len(values))
+	for i, v := range values {
+		result[i] = fn(v)
+	}
+	return result
+}
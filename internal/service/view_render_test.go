@@ -0,0 +1,278 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestFindProjectView(t *testing.T) {
+	views := []ViewInfo{{ID: "PVTV_1", Name: "Board", Number: 1}, {ID: "PVTV_2", Name: "Roadmap", Number: 2}}
+
+	for _, ref := range []string{"2", "PVTV_2", "roadmap"} {
+		view, err := FindProjectView(views, ref)
+		require.NoError(t, err)
+		assert.Equal(t, "Roadmap", view.Name)
+	}
+
+	_, err := FindProjectView(views, "Backlog")
+	assert.ErrorContains(t, err, "view Backlog not found")
+}
+
+func TestRenderViewAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+	status := project.Fields[2]
+	priority := store.AddField(project, "Priority", "SINGLE_SELECT", "High", "Low")
+	due := store.AddField(project, "Due", "DATE")
+
+	add := func(title, state, level, date string) *fake.Item {
+		item := store.AddDraftIssue(project, title, "")
+		if state != "" {
+			store.SetValue(item, status, fake.Value{OptionID: status.Option(state).ID})
+		}
+		if level != "" {
+			store.SetValue(item, priority, fake.Value{OptionID: priority.Option(level).ID})
+		}
+		if date != "" {
+			store.SetValue(item, due, fake.Value{Date: date})
+		}
+		return item
+	}
+	add("Write docs", "Todo", "Low", "2026-03-20")
+	add("Ship it", "Done", "High", "2026-03-01")
+	add("Fix login", "In Progress", "High", "")
+	add("Triage", "", "Low", "2026-03-10")
+
+	ctx := context.Background()
+	client := api.NewClient("ghp_fake")
+	viewService := NewViewService(client)
+	graphqlProject, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+	require.NoError(t, err)
+
+	render := func(t *testing.T, view *fake.View, opts RenderViewOptions) *RenderedView {
+		t.Helper()
+		views, err := viewService.GetProjectViews(ctx, project.ID)
+		require.NoError(t, err)
+		info, err := FindProjectView(views, view.Name)
+		require.NoError(t, err)
+		rendered, err := viewService.RenderView(ctx, graphqlProject, info, opts)
+		require.NoError(t, err)
+		return rendered
+	}
+	titles := func(group RenderedGroup) []string {
+		result := make([]string, len(group.Items))
+		for i, item := range group.Items {
+			result[i] = item.Title
+		}
+		return result
+	}
+
+	table := store.AddView(project, "Open work", "TABLE_LAYOUT")
+	table.Filter = "-status:Done"
+	table.SortBy = []fake.ViewSetting{{Field: priority, Direction: "ASC"}}
+	table.Fields = []*fake.Field{project.Fields[0], priority, status}
+
+	t.Run("Filters and sorts a table view and shows its visible fields", func(t *testing.T) {
+		rendered := render(t, table, RenderViewOptions{})
+
+		assert.Equal(t, "table", rendered.Layout)
+		assert.Equal(t, []string{"Priority", "Status"}, rendered.Fields)
+		require.Len(t, rendered.Groups, 1)
+		assert.Equal(t, []string{"Fix login", "Write docs", "Triage"}, titles(rendered.Groups[0]))
+		assert.Equal(t, map[string]string{"Priority": "High", "Status": "In Progress"}, rendered.Groups[0].Items[0].Values)
+		assert.Equal(t, 3, rendered.Groups[0].Items[0].Position)
+	})
+
+	t.Run("Groups a table view by its group-by field", func(t *testing.T) {
+		table.GroupBy = []fake.ViewSetting{{Field: status, Direction: "DESC"}}
+		defer func() { table.GroupBy = nil }()
+
+		rendered := render(t, table, RenderViewOptions{})
+
+		assert.Equal(t, "Status", rendered.GroupBy)
+		require.Len(t, rendered.Groups, 3)
+		assert.Equal(t, "In Progress", rendered.Groups[0].Name)
+		assert.Equal(t, "Todo", rendered.Groups[1].Name)
+		assert.Equal(t, "No Status", rendered.Groups[2].Name)
+		assert.Equal(t, []string{"Triage"}, titles(rendered.Groups[2]))
+	})
+
+	t.Run("Renders every column of a board view", func(t *testing.T) {
+		board := store.AddView(project, "Board", "BOARD_LAYOUT")
+
+		rendered := render(t, board, RenderViewOptions{})
+
+		assert.Equal(t, "Status", rendered.GroupBy)
+		names := make([]string, len(rendered.Groups))
+		for i, group := range rendered.Groups {
+			names[i] = group.Name
+		}
+		assert.Equal(t, []string{"Todo", "In Progress", "Done", "No Status"}, names)
+		assert.Equal(t, []string{"Ship it"}, titles(rendered.Groups[2]))
+
+		board.VerticalGroupBy = []fake.ViewSetting{{Field: priority, Direction: "ASC"}}
+		rendered = render(t, board, RenderViewOptions{})
+		assert.Equal(t, "Priority", rendered.GroupBy)
+		assert.Equal(t, []string{"Ship it", "Fix login"}, titles(rendered.Groups[0]))
+		assert.Empty(t, rendered.Groups[2].Items)
+	})
+
+	t.Run("Orders roadmap lanes by date", func(t *testing.T) {
+		roadmap := store.AddView(project, "Timeline", "ROADMAP_LAYOUT")
+		roadmap.GroupBy = []fake.ViewSetting{{Field: priority, Direction: "ASC"}}
+
+		rendered := render(t, roadmap, RenderViewOptions{})
+
+		assert.Equal(t, "Due", rendered.DateField)
+		require.Len(t, rendered.Groups, 2)
+		assert.Equal(t, []string{"Ship it", "Fix login"}, titles(rendered.Groups[0]))
+		assert.Equal(t, []string{"Triage", "Write docs"}, titles(rendered.Groups[1]))
+		assert.Equal(t, "2026-03-10", rendered.Groups[1].Items[0].Start)
+		assert.Equal(t, "2026-03-10", rendered.Groups[1].Items[0].End)
+
+		_, err := viewService.RenderView(ctx, graphqlProject, &ViewInfo{ID: roadmap.ID, Layout: "ROADMAP_LAYOUT"}, RenderViewOptions{DateField: "Priority"})
+		assert.ErrorContains(t, err, "not a date or iteration field")
+	})
+
+	t.Run("Formats a rendered view as Markdown and CSV", func(t *testing.T) {
+		rendered := render(t, table, RenderViewOptions{})
+
+		markdown := FormatRenderedViewMarkdown(rendered)
+		assert.Contains(t, markdown, "# Open work\n")
+		assert.Contains(t, markdown, "| Title | Priority | Status |\n| --- | --- | --- |\n")
+		assert.Contains(t, markdown, "| Fix login | High | In Progress |\n")
+
+		var buf bytes.Buffer
+		require.NoError(t, WriteRenderedViewCSV(&buf, rendered))
+		assert.Equal(t, "Title,Priority,Status\nFix login,High,In Progress\nWrite docs,Low,Todo\nTriage,Low,\n", buf.String())
+	})
+}
+
+func TestItemDateRangeSpansIteration(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	project := store.AddProject(fake.DefaultViewer, "Sprints")
+	sprint := store.AddField(project, "Sprint", "ITERATION")
+	item := store.AddDraftIssue(project, "Plan", "")
+	store.SetValue(item, sprint, fake.Value{IterationID: sprint.Iterations[0].ID})
+	roadmap := store.AddView(project, "Timeline", "ROADMAP_LAYOUT")
+
+	ctx := context.Background()
+	client := api.NewClient("ghp_fake")
+	graphqlProject, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+	require.NoError(t, err)
+
+	rendered, err := NewViewService(client).RenderView(ctx, graphqlProject, &ViewInfo{ID: roadmap.ID, Layout: "ROADMAP_LAYOUT"}, RenderViewOptions{})
+	require.NoError(t, err)
+
+	iteration := sprint.Iterations[0]
+	assert.Equal(t, "Sprint", rendered.DateField)
+	require.Len(t, rendered.Groups, 1)
+	assert.Equal(t, iteration.StartDate.Format(DateLayout), rendered.Groups[0].Items[0].Start)
+	assert.Equal(t, iteration.StartDate.AddDate(0, 0, iteration.Duration-1).Format(DateLayout), rendered.Groups[0].Items[0].End)
+}
+
+func TestRenderViewBuiltinFilters(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	project := store.AddProject(fake.DefaultViewer, "Releases")
+	sprint := store.AddField(project, "Sprint", "ITERATION")
+	week1 := store.AddIteration(sprint, "Week 1", time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), 7)
+	store.AddIteration(sprint, "Week 2", time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC), 7)
+	due := store.AddField(project, "Due", "DATE")
+
+	app := store.AddRepository(fake.DefaultViewer, "app")
+	web := store.AddRepository("acme", "web")
+	bug := store.AddLabel(app, "bug", "d73a4a")
+	v1 := store.AddMilestone(app, "v1")
+
+	login := store.AddIssue(app, "Fix login")
+	login.Assignees = []*fake.Account{store.Account(fake.DefaultViewer)}
+	login.Labels = []*fake.Label{bug}
+	login.Milestone = v1
+	loginItem := store.AddItem(project, login)
+	store.SetValue(loginItem, sprint, fake.Value{IterationID: week1.ID})
+	store.SetValue(loginItem, due, fake.Value{Date: "2026-03-05"})
+
+	store.AddItem(project, store.AddIssue(web, "Redesign"))
+	merged := store.AddPullRequest(app, "Add SSO")
+	merged.Merged = true
+	merged.Closed = true
+	store.AddItem(project, merged)
+	store.AddDraftIssue(project, "Plan launch", "")
+
+	ctx := context.Background()
+	client := api.NewClient("ghp_fake")
+	viewService := NewViewService(client)
+	graphqlProject, err := NewProjectService(client).GetProjectWithOwnerDetection(ctx, fake.DefaultViewer, project.Number)
+	require.NoError(t, err)
+
+	view := store.AddView(project, "Mine", "TABLE_LAYOUT")
+	view.Fields = []*fake.Field{project.Fields[0], project.Fields[1], project.Fields[3]}
+	render := func(t *testing.T, filter string) (*RenderedView, error) {
+		t.Helper()
+		view.Filter = filter
+		views, err := viewService.GetProjectViews(ctx, project.ID)
+		require.NoError(t, err)
+		info, err := FindProjectView(views, view.Name)
+		require.NoError(t, err)
+		return viewService.RenderView(ctx, graphqlProject, info, RenderViewOptions{Now: time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)})
+	}
+
+	tests := []struct {
+		filter string
+		want   []string
+	}{
+		{"assignee:@me label:bug", []string{"Fix login"}},
+		{"milestone:v1 repo:app", []string{"Fix login"}},
+		{"repo:acme/web", []string{"Redesign"}},
+		{"sprint:@current", []string{"Fix login"}},
+		{"sprint:@next", nil},
+		{"is:merged", []string{"Add SSO"}},
+		{"due:<=@today+1d", []string{"Fix login"}},
+		{"no:assignee -is:draft", []string{"Redesign", "Add SSO"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.filter, func(t *testing.T) {
+			rendered, err := render(t, tt.filter)
+			require.NoError(t, err)
+			require.Len(t, rendered.Groups, 1)
+			var got []string
+			for _, item := range rendered.Groups[0].Items {
+				got = append(got, item.Title)
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	t.Run("Shows the built-in Assignees and Labels columns", func(t *testing.T) {
+		rendered, err := render(t, "label:bug")
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Assignees", "Labels"}, rendered.Fields)
+		require.Len(t, rendered.Groups[0].Items, 1)
+		assert.Equal(t, map[string]string{"Assignees": fake.DefaultViewer, "Labels": "bug"}, rendered.Groups[0].Items[0].Values)
+	})
+
+	t.Run("Rejects filters it cannot evaluate", func(t *testing.T) {
+		_, err := render(t, "reviewers:alice")
+		assert.ErrorContains(t, err, "cannot evaluate filter reviewers:alice")
+	})
+}
@@ -0,0 +1,659 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/graphql"
+)
+
+// Webhook events handled by the webhook receiver
+const (
+	WebhookEventProjectItem = "projects_v2_item"
+	WebhookEventIssues      = "issues"
+	WebhookEventPullRequest = "pull_request"
+	WebhookEventDiscussion  = "discussion"
+	webhookEventPing        = "ping"
+)
+
+// Webhook request headers
+const (
+	WebhookHeaderEvent     = "X-GitHub-Event"
+	WebhookHeaderDelivery  = "X-GitHub-Delivery"
+	WebhookHeaderSignature = "X-Hub-Signature-256"
+)
+
+const (
+	webhookSignaturePrefix = "sha256="
+	// maxWebhookPayload is the largest payload GitHub delivers
+	maxWebhookPayload = 25 << 20
+	// webhookDeliveryLogSize is the number of delivery IDs remembered for deduplication
+	webhookDeliveryLogSize = 1000
+	webhookHandlerTimeout  = time.Minute
+	// webhookOwnEditWindow is how long the edited event of a field set by a
+	// handler is expected to take to arrive
+	webhookOwnEditWindow   = 5 * time.Minute
+	webhookSendTimeout     = 30 * time.Second
+	webhookDeliveryIDBytes = 16
+)
+
+// WebhookEvents lists the events handlers can subscribe to
+var WebhookEvents = []string{WebhookEventProjectItem, WebhookEventIssues, WebhookEventPullRequest, WebhookEventDiscussion}
+
+// WebhookConfig describes the handlers webhook events are dispatched to
+type WebhookConfig struct {
+	// Secret verifies X-Hub-Signature-256; a secret given on the command line or
+	// in GHX_WEBHOOK_SECRET takes precedence
+	Secret   string           `yaml:"secret,omitempty"`
+	Handlers []WebhookHandler `yaml:"handlers"`
+}
+
+// WebhookHandler describes what to do for matching events. Exactly one of Run,
+// SetField and AddToProject is set.
+type WebhookHandler struct {
+	SetField     *WebhookSetField `yaml:"setField,omitempty"`
+	Name         string           `yaml:"name,omitempty"`
+	Run          string           `yaml:"run,omitempty"`
+	AddToProject string           `yaml:"addToProject,omitempty"`
+	Events       []string         `yaml:"events"`
+	// Actions limits the handler to events with these actions, e.g. opened
+	Actions []string `yaml:"actions,omitempty"`
+}
+
+// WebhookSetField describes a field value set on the project item of an event
+type WebhookSetField struct {
+	Project string `yaml:"project"`
+	Field   string `yaml:"field"`
+	Value   string `yaml:"value"`
+}
+
+// WebhookEvent represents a webhook delivery
+type WebhookEvent struct {
+	Name       string
+	DeliveryID string
+	Action     string
+	// ContentID is the node ID of the issue or pull request the event is about
+	ContentID string
+	// ItemID and ProjectID are set for projects_v2_item events
+	ItemID    string
+	ProjectID string
+	// ChangedFieldID is the field whose value changed in a projects_v2_item
+	// edited event
+	ChangedFieldID string
+	Payload        []byte
+}
+
+type webhookPayload struct {
+	Issue          *webhookNode `json:"issue"`
+	PullRequest    *webhookNode `json:"pull_request"`
+	ProjectsV2Item *struct {
+		NodeID        string `json:"node_id"`
+		ProjectNodeID string `json:"project_node_id"`
+		ContentNodeID string `json:"content_node_id"`
+	} `json:"projects_v2_item"`
+	Changes *struct {
+		FieldValue *struct {
+			FieldNodeID string `json:"field_node_id"`
+		} `json:"field_value"`
+	} `json:"changes"`
+	Action string `json:"action"`
+}
+
+type webhookNode struct {
+	NodeID string `json:"node_id"`
+}
+
+// LoadWebhookConfig reads and validates a webhook handler config file
+func LoadWebhookConfig(path string) (*WebhookConfig, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // reading the user-provided config file is intended
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook config: %w", err)
+	}
+	config, err := ParseWebhookConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// ParseWebhookConfig parses and validates a YAML webhook handler config
+func ParseWebhookConfig(data []byte) (*WebhookConfig, error) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	config := &WebhookConfig{}
+	if err := decoder.Decode(config); err != nil {
+		return nil, fmt.Errorf("invalid webhook config: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// Validate checks that every handler subscribes to known events and has exactly
+// one action. Unnamed handlers are named after their position.
+func (c *WebhookConfig) Validate() error {
+	if len(c.Handlers) == 0 {
+		return fmt.Errorf("no handlers configured")
+	}
+	for i := range c.Handlers {
+		h := &c.Handlers[i]
+		if h.Name == "" {
+			h.Name = fmt.Sprintf("handler %d", i+1)
+		}
+		if len(h.Events) == 0 {
+			return fmt.Errorf("%s: no events listed", h.Name)
+		}
+		for _, event := range h.Events {
+			if !containsFold(WebhookEvents, event) {
+				return fmt.Errorf("%s: unsupported event %s (use %s)", h.Name, event, strings.Join(WebhookEvents, ", "))
+			}
+		}
+
+		actions := 0
+		if h.Run != "" {
+			actions++
+		}
+		if h.AddToProject != "" {
+			actions++
+		}
+		if h.SetField != nil {
+			actions++
+			if h.SetField.Project == "" || h.SetField.Field == "" {
+				return fmt.Errorf("%s: setField needs a project and a field", h.Name)
+			}
+		}
+		if actions != 1 {
+			return fmt.Errorf("%s: set exactly one of run, setField and addToProject", h.Name)
+		}
+	}
+	return nil
+}
+
+// NeedsAPI reports whether any handler changes a project
+func (c *WebhookConfig) NeedsAPI() bool {
+	for _, h := range c.Handlers {
+		if h.Run == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// project returns the project reference of a handler, or "" for run handlers
+func (h *WebhookHandler) project() string {
+	if h.SetField != nil {
+		return h.SetField.Project
+	}
+	return h.AddToProject
+}
+
+func (h *WebhookHandler) matches(event *WebhookEvent) bool {
+	if !containsFold(h.Events, event.Name) {
+		return false
+	}
+	return len(h.Actions) == 0 || containsFold(h.Actions, event.Action)
+}
+
+// ParseWebhookEvent parses the payload of a webhook delivery
+func ParseWebhookEvent(name, deliveryID string, payload []byte) (*WebhookEvent, error) {
+	var body webhookPayload
+	if err := json.Unmarshal(payload, &body); err != nil {
+		return nil, fmt.Errorf("invalid webhook payload: %w", err)
+	}
+
+	event := &WebhookEvent{Name: name, DeliveryID: deliveryID, Action: body.Action, Payload: payload}
+	switch {
+	case body.ProjectsV2Item != nil:
+		event.ItemID = body.ProjectsV2Item.NodeID
+		event.ProjectID = body.ProjectsV2Item.ProjectNodeID
+		event.ContentID = body.ProjectsV2Item.ContentNodeID
+		if body.Changes != nil && body.Changes.FieldValue != nil {
+			event.ChangedFieldID = body.Changes.FieldValue.FieldNodeID
+		}
+	case body.Issue != nil:
+		event.ContentID = body.Issue.NodeID
+	case body.PullRequest != nil:
+		event.ContentID = body.PullRequest.NodeID
+	}
+	return event, nil
+}
+
+// SignWebhookPayload returns the X-Hub-Signature-256 header value of a payload
+func SignWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return webhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature checks an X-Hub-Signature-256 header value against the
+// payload
+func VerifyWebhookSignature(secret string, payload []byte, signature string) error {
+	if signature == "" {
+		return fmt.Errorf("missing %s header", WebhookHeaderSignature)
+	}
+	if !hmac.Equal([]byte(signature), []byte(SignWebhookPayload(secret, payload))) {
+		return fmt.Errorf("signature does not match the payload")
+	}
+	return nil
+}
+
+// WebhookReceiver is an http.Handler that verifies webhook deliveries and
+// dispatches them to the configured handlers
+type WebhookReceiver struct {
+	projects   *ProjectService
+	logger     *slog.Logger
+	resolved   map[string]*graphql.ProjectV2
+	deliveries *deliveryLog
+	ownEdits   *editLog
+	secret     string
+	handlers   []WebhookHandler
+	running    sync.WaitGroup
+}
+
+// NewWebhookReceiver creates a webhook receiver. The projects of the handlers are
+// fetched and their field values checked up front, so client may only be nil
+// when every handler runs a command.
+func NewWebhookReceiver(
+	ctx context.Context, client *api.Client, config *WebhookConfig, secret string, logger *slog.Logger,
+) (*WebhookReceiver, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("a webhook secret is required")
+	}
+
+	r := &WebhookReceiver{
+		logger:     logger,
+		resolved:   map[string]*graphql.ProjectV2{},
+		deliveries: newDeliveryLog(webhookDeliveryLogSize),
+		ownEdits:   newEditLog(webhookOwnEditWindow),
+		secret:     secret,
+		handlers:   config.Handlers,
+	}
+	if client != nil {
+		r.projects = NewProjectService(client)
+	}
+
+	for i := range r.handlers {
+		h := &r.handlers[i]
+		ref := h.project()
+		if ref == "" {
+			continue
+		}
+		if r.projects == nil {
+			return nil, fmt.Errorf("%s: changing projects requires authentication", h.Name)
+		}
+		project, ok := r.resolved[ref]
+		if !ok {
+			owner, number, err := ParseProjectReference(ref)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", h.Name, err)
+			}
+			project, err = r.projects.GetProjectWithOwnerDetection(ctx, owner, number)
+			if err != nil {
+				return nil, fmt.Errorf("%s: failed to get project %s: %w", h.Name, ref, err)
+			}
+			r.resolved[ref] = project
+		}
+		if h.SetField != nil {
+			if _, err := h.fieldValue(project, time.Now()); err != nil {
+				return nil, fmt.Errorf("%s: %w", h.Name, err)
+			}
+		}
+	}
+	return r, nil
+}
+
+func (h *WebhookHandler) fieldValue(project *graphql.ProjectV2, now time.Time) (syncFieldValue, error) {
+	values, err := resolveSyncValues(project, []SyncFieldValue{{Field: h.SetField.Field, Value: h.SetField.Value}}, now)
+	if err != nil {
+		return syncFieldValue{}, err
+	}
+	return values[0], nil
+}
+
+// ServeHTTP verifies a delivery, answers it with 202 Accepted and dispatches it in
+// the background, so slow handlers do not exceed GitHub's 10 second timeout.
+// Deliveries already handled are acknowledged without dispatching them again; a
+// redelivery of a delivery with failed handlers runs only the handlers that failed.
+func (r *WebhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	name := req.Header.Get(WebhookHeaderEvent)
+	deliveryID := req.Header.Get(WebhookHeaderDelivery)
+	logger := r.logger.With("delivery", deliveryID, "event", name)
+
+	payload, err := io.ReadAll(http.MaxBytesReader(w, req.Body, maxWebhookPayload))
+	if err != nil {
+		logger.Warn("failed to read payload", "error", err)
+		http.Error(w, "failed to read payload", http.StatusBadRequest)
+		return
+	}
+	if err := VerifyWebhookSignature(r.secret, payload, req.Header.Get(WebhookHeaderSignature)); err != nil {
+		logger.Warn("rejected delivery", "error", err)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case name == webhookEventPing:
+		logger.Info("ping")
+		fmt.Fprintln(w, "pong")
+		return
+	case !containsFold(WebhookEvents, name):
+		logger.Info("ignored unsupported event")
+		fmt.Fprintln(w, "ignored")
+		return
+	}
+
+	event, err := ParseWebhookEvent(name, deliveryID, payload)
+	if err != nil {
+		logger.Warn("rejected delivery", "error", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	succeeded, ok := r.deliveries.claim(deliveryID)
+	if !ok {
+		logger.Info("skipped duplicate delivery", "action", event.Action)
+		fmt.Fprintln(w, "duplicate")
+		return
+	}
+
+	r.running.Add(1)
+	go func() {
+		defer r.running.Done()
+		ctx, cancel := context.WithTimeout(context.Background(), webhookHandlerTimeout)
+		defer cancel()
+		err := r.dispatch(ctx, event, succeeded)
+		r.deliveries.finish(deliveryID, err == nil)
+	}()
+
+	w.WriteHeader(http.StatusAccepted)
+	fmt.Fprintln(w, "accepted")
+}
+
+// Wait waits for the deliveries being dispatched in the background
+func (r *WebhookReceiver) Wait() {
+	r.running.Wait()
+}
+
+// Dispatch runs every handler matching an event and logs the outcome of each.
+// The errors of failed handlers are joined.
+func (r *WebhookReceiver) Dispatch(ctx context.Context, event *WebhookEvent) error {
+	return r.dispatch(ctx, event, map[int]bool{})
+}
+
+// dispatch runs the matching handlers that have not succeeded yet and records
+// the ones that succeed in succeeded. A setField handler is skipped for the
+// edited event caused by a value a handler set, so it does not trigger itself.
+func (r *WebhookReceiver) dispatch(ctx context.Context, event *WebhookEvent, succeeded map[int]bool) error {
+	logger := r.logger.With("delivery", event.DeliveryID, "event", event.Name, "action", event.Action)
+	ownEdit := event.Name == WebhookEventProjectItem && event.Action == "edited" &&
+		r.ownEdits.take(event.ItemID, event.ChangedFieldID)
+
+	var errs []error
+	matched := 0
+	for i := range r.handlers {
+		h := &r.handlers[i]
+		if !h.matches(event) {
+			continue
+		}
+		matched++
+		if succeeded[i] {
+			logger.Info("skipped handler that already succeeded", "handler", h.Name)
+			continue
+		}
+		if ownEdit && h.SetField != nil {
+			logger.Info("skipped edit made by a handler", "handler", h.Name)
+			continue
+		}
+
+		started := time.Now()
+		err := r.runHandler(ctx, h, event)
+		attrs := []any{"handler", h.Name, "duration", time.Since(started)}
+		if err != nil {
+			logger.Error("handler failed", append(attrs, "error", err)...)
+			errs = append(errs, fmt.Errorf("%s: %w", h.Name, err))
+			continue
+		}
+		succeeded[i] = true
+		logger.Info("handler succeeded", attrs...)
+	}
+	if matched == 0 {
+		logger.Info("no handler matched")
+	}
+	return errors.Join(errs...)
+}
+
+func (r *WebhookReceiver) runHandler(ctx context.Context, h *WebhookHandler, event *WebhookEvent) error {
+	if h.Run != "" {
+		return runWebhookCommand(ctx, h.Run, event)
+	}
+
+	project := r.resolved[h.project()]
+	itemID := event.ItemID
+	if itemID == "" || event.ProjectID != project.ID {
+		if event.ContentID == "" {
+			return fmt.Errorf("%s event has no issue or pull request to add to the project", event.Name)
+		}
+		item, err := r.projects.AddItem(ctx, AddItemInput{ProjectID: project.ID, ContentID: event.ContentID})
+		if err != nil {
+			return err
+		}
+		itemID = item.ID
+	}
+	if h.SetField == nil {
+		return nil
+	}
+
+	value, err := h.fieldValue(project, time.Now())
+	if err != nil {
+		return err
+	}
+	_, err = r.projects.UpdateItemField(ctx, UpdateItemFieldInput{
+		ProjectID: project.ID,
+		ItemID:    itemID,
+		FieldID:   value.field.ID,
+		Value:     value.value,
+	})
+	if err != nil {
+		return err
+	}
+	r.ownEdits.add(itemID, value.field.ID)
+	return nil
+}
+
+// runWebhookCommand runs a shell command with the event payload on stdin and the
+// event name, action and delivery ID in GHX_EVENT, GHX_ACTION and GHX_DELIVERY
+func runWebhookCommand(ctx context.Context, command string, event *WebhookEvent) error {
+	cmd := exec.CommandContext(ctx, "sh", "-c", command) //nolint:gosec // running the configured command is intended
+	cmd.Stdin = bytes.NewReader(event.Payload)
+	cmd.Env = append(os.Environ(),
+		"GHX_EVENT="+event.Name,
+		"GHX_ACTION="+event.Action,
+		"GHX_DELIVERY="+event.DeliveryID,
+	)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		if out := strings.TrimSpace(string(output)); out != "" {
+			return fmt.Errorf("command failed: %w: %s", err, out)
+		}
+		return fmt.Errorf("command failed: %w", err)
+	}
+	return nil
+}
+
+// deliveryLog remembers the most recent deliveries and the handlers that
+// succeeded for each
+type deliveryLog struct {
+	deliveries map[string]*deliveryState
+	order      []string
+	size       int
+	mu         sync.Mutex
+}
+
+type deliveryState struct {
+	// succeeded holds the indexes of the handlers that succeeded
+	succeeded map[int]bool
+	running   bool
+	done      bool
+}
+
+func newDeliveryLog(size int) *deliveryLog {
+	return &deliveryLog{deliveries: map[string]*deliveryState{}, size: size}
+}
+
+// claim starts handling a delivery and returns the handlers that already
+// succeeded for it. It returns false while the delivery is being handled and
+// once all its handlers succeeded. Deliveries without an ID are always handled.
+func (l *deliveryLog) claim(id string) (map[int]bool, bool) {
+	if id == "" {
+		return map[int]bool{}, true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if state, ok := l.deliveries[id]; ok {
+		if state.running || state.done {
+			return nil, false
+		}
+		state.running = true
+		return state.succeeded, true
+	}
+
+	if len(l.order) == l.size {
+		delete(l.deliveries, l.order[0])
+		l.order = l.order[1:]
+	}
+	state := &deliveryState{succeeded: map[int]bool{}, running: true}
+	l.deliveries[id] = state
+	l.order = append(l.order, id)
+	return state.succeeded, true
+}
+
+// finish ends the handling of a delivery claimed with claim; done records that
+// all its handlers succeeded
+func (l *deliveryLog) finish(id string, done bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if state, ok := l.deliveries[id]; ok {
+		state.running = false
+		state.done = done
+	}
+}
+
+// editLog remembers the field values handlers set until their edited events
+// arrive
+type editLog struct {
+	edits  map[string]time.Time
+	now    func() time.Time
+	window time.Duration
+	mu     sync.Mutex
+}
+
+func newEditLog(window time.Duration) *editLog {
+	return &editLog{edits: map[string]time.Time{}, now: time.Now, window: window}
+}
+
+// add records that a handler set a field of an item
+func (l *editLog) add(itemID, fieldID string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	for key, expires := range l.edits {
+		if now.After(expires) {
+			delete(l.edits, key)
+		}
+	}
+	l.edits[itemID+"/"+fieldID] = now.Add(l.window)
+}
+
+// take reports whether a handler recently set the field of the item and forgets
+// the edit, so only the event it caused is matched
+func (l *editLog) take(itemID, fieldID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	key := itemID + "/" + fieldID
+	expires, ok := l.edits[key]
+	delete(l.edits, key)
+	return ok && !l.now().After(expires)
+}
+
+// SendWebhookInput represents a signed sample delivery
+type SendWebhookInput struct {
+	URL    string
+	Secret string
+	Event  string
+	// DeliveryID defaults to a random ID
+	DeliveryID string
+	Payload    []byte
+}
+
+// SendWebhookResult represents the response to a sample delivery
+type SendWebhookResult struct {
+	DeliveryID string `json:"deliveryId"`
+	Status     string `json:"status"`
+	Body       string `json:"body"`
+	StatusCode int    `json:"statusCode"`
+}
+
+// SendWebhook signs a payload and posts it to a webhook receiver the way GitHub
+// delivers events
+func SendWebhook(ctx context.Context, input SendWebhookInput) (*SendWebhookResult, error) {
+	deliveryID := input.DeliveryID
+	if deliveryID == "" {
+		id := make([]byte, webhookDeliveryIDBytes)
+		if _, err := rand.Read(id); err != nil {
+			return nil, fmt.Errorf("failed to generate delivery ID: %w", err)
+		}
+		deliveryID = hex.EncodeToString(id)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, input.URL, bytes.NewReader(input.Payload))
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookHeaderEvent, input.Event)
+	req.Header.Set(WebhookHeaderDelivery, deliveryID)
+	req.Header.Set(WebhookHeaderSignature, SignWebhookPayload(input.Secret, input.Payload))
+
+	resp, err := (&http.Client{Timeout: webhookSendTimeout}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response: %w", err)
+	}
+
+	return &SendWebhookResult{
+		DeliveryID: deliveryID,
+		Status:     resp.Status,
+		StatusCode: resp.StatusCode,
+		Body:       strings.TrimSpace(string(body)),
+	}, nil
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/roboco-io/ghx-cli/internal/api"
+	"github.com/roboco-io/ghx-cli/internal/api/fake"
+)
+
+func TestParseWebhookConfig(t *testing.T) {
+	config, err := ParseWebhookConfig([]byte(`
+secret: s3cret
+handlers:
+  - events: [issues]
+    actions: [opened]
+    run: cat
+  - name: review
+    events: [pull_request]
+    setField:
+      project: octocat/1
+      field: Status
+      value: In Review
+`))
+	require.NoError(t, err)
+	require.Len(t, config.Handlers, 2)
+	assert.Equal(t, "handler 1", config.Handlers[0].Name)
+	assert.Equal(t, "In Review", config.Handlers[1].SetField.Value)
+	assert.True(t, config.NeedsAPI())
+
+	_, err = ParseWebhookConfig([]byte("handlers:\n  - events: [push]\n    run: cat\n"))
+	assert.ErrorContains(t, err, "handler 1: unsupported event push")
+
+	_, err = ParseWebhookConfig([]byte("handlers:\n  - events: [issues]\n    run: cat\n    addToProject: octocat/1\n"))
+	assert.ErrorContains(t, err, "set exactly one of run, setField and addToProject")
+
+	_, err = ParseWebhookConfig([]byte("handlers:\n  - event: [issues]\n"))
+	assert.ErrorContains(t, err, "field event not found")
+
+	_, err = ParseWebhookConfig([]byte("secret: s3cret\n"))
+	assert.ErrorContains(t, err, "no handlers configured")
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	payload := []byte(`{"action":"opened"}`)
+	signature := SignWebhookPayload("s3cret", payload)
+	assert.Regexp(t, "^sha256=[0-9a-f]{64}$", signature)
+
+	require.NoError(t, VerifyWebhookSignature("s3cret", payload, signature))
+	assert.ErrorContains(t, VerifyWebhookSignature("other", payload, signature), "does not match")
+	assert.ErrorContains(t, VerifyWebhookSignature("s3cret", payload, ""), "missing X-Hub-Signature-256")
+}
+
+func TestWebhookReceiverAgainstFake(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	t.Setenv(api.EnvAPIURL, server.URL)
+
+	store := server.Store
+	repo := store.AddRepository(fake.DefaultViewer, "app")
+	project := store.AddProject(fake.DefaultViewer, "Roadmap")
+	status := project.Field("Status")
+	issue := store.AddIssue(repo, "Fix login")
+
+	out := filepath.Join(t.TempDir(), "events.log")
+	config, err := ParseWebhookConfig([]byte(fmt.Sprintf(`
+handlers:
+  - name: log
+    events: [issues, discussion, pull_request]
+    run: 'echo "$GHX_EVENT $GHX_ACTION $(wc -c)" >> %s'
+  - name: intake
+    events: [issues]
+    actions: [opened]
+    addToProject: %s/%d
+  - name: start
+    events: [projects_v2_item]
+    actions: [created]
+    setField:
+      project: %[2]s/%[3]d
+      field: Status
+      value: In Progress
+  - name: finish
+    events: [projects_v2_item]
+    actions: [edited]
+    setField:
+      project: %[2]s/%[3]d
+      field: Status
+      value: Done
+  - name: fail
+    events: [pull_request]
+    run: echo boom >&2; exit 3
+`, out, fake.DefaultViewer, project.Number)))
+	require.NoError(t, err)
+
+	var logs bytes.Buffer
+	ctx := context.Background()
+	receiver, err := NewWebhookReceiver(ctx, api.NewClient("ghp_fake"), config, "s3cret", slog.New(slog.NewJSONHandler(&logs, nil)))
+	require.NoError(t, err)
+	hooks := httptest.NewServer(receiver)
+	defer hooks.Close()
+
+	// send delivers an event and waits for its handlers
+	send := func(event, delivery, payload string) *SendWebhookResult {
+		t.Helper()
+		result, err := SendWebhook(ctx, SendWebhookInput{
+			URL: hooks.URL, Secret: "s3cret", Event: event, DeliveryID: delivery, Payload: []byte(payload),
+		})
+		require.NoError(t, err)
+		receiver.Wait()
+		return result
+	}
+
+	t.Run("Runs commands and adds issues to the project", func(t *testing.T) {
+		payload := fmt.Sprintf(`{"action":"opened","issue":{"node_id":%q}}`, issue.ID)
+		result := send("issues", "d-1", payload)
+		assert.Equal(t, http.StatusAccepted, result.StatusCode)
+		assert.Equal(t, "accepted", result.Body)
+
+		require.Len(t, project.Items, 1)
+		assert.Same(t, issue, project.Items[0].Issue)
+		data, err := os.ReadFile(out)
+		require.NoError(t, err)
+		assert.Equal(t, fmt.Sprintf("issues opened %d\n", len(payload)), string(data))
+		assert.Contains(t, logs.String(), `"handler":"intake"`)
+		assert.Contains(t, logs.String(), `"delivery":"d-1"`)
+	})
+
+	t.Run("Skips duplicate deliveries", func(t *testing.T) {
+		result := send("issues", "d-1", `{"action":"closed","issue":{"node_id":"I_x"}}`)
+		assert.Equal(t, "duplicate", result.Body)
+		data, err := os.ReadFile(out)
+		require.NoError(t, err)
+		assert.NotContains(t, string(data), "closed")
+	})
+
+	t.Run("Sets a field on the item of a project item event", func(t *testing.T) {
+		item := project.Items[0]
+		result := send("projects_v2_item", "d-2", fmt.Sprintf(
+			`{"action":"created","projects_v2_item":{"node_id":%q,"project_node_id":%q,"content_node_id":%q}}`,
+			item.ID, project.ID, issue.ID))
+		assert.Equal(t, http.StatusAccepted, result.StatusCode)
+		assert.Equal(t, status.Option("In Progress").ID, item.Values[status.ID].OptionID)
+	})
+
+	t.Run("Does not run setField handlers for their own edits", func(t *testing.T) {
+		item := project.Items[0]
+		edited := fmt.Sprintf(
+			`{"action":"edited","projects_v2_item":{"node_id":%q,"project_node_id":%q,"content_node_id":%q},`+
+				`"changes":{"field_value":{"field_node_id":%q}}}`,
+			item.ID, project.ID, issue.ID, status.ID)
+
+		// The edit made by the start handler
+		send("projects_v2_item", "d-edit-1", edited)
+		assert.Equal(t, status.Option("In Progress").ID, item.Values[status.ID].OptionID)
+		assert.Contains(t, logs.String(), "skipped edit made by a handler")
+
+		// A later edit by someone else
+		send("projects_v2_item", "d-edit-2", edited)
+		assert.Equal(t, status.Option("Done").ID, item.Values[status.ID].OptionID)
+	})
+
+	t.Run("Logs failed handlers and reruns only those on redelivery", func(t *testing.T) {
+		payload := `{"action":"opened","pull_request":{"node_id":"PR_x"}}`
+		result := send("pull_request", "d-3", payload)
+		assert.Equal(t, http.StatusAccepted, result.StatusCode)
+		assert.Contains(t, logs.String(), "command failed: exit status 3: boom")
+
+		result = send("pull_request", "d-3", payload)
+		assert.Equal(t, http.StatusAccepted, result.StatusCode)
+		assert.Equal(t, 2, strings.Count(logs.String(), "exit status 3"))
+		assert.Contains(t, logs.String(), "skipped handler that already succeeded")
+
+		data, err := os.ReadFile(out)
+		require.NoError(t, err)
+		assert.Equal(t, 1, strings.Count(string(data), "pull_request opened"))
+	})
+
+	t.Run("Rejects invalid signatures and acknowledges pings", func(t *testing.T) {
+		result, err := SendWebhook(ctx, SendWebhookInput{URL: hooks.URL, Secret: "wrong", Event: "issues", Payload: []byte(`{}`)})
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusUnauthorized, result.StatusCode)
+		assert.Contains(t, logs.String(), "signature does not match")
+
+		assert.Equal(t, "pong", send("ping", "", `{"zen":"Keep it simple."}`).Body)
+		assert.Equal(t, "ignored", send("push", "", `{}`).Body)
+
+		resp, err := http.Get(hooks.URL)
+		require.NoError(t, err)
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+
+	t.Run("Checks handlers up front", func(t *testing.T) {
+		_, err := NewWebhookReceiver(ctx, api.NewClient("ghp_fake"), config, "", slog.Default())
+		assert.ErrorContains(t, err, "a webhook secret is required")
+
+		bad, err := ParseWebhookConfig([]byte(fmt.Sprintf(
+			"handlers:\n  - events: [issues]\n    setField: {project: %s/%d, field: Status, value: Blocked}\n",
+			fake.DefaultViewer, project.Number)))
+		require.NoError(t, err)
+		_, err = NewWebhookReceiver(ctx, api.NewClient("ghp_fake"), bad, "s3cret", slog.Default())
+		assert.ErrorContains(t, err, "handler 1:")
+
+		_, err = NewWebhookReceiver(ctx, nil, config, "s3cret", slog.Default())
+		assert.ErrorContains(t, err, "intake: changing projects requires authentication")
+	})
+}